@@ -8,15 +8,20 @@ import (
 	"io/fs"
 	stdlog "log"
 	"net/http"
+	"net/netip"
 	"net/url"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/appconstant"
 	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/notification"
+	"github.com/JosephJoshua/remana-backend/internal/modules/photo"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/projectpath"
 	"github.com/go-playground/validator/v10"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -31,12 +36,27 @@ const (
 	ReadTimeout       = 30 * time.Second
 	WriteTimeout      = 30 * time.Second
 	ShutdownTimeout   = 10 * time.Second
+
+	OrderEventDispatchInterval   = 2 * time.Second
+	NotificationDispatchInterval = 15 * time.Second
+	WebhookDispatchInterval      = 5 * time.Second
+	SessionCleanupInterval       = 10 * time.Minute
+	LoginThrottleCleanupInterval = 10 * time.Minute
 )
 
-func Run(ctx context.Context, db *pgxpool.Pool, addr string, certPEM string, keyPEM string) error {
+func Run(
+	ctx context.Context,
+	db *pgxpool.Pool,
+	addr string,
+	certPEM string,
+	keyPEM string,
+	notifier optional.Optional[notification.Notifier],
+	blobStore photo.BlobStore,
+	trustedProxies []netip.Prefix,
+) error {
 	log := logger.MustGet()
 
-	srv, middlewares, err := core.NewAPIServer(db)
+	srv, middlewares, err := core.NewAPIServer(db, blobStore, trustedProxies)
 	if err != nil {
 		return fmt.Errorf("error creating server: %w", err)
 	}
@@ -86,6 +106,16 @@ func Run(ctx context.Context, db *pgxpool.Pool, addr string, certPEM string, key
 	signalCtx, stop := signal.NotifyContext(ctx, os.Interrupt, syscall.SIGINT, syscall.SIGTERM)
 	defer stop()
 
+	go core.NewOrderEventDispatcher(db).Run(log.WithContext(signalCtx), OrderEventDispatchInterval)
+	go core.NewWebhookDispatcher(db).Run(log.WithContext(signalCtx), WebhookDispatchInterval)
+	go core.NewSessionCleaner(db).Run(log.WithContext(signalCtx), SessionCleanupInterval)
+	go core.NewLoginThrottleCleaner(db).Run(log.WithContext(signalCtx), LoginThrottleCleanupInterval)
+
+	if n, ok := notifier.Get(); ok {
+		dispatcher := core.NewNotificationDispatcher(db, n)
+		go dispatcher.Run(log.WithContext(signalCtx), NotificationDispatchInterval)
+	}
+
 	select {
 	case err = <-listenErr:
 		return err
@@ -132,6 +162,102 @@ type appConfig struct {
 	ConnString   string             `mapstructure:"remana_conn_string"    validate:"required"`
 	CertFilePath string             `mapstructure:"remana_cert_file_path" validate:"required"`
 	KeyFilePath  string             `mapstructure:"remana_key_file_path"  validate:"required"`
+
+	// TrustedProxies is a comma-separated list of addresses or CIDR ranges of
+	// the reverse proxies in front of the server, whose X-Forwarded-For is used
+	// to find the client IP.
+	TrustedProxies string `mapstructure:"remana_trusted_proxies"`
+
+	NotificationChannel      string `mapstructure:"remana_notification_channel"       validate:"omitempty,oneof=webhook smtp"`
+	NotificationWebhookURL   string `mapstructure:"remana_notification_webhook_url"   validate:"required_if=NotificationChannel webhook,omitempty,url"`
+	NotificationWebhookToken string `mapstructure:"remana_notification_webhook_token"`
+	SMTPAddr                 string `mapstructure:"remana_smtp_addr"                  validate:"required_if=NotificationChannel smtp,omitempty,hostname_port"`
+	SMTPFrom                 string `mapstructure:"remana_smtp_from"                  validate:"required_if=NotificationChannel smtp,omitempty,email"`
+	SMTPRecipientDomain      string `mapstructure:"remana_smtp_recipient_domain"      validate:"required_if=NotificationChannel smtp,omitempty,fqdn"`
+	SMTPSubject              string `mapstructure:"remana_smtp_subject"`
+	SMTPUsername             string `mapstructure:"remana_smtp_username"`
+	SMTPPassword             string `mapstructure:"remana_smtp_password"`
+
+	BlobStore         string `mapstructure:"remana_blob_store"            validate:"required,oneof=local s3"`
+	BlobStoreDir      string `mapstructure:"remana_blob_store_dir"        validate:"required_if=BlobStore local"`
+	S3Endpoint        string `mapstructure:"remana_s3_endpoint"           validate:"required_if=BlobStore s3,omitempty,url"`
+	S3Region          string `mapstructure:"remana_s3_region"             validate:"required_if=BlobStore s3"`
+	S3Bucket          string `mapstructure:"remana_s3_bucket"             validate:"required_if=BlobStore s3"`
+	S3AccessKeyID     string `mapstructure:"remana_s3_access_key_id"      validate:"required_if=BlobStore s3"`
+	S3SecretAccessKey string `mapstructure:"remana_s3_secret_access_key"  validate:"required_if=BlobStore s3"`
+}
+
+// notifier returns the channel customer notifications are sent through, if any.
+func (c appConfig) notifier() optional.Optional[notification.Notifier] {
+	switch c.NotificationChannel {
+	case "webhook":
+		return optional.Some[notification.Notifier](
+			core.NewWebhookNotifier(c.NotificationWebhookURL, c.NotificationWebhookToken),
+		)
+	case "smtp":
+		return optional.Some[notification.Notifier](core.NewSMTPNotifier(core.SMTPNotifierConfig{
+			Addr:            c.SMTPAddr,
+			From:            c.SMTPFrom,
+			RecipientDomain: c.SMTPRecipientDomain,
+			Subject:         c.SMTPSubject,
+			Username:        c.SMTPUsername,
+			Password:        c.SMTPPassword,
+		}))
+	default:
+		return optional.None[notification.Notifier]()
+	}
+}
+
+// blobStore returns where uploaded photos are kept.
+func (c appConfig) blobStore() (photo.BlobStore, error) {
+	if c.BlobStore == "s3" {
+		return core.NewS3BlobStore(core.S3BlobStoreConfig{
+			Endpoint:        c.S3Endpoint,
+			Region:          c.S3Region,
+			Bucket:          c.S3Bucket,
+			AccessKeyID:     c.S3AccessKeyID,
+			SecretAccessKey: c.S3SecretAccessKey,
+		})
+	}
+
+	dir, err := url.JoinPath(projectpath.Root(), c.BlobStoreDir)
+	if err != nil {
+		return nil, fmt.Errorf("error joining blob store dir: %w", err)
+	}
+
+	return core.NewLocalBlobStore(dir)
+}
+
+// trustedProxies returns the parsed address ranges of TrustedProxies. A single
+// address is treated as a range containing only that address.
+func (c appConfig) trustedProxies() ([]netip.Prefix, error) {
+	var prefixes []netip.Prefix
+
+	for _, entry := range strings.Split(c.TrustedProxies, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		if !strings.Contains(entry, "/") {
+			addr, err := netip.ParseAddr(entry)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing trusted proxy %q: %w", entry, err)
+			}
+
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(entry)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing trusted proxy %q: %w", entry, err)
+		}
+
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
 }
 
 func loadConfig() (appConfig, error) {
@@ -141,6 +267,23 @@ func loadConfig() (appConfig, error) {
 	viper.SetDefault("remana_app_env", "production")
 	viper.SetDefault("remana_cert_file_path", "server.crt")
 	viper.SetDefault("remana_key_file_path", "server.key")
+	viper.SetDefault("remana_trusted_proxies", "")
+	viper.SetDefault("remana_notification_channel", "")
+	viper.SetDefault("remana_notification_webhook_url", "")
+	viper.SetDefault("remana_notification_webhook_token", "")
+	viper.SetDefault("remana_smtp_addr", "localhost:25")
+	viper.SetDefault("remana_smtp_from", "")
+	viper.SetDefault("remana_smtp_recipient_domain", "")
+	viper.SetDefault("remana_smtp_subject", "Remana")
+	viper.SetDefault("remana_smtp_username", "")
+	viper.SetDefault("remana_smtp_password", "")
+	viper.SetDefault("remana_blob_store", "local")
+	viper.SetDefault("remana_blob_store_dir", "data/blobs")
+	viper.SetDefault("remana_s3_endpoint", "")
+	viper.SetDefault("remana_s3_region", "")
+	viper.SetDefault("remana_s3_bucket", "")
+	viper.SetDefault("remana_s3_access_key_id", "")
+	viper.SetDefault("remana_s3_secret_access_key", "")
 
 	viper.AutomaticEnv()
 
@@ -212,7 +355,30 @@ func main() {
 		l.Panic().Err(err).Msg("error reading key file")
 	}
 
-	if err = Run(ctx, pool, config.ServerAddr, string(certPEM), string(keyPEM)); err != nil {
+	if config.NotificationChannel == "" {
+		l.Warn().Msg("no notification channel configured, customer notifications will stay queued")
+	}
+
+	blobStore, err := config.blobStore()
+	if err != nil {
+		l.Panic().Err(err).Msg("error creating blob store")
+	}
+
+	trustedProxies, err := config.trustedProxies()
+	if err != nil {
+		l.Panic().Err(err).Msg("error parsing trusted proxies")
+	}
+
+	if err = Run(
+		ctx,
+		pool,
+		config.ServerAddr,
+		string(certPEM),
+		string(keyPEM),
+		config.notifier(),
+		blobStore,
+		trustedProxies,
+	); err != nil {
 		l.Panic().Err(err).Msg("error running app")
 	}
 }
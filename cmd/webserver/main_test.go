@@ -4,7 +4,11 @@
 package main_test
 
 import (
+	"bytes"
 	"context"
+	"image"
+	"image/color"
+	"image/png"
 	"net/http"
 	"net/url"
 	"strings"
@@ -19,6 +23,7 @@ import (
 	"github.com/gavv/httpexpect/v2"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/pquerna/otp/totp"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
@@ -151,6 +156,381 @@ func TestAuthnFlow(t *testing.T) {
 		Status(http.StatusUnauthorized)
 }
 
+func TestSessionRevocationFlow(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	const (
+		theAdminUsername    = "admin"
+		theAdminPassword    = "Password123"
+		theEmployeeUsername = "employee"
+		theEmployeePassword = "Password123"
+		theStoreCode        = "store-one"
+		theLoginCode        = "A1B2C3D4"
+	)
+
+	db := setupTest(t)
+
+	seedAuthnFlow(
+		t,
+		db,
+		theAdminUsername,
+		mustHashPassword(t, theAdminPassword),
+		theEmployeeUsername,
+		mustHashPassword(t, theEmployeePassword),
+		theStoreCode,
+		theLoginCode,
+	)
+
+	addr := runServer(context.Background(), t, db)
+	waitForReady(context.Background(), t, createHTTPClient(t), addr, 5*time.Second)
+
+	// Each client has its own cookie jar, so each one is a separate device.
+	newDevice := func() *httpexpect.Expect {
+		client := createHTTPClient(t)
+
+		return httpexpect.WithConfig(httpexpect.Config{
+			Reporter: httpexpect.NewFatalReporter(t),
+			Client:   &client,
+			BaseURL: (&url.URL{
+				Scheme: "https",
+				Host:   addr,
+			}).String(),
+		})
+	}
+
+	loginAsAdmin := func(e *httpexpect.Expect) {
+		e.POST("/auth/login").WithName("login as admin").
+			WithJSON(map[string]interface{}{
+				"username":   theAdminUsername,
+				"password":   theAdminPassword,
+				"store_code": theStoreCode,
+			}).
+			Expect().
+			Status(http.StatusOK)
+	}
+
+	adminLaptop := newDevice()
+	adminPhone := newDevice()
+
+	loginAsAdmin(adminLaptop)
+	loginAsAdmin(adminPhone)
+
+	sessions := adminLaptop.GET("/users/me/sessions").WithName("list admin sessions").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("items").Array()
+
+	sessions.Length().IsEqual(2)
+	sessions.Filter(func(_ int, value *httpexpect.Value) bool {
+		return value.Object().Value("is_current").Boolean().Raw()
+	}).Length().IsEqual(1)
+
+	adminLaptop.POST("/users/me/sessions/revoke-others").WithName("log out other devices").
+		Expect().
+		Status(http.StatusNoContent)
+
+	adminPhone.GET("/users/me").WithName("verify other device is logged out").
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	adminLaptop.GET("/users/me").WithName("verify current device is still logged in").
+		Expect().
+		Status(http.StatusOK)
+
+	employeeDevice := newDevice()
+
+	employeeDevice.POST("/auth/login").WithName("log in as employee").
+		WithJSON(map[string]interface{}{
+			"username":   theEmployeeUsername,
+			"password":   theEmployeePassword,
+			"store_code": theStoreCode,
+		}).
+		Expect().
+		Status(http.StatusOK)
+
+	employeeDevice.POST("/auth/login-code").WithName("supply employee login code").
+		WithJSON(map[string]interface{}{
+			"login_code": theLoginCode,
+		}).
+		Expect().
+		Status(http.StatusNoContent)
+
+	employeeID := employeeDevice.GET("/users/me").WithName("get employee details").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().Value("id").String().Raw()
+
+	employeeDevice.DELETE("/users/{userId}/sessions", employeeID).
+		WithName("employee cannot revoke sessions without permission").
+		Expect().
+		Status(http.StatusForbidden)
+
+	adminLaptop.DELETE("/users/{userId}/sessions", employeeID).
+		WithName("admin revokes employee sessions").
+		Expect().
+		Status(http.StatusNoContent)
+
+	employeeDevice.GET("/users/me").WithName("verify employee is logged out").
+		Expect().
+		Status(http.StatusUnauthorized)
+}
+
+func TestTOTPFlow(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	const (
+		theAdminUsername    = "admin"
+		theAdminPassword    = "Password123"
+		theEmployeeUsername = "employee"
+		theEmployeePassword = "Password123"
+		theStoreCode        = "store-one"
+		theLoginCode        = "A1B2C3D4"
+	)
+
+	db := setupTest(t)
+
+	seedAuthnFlow(
+		t,
+		db,
+		theAdminUsername,
+		mustHashPassword(t, theAdminPassword),
+		theEmployeeUsername,
+		mustHashPassword(t, theEmployeePassword),
+		theStoreCode,
+		theLoginCode,
+	)
+
+	addr := runServer(context.Background(), t, db)
+	waitForReady(context.Background(), t, createHTTPClient(t), addr, 5*time.Second)
+
+	client := createHTTPClient(t)
+	e := httpexpect.WithConfig(httpexpect.Config{
+		Reporter: httpexpect.NewFatalReporter(t),
+		Client:   &client,
+		BaseURL: (&url.URL{
+			Scheme: "https",
+			Host:   addr,
+		}).String(),
+	})
+
+	login := func(username, password string) *httpexpect.Value {
+		return e.POST("/auth/login").WithName("log in as " + username).
+			WithJSON(map[string]interface{}{
+				"username":   username,
+				"password":   password,
+				"store_code": theStoreCode,
+			}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().Object().Value("type")
+	}
+
+	enableTOTP := func() []string {
+		secret := e.POST("/users/me/totp").WithName("start TOTP enrollment").
+			Expect().
+			Status(http.StatusCreated).
+			JSON().Object().Value("secret").String().Raw()
+
+		code, err := totp.GenerateCode(secret, time.Now())
+		require.NoError(t, err)
+
+		e.POST("/users/me/totp/confirm").WithName("confirm with wrong code").
+			WithJSON(map[string]interface{}{"code": "000000"}).
+			Expect().
+			Status(http.StatusBadRequest)
+
+		raw := e.POST("/users/me/totp/confirm").WithName("confirm TOTP enrollment").
+			WithJSON(map[string]interface{}{"code": code}).
+			Expect().
+			Status(http.StatusOK).
+			JSON().Object().Value("recovery_codes").Array().Raw()
+
+		codes := make([]string, 0, len(raw))
+		for _, c := range raw {
+			codes = append(codes, c.(string))
+		}
+
+		return codes
+	}
+
+	logout := func() {
+		e.POST("/auth/logout").WithName("log out").
+			Expect().
+			Status(http.StatusResetContent)
+	}
+
+	login(theAdminUsername, theAdminPassword).IsEqual("admin")
+	recoveryCodes := enableTOTP()
+	require.Len(t, recoveryCodes, 10)
+	logout()
+
+	login(theAdminUsername, theAdminPassword).IsEqual("admin_totp")
+
+	e.GET("/users/me").WithName("verify admin needs a second factor").
+		Expect().
+		Status(http.StatusUnauthorized)
+
+	e.POST("/auth/login-code").WithName("log in with recovery code").
+		WithJSON(map[string]interface{}{"login_code": recoveryCodes[0]}).
+		Expect().
+		Status(http.StatusNoContent)
+
+	e.GET("/users/me/totp").WithName("get TOTP status").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		HasValue("enabled", true).
+		HasValue("remaining_recovery_codes", 9)
+
+	logout()
+
+	login(theAdminUsername, theAdminPassword).IsEqual("admin_totp")
+
+	e.POST("/auth/login-code").WithName("reuse recovery code").
+		WithJSON(map[string]interface{}{"login_code": recoveryCodes[0]}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	logout()
+
+	login(theEmployeeUsername, theEmployeePassword).IsEqual("employee")
+
+	e.POST("/auth/login-code").WithName("log in as employee with login code").
+		WithJSON(map[string]interface{}{"login_code": theLoginCode}).
+		Expect().
+		Status(http.StatusNoContent)
+
+	employeeRecoveryCodes := enableTOTP()
+
+	e.DELETE("/users/me/totp").WithName("disable TOTP with wrong code").
+		WithJSON(map[string]interface{}{"code": "000000"}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	e.DELETE("/users/me/totp").WithName("disable TOTP with recovery code").
+		WithJSON(map[string]interface{}{"code": employeeRecoveryCodes[0]}).
+		Expect().
+		Status(http.StatusNoContent)
+
+	e.GET("/users/me/totp").WithName("verify TOTP is disabled").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		HasValue("enabled", false)
+}
+
+func TestLoginLockoutFlow(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	const (
+		theAdminUsername    = "admin"
+		theAdminPassword    = "Password123"
+		theEmployeeUsername = "employee"
+		theEmployeePassword = "Password123"
+		theStoreCode        = "store-one"
+		theLoginCode        = "A1B2C3D4"
+	)
+
+	db := setupTest(t)
+
+	seedAuthnFlow(
+		t,
+		db,
+		theAdminUsername,
+		mustHashPassword(t, theAdminPassword),
+		theEmployeeUsername,
+		mustHashPassword(t, theEmployeePassword),
+		theStoreCode,
+		theLoginCode,
+	)
+
+	employee, err := gensql.New(db).GetUserByUsernameAndStoreCode(
+		context.Background(),
+		gensql.GetUserByUsernameAndStoreCodeParams{
+			Username:  theEmployeeUsername,
+			StoreCode: theStoreCode,
+		},
+	)
+	require.NoError(t, err)
+
+	employeeID := typemapper.MustPgtypeUUIDToUUID(employee.UserID).String()
+
+	addr := runServer(context.Background(), t, db)
+	waitForReady(context.Background(), t, createHTTPClient(t), addr, 5*time.Second)
+
+	newDevice := func() *httpexpect.Expect {
+		client := createHTTPClient(t)
+
+		return httpexpect.WithConfig(httpexpect.Config{
+			Reporter: httpexpect.NewFatalReporter(t),
+			Client:   &client,
+			BaseURL: (&url.URL{
+				Scheme: "https",
+				Host:   addr,
+			}).String(),
+		})
+	}
+
+	employeeDevice := newDevice()
+
+	employeeDevice.POST("/auth/login").WithName("log in as employee").
+		WithJSON(map[string]interface{}{
+			"username":   theEmployeeUsername,
+			"password":   theEmployeePassword,
+			"store_code": theStoreCode,
+		}).
+		Expect().
+		Status(http.StatusOK)
+
+	for range 3 {
+		employeeDevice.POST("/auth/login-code").WithName("supply incorrect login code").
+			WithJSON(map[string]interface{}{
+				"login_code": "12345678",
+			}).
+			Expect().
+			Status(http.StatusBadRequest)
+	}
+
+	employeeDevice.POST("/auth/login-code").WithName("correct login code is throttled").
+		WithJSON(map[string]interface{}{
+			"login_code": theLoginCode,
+		}).
+		Expect().
+		Status(http.StatusTooManyRequests)
+
+	adminDevice := newDevice()
+
+	adminDevice.POST("/auth/login").WithName("login as admin").
+		WithJSON(map[string]interface{}{
+			"username":   theAdminUsername,
+			"password":   theAdminPassword,
+			"store_code": theStoreCode,
+		}).
+		Expect().
+		Status(http.StatusOK)
+
+	adminDevice.DELETE("/users/{userId}/login-lockout", employeeID).WithName("admin unlocks employee login").
+		Expect().
+		Status(http.StatusNoContent)
+
+	employeeDevice.POST("/auth/login-code").WithName("supply correct login code after unlock").
+		WithJSON(map[string]interface{}{
+			"login_code": theLoginCode,
+		}).
+		Expect().
+		Status(http.StatusNoContent)
+
+	employeeDevice.GET("/users/me").WithName("verify employee is logged in").
+		Expect().
+		Status(http.StatusOK)
+}
+
 func TestCreateRepairOrderFlow(t *testing.T) {
 	t.Parallel()
 
@@ -296,7 +676,40 @@ func TestCreateRepairOrderFlow(t *testing.T) {
 		Expect().
 		Status(http.StatusConflict)
 
-	e.POST("/repair-orders").WithName("create minimal repair order").
+	uploadPhoto := func(name string) string {
+		photo := e.POST("/photos").WithName(name).
+			WithMultipart().
+			WithFile("file", "photo.png", bytes.NewReader(newTestPNG(t))).
+			Expect().
+			Status(http.StatusCreated).
+			JSON().Object()
+
+		photo.Value("content_type").String().IsEqual("image/png")
+		photo.Value("has_thumbnail").Boolean().IsTrue()
+
+		return photo.Value("id").String().Raw()
+	}
+
+	firstPhotoID := uploadPhoto("upload first photo")
+	secondPhotoID := uploadPhoto("upload second photo")
+
+	e.POST("/photos").WithName("upload photo that is not an image").
+		WithMultipart().
+		WithFile("file", "photo.png", strings.NewReader("definitely not an image")).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	e.GET("/photos/{photoId}", firstPhotoID).WithName("get photo").
+		Expect().
+		Status(http.StatusOK).
+		ContentType("image/png")
+
+	e.GET("/photos/{photoId}/thumbnail", firstPhotoID).WithName("get photo thumbnail").
+		Expect().
+		Status(http.StatusOK).
+		ContentType("image/jpeg")
+
+	minimalRepairOrderLocation := e.POST("/repair-orders").WithName("create minimal repair order").
 		WithJSON(map[string]interface{}{
 			"customer_name":        "John Doe",
 			"contact_phone_number": "+6281234567890",
@@ -306,13 +719,16 @@ func TestCreateRepairOrderFlow(t *testing.T) {
 			"sales_person_id":      salesPersonID,
 			"technician_id":        technicianID,
 			"damage_types":         []string{damageTypeID},
-			"photos":               []string{"https://example.com/photo1.jpg", "https://example.com/photo2.jpg"},
+			"photos":               []string{firstPhotoID, secondPhotoID},
 		}).
 		Expect().
 		Status(http.StatusCreated).
-		Header("Location").NotEmpty()
+		Header("Location").NotEmpty().Raw()
+
+	parts = strings.Split(minimalRepairOrderLocation, "/")
+	minimalRepairOrderID := parts[len(parts)-1]
 
-	e.POST("/repair-orders").WithName("create full repair order").
+	repairOrderLocation := e.POST("/repair-orders").WithName("create full repair order").
 		WithJSON(map[string]interface{}{
 			"customer_name":         "John Doe",
 			"contact_phone_number":  "+6281234567890",
@@ -329,16 +745,276 @@ func TestCreateRepairOrderFlow(t *testing.T) {
 				"amount": 50000,
 				"method": paymentMethodID,
 			},
-			"sales_person_id":  salesPersonID,
-			"technician_id":    technicianID,
-			"damage_types":     []string{damageTypeID},
-			"phone_conditions": []string{phoneConditionID},
-			"phone_equipments": []string{phoneEquipmentID},
-			"photos":           []string{"https://example.com/photo1.jpg", "https://example.com/photo2.jpg"},
+			"sales_person_id":           salesPersonID,
+			"technician_id":             technicianID,
+			"damage_types":              []string{damageTypeID},
+			"phone_conditions":          []string{phoneConditionID},
+			"phone_equipments":          []string{phoneEquipmentID},
+			"photos":                    []string{firstPhotoID, secondPhotoID},
+			"estimated_completion_time": "2099-01-02T03:04:05Z",
 		}).
 		Expect().
 		Status(http.StatusCreated).
-		Header("Location").NotEmpty()
+		Header("Location").NotEmpty().Raw()
+
+	parts = strings.Split(repairOrderLocation, "/")
+	repairOrderID := parts[len(parts)-1]
+
+	repairOrder := e.GET("/repair-orders/{repairOrderId}", repairOrderID).WithName("get repair order").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	repairOrder.Value("id").String().IsEqual(repairOrderID)
+	repairOrder.Value("imei").String().IsEqual("123456789012345")
+	repairOrder.Value("costs").Array().Length().IsEqual(1)
+	repairOrder.Value("damages").Array().Length().IsEqual(1)
+	repairOrder.Value("phone_conditions").Array().Length().IsEqual(1)
+	repairOrder.Value("phone_equipments").Array().Length().IsEqual(1)
+	repairOrder.Value("photos").Array().Length().IsEqual(2)
+	repairOrder.Value("payments").Array().Length().IsEqual(1)
+	repairOrder.Value("payments").Array().Value(0).Object().Value("type").String().IsEqual("down_payment")
+	repairOrder.Value("paid_amount").Number().IsEqual(50000)
+
+	slug := repairOrder.Value("slug").String().NotEmpty().Raw()
+
+	e.GET("/repair-orders/by-slug/{slug}", slug).WithName("get repair order by slug").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		Value("id").String().IsEqual(repairOrderID)
+
+	publicRepairOrder := e.GET("/public/orders/{slug}", slug).WithName("track repair order").
+		WithQuery("phone_last_four", "7890").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	publicRepairOrder.Value("status").String().IsEqual("open")
+	publicRepairOrder.Value("estimated_completion_time").String().IsEqual("2099-01-02T03:04:05Z")
+	publicRepairOrder.Value("outstanding_amount").Number().IsEqual(50000)
+	publicRepairOrder.NotContainsKey("imei")
+	publicRepairOrder.NotContainsKey("passcode")
+
+	e.GET("/public/orders/{slug}", slug).WithName("track repair order with wrong phone number").
+		WithQuery("phone_last_four", "0000").
+		Expect().
+		Status(http.StatusNotFound)
+
+	repairOrders := e.GET("/repair-orders").WithName("list repair orders").
+		WithQuery("q", "john").
+		WithQuery("limit", 1).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	repairOrders.Value("total_count").Number().IsEqual(2)
+	repairOrders.Value("items").Array().Length().IsEqual(1)
+	repairOrders.Value("items").Array().Value(0).Object().Value("id").String().IsEqual(repairOrderID)
+
+	nextCursor := repairOrders.Value("next_cursor").String().NotEmpty().Raw()
+
+	e.GET("/repair-orders").WithName("list next page of repair orders").
+		WithQuery("q", "john").
+		WithQuery("limit", 1).
+		WithQuery("cursor", nextCursor).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		NotContainsKey("next_cursor").
+		Value("items").Array().Length().IsEqual(1)
+
+	e.GET("/repair-orders/{repairOrderId}", uuid.NewString()).WithName("get non-existent repair order").
+		Expect().
+		Status(http.StatusNotFound)
+
+	e.POST("/repair-orders/{repairOrderId}/pick-up", repairOrderID).WithName("pick up unfinished repair order").
+		Expect().
+		Status(http.StatusConflict)
+
+	addedCost := e.POST("/repair-orders/{repairOrderId}/costs", repairOrderID).WithName("add repair order cost").
+		WithJSON(map[string]interface{}{
+			"amount": 20000,
+			"reason": "Replaced the battery as well",
+		}).
+		Expect().
+		Status(http.StatusCreated).
+		JSON().Object()
+
+	addedCost.Value("costs").Array().Length().IsEqual(2)
+	addedCost.Value("total_cost").Number().IsEqual(120000)
+	addedCost.Value("outstanding_amount").Number().IsEqual(70000)
+
+	e.POST("/repair-orders/{repairOrderId}/costs", repairOrderID).WithName("add cost below paid amount").
+		WithJSON(map[string]interface{}{
+			"amount": -100000,
+			"reason": "Discount",
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	e.POST("/repair-orders/{repairOrderId}/payments", repairOrderID).WithName("record partial payment").
+		WithJSON(map[string]interface{}{
+			"type":   "partial",
+			"amount": 20000,
+			"method": paymentMethodID,
+		}).
+		Expect().
+		Status(http.StatusCreated).
+		JSON().Object().
+		Value("outstanding_amount").Number().IsEqual(50000)
+
+	e.POST("/repair-orders/{repairOrderId}/payments", repairOrderID).WithName("record payment above outstanding amount").
+		WithJSON(map[string]interface{}{
+			"type":   "partial",
+			"amount": 60000,
+			"method": paymentMethodID,
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	payments := e.GET("/repair-orders/{repairOrderId}/payments", repairOrderID).WithName("list repair order payments").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	payments.Value("items").Array().Length().IsEqual(2)
+	payments.Value("items").Array().Value(1).Object().Value("type").String().IsEqual("partial")
+	payments.Value("paid_amount").Number().IsEqual(70000)
+	payments.Value("outstanding_amount").Number().IsEqual(50000)
+
+	receipt := e.GET("/repair-orders/{repairOrderId}/receipt", repairOrderID).WithName("get HTML receipt").
+		Expect().
+		Status(http.StatusOK)
+
+	receipt.Header("Content-Type").HasPrefix("text/html")
+	receipt.Body().Contains("Receipt").Contains("50,000")
+
+	e.GET("/repair-orders/{repairOrderId}/receipt", repairOrderID).WithName("get PDF receipt").
+		WithQuery("format", "pdf").
+		Expect().
+		Status(http.StatusOK).
+		Body().HasPrefix("%PDF-")
+
+	escposReceipt := e.GET("/repair-orders/{repairOrderId}/receipt", repairOrderID).WithName("get ESC/POS receipt").
+		WithQuery("format", "escpos").
+		Expect().
+		Status(http.StatusOK)
+
+	escposReceipt.Header("Content-Type").IsEqual("application/octet-stream")
+	escposReceipt.Body().HasPrefix("\x1b@").Contains(slug)
+
+	e.GET("/repair-orders/{repairOrderId}/label", repairOrderID).WithName("get PNG label").
+		Expect().
+		Status(http.StatusOK).
+		Header("Content-Type").IsEqual("image/png")
+
+	labels := e.POST("/repair-orders/labels").WithName("render labels in batch").
+		WithJSON(map[string]interface{}{
+			"repair_order_ids": []string{repairOrderID, repairOrderID},
+			"format":           "svg",
+		}).
+		Expect().
+		Status(http.StatusOK)
+
+	labels.Header("Content-Type").IsEqual("image/svg+xml")
+	labels.Body().HasPrefix("<svg ").Contains(slug)
+
+	e.POST("/repair-orders/labels").WithName("render labels of unknown repair order").
+		WithJSON(map[string]interface{}{
+			"repair_order_ids": []string{uuid.NewString()},
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	e.GET("/repair-orders/{repairOrderId}/receipt", repairOrderID).WithName("get receipt in unknown format").
+		WithQuery("format", "docx").
+		Expect().
+		Status(http.StatusBadRequest)
+
+	e.POST("/repair-orders/{repairOrderId}/confirm", repairOrderID).WithName("confirm repair order").
+		WithJSON(map[string]interface{}{
+			"contents": "Replace the LCD",
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		Value("confirmation").Object().Value("contents").String().IsEqual("Replace the LCD")
+
+	e.POST("/repair-orders/{repairOrderId}/complete", repairOrderID).WithName("complete repair order").
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object().
+		ContainsKey("completion_time")
+
+	e.POST("/repair-orders/{repairOrderId}/pick-up", repairOrderID).WithName("pick up repair order with underpayment").
+		WithJSON(map[string]interface{}{
+			"repayment": map[string]interface{}{
+				"amount": 30000,
+				"method": paymentMethodID,
+			},
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	pickedUp := e.POST("/repair-orders/{repairOrderId}/pick-up", repairOrderID).WithName("pick up repair order").
+		WithJSON(map[string]interface{}{
+			"repayment": map[string]interface{}{
+				"amount": 50000,
+				"method": paymentMethodID,
+			},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	pickedUp.Value("payments").Array().Length().IsEqual(3)
+	pickedUp.Value("payments").Array().Value(2).Object().Value("type").String().IsEqual("final")
+	pickedUp.Value("outstanding_amount").Number().IsEqual(0)
+	pickedUp.NotContainsKey("write_off")
+
+	e.POST("/repair-orders/{repairOrderId}/cancel", repairOrderID).WithName("cancel picked up repair order").
+		WithJSON(map[string]interface{}{
+			"reason": "Customer changed their mind",
+		}).
+		Expect().
+		Status(http.StatusConflict)
+
+	e.POST("/repair-orders/{repairOrderId}/payments", minimalRepairOrderID).WithName("record down payment").
+		WithJSON(map[string]interface{}{
+			"type":   "down_payment",
+			"amount": 30000,
+			"method": paymentMethodID,
+		}).
+		Expect().
+		Status(http.StatusCreated)
+
+	e.POST("/repair-orders/{repairOrderId}/cancel", minimalRepairOrderID).WithName("cancel paid repair order without refund").
+		WithJSON(map[string]interface{}{
+			"reason": "Customer changed their mind",
+		}).
+		Expect().
+		Status(http.StatusBadRequest)
+
+	cancelled := e.POST("/repair-orders/{repairOrderId}/cancel", minimalRepairOrderID).WithName("cancel paid repair order with refund").
+		WithJSON(map[string]interface{}{
+			"reason": "Customer changed their mind",
+			"fee":    10000,
+			"refund": map[string]interface{}{
+				"amount": 20000,
+				"method": paymentMethodID,
+				"reason": "Repair cancelled",
+			},
+		}).
+		Expect().
+		Status(http.StatusOK).
+		JSON().Object()
+
+	cancelled.Value("cancellation").Object().Value("fee").Number().IsEqual(10000)
+	cancelled.Value("payments").Array().Length().IsEqual(2)
+	cancelled.Value("payments").Array().Value(1).Object().Value("type").String().IsEqual("refund")
+	cancelled.Value("paid_amount").Number().IsEqual(10000)
+	cancelled.Value("outstanding_amount").Number().IsEqual(0)
 
 	var someRandomID = uuid.New()
 
@@ -364,7 +1040,7 @@ func TestCreateRepairOrderFlow(t *testing.T) {
 			"damage_types":     []string{someRandomID.String()},
 			"phone_conditions": []string{someRandomID.String()},
 			"phone_equipments": []string{phoneEquipmentID},
-			"photos":           []string{"https://example.com/photo1.jpg", "https://example.com/photo2.jpg"},
+			"photos":           []string{firstPhotoID, secondPhotoID},
 		}).
 		Expect().
 		Status(http.StatusBadRequest)
@@ -391,7 +1067,7 @@ func TestCreateRepairOrderFlow(t *testing.T) {
 			"damage_types":     []string{damageTypeID},
 			"phone_conditions": []string{phoneConditionID},
 			"phone_equipments": []string{phoneEquipmentID},
-			"photos":           []string{"https://example.com/photo1.jpg", "https://example.com/photo2.jpg"},
+			"photos":           []string{firstPhotoID, secondPhotoID},
 		}).
 		Expect().
 		Status(http.StatusBadRequest)
@@ -518,3 +1194,15 @@ func seedCreateRepairOrderFlow(
 	})
 	require.NoError(t, err)
 }
+
+func newTestPNG(t *testing.T) []byte {
+	img := image.NewRGBA(image.Rect(0, 0, 640, 480))
+	for x := 0; x < 640; x++ {
+		img.Set(x, x%480, color.RGBA{R: 255, A: 255})
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, img))
+
+	return buf.Bytes()
+}
@@ -16,6 +16,9 @@ import (
 	"time"
 
 	main "github.com/JosephJoshua/remana-backend/cmd/webserver"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/JosephJoshua/remana-backend/internal/modules/notification"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/testutil"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/ory/dockertest/v3"
@@ -141,8 +144,11 @@ func runServer(ctx context.Context, t testing.TB, db *pgxpool.Pool) string {
 	addr, err := getFreeAddress()
 	require.NoError(t, err)
 
+	blobStore, err := core.NewLocalBlobStore(t.TempDir())
+	require.NoError(t, err)
+
 	go func() {
-		err = main.Run(ctx, db, addr, serverCertPEM, serverKeyPEM)
+		err = main.Run(ctx, db, addr, serverCertPEM, serverKeyPEM, optional.None[notification.Notifier](), blobStore, nil)
 		assert.NoError(t, err)
 	}()
 
@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE EXTENSION IF NOT EXISTS pg_trgm;
+
+CREATE INDEX repair_orders_store_id_creation_time_idx
+  ON repair_orders (store_id, creation_time DESC, repair_order_id DESC);
+
+CREATE INDEX repair_orders_customer_name_trgm_idx ON repair_orders USING GIN (customer_name gin_trgm_ops);
+CREATE INDEX repair_orders_contact_number_trgm_idx ON repair_orders USING GIN (contact_number gin_trgm_ops);
+CREATE INDEX repair_orders_imei_trgm_idx ON repair_orders USING GIN (imei gin_trgm_ops);
+CREATE INDEX repair_orders_phone_type_trgm_idx ON repair_orders USING GIN (phone_type gin_trgm_ops);
+CREATE INDEX repair_orders_slug_trgm_idx ON repair_orders USING GIN (slug gin_trgm_ops);
+
+-- +migrate Down
+DROP INDEX repair_orders_store_id_creation_time_idx;
+DROP INDEX repair_orders_customer_name_trgm_idx;
+DROP INDEX repair_orders_contact_number_trgm_idx;
+DROP INDEX repair_orders_imei_trgm_idx;
+DROP INDEX repair_orders_phone_type_trgm_idx;
+DROP INDEX repair_orders_slug_trgm_idx;
+DROP EXTENSION IF EXISTS pg_trgm;
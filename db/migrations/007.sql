@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE stores ADD COLUMN requires_confirmation_before_completion BOOLEAN NOT NULL DEFAULT FALSE;
+ALTER TABLE repair_orders ADD COLUMN version INT NOT NULL DEFAULT 0;
+
+-- +migrate Down
+ALTER TABLE repair_orders DROP COLUMN version;
+ALTER TABLE stores DROP COLUMN requires_confirmation_before_completion;
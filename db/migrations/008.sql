@@ -0,0 +1,9 @@
+-- +migrate Up
+ALTER TABLE repair_orders
+  ADD COLUMN write_off_amount INTEGER,
+  ADD COLUMN write_off_reason TEXT;
+
+-- +migrate Down
+ALTER TABLE repair_orders
+  DROP COLUMN write_off_amount,
+  DROP COLUMN write_off_reason;
@@ -0,0 +1,70 @@
+-- +migrate Up
+CREATE TABLE repair_order_payments (
+  repair_order_payment_id UUID NOT NULL PRIMARY KEY,
+  repair_order_id UUID NOT NULL REFERENCES repair_orders (repair_order_id) ON DELETE CASCADE,
+  payment_type TEXT NOT NULL CHECK (payment_type IN ('down_payment', 'partial', 'final', 'refund')),
+  amount INTEGER NOT NULL CHECK (amount > 0),
+  payment_method_id UUID NOT NULL REFERENCES payment_methods (payment_method_id),
+  creation_time TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX repair_order_payments_repair_order_id_idx ON repair_order_payments (repair_order_id);
+
+INSERT INTO repair_order_payments (
+  repair_order_payment_id,
+  repair_order_id,
+  payment_type,
+  amount,
+  payment_method_id,
+  creation_time
+)
+SELECT gen_random_uuid(), repair_order_id, 'down_payment', down_payment_amount, down_payment_method_id, creation_time
+FROM repair_orders
+WHERE down_payment_amount IS NOT NULL AND down_payment_method_id IS NOT NULL;
+
+INSERT INTO repair_order_payments (
+  repair_order_payment_id,
+  repair_order_id,
+  payment_type,
+  amount,
+  payment_method_id,
+  creation_time
+)
+SELECT
+  gen_random_uuid(),
+  repair_order_id,
+  'final',
+  repayment_amount,
+  repayment_method_id,
+  COALESCE(pick_up_time, completion_time, creation_time)
+FROM repair_orders
+WHERE repayment_amount IS NOT NULL AND repayment_method_id IS NOT NULL;
+
+ALTER TABLE repair_orders
+  DROP COLUMN down_payment_amount,
+  DROP COLUMN down_payment_method_id,
+  DROP COLUMN repayment_amount,
+  DROP COLUMN repayment_method_id;
+
+-- +migrate Down
+ALTER TABLE repair_orders
+  ADD COLUMN down_payment_amount INTEGER,
+  ADD COLUMN down_payment_method_id UUID REFERENCES payment_methods (payment_method_id),
+  ADD COLUMN repayment_amount INTEGER,
+  ADD COLUMN repayment_method_id UUID REFERENCES payment_methods (payment_method_id);
+
+UPDATE repair_orders
+SET
+  down_payment_amount = payments.amount,
+  down_payment_method_id = payments.payment_method_id
+FROM repair_order_payments payments
+WHERE payments.repair_order_id = repair_orders.repair_order_id AND payments.payment_type = 'down_payment';
+
+UPDATE repair_orders
+SET
+  repayment_amount = payments.amount,
+  repayment_method_id = payments.payment_method_id
+FROM repair_order_payments payments
+WHERE payments.repair_order_id = repair_orders.repair_order_id AND payments.payment_type = 'final';
+
+DROP TABLE repair_order_payments;
@@ -0,0 +1,15 @@
+-- +migrate Up
+ALTER TABLE repair_orders
+  ADD COLUMN cancellation_fee INTEGER CHECK (cancellation_fee > 0);
+
+ALTER TABLE repair_order_payments
+  ADD COLUMN reason TEXT,
+  ADD CONSTRAINT repair_order_payments_refund_reason_check CHECK (payment_type <> 'refund' OR reason IS NOT NULL);
+
+-- +migrate Down
+ALTER TABLE repair_order_payments
+  DROP CONSTRAINT repair_order_payments_refund_reason_check,
+  DROP COLUMN reason;
+
+ALTER TABLE repair_orders
+  DROP COLUMN cancellation_fee;
@@ -0,0 +1,11 @@
+-- +migrate Up
+ALTER TABLE stores
+  ADD COLUMN warranty_terms TEXT,
+  ADD COLUMN receipt_html_template TEXT,
+  ADD COLUMN receipt_pdf_template TEXT;
+
+-- +migrate Down
+ALTER TABLE stores
+  DROP COLUMN receipt_pdf_template,
+  DROP COLUMN receipt_html_template,
+  DROP COLUMN warranty_terms;
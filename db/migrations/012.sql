@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE stores
+  ADD COLUMN receipt_paper_width INTEGER NOT NULL DEFAULT 58 CHECK (receipt_paper_width IN (58, 80));
+
+-- +migrate Down
+ALTER TABLE stores
+  DROP COLUMN receipt_paper_width;
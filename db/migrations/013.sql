@@ -0,0 +1,7 @@
+-- +migrate Up
+ALTER TABLE repair_orders
+  ADD COLUMN estimated_completion_time TIMESTAMPTZ;
+
+-- +migrate Down
+ALTER TABLE repair_orders
+  DROP COLUMN estimated_completion_time;
@@ -0,0 +1,38 @@
+-- +migrate Up
+ALTER TABLE stores
+  ADD COLUMN notification_language TEXT NOT NULL DEFAULT 'id' CHECK (notification_language IN ('id', 'en'));
+
+CREATE TABLE notification_templates (
+  notification_template_id UUID NOT NULL PRIMARY KEY,
+  store_id UUID NOT NULL REFERENCES stores (store_id),
+  event TEXT NOT NULL,
+  language TEXT NOT NULL,
+  template TEXT NOT NULL,
+  UNIQUE (store_id, event, language)
+);
+
+CREATE TABLE notifications (
+  notification_id UUID NOT NULL PRIMARY KEY,
+  store_id UUID NOT NULL REFERENCES stores (store_id),
+  repair_order_id UUID NOT NULL REFERENCES repair_orders (repair_order_id) ON DELETE CASCADE,
+  event TEXT NOT NULL,
+  recipient TEXT NOT NULL,
+  message TEXT NOT NULL,
+  creation_time TIMESTAMPTZ NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_time TIMESTAMPTZ NOT NULL,
+  last_error TEXT,
+  sent_time TIMESTAMPTZ
+);
+
+CREATE INDEX notifications_pending_idx ON notifications (next_attempt_time) WHERE sent_time IS NULL;
+
+-- +migrate Down
+DROP INDEX notifications_pending_idx;
+
+DROP TABLE notifications;
+
+DROP TABLE notification_templates;
+
+ALTER TABLE stores
+  DROP COLUMN notification_language;
@@ -0,0 +1,26 @@
+-- +migrate Up
+CREATE TABLE repair_order_events (
+  event_id UUID NOT NULL PRIMARY KEY,
+  store_id UUID NOT NULL REFERENCES stores (store_id),
+  repair_order_id UUID NOT NULL REFERENCES repair_orders (repair_order_id) ON DELETE CASCADE,
+  event_type TEXT NOT NULL,
+  payload JSONB NOT NULL,
+  occurred_time TIMESTAMPTZ NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_time TIMESTAMPTZ NOT NULL,
+  last_error TEXT,
+  dispatched_time TIMESTAMPTZ
+);
+
+CREATE INDEX repair_order_events_pending_idx ON repair_order_events (next_attempt_time) WHERE dispatched_time IS NULL;
+
+ALTER TABLE notifications
+  ADD COLUMN event_id UUID UNIQUE;
+
+-- +migrate Down
+ALTER TABLE notifications
+  DROP COLUMN event_id;
+
+DROP INDEX repair_order_events_pending_idx;
+
+DROP TABLE repair_order_events;
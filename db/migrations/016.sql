@@ -0,0 +1,46 @@
+-- +migrate Up
+CREATE TABLE webhooks (
+  webhook_id UUID NOT NULL PRIMARY KEY,
+  store_id UUID NOT NULL REFERENCES stores (store_id),
+  url TEXT NOT NULL,
+  secret TEXT NOT NULL,
+  event_types TEXT[] NOT NULL,
+  is_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+  consecutive_failures INTEGER NOT NULL DEFAULT 0,
+  disabled_time TIMESTAMPTZ,
+  creation_time TIMESTAMPTZ NOT NULL
+);
+
+CREATE TABLE webhook_deliveries (
+  webhook_delivery_id UUID NOT NULL PRIMARY KEY,
+  webhook_id UUID NOT NULL REFERENCES webhooks (webhook_id) ON DELETE CASCADE,
+  event_id UUID NOT NULL,
+  event_type TEXT NOT NULL,
+  payload BYTEA NOT NULL,
+  replay_of UUID REFERENCES webhook_deliveries (webhook_delivery_id),
+  creation_time TIMESTAMPTZ NOT NULL,
+  attempts INTEGER NOT NULL DEFAULT 0,
+  next_attempt_time TIMESTAMPTZ NOT NULL,
+  last_status_code INTEGER,
+  last_error TEXT,
+  delivered_time TIMESTAMPTZ,
+  failed_time TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX webhook_deliveries_event_idx ON webhook_deliveries (webhook_id, event_id) WHERE replay_of IS NULL;
+
+CREATE INDEX webhook_deliveries_pending_idx ON webhook_deliveries (next_attempt_time)
+  WHERE delivered_time IS NULL AND failed_time IS NULL;
+
+CREATE INDEX webhook_deliveries_log_idx ON webhook_deliveries (webhook_id, creation_time DESC);
+
+-- +migrate Down
+DROP INDEX webhook_deliveries_log_idx;
+
+DROP INDEX webhook_deliveries_pending_idx;
+
+DROP INDEX webhook_deliveries_event_idx;
+
+DROP TABLE webhook_deliveries;
+
+DROP TABLE webhooks;
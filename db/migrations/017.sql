@@ -0,0 +1,41 @@
+-- +migrate Up
+CREATE TABLE audit_log_entries (
+  audit_log_entry_id UUID NOT NULL PRIMARY KEY,
+  store_id UUID NOT NULL REFERENCES stores (store_id),
+  user_id UUID NOT NULL REFERENCES users (user_id),
+  username TEXT NOT NULL,
+  correlation_id TEXT,
+  action TEXT NOT NULL,
+  entity_type TEXT NOT NULL,
+  entity_id UUID NOT NULL,
+  before JSONB,
+  after JSONB,
+  creation_time TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX audit_log_entries_entity_idx ON audit_log_entries (store_id, entity_type, entity_id, creation_time DESC);
+
+CREATE INDEX audit_log_entries_store_idx ON audit_log_entries (store_id, creation_time DESC);
+
+-- +migrate StatementBegin
+CREATE FUNCTION reject_audit_log_entry_mutation() RETURNS TRIGGER AS $$
+BEGIN
+  RAISE EXCEPTION 'audit log entries are append-only';
+END;
+$$ LANGUAGE plpgsql;
+-- +migrate StatementEnd
+
+CREATE TRIGGER audit_log_entries_append_only
+  BEFORE UPDATE OR DELETE ON audit_log_entries
+  FOR EACH ROW EXECUTE FUNCTION reject_audit_log_entry_mutation();
+
+-- +migrate Down
+DROP TRIGGER audit_log_entries_append_only ON audit_log_entries;
+
+DROP FUNCTION reject_audit_log_entry_mutation;
+
+DROP INDEX audit_log_entries_store_idx;
+
+DROP INDEX audit_log_entries_entity_idx;
+
+DROP TABLE audit_log_entries;
@@ -0,0 +1,20 @@
+-- +migrate Up
+CREATE TABLE repair_order_technician_assignments (
+  repair_order_technician_assignment_id UUID NOT NULL PRIMARY KEY,
+  repair_order_id UUID NOT NULL REFERENCES repair_orders (repair_order_id) ON DELETE CASCADE,
+  previous_technician_id UUID NOT NULL REFERENCES technicians (technician_id),
+  technician_id UUID NOT NULL REFERENCES technicians (technician_id),
+  reason TEXT NOT NULL,
+  creation_time TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX repair_order_technician_assignments_repair_order_id_idx ON repair_order_technician_assignments (repair_order_id);
+
+CREATE INDEX repair_orders_technician_idx ON repair_orders (store_id, technician_id, creation_time);
+
+-- +migrate Down
+DROP INDEX repair_orders_technician_idx;
+
+DROP INDEX repair_order_technician_assignments_repair_order_id_idx;
+
+DROP TABLE repair_order_technician_assignments;
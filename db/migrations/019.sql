@@ -0,0 +1,17 @@
+-- +migrate Up
+CREATE TABLE repair_order_notes (
+  repair_order_note_id UUID NOT NULL PRIMARY KEY,
+  repair_order_id UUID NOT NULL REFERENCES repair_orders (repair_order_id) ON DELETE CASCADE,
+  author_user_id UUID NOT NULL REFERENCES users (user_id),
+  body TEXT NOT NULL,
+  visibility TEXT NOT NULL CHECK (visibility IN ('internal', 'customer')),
+  creation_time TIMESTAMPTZ NOT NULL,
+  edit_time TIMESTAMPTZ
+);
+
+CREATE INDEX repair_order_notes_repair_order_id_idx ON repair_order_notes (repair_order_id, creation_time);
+
+-- +migrate Down
+DROP INDEX repair_order_notes_repair_order_id_idx;
+
+DROP TABLE repair_order_notes;
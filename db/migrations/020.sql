@@ -0,0 +1,45 @@
+-- +migrate Up
+CREATE TABLE photos (
+  photo_id UUID NOT NULL PRIMARY KEY,
+  store_id UUID NOT NULL REFERENCES stores (store_id),
+  content_type TEXT NOT NULL,
+  blob_key TEXT,
+  thumbnail_blob_key TEXT,
+  source_url TEXT,
+  uploaded_by UUID REFERENCES users (user_id),
+  creation_time TIMESTAMPTZ NOT NULL,
+  CHECK ((blob_key IS NULL) <> (source_url IS NULL))
+);
+
+-- Photos linked by URL before uploads existed are kept as external photos.
+INSERT INTO photos (photo_id, store_id, content_type, source_url, creation_time)
+SELECT
+  repair_order_photos.repair_order_photo_id,
+  repair_orders.store_id,
+  'application/octet-stream',
+  repair_order_photos.photo_url,
+  repair_orders.creation_time
+FROM repair_order_photos
+JOIN repair_orders ON repair_orders.repair_order_id = repair_order_photos.repair_order_id;
+
+ALTER TABLE repair_order_photos ADD COLUMN photo_id UUID REFERENCES photos (photo_id);
+
+UPDATE repair_order_photos SET photo_id = repair_order_photo_id;
+
+ALTER TABLE repair_order_photos
+  ALTER COLUMN photo_id SET NOT NULL,
+  DROP COLUMN photo_url;
+
+-- +migrate Down
+ALTER TABLE repair_order_photos ADD COLUMN photo_url TEXT;
+
+UPDATE repair_order_photos
+SET photo_url = COALESCE(photos.source_url, '/photos/' || photos.photo_id::TEXT)
+FROM photos
+WHERE photos.photo_id = repair_order_photos.photo_id;
+
+ALTER TABLE repair_order_photos
+  ALTER COLUMN photo_url SET NOT NULL,
+  DROP COLUMN photo_id;
+
+DROP TABLE photos;
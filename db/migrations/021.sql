@@ -0,0 +1,22 @@
+-- +migrate Up
+ALTER TABLE repair_order_photos
+  ADD COLUMN stage TEXT NOT NULL DEFAULT 'intake' CHECK (stage IN ('intake', 'diagnosis', 'repair', 'completed')),
+  ADD COLUMN caption TEXT,
+  ADD COLUMN creation_time TIMESTAMPTZ;
+
+UPDATE repair_order_photos
+SET creation_time = repair_orders.creation_time
+FROM repair_orders
+WHERE repair_orders.repair_order_id = repair_order_photos.repair_order_id;
+
+ALTER TABLE repair_order_photos
+  ALTER COLUMN stage DROP DEFAULT,
+  ALTER COLUMN creation_time SET NOT NULL,
+  ADD CONSTRAINT repair_order_photos_repair_order_id_photo_id_key UNIQUE (repair_order_id, photo_id);
+
+-- +migrate Down
+ALTER TABLE repair_order_photos
+  DROP CONSTRAINT repair_order_photos_repair_order_id_photo_id_key,
+  DROP COLUMN creation_time,
+  DROP COLUMN caption,
+  DROP COLUMN stage;
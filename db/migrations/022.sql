@@ -0,0 +1,11 @@
+-- +migrate Up
+CREATE TABLE sessions (
+  token TEXT NOT NULL PRIMARY KEY,
+  data BYTEA NOT NULL,
+  expiry TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX sessions_expiry_idx ON sessions (expiry);
+
+-- +migrate Down
+DROP TABLE sessions;
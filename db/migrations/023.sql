@@ -0,0 +1,15 @@
+-- +migrate Up
+CREATE TABLE user_sessions (
+  user_session_id UUID NOT NULL PRIMARY KEY,
+  token TEXT NOT NULL UNIQUE REFERENCES sessions (token) ON DELETE CASCADE,
+  user_id UUID NOT NULL REFERENCES users (user_id),
+  user_agent TEXT,
+  ip_address TEXT,
+  creation_time TIMESTAMPTZ NOT NULL,
+  last_seen_time TIMESTAMPTZ NOT NULL
+);
+
+CREATE INDEX user_sessions_user_id_idx ON user_sessions (user_id);
+
+-- +migrate Down
+DROP TABLE user_sessions;
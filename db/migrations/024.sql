@@ -0,0 +1,23 @@
+-- +migrate Up
+ALTER TABLE login_codes
+  ADD COLUMN is_single_use BOOLEAN NOT NULL DEFAULT TRUE,
+  ADD COLUMN expiry_time TIMESTAMPTZ,
+  ADD COLUMN issued_by UUID REFERENCES users (user_id) ON DELETE SET NULL,
+  ADD COLUMN creation_time TIMESTAMPTZ;
+
+UPDATE login_codes SET creation_time = NOW();
+
+ALTER TABLE login_codes
+  ALTER COLUMN is_single_use DROP DEFAULT,
+  ALTER COLUMN creation_time SET NOT NULL;
+
+CREATE INDEX login_codes_user_id_idx ON login_codes (user_id);
+
+-- +migrate Down
+DROP INDEX login_codes_user_id_idx;
+
+ALTER TABLE login_codes
+  DROP COLUMN creation_time,
+  DROP COLUMN issued_by,
+  DROP COLUMN expiry_time,
+  DROP COLUMN is_single_use;
@@ -0,0 +1,22 @@
+-- +migrate Up
+CREATE TABLE user_totps (
+  user_id UUID NOT NULL PRIMARY KEY REFERENCES users (user_id) ON DELETE CASCADE,
+  totp_secret TEXT NOT NULL,
+  last_used_step BIGINT,
+  creation_time TIMESTAMPTZ NOT NULL,
+  confirmation_time TIMESTAMPTZ
+);
+
+CREATE TABLE totp_recovery_codes (
+  totp_recovery_code_id UUID NOT NULL PRIMARY KEY,
+  user_id UUID NOT NULL REFERENCES users (user_id) ON DELETE CASCADE,
+  code_hash TEXT NOT NULL,
+  creation_time TIMESTAMPTZ NOT NULL,
+  use_time TIMESTAMPTZ
+);
+
+CREATE INDEX totp_recovery_codes_user_id_idx ON totp_recovery_codes (user_id);
+
+-- +migrate Down
+DROP TABLE totp_recovery_codes;
+DROP TABLE user_totps;
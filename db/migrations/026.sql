@@ -0,0 +1,14 @@
+-- +migrate Up
+CREATE TABLE login_throttles (
+  throttle_scope TEXT NOT NULL,
+  throttle_key TEXT NOT NULL,
+  failure_count INT NOT NULL,
+  last_failure_time TIMESTAMPTZ NOT NULL,
+  locked_until TIMESTAMPTZ,
+  PRIMARY KEY (throttle_scope, throttle_key)
+);
+
+CREATE INDEX login_throttles_last_failure_time_idx ON login_throttles (last_failure_time);
+
+-- +migrate Down
+DROP TABLE login_throttles;
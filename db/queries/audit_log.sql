@@ -0,0 +1,42 @@
+-- name: CreateAuditLogEntry :exec
+INSERT INTO audit_log_entries (
+  audit_log_entry_id,
+  store_id,
+  user_id,
+  username,
+  correlation_id,
+  action,
+  entity_type,
+  entity_id,
+  before,
+  after,
+  creation_time
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);
+
+-- name: GetAuditLogEntriesByEntity :many
+SELECT audit_log_entries.*
+FROM audit_log_entries
+WHERE
+  audit_log_entries.store_id = $1 AND
+  audit_log_entries.entity_type = $2 AND
+  audit_log_entries.entity_id = $3
+ORDER BY audit_log_entries.creation_time, audit_log_entries.audit_log_entry_id;
+
+-- name: SearchAuditLogEntries :many
+SELECT audit_log_entries.*
+FROM audit_log_entries
+WHERE
+  audit_log_entries.store_id = sqlc.arg(store_id) AND
+  (sqlc.narg(entity_type)::TEXT IS NULL OR audit_log_entries.entity_type = sqlc.narg(entity_type)::TEXT) AND
+  (sqlc.narg(entity_id)::UUID IS NULL OR audit_log_entries.entity_id = sqlc.narg(entity_id)::UUID) AND
+  (sqlc.narg(user_id)::UUID IS NULL OR audit_log_entries.user_id = sqlc.narg(user_id)::UUID) AND
+  (sqlc.narg(action)::TEXT IS NULL OR audit_log_entries.action = sqlc.narg(action)::TEXT) AND
+  (sqlc.narg(created_from)::TIMESTAMPTZ IS NULL OR audit_log_entries.creation_time >= sqlc.narg(created_from)::TIMESTAMPTZ) AND
+  (sqlc.narg(created_to)::TIMESTAMPTZ IS NULL OR audit_log_entries.creation_time < sqlc.narg(created_to)::TIMESTAMPTZ) AND
+  (
+    sqlc.narg(cursor_creation_time)::TIMESTAMPTZ IS NULL OR
+    (audit_log_entries.creation_time, audit_log_entries.audit_log_entry_id) <
+      (sqlc.narg(cursor_creation_time)::TIMESTAMPTZ, sqlc.narg(cursor_audit_log_entry_id)::UUID)
+  )
+ORDER BY audit_log_entries.creation_time DESC, audit_log_entries.audit_log_entry_id DESC
+LIMIT sqlc.arg(page_size);
@@ -1,5 +1,12 @@
 -- name: GetUserByUsernameAndStoreCode :one
-SELECT users.user_id, users.user_password, roles.is_store_admin
+SELECT
+  users.user_id,
+  users.user_password,
+  roles.is_store_admin,
+  EXISTS (
+    SELECT 1 FROM user_totps
+    WHERE user_totps.user_id = users.user_id AND user_totps.confirmation_time IS NOT NULL
+  ) AS has_totp
 FROM users
 LEFT JOIN stores ON stores.store_id = users.store_id
 LEFT JOIN roles ON roles.role_id = users.role_id
@@ -7,10 +14,31 @@ WHERE users.username = $1 AND stores.store_code = $2
 LIMIT 1;
 
 -- name: GetLoginCodeByUserIDAndCode :one
-SELECT login_codes.login_code_id
+SELECT login_codes.login_code_id, login_codes.is_single_use
 FROM login_codes
-WHERE login_codes.user_id = $1 AND login_codes.login_code = $2;
+WHERE login_codes.user_id = sqlc.arg(user_id)
+  AND login_codes.login_code = sqlc.arg(login_code)
+  AND (login_codes.expiry_time IS NULL OR login_codes.expiry_time > sqlc.arg(now)::TIMESTAMPTZ);
 
--- name: DeleteLoginCodeByID :exec
+-- name: DeleteLoginCodeByID :execrows
 DELETE FROM login_codes
 WHERE login_codes.login_code_id = $1;
+
+-- name: GetConfirmedTOTPSecret :one
+SELECT user_totps.totp_secret
+FROM user_totps
+WHERE user_totps.user_id = $1 AND user_totps.confirmation_time IS NOT NULL;
+
+-- name: UseTOTPStep :execrows
+UPDATE user_totps
+SET last_used_step = sqlc.arg(step)::BIGINT
+WHERE user_totps.user_id = sqlc.arg(user_id)
+  AND user_totps.confirmation_time IS NOT NULL
+  AND (user_totps.last_used_step IS NULL OR user_totps.last_used_step < sqlc.arg(step)::BIGINT);
+
+-- name: UseTOTPRecoveryCode :execrows
+UPDATE totp_recovery_codes
+SET use_time = $3
+WHERE totp_recovery_codes.user_id = $1
+  AND totp_recovery_codes.code_hash = $2
+  AND totp_recovery_codes.use_time IS NULL;
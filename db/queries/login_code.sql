@@ -0,0 +1,27 @@
+-- name: CreateLoginCode :exec
+INSERT INTO login_codes (
+  login_code_id,
+  user_id,
+  login_code,
+  is_single_use,
+  expiry_time,
+  issued_by,
+  creation_time
+) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetActiveLoginCodesByUserID :many
+SELECT
+  login_codes.login_code_id,
+  login_codes.user_id,
+  login_codes.is_single_use,
+  login_codes.expiry_time,
+  login_codes.issued_by,
+  login_codes.creation_time
+FROM login_codes
+WHERE login_codes.user_id = sqlc.arg(user_id)
+  AND (login_codes.expiry_time IS NULL OR login_codes.expiry_time > sqlc.arg(now)::TIMESTAMPTZ)
+ORDER BY login_codes.creation_time DESC;
+
+-- name: DeleteLoginCode :execrows
+DELETE FROM login_codes
+WHERE login_codes.login_code_id = $1 AND login_codes.user_id = $2;
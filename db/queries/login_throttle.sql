@@ -0,0 +1,39 @@
+-- name: GetLoginThrottle :one
+SELECT login_throttles.failure_count, login_throttles.last_failure_time, login_throttles.locked_until
+FROM login_throttles
+WHERE login_throttles.throttle_scope = $1 AND login_throttles.throttle_key = $2;
+
+-- name: RecordLoginFailure :one
+INSERT INTO login_throttles (
+  throttle_scope,
+  throttle_key,
+  failure_count,
+  last_failure_time
+) VALUES (sqlc.arg(throttle_scope), sqlc.arg(throttle_key), 1, sqlc.arg(now))
+ON CONFLICT (throttle_scope, throttle_key) DO UPDATE
+SET
+  failure_count = CASE
+    WHEN login_throttles.last_failure_time < sqlc.arg(forget_before)::TIMESTAMPTZ THEN 1
+    WHEN login_throttles.locked_until <= EXCLUDED.last_failure_time THEN 1
+    ELSE login_throttles.failure_count + 1
+  END,
+  last_failure_time = EXCLUDED.last_failure_time,
+  locked_until = CASE
+    WHEN login_throttles.locked_until <= EXCLUDED.last_failure_time THEN NULL
+    ELSE login_throttles.locked_until
+  END
+RETURNING login_throttles.failure_count;
+
+-- name: LockLoginThrottle :exec
+UPDATE login_throttles
+SET locked_until = $3
+WHERE login_throttles.throttle_scope = $1 AND login_throttles.throttle_key = $2;
+
+-- name: DeleteLoginThrottle :execrows
+DELETE FROM login_throttles
+WHERE login_throttles.throttle_scope = $1 AND login_throttles.throttle_key = $2;
+
+-- name: DeleteStaleLoginThrottles :execrows
+DELETE FROM login_throttles
+WHERE login_throttles.last_failure_time < $1
+  AND (login_throttles.locked_until IS NULL OR login_throttles.locked_until < $1);
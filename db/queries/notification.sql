@@ -0,0 +1,67 @@
+-- name: GetStoreNotificationSettings :one
+SELECT
+  stores.store_name,
+  stores.notification_language,
+  notification_templates.template
+FROM stores
+LEFT JOIN notification_templates ON
+  notification_templates.store_id = stores.store_id AND
+  notification_templates.event = sqlc.arg(event) AND
+  notification_templates.language = stores.notification_language
+WHERE stores.store_id = sqlc.arg(store_id);
+
+-- name: CreateNotification :exec
+INSERT INTO notifications (
+  notification_id,
+  store_id,
+  repair_order_id,
+  event,
+  recipient,
+  message,
+  creation_time,
+  next_attempt_time,
+  event_id
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8,
+  $9
+)
+ON CONFLICT (event_id) DO NOTHING;
+
+-- name: ClaimPendingNotifications :many
+UPDATE notifications
+SET next_attempt_time = sqlc.arg(lease_until)
+WHERE notifications.notification_id IN (
+  SELECT pending.notification_id
+  FROM notifications AS pending
+  WHERE
+    pending.sent_time IS NULL AND
+    pending.attempts < sqlc.arg(max_attempts) AND
+    pending.next_attempt_time <= sqlc.arg(now)
+  ORDER BY pending.next_attempt_time ASC
+  LIMIT sqlc.arg(batch_size)
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING notifications.*;
+
+-- name: MarkNotificationSent :exec
+UPDATE notifications
+SET
+  attempts = notifications.attempts + 1,
+  sent_time = sqlc.arg(sent_time),
+  last_error = NULL
+WHERE notifications.notification_id = sqlc.arg(notification_id);
+
+-- name: MarkNotificationFailed :exec
+UPDATE notifications
+SET
+  attempts = notifications.attempts + 1,
+  next_attempt_time = sqlc.arg(next_attempt_time),
+  last_error = sqlc.arg(last_error)
+WHERE notifications.notification_id = sqlc.arg(notification_id);
@@ -0,0 +1,16 @@
+-- name: CreatePhoto :exec
+INSERT INTO photos (
+  photo_id,
+  store_id,
+  content_type,
+  blob_key,
+  thumbnail_blob_key,
+  uploaded_by,
+  creation_time
+) VALUES ($1, $2, $3, $4, $5, $6, $7);
+
+-- name: GetPhotoByID :one
+SELECT
+  photos.*
+FROM photos
+WHERE photos.store_id = $1 AND photos.photo_id = $2;
@@ -14,8 +14,7 @@ INSERT INTO repair_orders (
   parts_not_checked_yet,
   passcode_or_pattern,
   is_pattern_locked,
-  down_payment_amount,
-  down_payment_method_id
+  estimated_completion_time
 ) VALUES (
   $1,
   $2,
@@ -31,8 +30,7 @@ INSERT INTO repair_orders (
   $12,
   $13,
   $14,
-  $15,
-  $16
+  $15
 );
 
 -- name: AddDamagesToRepairOrder :copyfrom
@@ -72,11 +70,17 @@ INSERT INTO repair_order_phone_equipments (
 INSERT INTO repair_order_photos (
   repair_order_photo_id,
   repair_order_id,
-  photo_url
+  photo_id,
+  stage,
+  caption,
+  creation_time
 ) VALUES (
   $1,
   $2,
-  $3
+  $3,
+  $4,
+  $5,
+  $6
 );
 
 -- name: AddCostsToRepairOrder :copyfrom
@@ -94,6 +98,138 @@ INSERT INTO repair_order_costs (
   $5
 );
 
+-- name: SaveRepairOrderCost :exec
+INSERT INTO repair_order_costs (
+  repair_order_cost_id,
+  repair_order_id,
+  amount,
+  reason,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+ON CONFLICT (repair_order_cost_id) DO NOTHING;
+
+-- name: SaveRepairOrderPayment :exec
+INSERT INTO repair_order_payments (
+  repair_order_payment_id,
+  repair_order_id,
+  payment_type,
+  amount,
+  payment_method_id,
+  reason,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+ON CONFLICT (repair_order_payment_id) DO NOTHING;
+
+-- name: SaveRepairOrderTechnicianAssignment :exec
+INSERT INTO repair_order_technician_assignments (
+  repair_order_technician_assignment_id,
+  repair_order_id,
+  previous_technician_id,
+  technician_id,
+  reason,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+ON CONFLICT (repair_order_technician_assignment_id) DO NOTHING;
+
+-- name: SaveRepairOrderDamage :exec
+INSERT INTO repair_order_damages (
+  repair_order_damage_id,
+  repair_order_id,
+  damage_name
+) VALUES (
+  $1,
+  $2,
+  $3
+)
+ON CONFLICT (repair_order_damage_id) DO NOTHING;
+
+-- name: DeleteRepairOrderDamagesExcept :exec
+DELETE FROM repair_order_damages
+WHERE
+  repair_order_damages.repair_order_id = $1
+  AND NOT (repair_order_damages.repair_order_damage_id = ANY(sqlc.arg(keep_ids)::UUID[]));
+
+-- name: SaveRepairOrderPhoneCondition :exec
+INSERT INTO repair_order_phone_conditions (
+  repair_order_phone_condition_id,
+  repair_order_id,
+  phone_condition_name
+) VALUES (
+  $1,
+  $2,
+  $3
+)
+ON CONFLICT (repair_order_phone_condition_id) DO NOTHING;
+
+-- name: DeleteRepairOrderPhoneConditionsExcept :exec
+DELETE FROM repair_order_phone_conditions
+WHERE
+  repair_order_phone_conditions.repair_order_id = $1
+  AND NOT (repair_order_phone_conditions.repair_order_phone_condition_id = ANY(sqlc.arg(keep_ids)::UUID[]));
+
+-- name: SaveRepairOrderPhoneEquipment :exec
+INSERT INTO repair_order_phone_equipments (
+  repair_order_phone_equipment_id,
+  repair_order_id,
+  phone_equipment_name
+) VALUES (
+  $1,
+  $2,
+  $3
+)
+ON CONFLICT (repair_order_phone_equipment_id) DO NOTHING;
+
+-- name: DeleteRepairOrderPhoneEquipmentsExcept :exec
+DELETE FROM repair_order_phone_equipments
+WHERE
+  repair_order_phone_equipments.repair_order_id = $1
+  AND NOT (repair_order_phone_equipments.repair_order_phone_equipment_id = ANY(sqlc.arg(keep_ids)::UUID[]));
+
+-- name: SaveRepairOrderPhoto :exec
+INSERT INTO repair_order_photos (
+  repair_order_photo_id,
+  repair_order_id,
+  photo_id,
+  stage,
+  caption,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+ON CONFLICT (repair_order_photo_id) DO NOTHING;
+
+-- name: DeleteRepairOrderPhotosExcept :exec
+DELETE FROM repair_order_photos
+WHERE
+  repair_order_photos.repair_order_id = $1
+  AND NOT (repair_order_photos.repair_order_photo_id = ANY(sqlc.arg(keep_ids)::UUID[]));
+
 -- name: DoesSalesPersonExist :one
 SELECT 1
 FROM sales_persons
@@ -124,7 +260,236 @@ SELECT phone_equipments.phone_equipment_name
 FROM phone_equipments
 WHERE phone_equipments.store_id = $1 AND phone_equipments.phone_equipment_id = ANY(sqlc.arg(ids)::UUID[]);
 
+-- name: CountPhotosByIDs :one
+SELECT COUNT(*)
+FROM photos
+WHERE photos.store_id = $1 AND photos.photo_id = ANY(sqlc.arg(ids)::UUID[]);
+
 -- name: IsRepairOrderSlugTaken :one
 SELECT 1
 FROM repair_orders
 WHERE repair_orders.store_id = $1 AND repair_orders.slug = $2;
+
+-- name: GetRepairOrderByID :one
+SELECT
+  repair_orders.*
+FROM repair_orders
+WHERE repair_orders.store_id = $1 AND repair_orders.repair_order_id = $2
+LIMIT 1;
+
+-- name: GetRepairOrderBySlug :one
+SELECT
+  repair_orders.*
+FROM repair_orders
+WHERE repair_orders.store_id = $1 AND repair_orders.slug = $2
+LIMIT 1;
+
+-- name: GetRepairOrderBySlugInAnyStore :one
+SELECT
+  repair_orders.*
+FROM repair_orders
+WHERE repair_orders.slug = $1
+LIMIT 1;
+
+-- name: GetRepairOrderDamages :many
+SELECT
+  repair_order_damages.*
+FROM repair_order_damages
+WHERE repair_order_damages.repair_order_id = $1;
+
+-- name: GetRepairOrderPhoneConditions :many
+SELECT
+  repair_order_phone_conditions.*
+FROM repair_order_phone_conditions
+WHERE repair_order_phone_conditions.repair_order_id = $1;
+
+-- name: GetRepairOrderPhoneEquipments :many
+SELECT
+  repair_order_phone_equipments.*
+FROM repair_order_phone_equipments
+WHERE repair_order_phone_equipments.repair_order_id = $1;
+
+-- name: GetRepairOrderCosts :many
+SELECT
+  repair_order_costs.*
+FROM repair_order_costs
+WHERE repair_order_costs.repair_order_id = $1
+ORDER BY repair_order_costs.creation_time ASC;
+
+-- name: GetRepairOrderPayments :many
+SELECT
+  repair_order_payments.*
+FROM repair_order_payments
+WHERE repair_order_payments.repair_order_id = $1
+ORDER BY repair_order_payments.creation_time ASC;
+
+-- name: GetRepairOrderTechnicianAssignments :many
+SELECT
+  repair_order_technician_assignments.*
+FROM repair_order_technician_assignments
+WHERE repair_order_technician_assignments.repair_order_id = $1
+ORDER BY repair_order_technician_assignments.creation_time ASC;
+
+-- name: GetRepairOrderPhotos :many
+SELECT
+  repair_order_photos.*
+FROM repair_order_photos
+WHERE repair_order_photos.repair_order_id = $1
+ORDER BY repair_order_photos.creation_time ASC;
+
+-- name: ListRepairOrders :many
+SELECT
+  repair_orders.repair_order_id,
+  repair_orders.creation_time,
+  repair_orders.slug,
+  repair_orders.customer_name,
+  repair_orders.contact_number,
+  repair_orders.phone_type,
+  repair_orders.color,
+  repair_orders.imei,
+  repair_orders.technician_id,
+  repair_orders.sales_person_id,
+  repair_orders.confirmation_time,
+  repair_orders.completion_time,
+  repair_orders.pick_up_time,
+  repair_orders.cancellation_time
+FROM repair_orders
+WHERE
+  repair_orders.store_id = sqlc.arg(store_id) AND
+  (
+    sqlc.narg(status)::TEXT IS NULL OR
+    (
+      CASE
+        WHEN repair_orders.cancellation_time IS NOT NULL THEN 'cancelled'
+        WHEN repair_orders.pick_up_time IS NOT NULL THEN 'picked_up'
+        WHEN repair_orders.completion_time IS NOT NULL THEN 'completed'
+        WHEN repair_orders.confirmation_time IS NOT NULL THEN 'confirmed'
+        ELSE 'open'
+      END
+    ) = sqlc.narg(status)::TEXT
+  ) AND
+  (sqlc.narg(technician_id)::UUID IS NULL OR repair_orders.technician_id = sqlc.narg(technician_id)::UUID) AND
+  (sqlc.narg(sales_person_id)::UUID IS NULL OR repair_orders.sales_person_id = sqlc.narg(sales_person_id)::UUID) AND
+  (sqlc.narg(created_from)::TIMESTAMPTZ IS NULL OR repair_orders.creation_time >= sqlc.narg(created_from)::TIMESTAMPTZ) AND
+  (sqlc.narg(created_to)::TIMESTAMPTZ IS NULL OR repair_orders.creation_time < sqlc.narg(created_to)::TIMESTAMPTZ) AND
+  (
+    sqlc.narg(search)::TEXT IS NULL OR
+    repair_orders.customer_name ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.contact_number ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.imei ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.phone_type ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.slug ILIKE '%' || sqlc.narg(search)::TEXT || '%'
+  ) AND
+  (
+    sqlc.narg(cursor_creation_time)::TIMESTAMPTZ IS NULL OR
+    (repair_orders.creation_time, repair_orders.repair_order_id) <
+      (sqlc.narg(cursor_creation_time)::TIMESTAMPTZ, sqlc.narg(cursor_repair_order_id)::UUID)
+  )
+ORDER BY repair_orders.creation_time DESC, repair_orders.repair_order_id DESC
+LIMIT sqlc.arg(page_size);
+
+-- name: CountRepairOrders :one
+SELECT COUNT(*)
+FROM repair_orders
+WHERE
+  repair_orders.store_id = sqlc.arg(store_id) AND
+  (
+    sqlc.narg(status)::TEXT IS NULL OR
+    (
+      CASE
+        WHEN repair_orders.cancellation_time IS NOT NULL THEN 'cancelled'
+        WHEN repair_orders.pick_up_time IS NOT NULL THEN 'picked_up'
+        WHEN repair_orders.completion_time IS NOT NULL THEN 'completed'
+        WHEN repair_orders.confirmation_time IS NOT NULL THEN 'confirmed'
+        ELSE 'open'
+      END
+    ) = sqlc.narg(status)::TEXT
+  ) AND
+  (sqlc.narg(technician_id)::UUID IS NULL OR repair_orders.technician_id = sqlc.narg(technician_id)::UUID) AND
+  (sqlc.narg(sales_person_id)::UUID IS NULL OR repair_orders.sales_person_id = sqlc.narg(sales_person_id)::UUID) AND
+  (sqlc.narg(created_from)::TIMESTAMPTZ IS NULL OR repair_orders.creation_time >= sqlc.narg(created_from)::TIMESTAMPTZ) AND
+  (sqlc.narg(created_to)::TIMESTAMPTZ IS NULL OR repair_orders.creation_time < sqlc.narg(created_to)::TIMESTAMPTZ) AND
+  (
+    sqlc.narg(search)::TEXT IS NULL OR
+    repair_orders.customer_name ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.contact_number ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.imei ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.phone_type ILIKE '%' || sqlc.narg(search)::TEXT || '%' OR
+    repair_orders.slug ILIKE '%' || sqlc.narg(search)::TEXT || '%'
+  );
+
+-- name: UpdateRepairOrderProgress :execrows
+UPDATE repair_orders
+SET
+  version = repair_orders.version + 1,
+  technician_id = sqlc.arg(technician_id),
+  confirmation_time = sqlc.narg(confirmation_time),
+  confirmation_content = sqlc.narg(confirmation_content),
+  completion_time = sqlc.narg(completion_time),
+  pick_up_time = sqlc.narg(pick_up_time),
+  cancellation_time = sqlc.narg(cancellation_time),
+  cancellation_reason = sqlc.narg(cancellation_reason),
+  cancellation_fee = sqlc.narg(cancellation_fee),
+  write_off_amount = sqlc.narg(write_off_amount),
+  write_off_reason = sqlc.narg(write_off_reason)
+WHERE
+  repair_orders.store_id = sqlc.arg(store_id) AND
+  repair_orders.repair_order_id = sqlc.arg(repair_order_id) AND
+  repair_orders.version = sqlc.arg(version);
+
+-- name: GetTechnicianQueue :many
+SELECT
+  repair_orders.repair_order_id,
+  repair_orders.creation_time,
+  repair_orders.slug,
+  repair_orders.customer_name,
+  repair_orders.contact_number,
+  repair_orders.phone_type,
+  repair_orders.color,
+  repair_orders.imei,
+  repair_orders.technician_id,
+  repair_orders.sales_person_id,
+  repair_orders.confirmation_time,
+  repair_orders.completion_time,
+  repair_orders.pick_up_time,
+  repair_orders.cancellation_time
+FROM repair_orders
+WHERE
+  repair_orders.store_id = $1 AND
+  repair_orders.technician_id = $2 AND
+  repair_orders.completion_time IS NULL AND
+  repair_orders.cancellation_time IS NULL
+ORDER BY repair_orders.creation_time ASC, repair_orders.repair_order_id ASC;
+
+-- name: GetTechnicianWorkload :many
+SELECT
+  technicians.technician_id,
+  technicians.technician_name,
+  COUNT(repair_orders.repair_order_id) FILTER (WHERE repair_orders.confirmation_time IS NULL) AS open_count,
+  COUNT(repair_orders.repair_order_id) FILTER (WHERE repair_orders.confirmation_time IS NOT NULL) AS confirmed_count,
+  MIN(repair_orders.creation_time)::TIMESTAMPTZ AS oldest_order_creation_time
+FROM technicians
+LEFT JOIN repair_orders ON
+  repair_orders.technician_id = technicians.technician_id AND
+  repair_orders.completion_time IS NULL AND
+  repair_orders.cancellation_time IS NULL
+WHERE technicians.store_id = $1
+GROUP BY technicians.technician_id, technicians.technician_name
+ORDER BY technicians.technician_name ASC;
+
+-- name: DoesStoreRequireConfirmationBeforeCompletion :one
+SELECT stores.requires_confirmation_before_completion
+FROM stores
+WHERE stores.store_id = $1;
+
+-- name: GetStoreReceiptDetails :one
+SELECT
+  stores.store_name,
+  stores.store_address,
+  stores.phone_number,
+  stores.warranty_terms,
+  stores.receipt_html_template,
+  stores.receipt_pdf_template,
+  stores.receipt_paper_width
+FROM stores
+WHERE stores.store_id = $1;
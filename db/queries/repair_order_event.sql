@@ -0,0 +1,49 @@
+-- name: CreateRepairOrderEvent :exec
+INSERT INTO repair_order_events (
+  event_id,
+  store_id,
+  repair_order_id,
+  event_type,
+  payload,
+  occurred_time,
+  next_attempt_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+);
+
+-- name: ClaimPendingRepairOrderEvents :many
+UPDATE repair_order_events
+SET next_attempt_time = sqlc.arg(lease_until)
+WHERE repair_order_events.event_id IN (
+  SELECT pending.event_id
+  FROM repair_order_events AS pending
+  WHERE
+    pending.dispatched_time IS NULL AND
+    pending.next_attempt_time <= sqlc.arg(now)
+  ORDER BY pending.occurred_time ASC
+  LIMIT sqlc.arg(batch_size)
+  FOR UPDATE SKIP LOCKED
+)
+RETURNING repair_order_events.*;
+
+-- name: MarkRepairOrderEventDispatched :exec
+UPDATE repair_order_events
+SET
+  attempts = repair_order_events.attempts + 1,
+  dispatched_time = sqlc.arg(dispatched_time),
+  last_error = NULL
+WHERE repair_order_events.event_id = sqlc.arg(event_id);
+
+-- name: MarkRepairOrderEventFailed :exec
+UPDATE repair_order_events
+SET
+  attempts = repair_order_events.attempts + 1,
+  next_attempt_time = sqlc.arg(next_attempt_time),
+  last_error = sqlc.arg(last_error)
+WHERE repair_order_events.event_id = sqlc.arg(event_id);
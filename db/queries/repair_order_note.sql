@@ -0,0 +1,38 @@
+-- name: CreateRepairOrderNote :exec
+INSERT INTO repair_order_notes (
+  repair_order_note_id,
+  repair_order_id,
+  author_user_id,
+  body,
+  visibility,
+  creation_time
+) VALUES ($1, $2, $3, $4, $5, $6);
+
+-- name: GetRepairOrderNoteByID :one
+SELECT
+  repair_order_notes.*
+FROM repair_order_notes
+WHERE
+  repair_order_notes.repair_order_id = $1 AND
+  repair_order_notes.repair_order_note_id = $2;
+
+-- name: GetRepairOrderNotes :many
+SELECT
+  repair_order_notes.*,
+  users.username AS author_username
+FROM repair_order_notes
+JOIN users ON users.user_id = repair_order_notes.author_user_id
+WHERE
+  repair_order_notes.repair_order_id = sqlc.arg(repair_order_id) AND
+  (sqlc.narg(visibility)::TEXT IS NULL OR repair_order_notes.visibility = sqlc.narg(visibility)::TEXT)
+ORDER BY repair_order_notes.creation_time ASC;
+
+-- name: UpdateRepairOrderNote :exec
+UPDATE repair_order_notes
+SET
+  body = $3,
+  visibility = $4,
+  edit_time = $5
+WHERE
+  repair_order_notes.repair_order_id = $1 AND
+  repair_order_notes.repair_order_note_id = $2;
@@ -0,0 +1,23 @@
+-- name: FindSession :one
+SELECT
+  sessions.data
+FROM sessions
+WHERE sessions.token = $1 AND sessions.expiry > sqlc.arg(now)::TIMESTAMPTZ;
+
+-- name: CommitSession :exec
+INSERT INTO sessions (
+  token,
+  data,
+  expiry
+) VALUES ($1, $2, $3)
+ON CONFLICT (token) DO UPDATE SET
+  data = EXCLUDED.data,
+  expiry = EXCLUDED.expiry;
+
+-- name: DeleteSession :exec
+DELETE FROM sessions
+WHERE sessions.token = $1;
+
+-- name: DeleteExpiredSessions :execrows
+DELETE FROM sessions
+WHERE sessions.expiry <= sqlc.arg(now)::TIMESTAMPTZ;
@@ -28,8 +28,8 @@ VALUES ($1, $2, $3, $4, $5)
 RETURNING user_id;
 
 -- name: SeedLoginCode :one
-INSERT INTO login_codes (login_code_id, user_id, login_code)
-VALUES ($1, $2, $3)
+INSERT INTO login_codes (login_code_id, user_id, login_code, is_single_use, creation_time)
+VALUES ($1, $2, $3, TRUE, NOW())
 RETURNING login_code_id;
 
 -- name: SeedTechnician :one
@@ -62,6 +62,11 @@ INSERT INTO payment_methods (payment_method_id, payment_method_name, store_id)
 VALUES ($1, $2, $3)
 RETURNING payment_method_id;
 
+-- name: SeedPhoto :one
+INSERT INTO photos (photo_id, store_id, content_type, blob_key, creation_time)
+VALUES ($1, $2, 'image/jpeg', $3, NOW())
+RETURNING photo_id;
+
 -- name: GetRoleForTesting :one
 SELECT
   roles.*
@@ -150,8 +155,55 @@ SELECT
 FROM repair_order_costs
 WHERE repair_order_costs.repair_order_id = $1;
 
+-- name: GetRepairOrderPaymentsForTesting :many
+SELECT
+  repair_order_payments.*
+FROM repair_order_payments
+WHERE repair_order_payments.repair_order_id = $1;
+
 -- name: GetRepairOrderPhotosForTesting :many
 SELECT
   repair_order_photos.*
 FROM repair_order_photos
 WHERE repair_order_photos.repair_order_id = $1;
+
+-- name: SetStoreRequiresConfirmationBeforeCompletion :exec
+UPDATE stores
+SET requires_confirmation_before_completion = $2
+WHERE store_id = $1;
+
+-- name: SetStoreReceiptSettings :exec
+UPDATE stores
+SET
+  warranty_terms = $2,
+  receipt_html_template = $3,
+  receipt_pdf_template = $4
+WHERE store_id = $1;
+
+-- name: SetStoreReceiptPaperWidth :exec
+UPDATE stores
+SET receipt_paper_width = $2
+WHERE store_id = $1;
+
+-- name: SetStoreNotificationLanguage :exec
+UPDATE stores
+SET notification_language = $2
+WHERE store_id = $1;
+
+-- name: SeedNotificationTemplate :exec
+INSERT INTO notification_templates (notification_template_id, store_id, event, language, template)
+VALUES ($1, $2, $3, $4, $5);
+
+-- name: GetNotificationsForTesting :many
+SELECT
+  notifications.*
+FROM notifications
+WHERE notifications.repair_order_id = $1
+ORDER BY notifications.creation_time ASC;
+
+-- name: GetRepairOrderEventsForTesting :many
+SELECT
+  repair_order_events.*
+FROM repair_order_events
+WHERE repair_order_events.repair_order_id = $1
+ORDER BY repair_order_events.occurred_time ASC;
@@ -0,0 +1,40 @@
+-- name: GetUserTOTP :one
+SELECT user_totps.user_id, user_totps.totp_secret, user_totps.creation_time, user_totps.confirmation_time
+FROM user_totps
+WHERE user_totps.user_id = $1;
+
+-- name: UpsertPendingUserTOTP :execrows
+INSERT INTO user_totps (
+  user_id,
+  totp_secret,
+  creation_time
+) VALUES ($1, $2, $3)
+ON CONFLICT (user_id) DO UPDATE
+SET totp_secret = EXCLUDED.totp_secret, creation_time = EXCLUDED.creation_time, last_used_step = NULL
+WHERE user_totps.confirmation_time IS NULL;
+
+-- name: ConfirmUserTOTP :execrows
+UPDATE user_totps
+SET confirmation_time = sqlc.arg(confirmation_time), last_used_step = sqlc.arg(used_step)::BIGINT
+WHERE user_totps.user_id = sqlc.arg(user_id) AND user_totps.confirmation_time IS NULL;
+
+-- name: DeleteUserTOTP :execrows
+DELETE FROM user_totps
+WHERE user_totps.user_id = $1;
+
+-- name: CreateTOTPRecoveryCode :exec
+INSERT INTO totp_recovery_codes (
+  totp_recovery_code_id,
+  user_id,
+  code_hash,
+  creation_time
+) VALUES ($1, $2, $3, $4);
+
+-- name: DeleteTOTPRecoveryCodes :exec
+DELETE FROM totp_recovery_codes
+WHERE totp_recovery_codes.user_id = $1;
+
+-- name: CountUnusedTOTPRecoveryCodes :one
+SELECT COUNT(*)
+FROM totp_recovery_codes
+WHERE totp_recovery_codes.user_id = $1 AND totp_recovery_codes.use_time IS NULL;
@@ -0,0 +1,61 @@
+-- name: CreateUserSession :exec
+INSERT INTO user_sessions (
+  user_session_id,
+  token,
+  user_id,
+  user_agent,
+  ip_address,
+  creation_time,
+  last_seen_time
+) VALUES (
+  sqlc.arg(user_session_id),
+  sqlc.arg(token),
+  sqlc.arg(user_id),
+  sqlc.arg(user_agent),
+  sqlc.arg(ip_address),
+  sqlc.arg(creation_time),
+  sqlc.arg(creation_time)
+);
+
+-- name: TouchUserSession :execrows
+UPDATE user_sessions
+SET last_seen_time = sqlc.arg(now)::TIMESTAMPTZ
+WHERE user_sessions.user_session_id = sqlc.arg(user_session_id) AND user_sessions.user_id = sqlc.arg(user_id);
+
+-- name: GetActiveUserSessions :many
+SELECT
+  user_sessions.user_session_id,
+  user_sessions.user_id,
+  user_sessions.user_agent,
+  user_sessions.ip_address,
+  user_sessions.creation_time,
+  user_sessions.last_seen_time
+FROM user_sessions
+JOIN sessions ON sessions.token = user_sessions.token
+WHERE user_sessions.user_id = sqlc.arg(user_id) AND sessions.expiry > sqlc.arg(now)::TIMESTAMPTZ
+ORDER BY user_sessions.last_seen_time DESC;
+
+-- name: RevokeUserSession :execrows
+DELETE FROM sessions
+USING user_sessions
+WHERE sessions.token = user_sessions.token
+  AND user_sessions.user_session_id = sqlc.arg(user_session_id)
+  AND user_sessions.user_id = sqlc.arg(user_id);
+
+-- name: RevokeOtherUserSessions :execrows
+DELETE FROM sessions
+USING user_sessions
+WHERE sessions.token = user_sessions.token
+  AND user_sessions.user_id = sqlc.arg(user_id)
+  AND user_sessions.user_session_id <> sqlc.arg(keep_user_session_id);
+
+-- name: RevokeAllUserSessions :execrows
+DELETE FROM sessions
+USING user_sessions
+WHERE sessions.token = user_sessions.token
+  AND user_sessions.user_id = sqlc.arg(user_id);
+
+-- name: IsUserInStore :one
+SELECT 1
+FROM users
+WHERE users.user_id = sqlc.arg(user_id) AND users.store_id = sqlc.arg(store_id);
@@ -0,0 +1,160 @@
+-- name: CreateWebhook :exec
+INSERT INTO webhooks (
+  webhook_id,
+  store_id,
+  url,
+  secret,
+  event_types,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+);
+
+-- name: GetWebhooksByStoreID :many
+SELECT webhooks.*
+FROM webhooks
+WHERE webhooks.store_id = $1
+ORDER BY webhooks.creation_time ASC;
+
+-- name: GetWebhookByID :one
+SELECT webhooks.*
+FROM webhooks
+WHERE
+  webhooks.store_id = sqlc.arg(store_id) AND
+  webhooks.webhook_id = sqlc.arg(webhook_id);
+
+-- name: UpdateWebhook :execrows
+UPDATE webhooks
+SET
+  url = sqlc.arg(url),
+  secret = sqlc.arg(secret),
+  event_types = sqlc.arg(event_types),
+  is_enabled = sqlc.arg(is_enabled),
+  consecutive_failures = sqlc.arg(consecutive_failures),
+  disabled_time = sqlc.arg(disabled_time)
+WHERE
+  webhooks.store_id = sqlc.arg(store_id) AND
+  webhooks.webhook_id = sqlc.arg(webhook_id);
+
+-- name: DeleteWebhook :execrows
+DELETE FROM webhooks
+WHERE
+  webhooks.store_id = sqlc.arg(store_id) AND
+  webhooks.webhook_id = sqlc.arg(webhook_id);
+
+-- name: GetEnabledWebhooksForEvent :many
+SELECT webhooks.*
+FROM webhooks
+WHERE
+  webhooks.store_id = sqlc.arg(store_id) AND
+  webhooks.is_enabled AND
+  (cardinality(webhooks.event_types) = 0 OR sqlc.arg(event_type)::TEXT = ANY(webhooks.event_types));
+
+-- name: IncrementWebhookConsecutiveFailures :one
+UPDATE webhooks
+SET consecutive_failures = webhooks.consecutive_failures + 1
+WHERE webhooks.webhook_id = $1
+RETURNING webhooks.consecutive_failures;
+
+-- name: ResetWebhookConsecutiveFailures :exec
+UPDATE webhooks
+SET consecutive_failures = 0
+WHERE webhooks.webhook_id = $1;
+
+-- name: DisableWebhook :exec
+UPDATE webhooks
+SET
+  is_enabled = FALSE,
+  disabled_time = sqlc.arg(disabled_time)
+WHERE
+  webhooks.webhook_id = sqlc.arg(webhook_id) AND
+  webhooks.is_enabled;
+
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+  webhook_delivery_id,
+  webhook_id,
+  event_id,
+  event_type,
+  payload,
+  replay_of,
+  creation_time,
+  next_attempt_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7,
+  $8
+)
+ON CONFLICT (webhook_id, event_id) WHERE replay_of IS NULL DO NOTHING;
+
+-- name: GetWebhookDeliveriesByWebhookID :many
+SELECT webhook_deliveries.*
+FROM webhook_deliveries
+WHERE webhook_deliveries.webhook_id = sqlc.arg(webhook_id)
+ORDER BY webhook_deliveries.creation_time DESC
+LIMIT sqlc.arg(max_count);
+
+-- name: GetWebhookDeliveryByID :one
+SELECT webhook_deliveries.*
+FROM webhook_deliveries
+WHERE
+  webhook_deliveries.webhook_id = sqlc.arg(webhook_id) AND
+  webhook_deliveries.webhook_delivery_id = sqlc.arg(webhook_delivery_id);
+
+-- name: ClaimPendingWebhookDeliveries :many
+UPDATE webhook_deliveries
+SET next_attempt_time = sqlc.arg(lease_until)
+FROM webhooks
+WHERE
+  webhooks.webhook_id = webhook_deliveries.webhook_id AND
+  webhook_deliveries.webhook_delivery_id IN (
+    SELECT pending.webhook_delivery_id
+    FROM webhook_deliveries AS pending
+    JOIN webhooks AS pending_webhooks ON pending_webhooks.webhook_id = pending.webhook_id
+    WHERE
+      pending.delivered_time IS NULL AND
+      pending.failed_time IS NULL AND
+      pending.next_attempt_time <= sqlc.arg(now) AND
+      pending_webhooks.is_enabled
+    ORDER BY pending.next_attempt_time ASC
+    LIMIT sqlc.arg(batch_size)
+    FOR UPDATE OF pending SKIP LOCKED
+  )
+RETURNING
+  webhook_deliveries.webhook_delivery_id,
+  webhook_deliveries.webhook_id,
+  webhook_deliveries.event_id,
+  webhook_deliveries.event_type,
+  webhook_deliveries.payload,
+  webhook_deliveries.attempts,
+  webhooks.url,
+  webhooks.secret;
+
+-- name: MarkWebhookDeliverySucceeded :exec
+UPDATE webhook_deliveries
+SET
+  attempts = webhook_deliveries.attempts + 1,
+  last_status_code = sqlc.arg(status_code),
+  last_error = NULL,
+  delivered_time = sqlc.arg(delivered_time)
+WHERE webhook_deliveries.webhook_delivery_id = sqlc.arg(webhook_delivery_id);
+
+-- name: MarkWebhookDeliveryFailed :exec
+UPDATE webhook_deliveries
+SET
+  attempts = webhook_deliveries.attempts + 1,
+  next_attempt_time = sqlc.arg(next_attempt_time),
+  last_status_code = sqlc.narg(status_code),
+  last_error = sqlc.arg(last_error),
+  failed_time = sqlc.narg(failed_time)
+WHERE webhook_deliveries.webhook_delivery_id = sqlc.arg(webhook_delivery_id);
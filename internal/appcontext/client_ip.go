@@ -0,0 +1,16 @@
+package appcontext
+
+import (
+	"context"
+)
+
+type clientIPCtxKey struct{}
+
+func NewContextWithClientIP(ctx context.Context, ip string) context.Context {
+	return context.WithValue(ctx, clientIPCtxKey{}, ip)
+}
+
+func GetClientIPFromContext(ctx context.Context) (string, bool) {
+	ip, ok := ctx.Value(clientIPCtxKey{}).(string)
+	return ip, ok
+}
@@ -0,0 +1,16 @@
+package appcontext
+
+import (
+	"context"
+)
+
+type correlationIDCtxKey struct{}
+
+func NewContextWithCorrelationID(ctx context.Context, correlationID string) context.Context {
+	return context.WithValue(ctx, correlationIDCtxKey{}, correlationID)
+}
+
+func GetCorrelationIDFromContext(ctx context.Context) (string, bool) {
+	correlationID, ok := ctx.Value(correlationIDCtxKey{}).(string)
+	return correlationID, ok
+}
@@ -0,0 +1,18 @@
+package appcontext
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type sessionIDCtxKey struct{}
+
+func NewContextWithSessionID(ctx context.Context, sessionID uuid.UUID) context.Context {
+	return context.WithValue(ctx, sessionIDCtxKey{}, sessionID)
+}
+
+func GetSessionIDFromContext(ctx context.Context) (uuid.UUID, bool) {
+	sessionID, ok := ctx.Value(sessionIDCtxKey{}).(uuid.UUID)
+	return sessionID, ok
+}
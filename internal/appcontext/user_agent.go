@@ -0,0 +1,16 @@
+package appcontext
+
+import (
+	"context"
+)
+
+type userAgentCtxKey struct{}
+
+func NewContextWithUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentCtxKey{}, userAgent)
+}
+
+func GetUserAgentFromContext(ctx context.Context) (string, bool) {
+	userAgent, ok := ctx.Value(userAgentCtxKey{}).(string)
+	return userAgent, ok
+}
@@ -7,16 +7,33 @@ func (e appError) Error() string {
 }
 
 const (
-	ErrValueAlreadySet        appError = appError("value already set")
-	ErrInvalidInput           appError = appError("invalid input")
-	ErrPasswordTooLong        appError = appError("password too long")
-	ErrPasswordMismatch       appError = appError("password mismatch")
-	ErrMisingLoginCodePrompt  appError = appError("missing login code prompt")
-	ErrMissingSession         appError = appError("missing session")
-	ErrUserNotFound           appError = appError("user not found")
-	ErrDamageNotFound         appError = appError("damage not found")
-	ErrPhoneConditionNotFound appError = appError("phone condition not found")
-	ErrPhoneEquipmentNotFound appError = appError("phone equipment not found")
-	ErrPermissionNotFound     appError = appError("permission not found")
-	ErrLoginCodeMismatch      appError = appError("login code mismatch")
+	ErrValueAlreadySet                   appError = appError("value already set")
+	ErrInvalidInput                      appError = appError("invalid input")
+	ErrPasswordTooLong                   appError = appError("password too long")
+	ErrPasswordMismatch                  appError = appError("password mismatch")
+	ErrMisingLoginCodePrompt             appError = appError("missing login code prompt")
+	ErrMissingSession                    appError = appError("missing session")
+	ErrUserNotFound                      appError = appError("user not found")
+	ErrUserSessionNotFound               appError = appError("user session not found")
+	ErrDamageNotFound                    appError = appError("damage not found")
+	ErrPhoneConditionNotFound            appError = appError("phone condition not found")
+	ErrPhoneEquipmentNotFound            appError = appError("phone equipment not found")
+	ErrPermissionNotFound                appError = appError("permission not found")
+	ErrLoginCodeMismatch                 appError = appError("login code mismatch")
+	ErrLoginCodeNotFound                 appError = appError("login code not found")
+	ErrTOTPNotFound                      appError = appError("totp not found")
+	ErrTOTPAlreadyEnabled                appError = appError("totp already enabled")
+	ErrLoginThrottleNotFound             appError = appError("login throttle not found")
+	ErrRepairOrderNotFound               appError = appError("repair order not found")
+	ErrRepairOrderConcurrentUpdate       appError = appError("repair order was updated concurrently")
+	ErrRepairOrderNoteNotFound           appError = appError("repair order note not found")
+	ErrRepairOrderPhotoNotFound          appError = appError("repair order photo not found")
+	ErrRepairOrderDamageNotFound         appError = appError("repair order damage not found")
+	ErrRepairOrderPhoneConditionNotFound appError = appError("repair order phone condition not found")
+	ErrRepairOrderPhoneEquipmentNotFound appError = appError("repair order phone equipment not found")
+	ErrInvalidStateTransition            appError = appError("invalid state transition")
+	ErrWebhookNotFound                   appError = appError("webhook not found")
+	ErrWebhookDeliveryNotFound           appError = appError("webhook delivery not found")
+	ErrPhotoNotFound                     appError = appError("photo not found")
+	ErrBlobNotFound                      appError = appError("blob not found")
 )
@@ -0,0 +1,254 @@
+package escpos
+
+import (
+	"bytes"
+	"errors"
+	"strings"
+	"unicode/utf8"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+var ErrUnsupportedPaperWidth = errors.New("unsupported paper width")
+
+type PaperWidth int
+
+const (
+	PaperWidth58mm PaperWidth = 58
+	PaperWidth80mm PaperWidth = 80
+)
+
+func NewPaperWidth(mm int) (PaperWidth, error) {
+	switch width := PaperWidth(mm); width {
+	case PaperWidth58mm, PaperWidth80mm:
+		return width, nil
+	default:
+		return 0, ErrUnsupportedPaperWidth
+	}
+}
+
+// Columns returns the number of characters per line in the printer's default font.
+func (w PaperWidth) Columns() int {
+	if w == PaperWidth80mm {
+		return 48
+	}
+
+	return 32
+}
+
+func (w PaperWidth) qrModuleSize() byte {
+	if w == PaperWidth80mm {
+		return 8
+	}
+
+	return 6
+}
+
+const (
+	esc = 0x1b
+	gs  = 0x1d
+	lf  = 0x0a
+)
+
+const (
+	alignLeft   = 0
+	alignCenter = 1
+)
+
+// Document builds an ESC/POS byte stream. Text is encoded in code page 437,
+// which is selected at the start of the document.
+type Document struct {
+	buf     bytes.Buffer
+	width   PaperWidth
+	encoder *encoding.Encoder
+}
+
+func NewDocument(width PaperWidth) *Document {
+	d := &Document{
+		width:   width,
+		encoder: encoding.ReplaceUnsupported(charmap.CodePage437.NewEncoder()),
+	}
+
+	d.buf.Write([]byte{esc, '@'})
+	d.buf.Write([]byte{esc, 't', 0})
+
+	return d
+}
+
+// Heading prints centered, bold text at double width and height.
+func (d *Document) Heading(text string) {
+	d.setAlign(alignCenter)
+	d.setBold(true)
+	d.buf.Write([]byte{gs, '!', 0x11})
+
+	for _, line := range wrap(text, d.width.Columns()/2) {
+		d.writeLine(line)
+	}
+
+	d.buf.Write([]byte{gs, '!', 0x00})
+	d.setBold(false)
+}
+
+// Title prints left-aligned, bold text.
+func (d *Document) Title(text string) {
+	d.setAlign(alignLeft)
+	d.setBold(true)
+
+	for _, line := range wrap(text, d.width.Columns()) {
+		d.writeLine(line)
+	}
+
+	d.setBold(false)
+}
+
+// Text prints centered text, wrapped to the paper width.
+func (d *Document) Text(text string) {
+	d.setAlign(alignCenter)
+
+	for _, line := range wrap(text, d.width.Columns()) {
+		d.writeLine(line)
+	}
+}
+
+// Row prints the label on the left and the value on the right of the same
+// line, moving the value to its own line when both do not fit.
+func (d *Document) Row(label string, value string) {
+	d.setAlign(alignLeft)
+
+	columns := d.width.Columns()
+	labelWidth := utf8.RuneCountInString(label)
+	valueWidth := utf8.RuneCountInString(value)
+
+	if labelWidth+1+valueWidth <= columns {
+		d.writeLine(label + strings.Repeat(" ", columns-labelWidth-valueWidth) + value)
+		return
+	}
+
+	for _, line := range wrap(label, columns) {
+		d.writeLine(line)
+	}
+
+	for _, line := range wrap(value, columns) {
+		d.writeLine(strings.Repeat(" ", columns-utf8.RuneCountInString(line)) + line)
+	}
+}
+
+func (d *Document) Rule() {
+	d.setAlign(alignLeft)
+	d.writeLine(strings.Repeat("-", d.width.Columns()))
+}
+
+func (d *Document) Feed(lines int) {
+	for range lines {
+		d.buf.WriteByte(lf)
+	}
+}
+
+// QRCode prints a centered QR code using the printer's native QR code commands.
+func (d *Document) QRCode(data string) {
+	const (
+		fnModel           = 'A'
+		fnModuleSize      = 'C'
+		fnErrorCorrection = 'E'
+		fnStore           = 'P'
+		fnPrint           = 'Q'
+
+		model2           = '2'
+		errorCorrectionM = '1'
+	)
+
+	d.setAlign(alignCenter)
+
+	d.qrCommand(fnModel, model2, 0)
+	d.qrCommand(fnModuleSize, d.width.qrModuleSize())
+	d.qrCommand(fnErrorCorrection, errorCorrectionM)
+	d.qrCommand(fnStore, append([]byte{'0'}, data...)...)
+	d.qrCommand(fnPrint, '0')
+
+	d.buf.WriteByte(lf)
+}
+
+// Cut feeds the paper past the cutter and performs a partial cut.
+func (d *Document) Cut() {
+	d.buf.Write([]byte{gs, 'V', 'B', 0})
+}
+
+func (d *Document) Bytes() []byte {
+	return d.buf.Bytes()
+}
+
+func (d *Document) qrCommand(fn byte, params ...byte) {
+	length := len(params) + 2
+
+	d.buf.Write([]byte{gs, '(', 'k', byte(length), byte(length >> 8), '1', fn})
+	d.buf.Write(params)
+}
+
+func (d *Document) setAlign(align byte) {
+	d.buf.Write([]byte{esc, 'a', align})
+}
+
+func (d *Document) setBold(bold bool) {
+	var n byte
+	if bold {
+		n = 1
+	}
+
+	d.buf.Write([]byte{esc, 'E', n})
+}
+
+func (d *Document) writeLine(line string) {
+	encoded, err := d.encoder.String(line)
+	if err != nil {
+		// Unsupported characters are already replaced, so this should never happen.
+		encoded = strings.Map(func(r rune) rune {
+			if r >= utf8.RuneSelf {
+				return '?'
+			}
+
+			return r
+		}, line)
+	}
+
+	d.buf.WriteString(encoded)
+	d.buf.WriteByte(lf)
+}
+
+func wrap(text string, columns int) []string {
+	var (
+		lines   []string
+		current []rune
+	)
+
+	for _, word := range strings.Fields(text) {
+		runes := []rune(word)
+
+		if len(current) > 0 && len(current)+1+len(runes) > columns {
+			lines = append(lines, string(current))
+			current = nil
+		}
+
+		for len(runes) > columns {
+			if len(current) > 0 {
+				lines = append(lines, string(current))
+				current = nil
+			}
+
+			lines = append(lines, string(runes[:columns]))
+			runes = runes[columns:]
+		}
+
+		if len(current) > 0 {
+			current = append(current, ' ')
+		}
+
+		current = append(current, runes...)
+	}
+
+	if len(current) > 0 || len(lines) == 0 {
+		lines = append(lines, string(current))
+	}
+
+	return lines
+}
@@ -7,8 +7,13 @@ import (
 
 	"github.com/ogen-go/ogen/middleware"
 	"github.com/ogen-go/ogen/ogenerrors"
+	"github.com/ogen-go/ogen/ogenregex"
 )
 
+var regexMap = map[string]ogenregex.Regexp{
+	"^[0-9]{4}$": ogenregex.MustCompile("^[0-9]{4}$"),
+}
+
 type (
 	optionFunc[C any] func(*C)
 )
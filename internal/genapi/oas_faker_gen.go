@@ -4,10 +4,106 @@ package genapi
 
 import (
 	"net/url"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// SetFake set fake values.
+func (s *AddRepairOrderCostRequest) SetFake() {
+	{
+		{
+			s.Amount = int(0)
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AddRepairOrderDamagesRequest) SetFake() {
+	{
+		{
+			s.DamageTypes = nil
+			for i := 0; i < 1; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.DamageTypes = append(s.DamageTypes, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AddRepairOrderNoteRequest) SetFake() {
+	{
+		{
+			s.Body = "string"
+		}
+	}
+	{
+		{
+			s.Visibility.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AddRepairOrderPhoneConditionsRequest) SetFake() {
+	{
+		{
+			s.PhoneConditions = nil
+			for i := 0; i < 1; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.PhoneConditions = append(s.PhoneConditions, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AddRepairOrderPhoneEquipmentsRequest) SetFake() {
+	{
+		{
+			s.PhoneEquipments = nil
+			for i := 0; i < 1; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.PhoneEquipments = append(s.PhoneEquipments, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AddRepairOrderPhotoRequest) SetFake() {
+	{
+		{
+			s.PhotoID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Stage.SetFake()
+		}
+	}
+	{
+		{
+			s.Caption.SetFake()
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *AssignPermissionsToRoleRequest) SetFake() {
 	{
@@ -38,6 +134,161 @@ func (s *AssignPermissionsToRoleRequestPermissionsItem) SetFake() {
 	}
 }
 
+// SetFake set fake values.
+func (s *AuditLogAction) SetFake() {
+	*s = AuditLogActionRepairOrderCreated
+}
+
+// SetFake set fake values.
+func (s *AuditLogEntityType) SetFake() {
+	*s = AuditLogEntityTypeRepairOrder
+}
+
+// SetFake set fake values.
+func (s *AuditLogEntry) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Action.SetFake()
+		}
+	}
+	{
+		{
+			s.EntityType.SetFake()
+		}
+	}
+	{
+		{
+			s.EntityID = uuid.New()
+		}
+	}
+	{
+		{
+			s.UserID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Username = "string"
+		}
+	}
+	{
+		{
+			s.CorrelationID.SetFake()
+		}
+	}
+	{
+		{
+			s.Before = []byte("null")
+		}
+	}
+	{
+		{
+			s.After = []byte("null")
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *AuditLogEntryList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem AuditLogEntry
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+	{
+		{
+			s.NextCursor.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *CancelRepairOrderRequest) SetFake() {
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+	{
+		{
+			s.Fee.SetFake()
+		}
+	}
+	{
+		{
+			s.Refund.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *CancelRepairOrderRequestRefund) SetFake() {
+	{
+		{
+			s.Amount = int(0)
+		}
+	}
+	{
+		{
+			s.Method = uuid.New()
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ChangeRepairOrderTechnicianRequest) SetFake() {
+	{
+		{
+			s.TechnicianID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ConfirmRepairOrderRequest) SetFake() {
+	{
+		{
+			s.Contents = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *ConfirmTOTPRequest) SetFake() {
+	{
+		{
+			s.Code = "string"
+		}
+	}
+}
+
 // SetFake set fake values.
 func (s *CreateDamageTypeRequest) SetFake() {
 	{
@@ -111,6 +362,11 @@ func (s *CreateRepairOrderRequest) SetFake() {
 			s.Color = "string"
 		}
 	}
+	{
+		{
+			s.EstimatedCompletionTime.SetFake()
+		}
+	}
 	{
 		{
 			s.InitialCost = int(0)
@@ -171,9 +427,9 @@ func (s *CreateRepairOrderRequest) SetFake() {
 		{
 			s.Photos = nil
 			for i := 0; i < 1; i++ {
-				var elem url.URL
+				var elem uuid.UUID
 				{
-					elem = url.URL{Scheme: "https", Host: "github.com", Path: "/ogen-go/ogen"}
+					elem = uuid.New()
 				}
 				s.Photos = append(s.Photos, elem)
 			}
@@ -242,81 +498,1243 @@ func (s *CreateTechnicianRequest) SetFake() {
 }
 
 // SetFake set fake values.
-func (s *Error) SetFake() {
+func (s *CreateWebhookRequest) SetFake() {
 	{
 		{
-			s.Message = "string"
+			s.URL = url.URL{Scheme: "https", Host: "github.com", Path: "/ogen-go/ogen"}
+		}
+	}
+	{
+		{
+			s.Secret = "string"
+		}
+	}
+	{
+		{
+			s.EventTypes = nil
+			for i := 0; i < 0; i++ {
+				var elem WebhookEventType
+				{
+					elem.SetFake()
+				}
+				s.EventTypes = append(s.EventTypes, elem)
+			}
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *LoginCodePrompt) SetFake() {
+func (s *DisableTOTPRequest) SetFake() {
 	{
 		{
-			s.LoginCode = "string"
+			s.Code = "string"
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *LoginCredentials) SetFake() {
+func (s *EditRepairOrderNoteRequest) SetFake() {
 	{
 		{
-			s.Username = "string"
+			s.Body = "string"
 		}
 	}
 	{
 		{
-			s.Password = "string"
+			s.Visibility.SetFake()
 		}
 	}
+}
+
+// SetFake set fake values.
+func (s *Error) SetFake() {
 	{
 		{
-			s.StoreCode = "string"
+			s.Message = "string"
 		}
 	}
 }
 
 // SetFake set fake values.
-func (s *LoginResponse) SetFake() {
+func (s *IssueLoginCodeRequest) SetFake() {
 	{
 		{
 			s.Type.SetFake()
 		}
 	}
+	{
+		{
+			s.ValidForMinutes.SetFake()
+		}
+	}
 }
 
 // SetFake set fake values.
-func (s *LoginResponseType) SetFake() {
-	*s = LoginResponseTypeAdmin
-}
-
-// SetFake set fake values.
-func (s *OptCreateRepairOrderRequestDownPayment) SetFake() {
-	var elem CreateRepairOrderRequestDownPayment
+func (s *IssuedLoginCode) SetFake() {
 	{
-		elem.SetFake()
-	}
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Code = "string"
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.ExpiryTime.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LoginCode) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.ExpiryTime.SetFake()
+		}
+	}
+	{
+		{
+			s.IssuedBy.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LoginCodeList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem LoginCode
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LoginCodePrompt) SetFake() {
+	{
+		{
+			s.LoginCode = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LoginCodeType) SetFake() {
+	*s = LoginCodeTypeSingleUse
+}
+
+// SetFake set fake values.
+func (s *LoginCredentials) SetFake() {
+	{
+		{
+			s.Username = "string"
+		}
+	}
+	{
+		{
+			s.Password = "string"
+		}
+	}
+	{
+		{
+			s.StoreCode = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LoginResponse) SetFake() {
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *LoginResponseType) SetFake() {
+	*s = LoginResponseTypeAdmin
+}
+
+// SetFake set fake values.
+func (s *OptCancelRepairOrderRequestRefund) SetFake() {
+	var elem CancelRepairOrderRequestRefund
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCreateRepairOrderRequestDownPayment) SetFake() {
+	var elem CreateRepairOrderRequestDownPayment
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptCreateRepairOrderRequestPasscode) SetFake() {
+	var elem CreateRepairOrderRequestPasscode
+	{
+		elem.SetFake()
+	}
 	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptCreateRepairOrderRequestPasscode) SetFake() {
-	var elem CreateRepairOrderRequestPasscode
+func (s *OptDateTime) SetFake() {
+	var elem time.Time
+	{
+		elem = time.Now()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptInt) SetFake() {
+	var elem int
+	{
+		elem = int(0)
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptPickUpRepairOrderRequest) SetFake() {
+	var elem PickUpRepairOrderRequest
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptPickUpRepairOrderRequestRepayment) SetFake() {
+	var elem PickUpRepairOrderRequestRepayment
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRenderRepairOrderLabelsRequestFormat) SetFake() {
+	var elem RenderRepairOrderLabelsRequestFormat
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRepairOrderCancellation) SetFake() {
+	var elem RepairOrderCancellation
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRepairOrderConfirmation) SetFake() {
+	var elem RepairOrderConfirmation
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRepairOrderPasscode) SetFake() {
+	var elem RepairOrderPasscode
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptRepairOrderWriteOff) SetFake() {
+	var elem RepairOrderWriteOff
+	{
+		elem.SetFake()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptString) SetFake() {
+	var elem string
+	{
+		elem = "string"
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *OptUUID) SetFake() {
+	var elem uuid.UUID
+	{
+		elem = uuid.New()
+	}
+	s.SetTo(elem)
+}
+
+// SetFake set fake values.
+func (s *Photo) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.ContentType = "string"
+		}
+	}
+	{
+		{
+			s.HasThumbnail = true
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PickUpRepairOrderRequest) SetFake() {
+	{
+		{
+			s.Repayment.SetFake()
+		}
+	}
+	{
+		{
+			s.WriteOffReason.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PickUpRepairOrderRequestRepayment) SetFake() {
+	{
+		{
+			s.Amount = int(0)
+		}
+	}
+	{
+		{
+			s.Method = uuid.New()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PublicRepairOrder) SetFake() {
+	{
+		{
+			s.Slug = "string"
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+	{
+		{
+			s.PhoneType = "string"
+		}
+	}
+	{
+		{
+			s.EstimatedCompletionTime.SetFake()
+		}
+	}
+	{
+		{
+			s.CompletionTime.SetFake()
+		}
+	}
+	{
+		{
+			s.PickUpTime.SetFake()
+		}
+	}
+	{
+		{
+			s.OutstandingAmount = int(0)
+		}
+	}
+	{
+		{
+			s.Notes = nil
+			for i := 0; i < 0; i++ {
+				var elem PublicRepairOrderNotesItem
+				{
+					elem.SetFake()
+				}
+				s.Notes = append(s.Notes, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PublicRepairOrderNotesItem) SetFake() {
+	{
+		{
+			s.Body = "string"
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *PublicRepairOrderStatus) SetFake() {
+	*s = PublicRepairOrderStatusOpen
+}
+
+// SetFake set fake values.
+func (s *RecordRepairOrderPaymentRequest) SetFake() {
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.Amount = int(0)
+		}
+	}
+	{
+		{
+			s.Method = uuid.New()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RecordRepairOrderPaymentRequestType) SetFake() {
+	*s = RecordRepairOrderPaymentRequestTypeDownPayment
+}
+
+// SetFake set fake values.
+func (s *RenderRepairOrderLabelsRequest) SetFake() {
+	{
+		{
+			s.RepairOrderIds = nil
+			for i := 0; i < 1; i++ {
+				var elem uuid.UUID
+				{
+					elem = uuid.New()
+				}
+				s.RepairOrderIds = append(s.RepairOrderIds, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Format.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RenderRepairOrderLabelsRequestFormat) SetFake() {
+	*s = RenderRepairOrderLabelsRequestFormatPNG
+}
+
+// SetFake set fake values.
+func (s *RepairOrder) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Slug = "string"
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+	{
+		{
+			s.CustomerName = "string"
+		}
+	}
+	{
+		{
+			s.ContactPhoneNumber = "string"
+		}
+	}
+	{
+		{
+			s.PhoneType = "string"
+		}
+	}
+	{
+		{
+			s.Color = "string"
+		}
+	}
+	{
+		{
+			s.Imei.SetFake()
+		}
+	}
+	{
+		{
+			s.PartsNotCheckedYet.SetFake()
+		}
+	}
+	{
+		{
+			s.Passcode.SetFake()
+		}
+	}
+	{
+		{
+			s.SalesPersonID = uuid.New()
+		}
+	}
+	{
+		{
+			s.TechnicianID = uuid.New()
+		}
+	}
+	{
+		{
+			s.TechnicianAssignments = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderTechnicianAssignmentsItem
+				{
+					elem.SetFake()
+				}
+				s.TechnicianAssignments = append(s.TechnicianAssignments, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Costs = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderCostsItem
+				{
+					elem.SetFake()
+				}
+				s.Costs = append(s.Costs, elem)
+			}
+		}
+	}
+	{
+		{
+			s.TotalCost = int(0)
+		}
+	}
+	{
+		{
+			s.PaidAmount = int(0)
+		}
+	}
+	{
+		{
+			s.OutstandingAmount = int(0)
+		}
+	}
+	{
+		{
+			s.Payments = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderPayment
+				{
+					elem.SetFake()
+				}
+				s.Payments = append(s.Payments, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Damages = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderDamagesItem
+				{
+					elem.SetFake()
+				}
+				s.Damages = append(s.Damages, elem)
+			}
+		}
+	}
+	{
+		{
+			s.PhoneConditions = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderPhoneConditionsItem
+				{
+					elem.SetFake()
+				}
+				s.PhoneConditions = append(s.PhoneConditions, elem)
+			}
+		}
+	}
+	{
+		{
+			s.PhoneEquipments = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderPhoneEquipmentsItem
+				{
+					elem.SetFake()
+				}
+				s.PhoneEquipments = append(s.PhoneEquipments, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Photos = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderPhotosItem
+				{
+					elem.SetFake()
+				}
+				s.Photos = append(s.Photos, elem)
+			}
+		}
+	}
+	{
+		{
+			s.WriteOff.SetFake()
+		}
+	}
+	{
+		{
+			s.Confirmation.SetFake()
+		}
+	}
+	{
+		{
+			s.EstimatedCompletionTime.SetFake()
+		}
+	}
+	{
+		{
+			s.CompletionTime.SetFake()
+		}
+	}
+	{
+		{
+			s.PickUpTime.SetFake()
+		}
+	}
+	{
+		{
+			s.Cancellation.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderCancellation) SetFake() {
+	{
+		{
+			s.Time = time.Now()
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+	{
+		{
+			s.Fee.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderConfirmation) SetFake() {
+	{
+		{
+			s.Time = time.Now()
+		}
+	}
+	{
+		{
+			s.Contents = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderCostsItem) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Amount = int(0)
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+	{
+		{
+			s.IsInitial = true
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderDamagesItem) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderSummary
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+	{
+		{
+			s.TotalCount = int(0)
+		}
+	}
+	{
+		{
+			s.NextCursor.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderNote) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Body = "string"
+		}
+	}
+	{
+		{
+			s.Visibility.SetFake()
+		}
+	}
+	{
+		{
+			s.AuthorID = uuid.New()
+		}
+	}
+	{
+		{
+			s.AuthorUsername = "string"
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+	{
+		{
+			s.EditTime.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderNoteList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderNote
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderNoteVisibility) SetFake() {
+	*s = RepairOrderNoteVisibilityInternal
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPasscode) SetFake() {
+	{
+		{
+			s.IsPatternLocked = true
+		}
+	}
+	{
+		{
+			s.Value = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPayment) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Type.SetFake()
+		}
+	}
+	{
+		{
+			s.Amount = int(0)
+		}
+	}
+	{
+		{
+			s.Method = uuid.New()
+		}
+	}
+	{
+		{
+			s.Reason.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPaymentList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderPayment
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+	{
+		{
+			s.TotalCost = int(0)
+		}
+	}
+	{
+		{
+			s.PaidAmount = int(0)
+		}
+	}
+	{
+		{
+			s.OutstandingAmount = int(0)
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPaymentType) SetFake() {
+	*s = RepairOrderPaymentTypeDownPayment
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPhoneConditionsItem) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPhoneEquipmentsItem) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Name = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPhotoStage) SetFake() {
+	*s = RepairOrderPhotoStageIntake
+}
+
+// SetFake set fake values.
+func (s *RepairOrderPhotosItem) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.PhotoID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Stage.SetFake()
+		}
+	}
+	{
+		{
+			s.Caption.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderSummary) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Slug = "string"
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+	{
+		{
+			s.CustomerName = "string"
+		}
+	}
+	{
+		{
+			s.ContactPhoneNumber = "string"
+		}
+	}
+	{
+		{
+			s.PhoneType = "string"
+		}
+	}
+	{
+		{
+			s.Color = "string"
+		}
+	}
+	{
+		{
+			s.Imei.SetFake()
+		}
+	}
+	{
+		{
+			s.TechnicianID = uuid.New()
+		}
+	}
+	{
+		{
+			s.SalesPersonID = uuid.New()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderSummaryStatus) SetFake() {
+	*s = RepairOrderSummaryStatusOpen
+}
+
+// SetFake set fake values.
+func (s *RepairOrderTechnicianAssignmentsItem) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.PreviousTechnicianID = uuid.New()
+		}
+	}
+	{
+		{
+			s.TechnicianID = uuid.New()
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *RepairOrderWriteOff) SetFake() {
+	{
+		{
+			s.Amount = int(0)
+		}
+	}
+	{
+		{
+			s.Reason = "string"
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TOTPEnrollment) SetFake() {
 	{
-		elem.SetFake()
+		{
+			s.Secret = "string"
+		}
+	}
+	{
+		{
+			s.ProvisioningURI = "string"
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
-func (s *OptString) SetFake() {
-	var elem string
+func (s *TOTPRecoveryCodes) SetFake() {
 	{
-		elem = "string"
+		{
+			s.RecoveryCodes = nil
+			for i := 0; i < 0; i++ {
+				var elem string
+				{
+					elem = "string"
+				}
+				s.RecoveryCodes = append(s.RecoveryCodes, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TOTPStatus) SetFake() {
+	{
+		{
+			s.Enabled = true
+		}
+	}
+	{
+		{
+			s.RemainingRecoveryCodes.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TechnicianQueue) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem RepairOrderSummary
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TechnicianWorkload) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem TechnicianWorkloadItem
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *TechnicianWorkloadItem) SetFake() {
+	{
+		{
+			s.TechnicianID = uuid.New()
+		}
+	}
+	{
+		{
+			s.TechnicianName = "string"
+		}
+	}
+	{
+		{
+			s.OpenCount = int(0)
+		}
+	}
+	{
+		{
+			s.ConfirmedCount = int(0)
+		}
+	}
+	{
+		{
+			s.OldestOrderCreationTime.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UpdateWebhookRequest) SetFake() {
+	{
+		{
+			s.URL = url.URL{Scheme: "https", Host: "github.com", Path: "/ogen-go/ogen"}
+		}
+	}
+	{
+		{
+			s.Secret.SetFake()
+		}
+	}
+	{
+		{
+			s.EventTypes = nil
+			for i := 0; i < 0; i++ {
+				var elem WebhookEventType
+				{
+					elem.SetFake()
+				}
+				s.EventTypes = append(s.EventTypes, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Enabled = true
+		}
 	}
-	s.SetTo(elem)
 }
 
 // SetFake set fake values.
@@ -380,3 +1798,195 @@ func (s *UserDetailsStore) SetFake() {
 		}
 	}
 }
+
+// SetFake set fake values.
+func (s *UserSession) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.UserAgent.SetFake()
+		}
+	}
+	{
+		{
+			s.IPAddress.SetFake()
+		}
+	}
+	{
+		{
+			s.IsCurrent = true
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+	{
+		{
+			s.LastSeenTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *UserSessionList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem UserSession
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *Webhook) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.URL = url.URL{Scheme: "https", Host: "github.com", Path: "/ogen-go/ogen"}
+		}
+	}
+	{
+		{
+			s.EventTypes = nil
+			for i := 0; i < 0; i++ {
+				var elem WebhookEventType
+				{
+					elem.SetFake()
+				}
+				s.EventTypes = append(s.EventTypes, elem)
+			}
+		}
+	}
+	{
+		{
+			s.Enabled = true
+		}
+	}
+	{
+		{
+			s.ConsecutiveFailures = int(0)
+		}
+	}
+	{
+		{
+			s.DisabledTime.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *WebhookDelivery) SetFake() {
+	{
+		{
+			s.ID = uuid.New()
+		}
+	}
+	{
+		{
+			s.EventID = uuid.New()
+		}
+	}
+	{
+		{
+			s.EventType.SetFake()
+		}
+	}
+	{
+		{
+			s.Status.SetFake()
+		}
+	}
+	{
+		{
+			s.ReplayOf.SetFake()
+		}
+	}
+	{
+		{
+			s.Attempts = int(0)
+		}
+	}
+	{
+		{
+			s.LastStatusCode.SetFake()
+		}
+	}
+	{
+		{
+			s.LastError.SetFake()
+		}
+	}
+	{
+		{
+			s.CreationTime = time.Now()
+		}
+	}
+	{
+		{
+			s.DeliveredTime.SetFake()
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *WebhookDeliveryList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem WebhookDelivery
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+}
+
+// SetFake set fake values.
+func (s *WebhookDeliveryStatus) SetFake() {
+	*s = WebhookDeliveryStatusPending
+}
+
+// SetFake set fake values.
+func (s *WebhookEventType) SetFake() {
+	*s = WebhookEventTypeOrderCreated
+}
+
+// SetFake set fake values.
+func (s *WebhookList) SetFake() {
+	{
+		{
+			s.Items = nil
+			for i := 0; i < 0; i++ {
+				var elem Webhook
+				{
+					elem.SetFake()
+				}
+				s.Items = append(s.Items, elem)
+			}
+		}
+	}
+}
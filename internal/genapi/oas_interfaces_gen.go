@@ -0,0 +1,18 @@
+// Code generated by ogen, DO NOT EDIT.
+package genapi
+
+type GetPhotoRes interface {
+	getPhotoRes()
+}
+
+type GetRepairOrderLabelRes interface {
+	getRepairOrderLabelRes()
+}
+
+type GetRepairOrderReceiptRes interface {
+	getRepairOrderReceiptRes()
+}
+
+type RenderRepairOrderLabelsRes interface {
+	renderRepairOrderLabelsRes()
+}
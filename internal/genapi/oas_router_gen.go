@@ -40,7 +40,7 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		s.notFound(w, r)
 		return
 	}
-	args := [1]string{}
+	args := [2]string{}
 
 	// Static code generated router with unwrapped path search.
 	switch {
@@ -61,9 +61,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				break
 			}
 			switch elem[0] {
-			case 'a': // Prefix: "auth/log"
+			case 'a': // Prefix: "au"
 				origElem := elem
-				if l := len("auth/log"); len(elem) >= l && elem[0:l] == "auth/log" {
+				if l := len("au"); len(elem) >= l && elem[0:l] == "au" {
 					elem = elem[l:]
 				} else {
 					break
@@ -73,67 +73,103 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					break
 				}
 				switch elem[0] {
-				case 'i': // Prefix: "in"
+				case 'd': // Prefix: "dit-log"
 					origElem := elem
-					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					if l := len("dit-log"); len(elem) >= l && elem[0:l] == "dit-log" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
+						// Leaf node.
 						switch r.Method {
-						case "POST":
-							s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleSearchAuditLogRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "POST")
+							s.notAllowed(w, r, "GET")
 						}
 
 						return
 					}
+
+					elem = origElem
+				case 't': // Prefix: "th/log"
+					origElem := elem
+					if l := len("th/log"); len(elem) >= l && elem[0:l] == "th/log" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
 					switch elem[0] {
-					case '-': // Prefix: "-code"
+					case 'i': // Prefix: "in"
 						origElem := elem
-						if l := len("-code"); len(elem) >= l && elem[0:l] == "-code" {
+						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleLoginCodePromptRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleLoginRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '-': // Prefix: "-code"
+							origElem := elem
+							if l := len("-code"); len(elem) >= l && elem[0:l] == "-code" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleLoginCodePromptRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
 
 						elem = origElem
-					}
+					case 'o': // Prefix: "out"
+						origElem := elem
+						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+							elem = elem[l:]
+						} else {
+							break
+						}
 
-					elem = origElem
-				case 'o': // Prefix: "out"
-					origElem := elem
-					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "POST":
+								s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "POST")
+							}
 
-					if len(elem) == 0 {
-						// Leaf node.
-						switch r.Method {
-						case "POST":
-							s.handleLogoutRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+							return
 						}
 
-						return
+						elem = origElem
 					}
 
 					elem = origElem
@@ -215,9 +251,9 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					elem = origElem
-				case 'h': // Prefix: "hone-"
+				case 'h': // Prefix: "ho"
 					origElem := elem
-					if l := len("hone-"); len(elem) >= l && elem[0:l] == "hone-" {
+					if l := len("ho"); len(elem) >= l && elem[0:l] == "ho" {
 						elem = elem[l:]
 					} else {
 						break
@@ -227,50 +263,171 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						break
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "conditions"
+					case 'n': // Prefix: "ne-"
 						origElem := elem
-						if l := len("conditions"); len(elem) >= l && elem[0:l] == "conditions" {
+						if l := len("ne-"); len(elem) >= l && elem[0:l] == "ne-" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
-							switch r.Method {
-							case "POST":
-								s.handleCreatePhoneConditionRequest([0]string{}, elemIsEscaped, w, r)
-							default:
-								s.notAllowed(w, r, "POST")
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "conditions"
+							origElem := elem
+							if l := len("conditions"); len(elem) >= l && elem[0:l] == "conditions" {
+								elem = elem[l:]
+							} else {
+								break
 							}
 
-							return
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCreatePhoneConditionRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'e': // Prefix: "equipments"
+							origElem := elem
+							if l := len("equipments"); len(elem) >= l && elem[0:l] == "equipments" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleCreatePhoneEquipmentRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
+
+								return
+							}
+
+							elem = origElem
 						}
 
 						elem = origElem
-					case 'e': // Prefix: "equipments"
+					case 't': // Prefix: "tos"
 						origElem := elem
-						if l := len("equipments"); len(elem) >= l && elem[0:l] == "equipments" {
+						if l := len("tos"); len(elem) >= l && elem[0:l] == "tos" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							// Leaf node.
 							switch r.Method {
 							case "POST":
-								s.handleCreatePhoneEquipmentRequest([0]string{}, elemIsEscaped, w, r)
+								s.handleUploadPhotoRequest([0]string{}, elemIsEscaped, w, r)
 							default:
 								s.notAllowed(w, r, "POST")
 							}
 
 							return
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "photoId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleGetPhotoRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/thumbnail"
+								origElem := elem
+								if l := len("/thumbnail"); len(elem) >= l && elem[0:l] == "/thumbnail" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetPhotoThumbnailRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
 
 						elem = origElem
 					}
 
+					elem = origElem
+				case 'u': // Prefix: "ublic/orders/"
+					origElem := elem
+					if l := len("ublic/orders/"); len(elem) >= l && elem[0:l] == "ublic/orders/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "slug"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						// Leaf node.
+						switch r.Method {
+						case "GET":
+							s.handleGetPublicRepairOrderRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+
 					elem = origElem
 				}
 
@@ -296,32 +453,13 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 					}
 
 					if len(elem) == 0 {
-						// Leaf node.
 						switch r.Method {
+						case "GET":
+							s.handleListRepairOrdersRequest([0]string{}, elemIsEscaped, w, r)
 						case "POST":
 							s.handleCreateRepairOrderRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							s.notAllowed(w, r, "POST")
-						}
-
-						return
-					}
-
-					elem = origElem
-				case 'o': // Prefix: "oles"
-					origElem := elem
-					if l := len("oles"); len(elem) >= l && elem[0:l] == "oles" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						switch r.Method {
-						case "POST":
-							s.handleCreateRoleRequest([0]string{}, elemIsEscaped, w, r)
-						default:
-							s.notAllowed(w, r, "POST")
+							s.notAllowed(w, r, "GET,POST")
 						}
 
 						return
@@ -335,34 +473,51 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 							break
 						}
 
-						// Param: "roleId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
 							break
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/permissions"
+						case 'b': // Prefix: "by-slug/"
 							origElem := elem
-							if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
+							if l := len("by-slug/"); len(elem) >= l && elem[0:l] == "by-slug/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "slug"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
 							if len(elem) == 0 {
 								// Leaf node.
 								switch r.Method {
-								case "POST":
-									s.handleAssignPermissionsToRoleRequest([1]string{
+								case "GET":
+									s.handleGetRepairOrderBySlugRequest([1]string{
 										args[0],
 									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+
+							elem = origElem
+						case 'l': // Prefix: "labels"
+							origElem := elem
+							if l := len("labels"); len(elem) >= l && elem[0:l] == "labels" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleRenderRepairOrderLabelsRequest([0]string{}, elemIsEscaped, w, r)
 								default:
 									s.notAllowed(w, r, "POST")
 								}
@@ -372,353 +527,2995 @@ func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 							elem = origElem
 						}
+						// Param: "repairOrderId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
 
-						elem = origElem
-					}
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleGetRepairOrderRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
 
-					elem = origElem
-				}
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-				elem = origElem
-			case 's': // Prefix: "sales-persons"
-				origElem := elem
-				if l := len("sales-persons"); len(elem) >= l && elem[0:l] == "sales-persons" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "c"
+								origElem := elem
+								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "POST":
-						s.handleCreateSalesPersonRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "POST")
-					}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ancel"
+									origElem := elem
+									if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleCancelRepairOrderRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'o': // Prefix: "o"
+									origElem := elem
+									if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'm': // Prefix: "mplete"
+										origElem := elem
+										if l := len("mplete"); len(elem) >= l && elem[0:l] == "mplete" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleCompleteRepairOrderRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+										elem = origElem
+									case 'n': // Prefix: "nfirm"
+										origElem := elem
+										if l := len("nfirm"); len(elem) >= l && elem[0:l] == "nfirm" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleConfirmRepairOrderRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+										elem = origElem
+									case 's': // Prefix: "sts"
+										origElem := elem
+										if l := len("sts"); len(elem) >= l && elem[0:l] == "sts" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											// Leaf node.
+											switch r.Method {
+											case "POST":
+												s.handleAddRepairOrderCostRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
 
-					return
-				}
+								elem = origElem
+							case 'd': // Prefix: "damages"
+								origElem := elem
+								if l := len("damages"); len(elem) >= l && elem[0:l] == "damages" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				elem = origElem
-			case 't': // Prefix: "technicians"
-				origElem := elem
-				if l := len("technicians"); len(elem) >= l && elem[0:l] == "technicians" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								if len(elem) == 0 {
+									switch r.Method {
+									case "POST":
+										s.handleAddRepairOrderDamagesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "POST":
-						s.handleCreateTechnicianRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "POST")
-					}
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "repairOrderDamageId"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "DELETE":
+											s.handleRemoveRepairOrderDamageRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "DELETE")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
 
-					return
-				}
+								elem = origElem
+							case 'h': // Prefix: "history"
+								origElem := elem
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				elem = origElem
-			case 'u': // Prefix: "users/me"
-				origElem := elem
-				if l := len("users/me"); len(elem) >= l && elem[0:l] == "users/me" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRepairOrderHistoryRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-				if len(elem) == 0 {
-					// Leaf node.
-					switch r.Method {
-					case "GET":
-						s.handleGetMyUserDetailsRequest([0]string{}, elemIsEscaped, w, r)
-					default:
-						s.notAllowed(w, r, "GET")
-					}
+									return
+								}
 
-					return
-				}
+								elem = origElem
+							case 'l': // Prefix: "label"
+								origElem := elem
+								if l := len("label"); len(elem) >= l && elem[0:l] == "label" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-				elem = origElem
-			}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRepairOrderLabelRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-			elem = origElem
-		}
-	}
-	s.notFound(w, r)
-}
+									return
+								}
 
-// Route is route object.
-type Route struct {
-	name        string
-	summary     string
-	operationID string
-	pathPattern string
-	count       int
-	args        [1]string
-}
+								elem = origElem
+							case 'n': // Prefix: "notes"
+								origElem := elem
+								if l := len("notes"); len(elem) >= l && elem[0:l] == "notes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// Name returns ogen operation name.
-//
-// It is guaranteed to be unique and not empty.
-func (r Route) Name() string {
-	return r.name
-}
+								if len(elem) == 0 {
+									switch r.Method {
+									case "GET":
+										s.handleListRepairOrderNotesRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									case "POST":
+										s.handleAddRepairOrderNoteRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET,POST")
+									}
 
-// Summary returns OpenAPI summary.
-func (r Route) Summary() string {
-	return r.summary
-}
+									return
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "noteId"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "PUT":
+											s.handleEditRepairOrderNoteRequest([2]string{
+												args[0],
+												args[1],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "PUT")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
 
-// OperationID returns OpenAPI operationId.
-func (r Route) OperationID() string {
-	return r.operationID
-}
+								elem = origElem
+							case 'p': // Prefix: "p"
+								origElem := elem
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// PathPattern returns OpenAPI path.
-func (r Route) PathPattern() string {
-	return r.pathPattern
-}
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ayments"
+									origElem := elem
+									if l := len("ayments"); len(elem) >= l && elem[0:l] == "ayments" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "GET":
+											s.handleListRepairOrderPaymentsRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										case "POST":
+											s.handleRecordRepairOrderPaymentRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "GET,POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								case 'h': // Prefix: "ho"
+									origElem := elem
+									if l := len("ho"); len(elem) >= l && elem[0:l] == "ho" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "ne-"
+										origElem := elem
+										if l := len("ne-"); len(elem) >= l && elem[0:l] == "ne-" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'c': // Prefix: "conditions"
+											origElem := elem
+											if l := len("conditions"); len(elem) >= l && elem[0:l] == "conditions" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "POST":
+													s.handleAddRepairOrderPhoneConditionsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "repairOrderPhoneConditionId"
+												// Leaf parameter
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleRemoveRepairOrderPhoneConditionRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE")
+													}
+
+													return
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										case 'e': // Prefix: "equipments"
+											origElem := elem
+											if l := len("equipments"); len(elem) >= l && elem[0:l] == "equipments" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch r.Method {
+												case "POST":
+													s.handleAddRepairOrderPhoneEquipmentsRequest([1]string{
+														args[0],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "POST")
+												}
+
+												return
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "repairOrderPhoneEquipmentId"
+												// Leaf parameter
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													// Leaf node.
+													switch r.Method {
+													case "DELETE":
+														s.handleRemoveRepairOrderPhoneEquipmentRequest([2]string{
+															args[0],
+															args[1],
+														}, elemIsEscaped, w, r)
+													default:
+														s.notAllowed(w, r, "DELETE")
+													}
+
+													return
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 't': // Prefix: "tos"
+										origElem := elem
+										if l := len("tos"); len(elem) >= l && elem[0:l] == "tos" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch r.Method {
+											case "POST":
+												s.handleAddRepairOrderPhotoRequest([1]string{
+													args[0],
+												}, elemIsEscaped, w, r)
+											default:
+												s.notAllowed(w, r, "POST")
+											}
+
+											return
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+											origElem := elem
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "repairOrderPhotoId"
+											// Leaf parameter
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												// Leaf node.
+												switch r.Method {
+												case "DELETE":
+													s.handleRemoveRepairOrderPhotoRequest([2]string{
+														args[0],
+														args[1],
+													}, elemIsEscaped, w, r)
+												default:
+													s.notAllowed(w, r, "DELETE")
+												}
+
+												return
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								case 'i': // Prefix: "ick-up"
+									origElem := elem
+									if l := len("ick-up"); len(elem) >= l && elem[0:l] == "ick-up" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handlePickUpRepairOrderRequest([1]string{
+												args[0],
+											}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
 
-// Args returns parsed arguments.
-func (r Route) Args() []string {
-	return r.args[:r.count]
-}
+								elem = origElem
+							case 'r': // Prefix: "receipt"
+								origElem := elem
+								if l := len("receipt"); len(elem) >= l && elem[0:l] == "receipt" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-// FindRoute finds Route for given method and path.
-//
-// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
-func (s *Server) FindRoute(method, path string) (Route, bool) {
-	return s.FindPath(method, &url.URL{Path: path})
-}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "GET":
+										s.handleGetRepairOrderReceiptRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "GET")
+									}
 
-// FindPath finds Route for given method and URL.
-func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
-	var (
-		elem = u.Path
-		args = r.args
-	)
-	if rawPath := u.RawPath; rawPath != "" {
-		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
-			elem = normalized
-		}
-		defer func() {
-			for i, arg := range r.args[:r.count] {
-				if unescaped, err := url.PathUnescape(arg); err == nil {
-					r.args[i] = unescaped
-				}
-			}
-		}()
-	}
+									return
+								}
 
-	elem, ok := s.cutPrefix(elem)
-	if !ok {
-		return r, false
-	}
+								elem = origElem
+							case 't': // Prefix: "technician"
+								origElem := elem
+								if l := len("technician"); len(elem) >= l && elem[0:l] == "technician" {
+									elem = elem[l:]
+								} else {
+									break
+								}
 
-	// Static code generated router with unwrapped path search.
-	switch {
-	default:
-		if len(elem) == 0 {
-			break
-		}
-		switch elem[0] {
-		case '/': // Prefix: "/"
-			origElem := elem
-			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
-				elem = elem[l:]
-			} else {
-				break
-			}
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleChangeRepairOrderTechnicianRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
 
-			if len(elem) == 0 {
-				break
-			}
-			switch elem[0] {
-			case 'a': // Prefix: "auth/log"
-				origElem := elem
-				if l := len("auth/log"); len(elem) >= l && elem[0:l] == "auth/log" {
-					elem = elem[l:]
-				} else {
-					break
-				}
+									return
+								}
 
-				if len(elem) == 0 {
-					break
-				}
-				switch elem[0] {
-				case 'i': // Prefix: "in"
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'o': // Prefix: "oles"
 					origElem := elem
-					if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+					if l := len("oles"); len(elem) >= l && elem[0:l] == "oles" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
 					if len(elem) == 0 {
-						switch method {
+						switch r.Method {
 						case "POST":
-							r.name = "Login"
-							r.summary = "Logs in with credentials"
-							r.operationID = "login"
-							r.pathPattern = "/auth/login"
-							r.args = args
-							r.count = 0
-							return r, true
+							s.handleCreateRoleRequest([0]string{}, elemIsEscaped, w, r)
 						default:
-							return
+							s.notAllowed(w, r, "POST")
 						}
+
+						return
 					}
 					switch elem[0] {
-					case '-': // Prefix: "-code"
+					case '/': // Prefix: "/"
 						origElem := elem
-						if l := len("-code"); len(elem) >= l && elem[0:l] == "-code" {
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
+						// Param: "roleId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
 						if len(elem) == 0 {
-							switch method {
-							case "POST":
-								// Leaf: LoginCodePrompt
-								r.name = "LoginCodePrompt"
-								r.summary = "Logs store employees in with login code"
-								r.operationID = "loginCodePrompt"
-								r.pathPattern = "/auth/login-code"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
-							}
+							break
 						}
+						switch elem[0] {
+						case '/': // Prefix: "/permissions"
+							origElem := elem
+							if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
 
-						elem = origElem
-					}
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "POST":
+									s.handleAssignPermissionsToRoleRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "POST")
+								}
 
-					elem = origElem
-				case 'o': // Prefix: "out"
-					origElem := elem
-					if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
-						elem = elem[l:]
-					} else {
-						break
-					}
+								return
+							}
 
-					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							// Leaf: Logout
-							r.name = "Logout"
-							r.summary = "Logs out current session"
-							r.operationID = "logout"
-							r.pathPattern = "/auth/logout"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
+							elem = origElem
 						}
+
+						elem = origElem
 					}
 
 					elem = origElem
 				}
 
 				elem = origElem
-			case 'd': // Prefix: "damage-types"
+			case 's': // Prefix: "sales-persons"
 				origElem := elem
-				if l := len("damage-types"); len(elem) >= l && elem[0:l] == "damage-types" {
+				if l := len("sales-persons"); len(elem) >= l && elem[0:l] == "sales-persons" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
+					// Leaf node.
+					switch r.Method {
 					case "POST":
-						// Leaf: CreateDamageType
-						r.name = "CreateDamageType"
-						r.summary = "Creates a new damage type"
-						r.operationID = "createDamageType"
-						r.pathPattern = "/damage-types"
-						r.args = args
-						r.count = 0
-						return r, true
+						s.handleCreateSalesPersonRequest([0]string{}, elemIsEscaped, w, r)
 					default:
-						return
+						s.notAllowed(w, r, "POST")
 					}
+
+					return
 				}
 
 				elem = origElem
-			case 'h': // Prefix: "healthz"
+			case 't': // Prefix: "technicians"
 				origElem := elem
-				if l := len("healthz"); len(elem) >= l && elem[0:l] == "healthz" {
+				if l := len("technicians"); len(elem) >= l && elem[0:l] == "technicians" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						// Leaf: GetHealth
-						r.name = "GetHealth"
-						r.summary = "Returns the health status of the service"
-						r.operationID = "getHealth"
-						r.pathPattern = "/healthz"
-						r.args = args
+					switch r.Method {
+					case "POST":
+						s.handleCreateTechnicianRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'w': // Prefix: "workload"
+						origElem := elem
+						if l := len("workload"); len(elem) >= l && elem[0:l] == "workload" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetTechnicianWorkloadRequest([0]string{}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+					// Param: "technicianId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/queue"
+						origElem := elem
+						if l := len("/queue"); len(elem) >= l && elem[0:l] == "/queue" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "GET":
+								s.handleGetTechnicianQueueRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'u': // Prefix: "users/"
+				origElem := elem
+				if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'm': // Prefix: "me"
+					origElem := elem
+					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "GET":
+							s.handleGetMyUserDetailsRequest([0]string{}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "GET")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "sessions"
+							origElem := elem
+							if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListMySessionsRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'r': // Prefix: "revoke-others"
+									origElem := elem
+									if l := len("revoke-others"); len(elem) >= l && elem[0:l] == "revoke-others" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										// Leaf node.
+										switch r.Method {
+										case "POST":
+											s.handleRevokeMyOtherSessionsRequest([0]string{}, elemIsEscaped, w, r)
+										default:
+											s.notAllowed(w, r, "POST")
+										}
+
+										return
+									}
+
+									elem = origElem
+								}
+								// Param: "sessionId"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRevokeMySessionRequest([1]string{
+											args[0],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 't': // Prefix: "totp"
+							origElem := elem
+							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "DELETE":
+									s.handleDisableMyTOTPRequest([0]string{}, elemIsEscaped, w, r)
+								case "GET":
+									s.handleGetMyTOTPStatusRequest([0]string{}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleEnrollMyTOTPRequest([0]string{}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE,GET,POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/confirm"
+								origElem := elem
+								if l := len("/confirm"); len(elem) >= l && elem[0:l] == "/confirm" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleConfirmMyTOTPRequest([0]string{}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+				// Param: "userId"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
+				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'l': // Prefix: "login-"
+						origElem := elem
+						if l := len("login-"); len(elem) >= l && elem[0:l] == "login-" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "codes"
+							origElem := elem
+							if l := len("codes"); len(elem) >= l && elem[0:l] == "codes" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch r.Method {
+								case "GET":
+									s.handleListLoginCodesRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								case "POST":
+									s.handleIssueLoginCodeRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "GET,POST")
+								}
+
+								return
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "loginCodeId"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "DELETE":
+										s.handleRevokeLoginCodeRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "DELETE")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'l': // Prefix: "lockout"
+							origElem := elem
+							if l := len("lockout"); len(elem) >= l && elem[0:l] == "lockout" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								// Leaf node.
+								switch r.Method {
+								case "DELETE":
+									s.handleUnlockUserLoginRequest([1]string{
+										args[0],
+									}, elemIsEscaped, w, r)
+								default:
+									s.notAllowed(w, r, "DELETE")
+								}
+
+								return
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 's': // Prefix: "sessions"
+						origElem := elem
+						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							// Leaf node.
+							switch r.Method {
+							case "DELETE":
+								s.handleRevokeUserSessionsRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "DELETE")
+							}
+
+							return
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'w': // Prefix: "webhooks"
+				origElem := elem
+				if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch r.Method {
+					case "GET":
+						s.handleListWebhooksRequest([0]string{}, elemIsEscaped, w, r)
+					case "POST":
+						s.handleCreateWebhookRequest([0]string{}, elemIsEscaped, w, r)
+					default:
+						s.notAllowed(w, r, "GET,POST")
+					}
+
+					return
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "webhookId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						switch r.Method {
+						case "DELETE":
+							s.handleDeleteWebhookRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "GET":
+							s.handleGetWebhookRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						case "PUT":
+							s.handleUpdateWebhookRequest([1]string{
+								args[0],
+							}, elemIsEscaped, w, r)
+						default:
+							s.notAllowed(w, r, "DELETE,GET,PUT")
+						}
+
+						return
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/deliveries"
+						origElem := elem
+						if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch r.Method {
+							case "GET":
+								s.handleListWebhookDeliveriesRequest([1]string{
+									args[0],
+								}, elemIsEscaped, w, r)
+							default:
+								s.notAllowed(w, r, "GET")
+							}
+
+							return
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "deliveryId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/replay"
+								origElem := elem
+								if l := len("/replay"); len(elem) >= l && elem[0:l] == "/replay" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									// Leaf node.
+									switch r.Method {
+									case "POST":
+										s.handleReplayWebhookDeliveryRequest([2]string{
+											args[0],
+											args[1],
+										}, elemIsEscaped, w, r)
+									default:
+										s.notAllowed(w, r, "POST")
+									}
+
+									return
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			}
+
+			elem = origElem
+		}
+	}
+	s.notFound(w, r)
+}
+
+// Route is route object.
+type Route struct {
+	name        string
+	summary     string
+	operationID string
+	pathPattern string
+	count       int
+	args        [2]string
+}
+
+// Name returns ogen operation name.
+//
+// It is guaranteed to be unique and not empty.
+func (r Route) Name() string {
+	return r.name
+}
+
+// Summary returns OpenAPI summary.
+func (r Route) Summary() string {
+	return r.summary
+}
+
+// OperationID returns OpenAPI operationId.
+func (r Route) OperationID() string {
+	return r.operationID
+}
+
+// PathPattern returns OpenAPI path.
+func (r Route) PathPattern() string {
+	return r.pathPattern
+}
+
+// Args returns parsed arguments.
+func (r Route) Args() []string {
+	return r.args[:r.count]
+}
+
+// FindRoute finds Route for given method and path.
+//
+// Note: this method does not unescape path or handle reserved characters in path properly. Use FindPath instead.
+func (s *Server) FindRoute(method, path string) (Route, bool) {
+	return s.FindPath(method, &url.URL{Path: path})
+}
+
+// FindPath finds Route for given method and URL.
+func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
+	var (
+		elem = u.Path
+		args = r.args
+	)
+	if rawPath := u.RawPath; rawPath != "" {
+		if normalized, ok := uri.NormalizeEscapedPath(rawPath); ok {
+			elem = normalized
+		}
+		defer func() {
+			for i, arg := range r.args[:r.count] {
+				if unescaped, err := url.PathUnescape(arg); err == nil {
+					r.args[i] = unescaped
+				}
+			}
+		}()
+	}
+
+	elem, ok := s.cutPrefix(elem)
+	if !ok {
+		return r, false
+	}
+
+	// Static code generated router with unwrapped path search.
+	switch {
+	default:
+		if len(elem) == 0 {
+			break
+		}
+		switch elem[0] {
+		case '/': // Prefix: "/"
+			origElem := elem
+			if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+				elem = elem[l:]
+			} else {
+				break
+			}
+
+			if len(elem) == 0 {
+				break
+			}
+			switch elem[0] {
+			case 'a': // Prefix: "au"
+				origElem := elem
+				if l := len("au"); len(elem) >= l && elem[0:l] == "au" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'd': // Prefix: "dit-log"
+					origElem := elem
+					if l := len("dit-log"); len(elem) >= l && elem[0:l] == "dit-log" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: SearchAuditLog
+							r.name = "SearchAuditLog"
+							r.summary = "Searches the audit log"
+							r.operationID = "searchAuditLog"
+							r.pathPattern = "/audit-log"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 't': // Prefix: "th/log"
+					origElem := elem
+					if l := len("th/log"); len(elem) >= l && elem[0:l] == "th/log" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'i': // Prefix: "in"
+						origElem := elem
+						if l := len("in"); len(elem) >= l && elem[0:l] == "in" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = "Login"
+								r.summary = "Logs in with credentials"
+								r.operationID = "login"
+								r.pathPattern = "/auth/login"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '-': // Prefix: "-code"
+							origElem := elem
+							if l := len("-code"); len(elem) >= l && elem[0:l] == "-code" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: LoginCodePrompt
+									r.name = "LoginCodePrompt"
+									r.summary = "Logs store employees in with login code"
+									r.operationID = "loginCodePrompt"
+									r.pathPattern = "/auth/login-code"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 'o': // Prefix: "out"
+						origElem := elem
+						if l := len("out"); len(elem) >= l && elem[0:l] == "out" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								// Leaf: Logout
+								r.name = "Logout"
+								r.summary = "Logs out current session"
+								r.operationID = "logout"
+								r.pathPattern = "/auth/logout"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'd': // Prefix: "damage-types"
+				origElem := elem
+				if l := len("damage-types"); len(elem) >= l && elem[0:l] == "damage-types" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "POST":
+						// Leaf: CreateDamageType
+						r.name = "CreateDamageType"
+						r.summary = "Creates a new damage type"
+						r.operationID = "createDamageType"
+						r.pathPattern = "/damage-types"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+				elem = origElem
+			case 'h': // Prefix: "healthz"
+				origElem := elem
+				if l := len("healthz"); len(elem) >= l && elem[0:l] == "healthz" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "GET":
+						// Leaf: GetHealth
+						r.name = "GetHealth"
+						r.summary = "Returns the health status of the service"
+						r.operationID = "getHealth"
+						r.pathPattern = "/healthz"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+				elem = origElem
+			case 'p': // Prefix: "p"
+				origElem := elem
+				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'a': // Prefix: "ayment-methods"
+					origElem := elem
+					if l := len("ayment-methods"); len(elem) >= l && elem[0:l] == "ayment-methods" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							// Leaf: CreatePaymentMethod
+							r.name = "CreatePaymentMethod"
+							r.summary = "Creates a new payment method"
+							r.operationID = "createPaymentMethod"
+							r.pathPattern = "/payment-methods"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				case 'h': // Prefix: "ho"
+					origElem := elem
+					if l := len("ho"); len(elem) >= l && elem[0:l] == "ho" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'n': // Prefix: "ne-"
+						origElem := elem
+						if l := len("ne-"); len(elem) >= l && elem[0:l] == "ne-" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "conditions"
+							origElem := elem
+							if l := len("conditions"); len(elem) >= l && elem[0:l] == "conditions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: CreatePhoneCondition
+									r.name = "CreatePhoneCondition"
+									r.summary = "Creates a new phone condition"
+									r.operationID = "createPhoneCondition"
+									r.pathPattern = "/phone-conditions"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'e': // Prefix: "equipments"
+							origElem := elem
+							if l := len("equipments"); len(elem) >= l && elem[0:l] == "equipments" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: CreatePhoneEquipment
+									r.name = "CreatePhoneEquipment"
+									r.summary = "Creates a new phone equipment"
+									r.operationID = "createPhoneEquipment"
+									r.pathPattern = "/phone-equipments"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					case 't': // Prefix: "tos"
+						origElem := elem
+						if l := len("tos"); len(elem) >= l && elem[0:l] == "tos" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "POST":
+								r.name = "UploadPhoto"
+								r.summary = "Uploads a photo"
+								r.operationID = "uploadPhoto"
+								r.pathPattern = "/photos"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "photoId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[0] = elem[:idx]
+							elem = elem[idx:]
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = "GetPhoto"
+									r.summary = "Returns the contents of a photo"
+									r.operationID = "getPhoto"
+									r.pathPattern = "/photos/{photoId}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/thumbnail"
+								origElem := elem
+								if l := len("/thumbnail"); len(elem) >= l && elem[0:l] == "/thumbnail" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetPhotoThumbnail
+										r.name = "GetPhotoThumbnail"
+										r.summary = "Returns the thumbnail of a photo"
+										r.operationID = "getPhotoThumbnail"
+										r.pathPattern = "/photos/{photoId}/thumbnail"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'u': // Prefix: "ublic/orders/"
+					origElem := elem
+					if l := len("ublic/orders/"); len(elem) >= l && elem[0:l] == "ublic/orders/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					// Param: "slug"
+					// Leaf parameter
+					args[0] = elem
+					elem = ""
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							// Leaf: GetPublicRepairOrder
+							r.name = "GetPublicRepairOrder"
+							r.summary = "Tracks a repair order"
+							r.operationID = "getPublicRepairOrder"
+							r.pathPattern = "/public/orders/{slug}"
+							r.args = args
+							r.count = 1
+							return r, true
+						default:
+							return
+						}
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'r': // Prefix: "r"
+				origElem := elem
+				if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'e': // Prefix: "epair-orders"
+					origElem := elem
+					if l := len("epair-orders"); len(elem) >= l && elem[0:l] == "epair-orders" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = "ListRepairOrders"
+							r.summary = "Lists repair orders"
+							r.operationID = "listRepairOrders"
+							r.pathPattern = "/repair-orders"
+							r.args = args
+							r.count = 0
+							return r, true
+						case "POST":
+							r.name = "CreateRepairOrder"
+							r.summary = "Creates a new repair order"
+							r.operationID = "createRepairOrder"
+							r.pathPattern = "/repair-orders"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 'b': // Prefix: "by-slug/"
+							origElem := elem
+							if l := len("by-slug/"); len(elem) >= l && elem[0:l] == "by-slug/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							// Param: "slug"
+							// Leaf parameter
+							args[0] = elem
+							elem = ""
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									// Leaf: GetRepairOrderBySlug
+									r.name = "GetRepairOrderBySlug"
+									r.summary = "Returns a repair order by its slug"
+									r.operationID = "getRepairOrderBySlug"
+									r.pathPattern = "/repair-orders/by-slug/{slug}"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						case 'l': // Prefix: "labels"
+							origElem := elem
+							if l := len("labels"); len(elem) >= l && elem[0:l] == "labels" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: RenderRepairOrderLabels
+									r.name = "RenderRepairOrderLabels"
+									r.summary = "Renders device labels for a batch of repair orders"
+									r.operationID = "renderRepairOrderLabels"
+									r.pathPattern = "/repair-orders/labels"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+						// Param: "repairOrderId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								r.name = "GetRepairOrder"
+								r.summary = "Returns a repair order"
+								r.operationID = "getRepairOrder"
+								r.pathPattern = "/repair-orders/{repairOrderId}"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/"
+							origElem := elem
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								break
+							}
+							switch elem[0] {
+							case 'c': // Prefix: "c"
+								origElem := elem
+								if l := len("c"); len(elem) >= l && elem[0:l] == "c" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ancel"
+									origElem := elem
+									if l := len("ancel"); len(elem) >= l && elem[0:l] == "ancel" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											// Leaf: CancelRepairOrder
+											r.name = "CancelRepairOrder"
+											r.summary = "Cancels a repair order"
+											r.operationID = "cancelRepairOrder"
+											r.pathPattern = "/repair-orders/{repairOrderId}/cancel"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'o': // Prefix: "o"
+									origElem := elem
+									if l := len("o"); len(elem) >= l && elem[0:l] == "o" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'm': // Prefix: "mplete"
+										origElem := elem
+										if l := len("mplete"); len(elem) >= l && elem[0:l] == "mplete" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "POST":
+												// Leaf: CompleteRepairOrder
+												r.name = "CompleteRepairOrder"
+												r.summary = "Completes a repair order"
+												r.operationID = "completeRepairOrder"
+												r.pathPattern = "/repair-orders/{repairOrderId}/complete"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									case 'n': // Prefix: "nfirm"
+										origElem := elem
+										if l := len("nfirm"); len(elem) >= l && elem[0:l] == "nfirm" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "POST":
+												// Leaf: ConfirmRepairOrder
+												r.name = "ConfirmRepairOrder"
+												r.summary = "Confirms a repair order to the customer"
+												r.operationID = "confirmRepairOrder"
+												r.pathPattern = "/repair-orders/{repairOrderId}/confirm"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									case 's': // Prefix: "sts"
+										origElem := elem
+										if l := len("sts"); len(elem) >= l && elem[0:l] == "sts" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "POST":
+												// Leaf: AddRepairOrderCost
+												r.name = "AddRepairOrderCost"
+												r.summary = "Adds a cost adjustment to a repair order"
+												r.operationID = "addRepairOrderCost"
+												r.pathPattern = "/repair-orders/{repairOrderId}/costs"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'd': // Prefix: "damages"
+								origElem := elem
+								if l := len("damages"); len(elem) >= l && elem[0:l] == "damages" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										r.name = "AddRepairOrderDamages"
+										r.summary = "Adds damages to a repair order"
+										r.operationID = "addRepairOrderDamages"
+										r.pathPattern = "/repair-orders/{repairOrderId}/damages"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "repairOrderDamageId"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										switch method {
+										case "DELETE":
+											// Leaf: RemoveRepairOrderDamage
+											r.name = "RemoveRepairOrderDamage"
+											r.summary = "Removes a damage from a repair order"
+											r.operationID = "removeRepairOrderDamage"
+											r.pathPattern = "/repair-orders/{repairOrderId}/damages/{repairOrderDamageId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'h': // Prefix: "history"
+								origElem := elem
+								if l := len("history"); len(elem) >= l && elem[0:l] == "history" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetRepairOrderHistory
+										r.name = "GetRepairOrderHistory"
+										r.summary = "Returns the change history of a repair order"
+										r.operationID = "getRepairOrderHistory"
+										r.pathPattern = "/repair-orders/{repairOrderId}/history"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'l': // Prefix: "label"
+								origElem := elem
+								if l := len("label"); len(elem) >= l && elem[0:l] == "label" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetRepairOrderLabel
+										r.name = "GetRepairOrderLabel"
+										r.summary = "Returns the device label of a repair order"
+										r.operationID = "getRepairOrderLabel"
+										r.pathPattern = "/repair-orders/{repairOrderId}/label"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 'n': // Prefix: "notes"
+								origElem := elem
+								if l := len("notes"); len(elem) >= l && elem[0:l] == "notes" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										r.name = "ListRepairOrderNotes"
+										r.summary = "Returns the notes of a repair order"
+										r.operationID = "listRepairOrderNotes"
+										r.pathPattern = "/repair-orders/{repairOrderId}/notes"
+										r.args = args
+										r.count = 1
+										return r, true
+									case "POST":
+										r.name = "AddRepairOrderNote"
+										r.summary = "Adds a note to a repair order"
+										r.operationID = "addRepairOrderNote"
+										r.pathPattern = "/repair-orders/{repairOrderId}/notes"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+								switch elem[0] {
+								case '/': // Prefix: "/"
+									origElem := elem
+									if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									// Param: "noteId"
+									// Leaf parameter
+									args[1] = elem
+									elem = ""
+
+									if len(elem) == 0 {
+										switch method {
+										case "PUT":
+											// Leaf: EditRepairOrderNote
+											r.name = "EditRepairOrderNote"
+											r.summary = "Edits a note of a repair order"
+											r.operationID = "editRepairOrderNote"
+											r.pathPattern = "/repair-orders/{repairOrderId}/notes/{noteId}"
+											r.args = args
+											r.count = 2
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'p': // Prefix: "p"
+								origElem := elem
+								if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'a': // Prefix: "ayments"
+									origElem := elem
+									if l := len("ayments"); len(elem) >= l && elem[0:l] == "ayments" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "GET":
+											// Leaf: ListRepairOrderPayments
+											r.name = "ListRepairOrderPayments"
+											r.summary = "Returns the payments of a repair order"
+											r.operationID = "listRepairOrderPayments"
+											r.pathPattern = "/repair-orders/{repairOrderId}/payments"
+											r.args = args
+											r.count = 1
+											return r, true
+										case "POST":
+											// Leaf: RecordRepairOrderPayment
+											r.name = "RecordRepairOrderPayment"
+											r.summary = "Records a payment for a repair order"
+											r.operationID = "recordRepairOrderPayment"
+											r.pathPattern = "/repair-orders/{repairOrderId}/payments"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								case 'h': // Prefix: "ho"
+									origElem := elem
+									if l := len("ho"); len(elem) >= l && elem[0:l] == "ho" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										break
+									}
+									switch elem[0] {
+									case 'n': // Prefix: "ne-"
+										origElem := elem
+										if l := len("ne-"); len(elem) >= l && elem[0:l] == "ne-" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											break
+										}
+										switch elem[0] {
+										case 'c': // Prefix: "conditions"
+											origElem := elem
+											if l := len("conditions"); len(elem) >= l && elem[0:l] == "conditions" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "POST":
+													r.name = "AddRepairOrderPhoneConditions"
+													r.summary = "Adds phone conditions to a repair order"
+													r.operationID = "addRepairOrderPhoneConditions"
+													r.pathPattern = "/repair-orders/{repairOrderId}/phone-conditions"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "repairOrderPhoneConditionId"
+												// Leaf parameter
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													switch method {
+													case "DELETE":
+														// Leaf: RemoveRepairOrderPhoneCondition
+														r.name = "RemoveRepairOrderPhoneCondition"
+														r.summary = "Removes a phone condition from a repair order"
+														r.operationID = "removeRepairOrderPhoneCondition"
+														r.pathPattern = "/repair-orders/{repairOrderId}/phone-conditions/{repairOrderPhoneConditionId}"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										case 'e': // Prefix: "equipments"
+											origElem := elem
+											if l := len("equipments"); len(elem) >= l && elem[0:l] == "equipments" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											if len(elem) == 0 {
+												switch method {
+												case "POST":
+													r.name = "AddRepairOrderPhoneEquipments"
+													r.summary = "Adds phone equipments to a repair order"
+													r.operationID = "addRepairOrderPhoneEquipments"
+													r.pathPattern = "/repair-orders/{repairOrderId}/phone-equipments"
+													r.args = args
+													r.count = 1
+													return r, true
+												default:
+													return
+												}
+											}
+											switch elem[0] {
+											case '/': // Prefix: "/"
+												origElem := elem
+												if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+													elem = elem[l:]
+												} else {
+													break
+												}
+
+												// Param: "repairOrderPhoneEquipmentId"
+												// Leaf parameter
+												args[1] = elem
+												elem = ""
+
+												if len(elem) == 0 {
+													switch method {
+													case "DELETE":
+														// Leaf: RemoveRepairOrderPhoneEquipment
+														r.name = "RemoveRepairOrderPhoneEquipment"
+														r.summary = "Removes a phone equipment from a repair order"
+														r.operationID = "removeRepairOrderPhoneEquipment"
+														r.pathPattern = "/repair-orders/{repairOrderId}/phone-equipments/{repairOrderPhoneEquipmentId}"
+														r.args = args
+														r.count = 2
+														return r, true
+													default:
+														return
+													}
+												}
+
+												elem = origElem
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									case 't': // Prefix: "tos"
+										origElem := elem
+										if l := len("tos"); len(elem) >= l && elem[0:l] == "tos" {
+											elem = elem[l:]
+										} else {
+											break
+										}
+
+										if len(elem) == 0 {
+											switch method {
+											case "POST":
+												r.name = "AddRepairOrderPhoto"
+												r.summary = "Attaches a photo to a repair order"
+												r.operationID = "addRepairOrderPhoto"
+												r.pathPattern = "/repair-orders/{repairOrderId}/photos"
+												r.args = args
+												r.count = 1
+												return r, true
+											default:
+												return
+											}
+										}
+										switch elem[0] {
+										case '/': // Prefix: "/"
+											origElem := elem
+											if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+												elem = elem[l:]
+											} else {
+												break
+											}
+
+											// Param: "repairOrderPhotoId"
+											// Leaf parameter
+											args[1] = elem
+											elem = ""
+
+											if len(elem) == 0 {
+												switch method {
+												case "DELETE":
+													// Leaf: RemoveRepairOrderPhoto
+													r.name = "RemoveRepairOrderPhoto"
+													r.summary = "Removes a photo from a repair order"
+													r.operationID = "removeRepairOrderPhoto"
+													r.pathPattern = "/repair-orders/{repairOrderId}/photos/{repairOrderPhotoId}"
+													r.args = args
+													r.count = 2
+													return r, true
+												default:
+													return
+												}
+											}
+
+											elem = origElem
+										}
+
+										elem = origElem
+									}
+
+									elem = origElem
+								case 'i': // Prefix: "ick-up"
+									origElem := elem
+									if l := len("ick-up"); len(elem) >= l && elem[0:l] == "ick-up" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											// Leaf: PickUpRepairOrder
+											r.name = "PickUpRepairOrder"
+											r.summary = "Marks a repair order as picked up"
+											r.operationID = "pickUpRepairOrder"
+											r.pathPattern = "/repair-orders/{repairOrderId}/pick-up"
+											r.args = args
+											r.count = 1
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+
+								elem = origElem
+							case 'r': // Prefix: "receipt"
+								origElem := elem
+								if l := len("receipt"); len(elem) >= l && elem[0:l] == "receipt" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "GET":
+										// Leaf: GetRepairOrderReceipt
+										r.name = "GetRepairOrderReceipt"
+										r.summary = "Returns a printable receipt of a repair order"
+										r.operationID = "getRepairOrderReceipt"
+										r.pathPattern = "/repair-orders/{repairOrderId}/receipt"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							case 't': // Prefix: "technician"
+								origElem := elem
+								if l := len("technician"); len(elem) >= l && elem[0:l] == "technician" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										// Leaf: ChangeRepairOrderTechnician
+										r.name = "ChangeRepairOrderTechnician"
+										r.summary = "Reassigns a repair order to another technician"
+										r.operationID = "changeRepairOrderTechnician"
+										r.pathPattern = "/repair-orders/{repairOrderId}/technician"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				case 'o': // Prefix: "oles"
+					origElem := elem
+					if l := len("oles"); len(elem) >= l && elem[0:l] == "oles" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "POST":
+							r.name = "CreateRole"
+							r.summary = "Creates a role"
+							r.operationID = "createRole"
+							r.pathPattern = "/roles"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						// Param: "roleId"
+						// Match until "/"
+						idx := strings.IndexByte(elem, '/')
+						if idx < 0 {
+							idx = len(elem)
+						}
+						args[0] = elem[:idx]
+						elem = elem[idx:]
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case '/': // Prefix: "/permissions"
+							origElem := elem
+							if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "POST":
+									// Leaf: AssignPermissionsToRole
+									r.name = "AssignPermissionsToRole"
+									r.summary = "Assigns permissions to a role"
+									r.operationID = "assignPermissionsToRole"
+									r.pathPattern = "/roles/{roleId}/permissions"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 's': // Prefix: "sales-persons"
+				origElem := elem
+				if l := len("sales-persons"); len(elem) >= l && elem[0:l] == "sales-persons" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "POST":
+						// Leaf: CreateSalesPerson
+						r.name = "CreateSalesPerson"
+						r.summary = "Creates a new sales person"
+						r.operationID = "createSalesPerson"
+						r.pathPattern = "/sales-persons"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
+				}
+
+				elem = origElem
+			case 't': // Prefix: "technicians"
+				origElem := elem
+				if l := len("technicians"); len(elem) >= l && elem[0:l] == "technicians" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					switch method {
+					case "POST":
+						r.name = "CreateTechnician"
+						r.summary = "Creates a new technician"
+						r.operationID = "createTechnician"
+						r.pathPattern = "/technicians"
+						r.args = args
 						r.count = 0
 						return r, true
 					default:
 						return
 					}
-				}
+				}
+				switch elem[0] {
+				case '/': // Prefix: "/"
+					origElem := elem
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case 'w': // Prefix: "workload"
+						origElem := elem
+						if l := len("workload"); len(elem) >= l && elem[0:l] == "workload" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetTechnicianWorkload
+								r.name = "GetTechnicianWorkload"
+								r.summary = "Returns the workload of every technician"
+								r.operationID = "getTechnicianWorkload"
+								r.pathPattern = "/technicians/workload"
+								r.args = args
+								r.count = 0
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+					// Param: "technicianId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
+					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
+
+					if len(elem) == 0 {
+						break
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/queue"
+						origElem := elem
+						if l := len("/queue"); len(elem) >= l && elem[0:l] == "/queue" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							switch method {
+							case "GET":
+								// Leaf: GetTechnicianQueue
+								r.name = "GetTechnicianQueue"
+								r.summary = "Returns the work queue of a technician"
+								r.operationID = "getTechnicianQueue"
+								r.pathPattern = "/technicians/{technicianId}/queue"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
+						}
+
+						elem = origElem
+					}
+
+					elem = origElem
+				}
+
+				elem = origElem
+			case 'u': // Prefix: "users/"
+				origElem := elem
+				if l := len("users/"); len(elem) >= l && elem[0:l] == "users/" {
+					elem = elem[l:]
+				} else {
+					break
+				}
+
+				if len(elem) == 0 {
+					break
+				}
+				switch elem[0] {
+				case 'm': // Prefix: "me"
+					origElem := elem
+					if l := len("me"); len(elem) >= l && elem[0:l] == "me" {
+						elem = elem[l:]
+					} else {
+						break
+					}
+
+					if len(elem) == 0 {
+						switch method {
+						case "GET":
+							r.name = "GetMyUserDetails"
+							r.summary = "Returns details of the currently logged in user"
+							r.operationID = "getMyUserDetails"
+							r.pathPattern = "/users/me"
+							r.args = args
+							r.count = 0
+							return r, true
+						default:
+							return
+						}
+					}
+					switch elem[0] {
+					case '/': // Prefix: "/"
+						origElem := elem
+						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+							elem = elem[l:]
+						} else {
+							break
+						}
+
+						if len(elem) == 0 {
+							break
+						}
+						switch elem[0] {
+						case 's': // Prefix: "sessions"
+							origElem := elem
+							if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = "ListMySessions"
+									r.summary = "Lists the active sessions of the current user"
+									r.operationID = "listMySessions"
+									r.pathPattern = "/users/me/sessions"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									break
+								}
+								switch elem[0] {
+								case 'r': // Prefix: "revoke-others"
+									origElem := elem
+									if l := len("revoke-others"); len(elem) >= l && elem[0:l] == "revoke-others" {
+										elem = elem[l:]
+									} else {
+										break
+									}
+
+									if len(elem) == 0 {
+										switch method {
+										case "POST":
+											// Leaf: RevokeMyOtherSessions
+											r.name = "RevokeMyOtherSessions"
+											r.summary = "Logs out all other devices"
+											r.operationID = "revokeMyOtherSessions"
+											r.pathPattern = "/users/me/sessions/revoke-others"
+											r.args = args
+											r.count = 0
+											return r, true
+										default:
+											return
+										}
+									}
+
+									elem = origElem
+								}
+								// Param: "sessionId"
+								// Leaf parameter
+								args[0] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										// Leaf: RevokeMySession
+										r.name = "RevokeMySession"
+										r.summary = "Logs out one of the current user's sessions"
+										r.operationID = "revokeMySession"
+										r.pathPattern = "/users/me/sessions/{sessionId}"
+										r.args = args
+										r.count = 1
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 't': // Prefix: "totp"
+							origElem := elem
+							if l := len("totp"); len(elem) >= l && elem[0:l] == "totp" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									r.name = "DisableMyTOTP"
+									r.summary = "Disables TOTP for the current user"
+									r.operationID = "disableMyTOTP"
+									r.pathPattern = "/users/me/totp"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "GET":
+									r.name = "GetMyTOTPStatus"
+									r.summary = "Gets the TOTP status of the current user"
+									r.operationID = "getMyTOTPStatus"
+									r.pathPattern = "/users/me/totp"
+									r.args = args
+									r.count = 0
+									return r, true
+								case "POST":
+									r.name = "EnrollMyTOTP"
+									r.summary = "Starts TOTP enrollment for the current user"
+									r.operationID = "enrollMyTOTP"
+									r.pathPattern = "/users/me/totp"
+									r.args = args
+									r.count = 0
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/confirm"
+								origElem := elem
+								if l := len("/confirm"); len(elem) >= l && elem[0:l] == "/confirm" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										// Leaf: ConfirmMyTOTP
+										r.name = "ConfirmMyTOTP"
+										r.summary = "Enables TOTP for the current user"
+										r.operationID = "confirmMyTOTP"
+										r.pathPattern = "/users/me/totp/confirm"
+										r.args = args
+										r.count = 0
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						}
+
+						elem = origElem
+					}
 
-				elem = origElem
-			case 'p': // Prefix: "p"
-				origElem := elem
-				if l := len("p"); len(elem) >= l && elem[0:l] == "p" {
-					elem = elem[l:]
-				} else {
-					break
+					elem = origElem
+				}
+				// Param: "userId"
+				// Match until "/"
+				idx := strings.IndexByte(elem, '/')
+				if idx < 0 {
+					idx = len(elem)
 				}
+				args[0] = elem[:idx]
+				elem = elem[idx:]
 
 				if len(elem) == 0 {
 					break
 				}
 				switch elem[0] {
-				case 'a': // Prefix: "ayment-methods"
-					origElem := elem
-					if l := len("ayment-methods"); len(elem) >= l && elem[0:l] == "ayment-methods" {
-						elem = elem[l:]
-					} else {
-						break
-					}
-
-					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							// Leaf: CreatePaymentMethod
-							r.name = "CreatePaymentMethod"
-							r.summary = "Creates a new payment method"
-							r.operationID = "createPaymentMethod"
-							r.pathPattern = "/payment-methods"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-					elem = origElem
-				case 'h': // Prefix: "hone-"
+				case '/': // Prefix: "/"
 					origElem := elem
-					if l := len("hone-"); len(elem) >= l && elem[0:l] == "hone-" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
@@ -728,34 +3525,113 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 						break
 					}
 					switch elem[0] {
-					case 'c': // Prefix: "conditions"
+					case 'l': // Prefix: "login-"
 						origElem := elem
-						if l := len("conditions"); len(elem) >= l && elem[0:l] == "conditions" {
+						if l := len("login-"); len(elem) >= l && elem[0:l] == "login-" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
 						if len(elem) == 0 {
-							switch method {
-							case "POST":
-								// Leaf: CreatePhoneCondition
-								r.name = "CreatePhoneCondition"
-								r.summary = "Creates a new phone condition"
-								r.operationID = "createPhoneCondition"
-								r.pathPattern = "/phone-conditions"
-								r.args = args
-								r.count = 0
-								return r, true
-							default:
-								return
+							break
+						}
+						switch elem[0] {
+						case 'c': // Prefix: "codes"
+							origElem := elem
+							if l := len("codes"); len(elem) >= l && elem[0:l] == "codes" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "GET":
+									r.name = "ListLoginCodes"
+									r.summary = "Lists the active login codes of a user"
+									r.operationID = "listLoginCodes"
+									r.pathPattern = "/users/{userId}/login-codes"
+									r.args = args
+									r.count = 1
+									return r, true
+								case "POST":
+									r.name = "IssueLoginCode"
+									r.summary = "Issues a login code for an employee"
+									r.operationID = "issueLoginCode"
+									r.pathPattern = "/users/{userId}/login-codes"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/"
+								origElem := elem
+								if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								// Param: "loginCodeId"
+								// Leaf parameter
+								args[1] = elem
+								elem = ""
+
+								if len(elem) == 0 {
+									switch method {
+									case "DELETE":
+										// Leaf: RevokeLoginCode
+										r.name = "RevokeLoginCode"
+										r.summary = "Revokes a login code"
+										r.operationID = "revokeLoginCode"
+										r.pathPattern = "/users/{userId}/login-codes/{loginCodeId}"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
+								}
+
+								elem = origElem
+							}
+
+							elem = origElem
+						case 'l': // Prefix: "lockout"
+							origElem := elem
+							if l := len("lockout"); len(elem) >= l && elem[0:l] == "lockout" {
+								elem = elem[l:]
+							} else {
+								break
+							}
+
+							if len(elem) == 0 {
+								switch method {
+								case "DELETE":
+									// Leaf: UnlockUserLogin
+									r.name = "UnlockUserLogin"
+									r.summary = "Unlocks a user's login"
+									r.operationID = "unlockUserLogin"
+									r.pathPattern = "/users/{userId}/login-lockout"
+									r.args = args
+									r.count = 1
+									return r, true
+								default:
+									return
+								}
 							}
+
+							elem = origElem
 						}
 
 						elem = origElem
-					case 'e': // Prefix: "equipments"
+					case 's': // Prefix: "sessions"
 						origElem := elem
-						if l := len("equipments"); len(elem) >= l && elem[0:l] == "equipments" {
+						if l := len("sessions"); len(elem) >= l && elem[0:l] == "sessions" {
 							elem = elem[l:]
 						} else {
 							break
@@ -763,14 +3639,14 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 
 						if len(elem) == 0 {
 							switch method {
-							case "POST":
-								// Leaf: CreatePhoneEquipment
-								r.name = "CreatePhoneEquipment"
-								r.summary = "Creates a new phone equipment"
-								r.operationID = "createPhoneEquipment"
-								r.pathPattern = "/phone-equipments"
+							case "DELETE":
+								// Leaf: RevokeUserSessions
+								r.name = "RevokeUserSessions"
+								r.summary = "Logs out another user everywhere"
+								r.operationID = "revokeUserSessions"
+								r.pathPattern = "/users/{userId}/sessions"
 								r.args = args
-								r.count = 0
+								r.count = 1
 								return r, true
 							default:
 								return
@@ -784,109 +3660,154 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 				}
 
 				elem = origElem
-			case 'r': // Prefix: "r"
+			case 'w': // Prefix: "webhooks"
 				origElem := elem
-				if l := len("r"); len(elem) >= l && elem[0:l] == "r" {
+				if l := len("webhooks"); len(elem) >= l && elem[0:l] == "webhooks" {
 					elem = elem[l:]
 				} else {
 					break
 				}
 
 				if len(elem) == 0 {
-					break
+					switch method {
+					case "GET":
+						r.name = "ListWebhooks"
+						r.summary = "Lists webhooks"
+						r.operationID = "listWebhooks"
+						r.pathPattern = "/webhooks"
+						r.args = args
+						r.count = 0
+						return r, true
+					case "POST":
+						r.name = "CreateWebhook"
+						r.summary = "Creates a new webhook"
+						r.operationID = "createWebhook"
+						r.pathPattern = "/webhooks"
+						r.args = args
+						r.count = 0
+						return r, true
+					default:
+						return
+					}
 				}
 				switch elem[0] {
-				case 'e': // Prefix: "epair-orders"
+				case '/': // Prefix: "/"
 					origElem := elem
-					if l := len("epair-orders"); len(elem) >= l && elem[0:l] == "epair-orders" {
+					if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 						elem = elem[l:]
 					} else {
 						break
 					}
 
-					if len(elem) == 0 {
-						switch method {
-						case "POST":
-							// Leaf: CreateRepairOrder
-							r.name = "CreateRepairOrder"
-							r.summary = "Creates a new repair order"
-							r.operationID = "createRepairOrder"
-							r.pathPattern = "/repair-orders"
-							r.args = args
-							r.count = 0
-							return r, true
-						default:
-							return
-						}
-					}
-
-					elem = origElem
-				case 'o': // Prefix: "oles"
-					origElem := elem
-					if l := len("oles"); len(elem) >= l && elem[0:l] == "oles" {
-						elem = elem[l:]
-					} else {
-						break
+					// Param: "webhookId"
+					// Match until "/"
+					idx := strings.IndexByte(elem, '/')
+					if idx < 0 {
+						idx = len(elem)
 					}
+					args[0] = elem[:idx]
+					elem = elem[idx:]
 
 					if len(elem) == 0 {
 						switch method {
-						case "POST":
-							r.name = "CreateRole"
-							r.summary = "Creates a role"
-							r.operationID = "createRole"
-							r.pathPattern = "/roles"
+						case "DELETE":
+							r.name = "DeleteWebhook"
+							r.summary = "Deletes a webhook"
+							r.operationID = "deleteWebhook"
+							r.pathPattern = "/webhooks/{webhookId}"
 							r.args = args
-							r.count = 0
+							r.count = 1
+							return r, true
+						case "GET":
+							r.name = "GetWebhook"
+							r.summary = "Returns a webhook"
+							r.operationID = "getWebhook"
+							r.pathPattern = "/webhooks/{webhookId}"
+							r.args = args
+							r.count = 1
+							return r, true
+						case "PUT":
+							r.name = "UpdateWebhook"
+							r.summary = "Updates a webhook"
+							r.operationID = "updateWebhook"
+							r.pathPattern = "/webhooks/{webhookId}"
+							r.args = args
+							r.count = 1
 							return r, true
 						default:
 							return
 						}
 					}
 					switch elem[0] {
-					case '/': // Prefix: "/"
+					case '/': // Prefix: "/deliveries"
 						origElem := elem
-						if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
+						if l := len("/deliveries"); len(elem) >= l && elem[0:l] == "/deliveries" {
 							elem = elem[l:]
 						} else {
 							break
 						}
 
-						// Param: "roleId"
-						// Match until "/"
-						idx := strings.IndexByte(elem, '/')
-						if idx < 0 {
-							idx = len(elem)
-						}
-						args[0] = elem[:idx]
-						elem = elem[idx:]
-
 						if len(elem) == 0 {
-							break
+							switch method {
+							case "GET":
+								r.name = "ListWebhookDeliveries"
+								r.summary = "Lists the deliveries of a webhook"
+								r.operationID = "listWebhookDeliveries"
+								r.pathPattern = "/webhooks/{webhookId}/deliveries"
+								r.args = args
+								r.count = 1
+								return r, true
+							default:
+								return
+							}
 						}
 						switch elem[0] {
-						case '/': // Prefix: "/permissions"
+						case '/': // Prefix: "/"
 							origElem := elem
-							if l := len("/permissions"); len(elem) >= l && elem[0:l] == "/permissions" {
+							if l := len("/"); len(elem) >= l && elem[0:l] == "/" {
 								elem = elem[l:]
 							} else {
 								break
 							}
 
+							// Param: "deliveryId"
+							// Match until "/"
+							idx := strings.IndexByte(elem, '/')
+							if idx < 0 {
+								idx = len(elem)
+							}
+							args[1] = elem[:idx]
+							elem = elem[idx:]
+
 							if len(elem) == 0 {
-								switch method {
-								case "POST":
-									// Leaf: AssignPermissionsToRole
-									r.name = "AssignPermissionsToRole"
-									r.summary = "Assigns permissions to a role"
-									r.operationID = "assignPermissionsToRole"
-									r.pathPattern = "/roles/{roleId}/permissions"
-									r.args = args
-									r.count = 1
-									return r, true
-								default:
-									return
+								break
+							}
+							switch elem[0] {
+							case '/': // Prefix: "/replay"
+								origElem := elem
+								if l := len("/replay"); len(elem) >= l && elem[0:l] == "/replay" {
+									elem = elem[l:]
+								} else {
+									break
+								}
+
+								if len(elem) == 0 {
+									switch method {
+									case "POST":
+										// Leaf: ReplayWebhookDelivery
+										r.name = "ReplayWebhookDelivery"
+										r.summary = "Replays a webhook delivery"
+										r.operationID = "replayWebhookDelivery"
+										r.pathPattern = "/webhooks/{webhookId}/deliveries/{deliveryId}/replay"
+										r.args = args
+										r.count = 2
+										return r, true
+									default:
+										return
+									}
 								}
+
+								elem = origElem
 							}
 
 							elem = origElem
@@ -898,81 +3819,6 @@ func (s *Server) FindPath(method string, u *url.URL) (r Route, _ bool) {
 					elem = origElem
 				}
 
-				elem = origElem
-			case 's': // Prefix: "sales-persons"
-				origElem := elem
-				if l := len("sales-persons"); len(elem) >= l && elem[0:l] == "sales-persons" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch method {
-					case "POST":
-						// Leaf: CreateSalesPerson
-						r.name = "CreateSalesPerson"
-						r.summary = "Creates a new sales person"
-						r.operationID = "createSalesPerson"
-						r.pathPattern = "/sales-persons"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-
-				elem = origElem
-			case 't': // Prefix: "technicians"
-				origElem := elem
-				if l := len("technicians"); len(elem) >= l && elem[0:l] == "technicians" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch method {
-					case "POST":
-						// Leaf: CreateTechnician
-						r.name = "CreateTechnician"
-						r.summary = "Creates a new technician"
-						r.operationID = "createTechnician"
-						r.pathPattern = "/technicians"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-
-				elem = origElem
-			case 'u': // Prefix: "users/me"
-				origElem := elem
-				if l := len("users/me"); len(elem) >= l && elem[0:l] == "users/me" {
-					elem = elem[l:]
-				} else {
-					break
-				}
-
-				if len(elem) == 0 {
-					switch method {
-					case "GET":
-						// Leaf: GetMyUserDetails
-						r.name = "GetMyUserDetails"
-						r.summary = "Returns details of the currently logged in user"
-						r.operationID = "getMyUserDetails"
-						r.pathPattern = "/users/me"
-						r.args = args
-						r.count = 0
-						return r, true
-					default:
-						return
-					}
-				}
-
 				elem = origElem
 			}
 
@@ -4,16 +4,154 @@ package genapi
 
 import (
 	"fmt"
+	"io"
 	"net/url"
+	"time"
 
 	"github.com/go-faster/errors"
+	"github.com/go-faster/jx"
 	"github.com/google/uuid"
+
+	ht "github.com/ogen-go/ogen/http"
 )
 
 func (s *ErrorStatusCode) Error() string {
 	return fmt.Sprintf("code %d: %+v", s.StatusCode, s.Response)
 }
 
+type AddRepairOrderCostRequest struct {
+	// Cost adjustment, negative for discounts.
+	Amount int    `json:"amount"`
+	Reason string `json:"reason"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *AddRepairOrderCostRequest) GetAmount() int {
+	return s.Amount
+}
+
+// GetReason returns the value of Reason.
+func (s *AddRepairOrderCostRequest) GetReason() string {
+	return s.Reason
+}
+
+// SetAmount sets the value of Amount.
+func (s *AddRepairOrderCostRequest) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetReason sets the value of Reason.
+func (s *AddRepairOrderCostRequest) SetReason(val string) {
+	s.Reason = val
+}
+
+type AddRepairOrderDamagesRequest struct {
+	// IDs of the store's damage types to add.
+	DamageTypes []uuid.UUID `json:"damage_types"`
+}
+
+// GetDamageTypes returns the value of DamageTypes.
+func (s *AddRepairOrderDamagesRequest) GetDamageTypes() []uuid.UUID {
+	return s.DamageTypes
+}
+
+// SetDamageTypes sets the value of DamageTypes.
+func (s *AddRepairOrderDamagesRequest) SetDamageTypes(val []uuid.UUID) {
+	s.DamageTypes = val
+}
+
+type AddRepairOrderNoteRequest struct {
+	Body       string                    `json:"body"`
+	Visibility RepairOrderNoteVisibility `json:"visibility"`
+}
+
+// GetBody returns the value of Body.
+func (s *AddRepairOrderNoteRequest) GetBody() string {
+	return s.Body
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *AddRepairOrderNoteRequest) GetVisibility() RepairOrderNoteVisibility {
+	return s.Visibility
+}
+
+// SetBody sets the value of Body.
+func (s *AddRepairOrderNoteRequest) SetBody(val string) {
+	s.Body = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *AddRepairOrderNoteRequest) SetVisibility(val RepairOrderNoteVisibility) {
+	s.Visibility = val
+}
+
+type AddRepairOrderPhoneConditionsRequest struct {
+	// IDs of the store's phone conditions to add.
+	PhoneConditions []uuid.UUID `json:"phone_conditions"`
+}
+
+// GetPhoneConditions returns the value of PhoneConditions.
+func (s *AddRepairOrderPhoneConditionsRequest) GetPhoneConditions() []uuid.UUID {
+	return s.PhoneConditions
+}
+
+// SetPhoneConditions sets the value of PhoneConditions.
+func (s *AddRepairOrderPhoneConditionsRequest) SetPhoneConditions(val []uuid.UUID) {
+	s.PhoneConditions = val
+}
+
+type AddRepairOrderPhoneEquipmentsRequest struct {
+	// IDs of the store's phone equipments to add.
+	PhoneEquipments []uuid.UUID `json:"phone_equipments"`
+}
+
+// GetPhoneEquipments returns the value of PhoneEquipments.
+func (s *AddRepairOrderPhoneEquipmentsRequest) GetPhoneEquipments() []uuid.UUID {
+	return s.PhoneEquipments
+}
+
+// SetPhoneEquipments sets the value of PhoneEquipments.
+func (s *AddRepairOrderPhoneEquipmentsRequest) SetPhoneEquipments(val []uuid.UUID) {
+	s.PhoneEquipments = val
+}
+
+type AddRepairOrderPhotoRequest struct {
+	// ID of a photo uploaded through POST /photos.
+	PhotoID uuid.UUID             `json:"photo_id"`
+	Stage   RepairOrderPhotoStage `json:"stage"`
+	Caption OptString             `json:"caption"`
+}
+
+// GetPhotoID returns the value of PhotoID.
+func (s *AddRepairOrderPhotoRequest) GetPhotoID() uuid.UUID {
+	return s.PhotoID
+}
+
+// GetStage returns the value of Stage.
+func (s *AddRepairOrderPhotoRequest) GetStage() RepairOrderPhotoStage {
+	return s.Stage
+}
+
+// GetCaption returns the value of Caption.
+func (s *AddRepairOrderPhotoRequest) GetCaption() OptString {
+	return s.Caption
+}
+
+// SetPhotoID sets the value of PhotoID.
+func (s *AddRepairOrderPhotoRequest) SetPhotoID(val uuid.UUID) {
+	s.PhotoID = val
+}
+
+// SetStage sets the value of Stage.
+func (s *AddRepairOrderPhotoRequest) SetStage(val RepairOrderPhotoStage) {
+	s.Stage = val
+}
+
+// SetCaption sets the value of Caption.
+func (s *AddRepairOrderPhotoRequest) SetCaption(val OptString) {
+	s.Caption = val
+}
+
 // AssignPermissionsToRoleNoContent is response for AssignPermissionsToRole operation.
 type AssignPermissionsToRoleNoContent struct{}
 
@@ -56,771 +194,4804 @@ func (s *AssignPermissionsToRoleRequestPermissionsItem) SetName(val string) {
 	s.Name = val
 }
 
-// CreateDamageTypeCreated is response for CreateDamageType operation.
-type CreateDamageTypeCreated struct {
-	Location url.URL
+// Ref: #/components/schemas/AuditLogAction
+type AuditLogAction string
+
+const (
+	AuditLogActionRepairOrderCreated               AuditLogAction = "repair_order_created"
+	AuditLogActionRepairOrderCostAdded             AuditLogAction = "repair_order_cost_added"
+	AuditLogActionRepairOrderPaymentRecorded       AuditLogAction = "repair_order_payment_recorded"
+	AuditLogActionRepairOrderTechnicianChanged     AuditLogAction = "repair_order_technician_changed"
+	AuditLogActionRepairOrderDamagesAdded          AuditLogAction = "repair_order_damages_added"
+	AuditLogActionRepairOrderDamageRemoved         AuditLogAction = "repair_order_damage_removed"
+	AuditLogActionRepairOrderPhoneConditionsAdded  AuditLogAction = "repair_order_phone_conditions_added"
+	AuditLogActionRepairOrderPhoneConditionRemoved AuditLogAction = "repair_order_phone_condition_removed"
+	AuditLogActionRepairOrderPhoneEquipmentsAdded  AuditLogAction = "repair_order_phone_equipments_added"
+	AuditLogActionRepairOrderPhoneEquipmentRemoved AuditLogAction = "repair_order_phone_equipment_removed"
+	AuditLogActionRepairOrderPhotoAdded            AuditLogAction = "repair_order_photo_added"
+	AuditLogActionRepairOrderPhotoRemoved          AuditLogAction = "repair_order_photo_removed"
+	AuditLogActionRepairOrderConfirmed             AuditLogAction = "repair_order_confirmed"
+	AuditLogActionRepairOrderCompleted             AuditLogAction = "repair_order_completed"
+	AuditLogActionRepairOrderPickedUp              AuditLogAction = "repair_order_picked_up"
+	AuditLogActionRepairOrderCancelled             AuditLogAction = "repair_order_cancelled"
+	AuditLogActionRepairOrderNoteAdded             AuditLogAction = "repair_order_note_added"
+	AuditLogActionRepairOrderNoteEdited            AuditLogAction = "repair_order_note_edited"
+	AuditLogActionRoleCreated                      AuditLogAction = "role_created"
+	AuditLogActionRolePermissionsAssigned          AuditLogAction = "role_permissions_assigned"
+	AuditLogActionTechnicianCreated                AuditLogAction = "technician_created"
+	AuditLogActionSalesPersonCreated               AuditLogAction = "sales_person_created"
+	AuditLogActionDamageTypeCreated                AuditLogAction = "damage_type_created"
+	AuditLogActionPhoneConditionCreated            AuditLogAction = "phone_condition_created"
+	AuditLogActionPhoneEquipmentCreated            AuditLogAction = "phone_equipment_created"
+	AuditLogActionPaymentMethodCreated             AuditLogAction = "payment_method_created"
+	AuditLogActionWebhookCreated                   AuditLogAction = "webhook_created"
+	AuditLogActionWebhookUpdated                   AuditLogAction = "webhook_updated"
+	AuditLogActionWebhookDeleted                   AuditLogAction = "webhook_deleted"
+	AuditLogActionPhotoUploaded                    AuditLogAction = "photo_uploaded"
+	AuditLogActionUserSessionsRevoked              AuditLogAction = "user_sessions_revoked"
+	AuditLogActionLoginCodeIssued                  AuditLogAction = "login_code_issued"
+	AuditLogActionLoginCodeRevoked                 AuditLogAction = "login_code_revoked"
+	AuditLogActionTotpEnabled                      AuditLogAction = "totp_enabled"
+	AuditLogActionTotpDisabled                     AuditLogAction = "totp_disabled"
+	AuditLogActionUserLoginUnlocked                AuditLogAction = "user_login_unlocked"
+)
+
+// AllValues returns all AuditLogAction values.
+func (AuditLogAction) AllValues() []AuditLogAction {
+	return []AuditLogAction{
+		AuditLogActionRepairOrderCreated,
+		AuditLogActionRepairOrderCostAdded,
+		AuditLogActionRepairOrderPaymentRecorded,
+		AuditLogActionRepairOrderTechnicianChanged,
+		AuditLogActionRepairOrderDamagesAdded,
+		AuditLogActionRepairOrderDamageRemoved,
+		AuditLogActionRepairOrderPhoneConditionsAdded,
+		AuditLogActionRepairOrderPhoneConditionRemoved,
+		AuditLogActionRepairOrderPhoneEquipmentsAdded,
+		AuditLogActionRepairOrderPhoneEquipmentRemoved,
+		AuditLogActionRepairOrderPhotoAdded,
+		AuditLogActionRepairOrderPhotoRemoved,
+		AuditLogActionRepairOrderConfirmed,
+		AuditLogActionRepairOrderCompleted,
+		AuditLogActionRepairOrderPickedUp,
+		AuditLogActionRepairOrderCancelled,
+		AuditLogActionRepairOrderNoteAdded,
+		AuditLogActionRepairOrderNoteEdited,
+		AuditLogActionRoleCreated,
+		AuditLogActionRolePermissionsAssigned,
+		AuditLogActionTechnicianCreated,
+		AuditLogActionSalesPersonCreated,
+		AuditLogActionDamageTypeCreated,
+		AuditLogActionPhoneConditionCreated,
+		AuditLogActionPhoneEquipmentCreated,
+		AuditLogActionPaymentMethodCreated,
+		AuditLogActionWebhookCreated,
+		AuditLogActionWebhookUpdated,
+		AuditLogActionWebhookDeleted,
+		AuditLogActionPhotoUploaded,
+		AuditLogActionUserSessionsRevoked,
+		AuditLogActionLoginCodeIssued,
+		AuditLogActionLoginCodeRevoked,
+		AuditLogActionTotpEnabled,
+		AuditLogActionTotpDisabled,
+		AuditLogActionUserLoginUnlocked,
+	}
 }
 
-// GetLocation returns the value of Location.
-func (s *CreateDamageTypeCreated) GetLocation() url.URL {
-	return s.Location
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditLogAction) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditLogActionRepairOrderCreated:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderCostAdded:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPaymentRecorded:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderTechnicianChanged:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderDamagesAdded:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderDamageRemoved:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPhoneConditionsAdded:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPhoneConditionRemoved:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPhoneEquipmentsAdded:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPhoneEquipmentRemoved:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPhotoAdded:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPhotoRemoved:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderConfirmed:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderCompleted:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderPickedUp:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderCancelled:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderNoteAdded:
+		return []byte(s), nil
+	case AuditLogActionRepairOrderNoteEdited:
+		return []byte(s), nil
+	case AuditLogActionRoleCreated:
+		return []byte(s), nil
+	case AuditLogActionRolePermissionsAssigned:
+		return []byte(s), nil
+	case AuditLogActionTechnicianCreated:
+		return []byte(s), nil
+	case AuditLogActionSalesPersonCreated:
+		return []byte(s), nil
+	case AuditLogActionDamageTypeCreated:
+		return []byte(s), nil
+	case AuditLogActionPhoneConditionCreated:
+		return []byte(s), nil
+	case AuditLogActionPhoneEquipmentCreated:
+		return []byte(s), nil
+	case AuditLogActionPaymentMethodCreated:
+		return []byte(s), nil
+	case AuditLogActionWebhookCreated:
+		return []byte(s), nil
+	case AuditLogActionWebhookUpdated:
+		return []byte(s), nil
+	case AuditLogActionWebhookDeleted:
+		return []byte(s), nil
+	case AuditLogActionPhotoUploaded:
+		return []byte(s), nil
+	case AuditLogActionUserSessionsRevoked:
+		return []byte(s), nil
+	case AuditLogActionLoginCodeIssued:
+		return []byte(s), nil
+	case AuditLogActionLoginCodeRevoked:
+		return []byte(s), nil
+	case AuditLogActionTotpEnabled:
+		return []byte(s), nil
+	case AuditLogActionTotpDisabled:
+		return []byte(s), nil
+	case AuditLogActionUserLoginUnlocked:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetLocation sets the value of Location.
-func (s *CreateDamageTypeCreated) SetLocation(val url.URL) {
-	s.Location = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditLogAction) UnmarshalText(data []byte) error {
+	switch AuditLogAction(data) {
+	case AuditLogActionRepairOrderCreated:
+		*s = AuditLogActionRepairOrderCreated
+		return nil
+	case AuditLogActionRepairOrderCostAdded:
+		*s = AuditLogActionRepairOrderCostAdded
+		return nil
+	case AuditLogActionRepairOrderPaymentRecorded:
+		*s = AuditLogActionRepairOrderPaymentRecorded
+		return nil
+	case AuditLogActionRepairOrderTechnicianChanged:
+		*s = AuditLogActionRepairOrderTechnicianChanged
+		return nil
+	case AuditLogActionRepairOrderDamagesAdded:
+		*s = AuditLogActionRepairOrderDamagesAdded
+		return nil
+	case AuditLogActionRepairOrderDamageRemoved:
+		*s = AuditLogActionRepairOrderDamageRemoved
+		return nil
+	case AuditLogActionRepairOrderPhoneConditionsAdded:
+		*s = AuditLogActionRepairOrderPhoneConditionsAdded
+		return nil
+	case AuditLogActionRepairOrderPhoneConditionRemoved:
+		*s = AuditLogActionRepairOrderPhoneConditionRemoved
+		return nil
+	case AuditLogActionRepairOrderPhoneEquipmentsAdded:
+		*s = AuditLogActionRepairOrderPhoneEquipmentsAdded
+		return nil
+	case AuditLogActionRepairOrderPhoneEquipmentRemoved:
+		*s = AuditLogActionRepairOrderPhoneEquipmentRemoved
+		return nil
+	case AuditLogActionRepairOrderPhotoAdded:
+		*s = AuditLogActionRepairOrderPhotoAdded
+		return nil
+	case AuditLogActionRepairOrderPhotoRemoved:
+		*s = AuditLogActionRepairOrderPhotoRemoved
+		return nil
+	case AuditLogActionRepairOrderConfirmed:
+		*s = AuditLogActionRepairOrderConfirmed
+		return nil
+	case AuditLogActionRepairOrderCompleted:
+		*s = AuditLogActionRepairOrderCompleted
+		return nil
+	case AuditLogActionRepairOrderPickedUp:
+		*s = AuditLogActionRepairOrderPickedUp
+		return nil
+	case AuditLogActionRepairOrderCancelled:
+		*s = AuditLogActionRepairOrderCancelled
+		return nil
+	case AuditLogActionRepairOrderNoteAdded:
+		*s = AuditLogActionRepairOrderNoteAdded
+		return nil
+	case AuditLogActionRepairOrderNoteEdited:
+		*s = AuditLogActionRepairOrderNoteEdited
+		return nil
+	case AuditLogActionRoleCreated:
+		*s = AuditLogActionRoleCreated
+		return nil
+	case AuditLogActionRolePermissionsAssigned:
+		*s = AuditLogActionRolePermissionsAssigned
+		return nil
+	case AuditLogActionTechnicianCreated:
+		*s = AuditLogActionTechnicianCreated
+		return nil
+	case AuditLogActionSalesPersonCreated:
+		*s = AuditLogActionSalesPersonCreated
+		return nil
+	case AuditLogActionDamageTypeCreated:
+		*s = AuditLogActionDamageTypeCreated
+		return nil
+	case AuditLogActionPhoneConditionCreated:
+		*s = AuditLogActionPhoneConditionCreated
+		return nil
+	case AuditLogActionPhoneEquipmentCreated:
+		*s = AuditLogActionPhoneEquipmentCreated
+		return nil
+	case AuditLogActionPaymentMethodCreated:
+		*s = AuditLogActionPaymentMethodCreated
+		return nil
+	case AuditLogActionWebhookCreated:
+		*s = AuditLogActionWebhookCreated
+		return nil
+	case AuditLogActionWebhookUpdated:
+		*s = AuditLogActionWebhookUpdated
+		return nil
+	case AuditLogActionWebhookDeleted:
+		*s = AuditLogActionWebhookDeleted
+		return nil
+	case AuditLogActionPhotoUploaded:
+		*s = AuditLogActionPhotoUploaded
+		return nil
+	case AuditLogActionUserSessionsRevoked:
+		*s = AuditLogActionUserSessionsRevoked
+		return nil
+	case AuditLogActionLoginCodeIssued:
+		*s = AuditLogActionLoginCodeIssued
+		return nil
+	case AuditLogActionLoginCodeRevoked:
+		*s = AuditLogActionLoginCodeRevoked
+		return nil
+	case AuditLogActionTotpEnabled:
+		*s = AuditLogActionTotpEnabled
+		return nil
+	case AuditLogActionTotpDisabled:
+		*s = AuditLogActionTotpDisabled
+		return nil
+	case AuditLogActionUserLoginUnlocked:
+		*s = AuditLogActionUserLoginUnlocked
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-type CreateDamageTypeRequest struct {
-	Name string `json:"name"`
+// Ref: #/components/schemas/AuditLogEntityType
+type AuditLogEntityType string
+
+const (
+	AuditLogEntityTypeRepairOrder    AuditLogEntityType = "repair_order"
+	AuditLogEntityTypeRole           AuditLogEntityType = "role"
+	AuditLogEntityTypeTechnician     AuditLogEntityType = "technician"
+	AuditLogEntityTypeSalesPerson    AuditLogEntityType = "sales_person"
+	AuditLogEntityTypeDamageType     AuditLogEntityType = "damage_type"
+	AuditLogEntityTypePhoneCondition AuditLogEntityType = "phone_condition"
+	AuditLogEntityTypePhoneEquipment AuditLogEntityType = "phone_equipment"
+	AuditLogEntityTypePaymentMethod  AuditLogEntityType = "payment_method"
+	AuditLogEntityTypePhoto          AuditLogEntityType = "photo"
+	AuditLogEntityTypeWebhook        AuditLogEntityType = "webhook"
+	AuditLogEntityTypeUser           AuditLogEntityType = "user"
+)
+
+// AllValues returns all AuditLogEntityType values.
+func (AuditLogEntityType) AllValues() []AuditLogEntityType {
+	return []AuditLogEntityType{
+		AuditLogEntityTypeRepairOrder,
+		AuditLogEntityTypeRole,
+		AuditLogEntityTypeTechnician,
+		AuditLogEntityTypeSalesPerson,
+		AuditLogEntityTypeDamageType,
+		AuditLogEntityTypePhoneCondition,
+		AuditLogEntityTypePhoneEquipment,
+		AuditLogEntityTypePaymentMethod,
+		AuditLogEntityTypePhoto,
+		AuditLogEntityTypeWebhook,
+		AuditLogEntityTypeUser,
+	}
 }
 
-// GetName returns the value of Name.
-func (s *CreateDamageTypeRequest) GetName() string {
-	return s.Name
+// MarshalText implements encoding.TextMarshaler.
+func (s AuditLogEntityType) MarshalText() ([]byte, error) {
+	switch s {
+	case AuditLogEntityTypeRepairOrder:
+		return []byte(s), nil
+	case AuditLogEntityTypeRole:
+		return []byte(s), nil
+	case AuditLogEntityTypeTechnician:
+		return []byte(s), nil
+	case AuditLogEntityTypeSalesPerson:
+		return []byte(s), nil
+	case AuditLogEntityTypeDamageType:
+		return []byte(s), nil
+	case AuditLogEntityTypePhoneCondition:
+		return []byte(s), nil
+	case AuditLogEntityTypePhoneEquipment:
+		return []byte(s), nil
+	case AuditLogEntityTypePaymentMethod:
+		return []byte(s), nil
+	case AuditLogEntityTypePhoto:
+		return []byte(s), nil
+	case AuditLogEntityTypeWebhook:
+		return []byte(s), nil
+	case AuditLogEntityTypeUser:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// SetName sets the value of Name.
-func (s *CreateDamageTypeRequest) SetName(val string) {
-	s.Name = val
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *AuditLogEntityType) UnmarshalText(data []byte) error {
+	switch AuditLogEntityType(data) {
+	case AuditLogEntityTypeRepairOrder:
+		*s = AuditLogEntityTypeRepairOrder
+		return nil
+	case AuditLogEntityTypeRole:
+		*s = AuditLogEntityTypeRole
+		return nil
+	case AuditLogEntityTypeTechnician:
+		*s = AuditLogEntityTypeTechnician
+		return nil
+	case AuditLogEntityTypeSalesPerson:
+		*s = AuditLogEntityTypeSalesPerson
+		return nil
+	case AuditLogEntityTypeDamageType:
+		*s = AuditLogEntityTypeDamageType
+		return nil
+	case AuditLogEntityTypePhoneCondition:
+		*s = AuditLogEntityTypePhoneCondition
+		return nil
+	case AuditLogEntityTypePhoneEquipment:
+		*s = AuditLogEntityTypePhoneEquipment
+		return nil
+	case AuditLogEntityTypePaymentMethod:
+		*s = AuditLogEntityTypePaymentMethod
+		return nil
+	case AuditLogEntityTypePhoto:
+		*s = AuditLogEntityTypePhoto
+		return nil
+	case AuditLogEntityTypeWebhook:
+		*s = AuditLogEntityTypeWebhook
+		return nil
+	case AuditLogEntityTypeUser:
+		*s = AuditLogEntityTypeUser
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// CreatePaymentMethodCreated is response for CreatePaymentMethod operation.
-type CreatePaymentMethodCreated struct {
-	Location url.URL
+// Ref: #/components/schemas/AuditLogEntry
+type AuditLogEntry struct {
+	ID         uuid.UUID          `json:"id"`
+	Action     AuditLogAction     `json:"action"`
+	EntityType AuditLogEntityType `json:"entity_type"`
+	EntityID   uuid.UUID          `json:"entity_id"`
+	// ID of the user who made the change.
+	UserID uuid.UUID `json:"user_id"`
+	// Username of the user at the time of the change.
+	Username string `json:"username"`
+	// Correlation ID of the request that made the change, as sent in the `X-Correlation-ID` header.
+	CorrelationID OptString `json:"correlation_id"`
+	// State of the entity before the change, absent if the change created it.
+	Before jx.Raw `json:"before"`
+	// State of the entity after the change.
+	After        jx.Raw    `json:"after"`
+	CreationTime time.Time `json:"creation_time"`
 }
 
-// GetLocation returns the value of Location.
-func (s *CreatePaymentMethodCreated) GetLocation() url.URL {
-	return s.Location
+// GetID returns the value of ID.
+func (s *AuditLogEntry) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetLocation sets the value of Location.
-func (s *CreatePaymentMethodCreated) SetLocation(val url.URL) {
-	s.Location = val
+// GetAction returns the value of Action.
+func (s *AuditLogEntry) GetAction() AuditLogAction {
+	return s.Action
 }
 
-type CreatePaymentMethodRequest struct {
-	Name string `json:"name"`
+// GetEntityType returns the value of EntityType.
+func (s *AuditLogEntry) GetEntityType() AuditLogEntityType {
+	return s.EntityType
 }
 
-// GetName returns the value of Name.
-func (s *CreatePaymentMethodRequest) GetName() string {
-	return s.Name
+// GetEntityID returns the value of EntityID.
+func (s *AuditLogEntry) GetEntityID() uuid.UUID {
+	return s.EntityID
 }
 
-// SetName sets the value of Name.
-func (s *CreatePaymentMethodRequest) SetName(val string) {
-	s.Name = val
+// GetUserID returns the value of UserID.
+func (s *AuditLogEntry) GetUserID() uuid.UUID {
+	return s.UserID
 }
 
-// CreatePhoneConditionCreated is response for CreatePhoneCondition operation.
-type CreatePhoneConditionCreated struct {
-	Location url.URL
+// GetUsername returns the value of Username.
+func (s *AuditLogEntry) GetUsername() string {
+	return s.Username
 }
 
-// GetLocation returns the value of Location.
-func (s *CreatePhoneConditionCreated) GetLocation() url.URL {
-	return s.Location
+// GetCorrelationID returns the value of CorrelationID.
+func (s *AuditLogEntry) GetCorrelationID() OptString {
+	return s.CorrelationID
 }
 
-// SetLocation sets the value of Location.
-func (s *CreatePhoneConditionCreated) SetLocation(val url.URL) {
-	s.Location = val
+// GetBefore returns the value of Before.
+func (s *AuditLogEntry) GetBefore() jx.Raw {
+	return s.Before
 }
 
-type CreatePhoneConditionRequest struct {
-	Name string `json:"name"`
+// GetAfter returns the value of After.
+func (s *AuditLogEntry) GetAfter() jx.Raw {
+	return s.After
 }
 
-// GetName returns the value of Name.
-func (s *CreatePhoneConditionRequest) GetName() string {
-	return s.Name
+// GetCreationTime returns the value of CreationTime.
+func (s *AuditLogEntry) GetCreationTime() time.Time {
+	return s.CreationTime
 }
 
-// SetName sets the value of Name.
-func (s *CreatePhoneConditionRequest) SetName(val string) {
-	s.Name = val
+// SetID sets the value of ID.
+func (s *AuditLogEntry) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// CreatePhoneEquipmentCreated is response for CreatePhoneEquipment operation.
-type CreatePhoneEquipmentCreated struct {
-	Location url.URL
+// SetAction sets the value of Action.
+func (s *AuditLogEntry) SetAction(val AuditLogAction) {
+	s.Action = val
+}
+
+// SetEntityType sets the value of EntityType.
+func (s *AuditLogEntry) SetEntityType(val AuditLogEntityType) {
+	s.EntityType = val
+}
+
+// SetEntityID sets the value of EntityID.
+func (s *AuditLogEntry) SetEntityID(val uuid.UUID) {
+	s.EntityID = val
+}
+
+// SetUserID sets the value of UserID.
+func (s *AuditLogEntry) SetUserID(val uuid.UUID) {
+	s.UserID = val
+}
+
+// SetUsername sets the value of Username.
+func (s *AuditLogEntry) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetCorrelationID sets the value of CorrelationID.
+func (s *AuditLogEntry) SetCorrelationID(val OptString) {
+	s.CorrelationID = val
+}
+
+// SetBefore sets the value of Before.
+func (s *AuditLogEntry) SetBefore(val jx.Raw) {
+	s.Before = val
+}
+
+// SetAfter sets the value of After.
+func (s *AuditLogEntry) SetAfter(val jx.Raw) {
+	s.After = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *AuditLogEntry) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// Ref: #/components/schemas/AuditLogEntryList
+type AuditLogEntryList struct {
+	Items []AuditLogEntry `json:"items"`
+	// Cursor of the next page, absent on the last page.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *AuditLogEntryList) GetItems() []AuditLogEntry {
+	return s.Items
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *AuditLogEntryList) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *AuditLogEntryList) SetItems(val []AuditLogEntry) {
+	s.Items = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *AuditLogEntryList) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+type CancelRepairOrderRequest struct {
+	Reason string `json:"reason"`
+	// Part of the paid amount kept by the store.
+	Fee OptInt `json:"fee"`
+	// Required when the paid amount is greater than the cancellation fee, and must refund the difference.
+	Refund OptCancelRepairOrderRequestRefund `json:"refund"`
+}
+
+// GetReason returns the value of Reason.
+func (s *CancelRepairOrderRequest) GetReason() string {
+	return s.Reason
+}
+
+// GetFee returns the value of Fee.
+func (s *CancelRepairOrderRequest) GetFee() OptInt {
+	return s.Fee
+}
+
+// GetRefund returns the value of Refund.
+func (s *CancelRepairOrderRequest) GetRefund() OptCancelRepairOrderRequestRefund {
+	return s.Refund
+}
+
+// SetReason sets the value of Reason.
+func (s *CancelRepairOrderRequest) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetFee sets the value of Fee.
+func (s *CancelRepairOrderRequest) SetFee(val OptInt) {
+	s.Fee = val
+}
+
+// SetRefund sets the value of Refund.
+func (s *CancelRepairOrderRequest) SetRefund(val OptCancelRepairOrderRequestRefund) {
+	s.Refund = val
+}
+
+// Required when the paid amount is greater than the cancellation fee, and must refund the difference.
+type CancelRepairOrderRequestRefund struct {
+	Amount int       `json:"amount"`
+	Method uuid.UUID `json:"method"`
+	Reason string    `json:"reason"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *CancelRepairOrderRequestRefund) GetAmount() int {
+	return s.Amount
+}
+
+// GetMethod returns the value of Method.
+func (s *CancelRepairOrderRequestRefund) GetMethod() uuid.UUID {
+	return s.Method
+}
+
+// GetReason returns the value of Reason.
+func (s *CancelRepairOrderRequestRefund) GetReason() string {
+	return s.Reason
+}
+
+// SetAmount sets the value of Amount.
+func (s *CancelRepairOrderRequestRefund) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetMethod sets the value of Method.
+func (s *CancelRepairOrderRequestRefund) SetMethod(val uuid.UUID) {
+	s.Method = val
+}
+
+// SetReason sets the value of Reason.
+func (s *CancelRepairOrderRequestRefund) SetReason(val string) {
+	s.Reason = val
+}
+
+type ChangeRepairOrderTechnicianRequest struct {
+	// ID of the technician to hand the repair order over to.
+	TechnicianID uuid.UUID `json:"technician_id"`
+	Reason       string    `json:"reason"`
+}
+
+// GetTechnicianID returns the value of TechnicianID.
+func (s *ChangeRepairOrderTechnicianRequest) GetTechnicianID() uuid.UUID {
+	return s.TechnicianID
+}
+
+// GetReason returns the value of Reason.
+func (s *ChangeRepairOrderTechnicianRequest) GetReason() string {
+	return s.Reason
+}
+
+// SetTechnicianID sets the value of TechnicianID.
+func (s *ChangeRepairOrderTechnicianRequest) SetTechnicianID(val uuid.UUID) {
+	s.TechnicianID = val
+}
+
+// SetReason sets the value of Reason.
+func (s *ChangeRepairOrderTechnicianRequest) SetReason(val string) {
+	s.Reason = val
+}
+
+type ConfirmRepairOrderRequest struct {
+	Contents string `json:"contents"`
+}
+
+// GetContents returns the value of Contents.
+func (s *ConfirmRepairOrderRequest) GetContents() string {
+	return s.Contents
+}
+
+// SetContents sets the value of Contents.
+func (s *ConfirmRepairOrderRequest) SetContents(val string) {
+	s.Contents = val
+}
+
+type ConfirmTOTPRequest struct {
+	// Current code from the authenticator app.
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *ConfirmTOTPRequest) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *ConfirmTOTPRequest) SetCode(val string) {
+	s.Code = val
+}
+
+// CreateDamageTypeCreated is response for CreateDamageType operation.
+type CreateDamageTypeCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreateDamageTypeCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreateDamageTypeCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreateDamageTypeRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateDamageTypeRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CreateDamageTypeRequest) SetName(val string) {
+	s.Name = val
+}
+
+// CreatePaymentMethodCreated is response for CreatePaymentMethod operation.
+type CreatePaymentMethodCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreatePaymentMethodCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreatePaymentMethodCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreatePaymentMethodRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CreatePaymentMethodRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CreatePaymentMethodRequest) SetName(val string) {
+	s.Name = val
+}
+
+// CreatePhoneConditionCreated is response for CreatePhoneCondition operation.
+type CreatePhoneConditionCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreatePhoneConditionCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreatePhoneConditionCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreatePhoneConditionRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CreatePhoneConditionRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CreatePhoneConditionRequest) SetName(val string) {
+	s.Name = val
+}
+
+// CreatePhoneEquipmentCreated is response for CreatePhoneEquipment operation.
+type CreatePhoneEquipmentCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreatePhoneEquipmentCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreatePhoneEquipmentCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreatePhoneEquipmentRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CreatePhoneEquipmentRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CreatePhoneEquipmentRequest) SetName(val string) {
+	s.Name = val
+}
+
+// CreateRepairOrderCreated is response for CreateRepairOrder operation.
+type CreateRepairOrderCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreateRepairOrderCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreateRepairOrderCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreateRepairOrderRequest struct {
+	CustomerName       string                              `json:"customer_name"`
+	ContactPhoneNumber string                              `json:"contact_phone_number"`
+	PhoneType          string                              `json:"phone_type"`
+	Imei               OptString                           `json:"imei"`
+	PartsNotCheckedYet OptString                           `json:"parts_not_checked_yet"`
+	Passcode           OptCreateRepairOrderRequestPasscode `json:"passcode"`
+	Color              string                              `json:"color"`
+	// When the repair is expected to be done, shown to the customer when they track the order.
+	EstimatedCompletionTime OptDateTime                            `json:"estimated_completion_time"`
+	InitialCost             int                                    `json:"initial_cost"`
+	DownPayment             OptCreateRepairOrderRequestDownPayment `json:"down_payment"`
+	SalesPersonID           uuid.UUID                              `json:"sales_person_id"`
+	TechnicianID            uuid.UUID                              `json:"technician_id"`
+	PhoneConditions         []uuid.UUID                            `json:"phone_conditions"`
+	DamageTypes             []uuid.UUID                            `json:"damage_types"`
+	PhoneEquipments         []uuid.UUID                            `json:"phone_equipments"`
+	// IDs of photos uploaded to the current store.
+	Photos []uuid.UUID `json:"photos"`
+}
+
+// GetCustomerName returns the value of CustomerName.
+func (s *CreateRepairOrderRequest) GetCustomerName() string {
+	return s.CustomerName
+}
+
+// GetContactPhoneNumber returns the value of ContactPhoneNumber.
+func (s *CreateRepairOrderRequest) GetContactPhoneNumber() string {
+	return s.ContactPhoneNumber
+}
+
+// GetPhoneType returns the value of PhoneType.
+func (s *CreateRepairOrderRequest) GetPhoneType() string {
+	return s.PhoneType
+}
+
+// GetImei returns the value of Imei.
+func (s *CreateRepairOrderRequest) GetImei() OptString {
+	return s.Imei
+}
+
+// GetPartsNotCheckedYet returns the value of PartsNotCheckedYet.
+func (s *CreateRepairOrderRequest) GetPartsNotCheckedYet() OptString {
+	return s.PartsNotCheckedYet
+}
+
+// GetPasscode returns the value of Passcode.
+func (s *CreateRepairOrderRequest) GetPasscode() OptCreateRepairOrderRequestPasscode {
+	return s.Passcode
+}
+
+// GetColor returns the value of Color.
+func (s *CreateRepairOrderRequest) GetColor() string {
+	return s.Color
+}
+
+// GetEstimatedCompletionTime returns the value of EstimatedCompletionTime.
+func (s *CreateRepairOrderRequest) GetEstimatedCompletionTime() OptDateTime {
+	return s.EstimatedCompletionTime
+}
+
+// GetInitialCost returns the value of InitialCost.
+func (s *CreateRepairOrderRequest) GetInitialCost() int {
+	return s.InitialCost
+}
+
+// GetDownPayment returns the value of DownPayment.
+func (s *CreateRepairOrderRequest) GetDownPayment() OptCreateRepairOrderRequestDownPayment {
+	return s.DownPayment
+}
+
+// GetSalesPersonID returns the value of SalesPersonID.
+func (s *CreateRepairOrderRequest) GetSalesPersonID() uuid.UUID {
+	return s.SalesPersonID
+}
+
+// GetTechnicianID returns the value of TechnicianID.
+func (s *CreateRepairOrderRequest) GetTechnicianID() uuid.UUID {
+	return s.TechnicianID
+}
+
+// GetPhoneConditions returns the value of PhoneConditions.
+func (s *CreateRepairOrderRequest) GetPhoneConditions() []uuid.UUID {
+	return s.PhoneConditions
+}
+
+// GetDamageTypes returns the value of DamageTypes.
+func (s *CreateRepairOrderRequest) GetDamageTypes() []uuid.UUID {
+	return s.DamageTypes
+}
+
+// GetPhoneEquipments returns the value of PhoneEquipments.
+func (s *CreateRepairOrderRequest) GetPhoneEquipments() []uuid.UUID {
+	return s.PhoneEquipments
+}
+
+// GetPhotos returns the value of Photos.
+func (s *CreateRepairOrderRequest) GetPhotos() []uuid.UUID {
+	return s.Photos
+}
+
+// SetCustomerName sets the value of CustomerName.
+func (s *CreateRepairOrderRequest) SetCustomerName(val string) {
+	s.CustomerName = val
+}
+
+// SetContactPhoneNumber sets the value of ContactPhoneNumber.
+func (s *CreateRepairOrderRequest) SetContactPhoneNumber(val string) {
+	s.ContactPhoneNumber = val
+}
+
+// SetPhoneType sets the value of PhoneType.
+func (s *CreateRepairOrderRequest) SetPhoneType(val string) {
+	s.PhoneType = val
+}
+
+// SetImei sets the value of Imei.
+func (s *CreateRepairOrderRequest) SetImei(val OptString) {
+	s.Imei = val
+}
+
+// SetPartsNotCheckedYet sets the value of PartsNotCheckedYet.
+func (s *CreateRepairOrderRequest) SetPartsNotCheckedYet(val OptString) {
+	s.PartsNotCheckedYet = val
+}
+
+// SetPasscode sets the value of Passcode.
+func (s *CreateRepairOrderRequest) SetPasscode(val OptCreateRepairOrderRequestPasscode) {
+	s.Passcode = val
+}
+
+// SetColor sets the value of Color.
+func (s *CreateRepairOrderRequest) SetColor(val string) {
+	s.Color = val
+}
+
+// SetEstimatedCompletionTime sets the value of EstimatedCompletionTime.
+func (s *CreateRepairOrderRequest) SetEstimatedCompletionTime(val OptDateTime) {
+	s.EstimatedCompletionTime = val
+}
+
+// SetInitialCost sets the value of InitialCost.
+func (s *CreateRepairOrderRequest) SetInitialCost(val int) {
+	s.InitialCost = val
+}
+
+// SetDownPayment sets the value of DownPayment.
+func (s *CreateRepairOrderRequest) SetDownPayment(val OptCreateRepairOrderRequestDownPayment) {
+	s.DownPayment = val
+}
+
+// SetSalesPersonID sets the value of SalesPersonID.
+func (s *CreateRepairOrderRequest) SetSalesPersonID(val uuid.UUID) {
+	s.SalesPersonID = val
+}
+
+// SetTechnicianID sets the value of TechnicianID.
+func (s *CreateRepairOrderRequest) SetTechnicianID(val uuid.UUID) {
+	s.TechnicianID = val
+}
+
+// SetPhoneConditions sets the value of PhoneConditions.
+func (s *CreateRepairOrderRequest) SetPhoneConditions(val []uuid.UUID) {
+	s.PhoneConditions = val
+}
+
+// SetDamageTypes sets the value of DamageTypes.
+func (s *CreateRepairOrderRequest) SetDamageTypes(val []uuid.UUID) {
+	s.DamageTypes = val
+}
+
+// SetPhoneEquipments sets the value of PhoneEquipments.
+func (s *CreateRepairOrderRequest) SetPhoneEquipments(val []uuid.UUID) {
+	s.PhoneEquipments = val
+}
+
+// SetPhotos sets the value of Photos.
+func (s *CreateRepairOrderRequest) SetPhotos(val []uuid.UUID) {
+	s.Photos = val
+}
+
+type CreateRepairOrderRequestDownPayment struct {
+	Amount int       `json:"amount"`
+	Method uuid.UUID `json:"method"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *CreateRepairOrderRequestDownPayment) GetAmount() int {
+	return s.Amount
+}
+
+// GetMethod returns the value of Method.
+func (s *CreateRepairOrderRequestDownPayment) GetMethod() uuid.UUID {
+	return s.Method
+}
+
+// SetAmount sets the value of Amount.
+func (s *CreateRepairOrderRequestDownPayment) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetMethod sets the value of Method.
+func (s *CreateRepairOrderRequestDownPayment) SetMethod(val uuid.UUID) {
+	s.Method = val
+}
+
+type CreateRepairOrderRequestPasscode struct {
+	IsPatternLocked bool   `json:"is_pattern_locked"`
+	Value           string `json:"value"`
+}
+
+// GetIsPatternLocked returns the value of IsPatternLocked.
+func (s *CreateRepairOrderRequestPasscode) GetIsPatternLocked() bool {
+	return s.IsPatternLocked
+}
+
+// GetValue returns the value of Value.
+func (s *CreateRepairOrderRequestPasscode) GetValue() string {
+	return s.Value
+}
+
+// SetIsPatternLocked sets the value of IsPatternLocked.
+func (s *CreateRepairOrderRequestPasscode) SetIsPatternLocked(val bool) {
+	s.IsPatternLocked = val
+}
+
+// SetValue sets the value of Value.
+func (s *CreateRepairOrderRequestPasscode) SetValue(val string) {
+	s.Value = val
+}
+
+// CreateRoleCreated is response for CreateRole operation.
+type CreateRoleCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreateRoleCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreateRoleCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreateRoleRequest struct {
+	Name         string `json:"name"`
+	IsStoreAdmin bool   `json:"is_store_admin"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateRoleRequest) GetName() string {
+	return s.Name
+}
+
+// GetIsStoreAdmin returns the value of IsStoreAdmin.
+func (s *CreateRoleRequest) GetIsStoreAdmin() bool {
+	return s.IsStoreAdmin
+}
+
+// SetName sets the value of Name.
+func (s *CreateRoleRequest) SetName(val string) {
+	s.Name = val
+}
+
+// SetIsStoreAdmin sets the value of IsStoreAdmin.
+func (s *CreateRoleRequest) SetIsStoreAdmin(val bool) {
+	s.IsStoreAdmin = val
+}
+
+// CreateSalesPersonCreated is response for CreateSalesPerson operation.
+type CreateSalesPersonCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreateSalesPersonCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreateSalesPersonCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreateSalesPersonRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateSalesPersonRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CreateSalesPersonRequest) SetName(val string) {
+	s.Name = val
+}
+
+// CreateTechnicianCreated is response for CreateTechnician operation.
+type CreateTechnicianCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreateTechnicianCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreateTechnicianCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreateTechnicianRequest struct {
+	Name string `json:"name"`
+}
+
+// GetName returns the value of Name.
+func (s *CreateTechnicianRequest) GetName() string {
+	return s.Name
+}
+
+// SetName sets the value of Name.
+func (s *CreateTechnicianRequest) SetName(val string) {
+	s.Name = val
+}
+
+// CreateWebhookCreated is response for CreateWebhook operation.
+type CreateWebhookCreated struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *CreateWebhookCreated) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *CreateWebhookCreated) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+type CreateWebhookRequest struct {
+	URL url.URL `json:"url"`
+	// Key used to sign the deliveries with HMAC-SHA256.
+	Secret string `json:"secret"`
+	// Events to subscribe to. Empty means every event.
+	EventTypes []WebhookEventType `json:"event_types"`
+}
+
+// GetURL returns the value of URL.
+func (s *CreateWebhookRequest) GetURL() url.URL {
+	return s.URL
+}
+
+// GetSecret returns the value of Secret.
+func (s *CreateWebhookRequest) GetSecret() string {
+	return s.Secret
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *CreateWebhookRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// SetURL sets the value of URL.
+func (s *CreateWebhookRequest) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetSecret sets the value of Secret.
+func (s *CreateWebhookRequest) SetSecret(val string) {
+	s.Secret = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *CreateWebhookRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// DeleteWebhookNoContent is response for DeleteWebhook operation.
+type DeleteWebhookNoContent struct{}
+
+// DisableMyTOTPNoContent is response for DisableMyTOTP operation.
+type DisableMyTOTPNoContent struct{}
+
+type DisableTOTPRequest struct {
+	// Current code from the authenticator app or one of the user's recovery codes.
+	Code string `json:"code"`
+}
+
+// GetCode returns the value of Code.
+func (s *DisableTOTPRequest) GetCode() string {
+	return s.Code
+}
+
+// SetCode sets the value of Code.
+func (s *DisableTOTPRequest) SetCode(val string) {
+	s.Code = val
+}
+
+type EditRepairOrderNoteRequest struct {
+	Body       string                    `json:"body"`
+	Visibility RepairOrderNoteVisibility `json:"visibility"`
+}
+
+// GetBody returns the value of Body.
+func (s *EditRepairOrderNoteRequest) GetBody() string {
+	return s.Body
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *EditRepairOrderNoteRequest) GetVisibility() RepairOrderNoteVisibility {
+	return s.Visibility
+}
+
+// SetBody sets the value of Body.
+func (s *EditRepairOrderNoteRequest) SetBody(val string) {
+	s.Body = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *EditRepairOrderNoteRequest) SetVisibility(val RepairOrderNoteVisibility) {
+	s.Visibility = val
+}
+
+type Error struct {
+	Message string `json:"message"`
+}
+
+// GetMessage returns the value of Message.
+func (s *Error) GetMessage() string {
+	return s.Message
+}
+
+// SetMessage sets the value of Message.
+func (s *Error) SetMessage(val string) {
+	s.Message = val
+}
+
+// ErrorStatusCode wraps Error with StatusCode.
+type ErrorStatusCode struct {
+	StatusCode int
+	Response   Error
+}
+
+// GetStatusCode returns the value of StatusCode.
+func (s *ErrorStatusCode) GetStatusCode() int {
+	return s.StatusCode
+}
+
+// GetResponse returns the value of Response.
+func (s *ErrorStatusCode) GetResponse() Error {
+	return s.Response
+}
+
+// SetStatusCode sets the value of StatusCode.
+func (s *ErrorStatusCode) SetStatusCode(val int) {
+	s.StatusCode = val
+}
+
+// SetResponse sets the value of Response.
+func (s *ErrorStatusCode) SetResponse(val Error) {
+	s.Response = val
+}
+
+// GetHealthNoContent is response for GetHealth operation.
+type GetHealthNoContent struct{}
+
+// GetPhotoFound is response for GetPhoto operation.
+type GetPhotoFound struct {
+	Location url.URL
+}
+
+// GetLocation returns the value of Location.
+func (s *GetPhotoFound) GetLocation() url.URL {
+	return s.Location
+}
+
+// SetLocation sets the value of Location.
+func (s *GetPhotoFound) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+func (*GetPhotoFound) getPhotoRes() {}
+
+type GetPhotoOKImageJpeg struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPhotoOKImageJpeg) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPhotoOKImageJpeg) getPhotoRes() {}
+
+type GetPhotoOKImagePNG struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPhotoOKImagePNG) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetPhotoOKImagePNG) getPhotoRes() {}
+
+type GetPhotoThumbnailOK struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetPhotoThumbnailOK) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+type GetRepairOrderLabelFormat string
+
+const (
+	GetRepairOrderLabelFormatPNG GetRepairOrderLabelFormat = "png"
+	GetRepairOrderLabelFormatSvg GetRepairOrderLabelFormat = "svg"
+)
+
+// AllValues returns all GetRepairOrderLabelFormat values.
+func (GetRepairOrderLabelFormat) AllValues() []GetRepairOrderLabelFormat {
+	return []GetRepairOrderLabelFormat{
+		GetRepairOrderLabelFormatPNG,
+		GetRepairOrderLabelFormatSvg,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetRepairOrderLabelFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case GetRepairOrderLabelFormatPNG:
+		return []byte(s), nil
+	case GetRepairOrderLabelFormatSvg:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetRepairOrderLabelFormat) UnmarshalText(data []byte) error {
+	switch GetRepairOrderLabelFormat(data) {
+	case GetRepairOrderLabelFormatPNG:
+		*s = GetRepairOrderLabelFormatPNG
+		return nil
+	case GetRepairOrderLabelFormatSvg:
+		*s = GetRepairOrderLabelFormatSvg
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetRepairOrderLabelOKImagePNG struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetRepairOrderLabelOKImagePNG) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetRepairOrderLabelOKImagePNG) getRepairOrderLabelRes() {}
+
+type GetRepairOrderLabelOKImageSvgXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetRepairOrderLabelOKImageSvgXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetRepairOrderLabelOKImageSvgXML) getRepairOrderLabelRes() {}
+
+type GetRepairOrderReceiptFormat string
+
+const (
+	GetRepairOrderReceiptFormatHTML   GetRepairOrderReceiptFormat = "html"
+	GetRepairOrderReceiptFormatPdf    GetRepairOrderReceiptFormat = "pdf"
+	GetRepairOrderReceiptFormatEscpos GetRepairOrderReceiptFormat = "escpos"
+)
+
+// AllValues returns all GetRepairOrderReceiptFormat values.
+func (GetRepairOrderReceiptFormat) AllValues() []GetRepairOrderReceiptFormat {
+	return []GetRepairOrderReceiptFormat{
+		GetRepairOrderReceiptFormatHTML,
+		GetRepairOrderReceiptFormatPdf,
+		GetRepairOrderReceiptFormatEscpos,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s GetRepairOrderReceiptFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case GetRepairOrderReceiptFormatHTML:
+		return []byte(s), nil
+	case GetRepairOrderReceiptFormatPdf:
+		return []byte(s), nil
+	case GetRepairOrderReceiptFormatEscpos:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *GetRepairOrderReceiptFormat) UnmarshalText(data []byte) error {
+	switch GetRepairOrderReceiptFormat(data) {
+	case GetRepairOrderReceiptFormatHTML:
+		*s = GetRepairOrderReceiptFormatHTML
+		return nil
+	case GetRepairOrderReceiptFormatPdf:
+		*s = GetRepairOrderReceiptFormatPdf
+		return nil
+	case GetRepairOrderReceiptFormatEscpos:
+		*s = GetRepairOrderReceiptFormatEscpos
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type GetRepairOrderReceiptOKApplicationOctetStream struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetRepairOrderReceiptOKApplicationOctetStream) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetRepairOrderReceiptOKApplicationOctetStream) getRepairOrderReceiptRes() {}
+
+type GetRepairOrderReceiptOKApplicationPdf struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetRepairOrderReceiptOKApplicationPdf) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetRepairOrderReceiptOKApplicationPdf) getRepairOrderReceiptRes() {}
+
+type GetRepairOrderReceiptOKTextHTML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s GetRepairOrderReceiptOKTextHTML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*GetRepairOrderReceiptOKTextHTML) getRepairOrderReceiptRes() {}
+
+type IssueLoginCodeRequest struct {
+	Type LoginCodeType `json:"type"`
+	// How long the code can be used for. Required for time-boxed codes.
+	ValidForMinutes OptInt `json:"valid_for_minutes"`
+}
+
+// GetType returns the value of Type.
+func (s *IssueLoginCodeRequest) GetType() LoginCodeType {
+	return s.Type
+}
+
+// GetValidForMinutes returns the value of ValidForMinutes.
+func (s *IssueLoginCodeRequest) GetValidForMinutes() OptInt {
+	return s.ValidForMinutes
+}
+
+// SetType sets the value of Type.
+func (s *IssueLoginCodeRequest) SetType(val LoginCodeType) {
+	s.Type = val
+}
+
+// SetValidForMinutes sets the value of ValidForMinutes.
+func (s *IssueLoginCodeRequest) SetValidForMinutes(val OptInt) {
+	s.ValidForMinutes = val
+}
+
+type IssuedLoginCode struct {
+	ID uuid.UUID `json:"id"`
+	// The code to hand to the employee. It can't be retrieved again.
+	Code string        `json:"code"`
+	Type LoginCodeType `json:"type"`
+	// Only set if the code expires.
+	ExpiryTime   OptDateTime `json:"expiry_time"`
+	CreationTime time.Time   `json:"creation_time"`
+}
+
+// GetID returns the value of ID.
+func (s *IssuedLoginCode) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetCode returns the value of Code.
+func (s *IssuedLoginCode) GetCode() string {
+	return s.Code
+}
+
+// GetType returns the value of Type.
+func (s *IssuedLoginCode) GetType() LoginCodeType {
+	return s.Type
+}
+
+// GetExpiryTime returns the value of ExpiryTime.
+func (s *IssuedLoginCode) GetExpiryTime() OptDateTime {
+	return s.ExpiryTime
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *IssuedLoginCode) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// SetID sets the value of ID.
+func (s *IssuedLoginCode) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetCode sets the value of Code.
+func (s *IssuedLoginCode) SetCode(val string) {
+	s.Code = val
+}
+
+// SetType sets the value of Type.
+func (s *IssuedLoginCode) SetType(val LoginCodeType) {
+	s.Type = val
+}
+
+// SetExpiryTime sets the value of ExpiryTime.
+func (s *IssuedLoginCode) SetExpiryTime(val OptDateTime) {
+	s.ExpiryTime = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *IssuedLoginCode) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+type ListRepairOrdersStatus string
+
+const (
+	ListRepairOrdersStatusOpen      ListRepairOrdersStatus = "open"
+	ListRepairOrdersStatusConfirmed ListRepairOrdersStatus = "confirmed"
+	ListRepairOrdersStatusCompleted ListRepairOrdersStatus = "completed"
+	ListRepairOrdersStatusPickedUp  ListRepairOrdersStatus = "picked_up"
+	ListRepairOrdersStatusCancelled ListRepairOrdersStatus = "cancelled"
+)
+
+// AllValues returns all ListRepairOrdersStatus values.
+func (ListRepairOrdersStatus) AllValues() []ListRepairOrdersStatus {
+	return []ListRepairOrdersStatus{
+		ListRepairOrdersStatusOpen,
+		ListRepairOrdersStatusConfirmed,
+		ListRepairOrdersStatusCompleted,
+		ListRepairOrdersStatusPickedUp,
+		ListRepairOrdersStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s ListRepairOrdersStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case ListRepairOrdersStatusOpen:
+		return []byte(s), nil
+	case ListRepairOrdersStatusConfirmed:
+		return []byte(s), nil
+	case ListRepairOrdersStatusCompleted:
+		return []byte(s), nil
+	case ListRepairOrdersStatusPickedUp:
+		return []byte(s), nil
+	case ListRepairOrdersStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *ListRepairOrdersStatus) UnmarshalText(data []byte) error {
+	switch ListRepairOrdersStatus(data) {
+	case ListRepairOrdersStatusOpen:
+		*s = ListRepairOrdersStatusOpen
+		return nil
+	case ListRepairOrdersStatusConfirmed:
+		*s = ListRepairOrdersStatusConfirmed
+		return nil
+	case ListRepairOrdersStatusCompleted:
+		*s = ListRepairOrdersStatusCompleted
+		return nil
+	case ListRepairOrdersStatusPickedUp:
+		*s = ListRepairOrdersStatusPickedUp
+		return nil
+	case ListRepairOrdersStatusCancelled:
+		*s = ListRepairOrdersStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/LoginCode
+type LoginCode struct {
+	ID   uuid.UUID     `json:"id"`
+	Type LoginCodeType `json:"type"`
+	// Only set if the code expires.
+	ExpiryTime OptDateTime `json:"expiry_time"`
+	// ID of the admin who issued the code.
+	IssuedBy     OptUUID   `json:"issued_by"`
+	CreationTime time.Time `json:"creation_time"`
+}
+
+// GetID returns the value of ID.
+func (s *LoginCode) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *LoginCode) GetType() LoginCodeType {
+	return s.Type
+}
+
+// GetExpiryTime returns the value of ExpiryTime.
+func (s *LoginCode) GetExpiryTime() OptDateTime {
+	return s.ExpiryTime
+}
+
+// GetIssuedBy returns the value of IssuedBy.
+func (s *LoginCode) GetIssuedBy() OptUUID {
+	return s.IssuedBy
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *LoginCode) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// SetID sets the value of ID.
+func (s *LoginCode) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *LoginCode) SetType(val LoginCodeType) {
+	s.Type = val
+}
+
+// SetExpiryTime sets the value of ExpiryTime.
+func (s *LoginCode) SetExpiryTime(val OptDateTime) {
+	s.ExpiryTime = val
+}
+
+// SetIssuedBy sets the value of IssuedBy.
+func (s *LoginCode) SetIssuedBy(val OptUUID) {
+	s.IssuedBy = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *LoginCode) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+type LoginCodeList struct {
+	Items []LoginCode `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *LoginCodeList) GetItems() []LoginCode {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *LoginCodeList) SetItems(val []LoginCode) {
+	s.Items = val
+}
+
+type LoginCodePrompt struct {
+	// A login code given by the store admin, a TOTP code from the user's authenticator app or one of the
+	// user's recovery codes.
+	LoginCode string `json:"login_code"`
+}
+
+// GetLoginCode returns the value of LoginCode.
+func (s *LoginCodePrompt) GetLoginCode() string {
+	return s.LoginCode
+}
+
+// SetLoginCode sets the value of LoginCode.
+func (s *LoginCodePrompt) SetLoginCode(val string) {
+	s.LoginCode = val
+}
+
+// LoginCodePromptNoContent is response for LoginCodePrompt operation.
+type LoginCodePromptNoContent struct{}
+
+// Single-use codes are deleted once used. Time-boxed codes can be used any number of times until
+// they expire.
+// Ref: #/components/schemas/LoginCodeType
+type LoginCodeType string
+
+const (
+	LoginCodeTypeSingleUse LoginCodeType = "single_use"
+	LoginCodeTypeTimeBoxed LoginCodeType = "time_boxed"
+)
+
+// AllValues returns all LoginCodeType values.
+func (LoginCodeType) AllValues() []LoginCodeType {
+	return []LoginCodeType{
+		LoginCodeTypeSingleUse,
+		LoginCodeTypeTimeBoxed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LoginCodeType) MarshalText() ([]byte, error) {
+	switch s {
+	case LoginCodeTypeSingleUse:
+		return []byte(s), nil
+	case LoginCodeTypeTimeBoxed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LoginCodeType) UnmarshalText(data []byte) error {
+	switch LoginCodeType(data) {
+	case LoginCodeTypeSingleUse:
+		*s = LoginCodeTypeSingleUse
+		return nil
+	case LoginCodeTypeTimeBoxed:
+		*s = LoginCodeTypeTimeBoxed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type LoginCredentials struct {
+	Username  string `json:"username"`
+	Password  string `json:"password"`
+	StoreCode string `json:"store_code"`
+}
+
+// GetUsername returns the value of Username.
+func (s *LoginCredentials) GetUsername() string {
+	return s.Username
+}
+
+// GetPassword returns the value of Password.
+func (s *LoginCredentials) GetPassword() string {
+	return s.Password
+}
+
+// GetStoreCode returns the value of StoreCode.
+func (s *LoginCredentials) GetStoreCode() string {
+	return s.StoreCode
+}
+
+// SetUsername sets the value of Username.
+func (s *LoginCredentials) SetUsername(val string) {
+	s.Username = val
+}
+
+// SetPassword sets the value of Password.
+func (s *LoginCredentials) SetPassword(val string) {
+	s.Password = val
+}
+
+// SetStoreCode sets the value of StoreCode.
+func (s *LoginCredentials) SetStoreCode(val string) {
+	s.StoreCode = val
+}
+
+type LoginResponse struct {
+	// The type of user that logged in:
+	// * `admin` - Store admin. The session ID is returned in a cookie named
+	// `session_id`. You need to include this cookie in subsequent requests.
+	// * `employee` - Store employee. The user needs to log in with a login
+	// code given by the store admin. The login code prompt ID is returned in
+	// a cookie named `login_code_prompt_id`. You need to visit [/auth/login-code](#/auth/loginCodePrompt)
+	// with the login code to log in. If the user has enabled TOTP, a code
+	// from their authenticator app or a recovery code is also accepted.
+	// * `admin_totp` - Store admin who has enabled TOTP. Works like `employee`,
+	// but the user needs to enter a code from their authenticator app or a
+	// recovery code.
+	Type LoginResponseType `json:"type"`
+}
+
+// GetType returns the value of Type.
+func (s *LoginResponse) GetType() LoginResponseType {
+	return s.Type
+}
+
+// SetType sets the value of Type.
+func (s *LoginResponse) SetType(val LoginResponseType) {
+	s.Type = val
+}
+
+// The type of user that logged in:
+// * `admin` - Store admin. The session ID is returned in a cookie named
+// `session_id`. You need to include this cookie in subsequent requests.
+// * `employee` - Store employee. The user needs to log in with a login
+// code given by the store admin. The login code prompt ID is returned in
+// a cookie named `login_code_prompt_id`. You need to visit [/auth/login-code](#/auth/loginCodePrompt)
+// with the login code to log in. If the user has enabled TOTP, a code
+// from their authenticator app or a recovery code is also accepted.
+// * `admin_totp` - Store admin who has enabled TOTP. Works like `employee`,
+// but the user needs to enter a code from their authenticator app or a
+// recovery code.
+type LoginResponseType string
+
+const (
+	LoginResponseTypeAdmin     LoginResponseType = "admin"
+	LoginResponseTypeEmployee  LoginResponseType = "employee"
+	LoginResponseTypeAdminTotp LoginResponseType = "admin_totp"
+)
+
+// AllValues returns all LoginResponseType values.
+func (LoginResponseType) AllValues() []LoginResponseType {
+	return []LoginResponseType{
+		LoginResponseTypeAdmin,
+		LoginResponseTypeEmployee,
+		LoginResponseTypeAdminTotp,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s LoginResponseType) MarshalText() ([]byte, error) {
+	switch s {
+	case LoginResponseTypeAdmin:
+		return []byte(s), nil
+	case LoginResponseTypeEmployee:
+		return []byte(s), nil
+	case LoginResponseTypeAdminTotp:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *LoginResponseType) UnmarshalText(data []byte) error {
+	switch LoginResponseType(data) {
+	case LoginResponseTypeAdmin:
+		*s = LoginResponseTypeAdmin
+		return nil
+	case LoginResponseTypeEmployee:
+		*s = LoginResponseTypeEmployee
+		return nil
+	case LoginResponseTypeAdminTotp:
+		*s = LoginResponseTypeAdminTotp
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// LogoutResetContent is response for Logout operation.
+type LogoutResetContent struct{}
+
+// NewOptAuditLogAction returns new OptAuditLogAction with value set to v.
+func NewOptAuditLogAction(v AuditLogAction) OptAuditLogAction {
+	return OptAuditLogAction{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditLogAction is optional AuditLogAction.
+type OptAuditLogAction struct {
+	Value AuditLogAction
+	Set   bool
+}
+
+// IsSet returns true if OptAuditLogAction was set.
+func (o OptAuditLogAction) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditLogAction) Reset() {
+	var v AuditLogAction
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuditLogAction) SetTo(v AuditLogAction) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditLogAction) Get() (v AuditLogAction, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditLogAction) Or(d AuditLogAction) AuditLogAction {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptAuditLogEntityType returns new OptAuditLogEntityType with value set to v.
+func NewOptAuditLogEntityType(v AuditLogEntityType) OptAuditLogEntityType {
+	return OptAuditLogEntityType{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptAuditLogEntityType is optional AuditLogEntityType.
+type OptAuditLogEntityType struct {
+	Value AuditLogEntityType
+	Set   bool
+}
+
+// IsSet returns true if OptAuditLogEntityType was set.
+func (o OptAuditLogEntityType) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptAuditLogEntityType) Reset() {
+	var v AuditLogEntityType
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptAuditLogEntityType) SetTo(v AuditLogEntityType) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptAuditLogEntityType) Get() (v AuditLogEntityType, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptAuditLogEntityType) Or(d AuditLogEntityType) AuditLogEntityType {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCancelRepairOrderRequestRefund returns new OptCancelRepairOrderRequestRefund with value set to v.
+func NewOptCancelRepairOrderRequestRefund(v CancelRepairOrderRequestRefund) OptCancelRepairOrderRequestRefund {
+	return OptCancelRepairOrderRequestRefund{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCancelRepairOrderRequestRefund is optional CancelRepairOrderRequestRefund.
+type OptCancelRepairOrderRequestRefund struct {
+	Value CancelRepairOrderRequestRefund
+	Set   bool
+}
+
+// IsSet returns true if OptCancelRepairOrderRequestRefund was set.
+func (o OptCancelRepairOrderRequestRefund) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCancelRepairOrderRequestRefund) Reset() {
+	var v CancelRepairOrderRequestRefund
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCancelRepairOrderRequestRefund) SetTo(v CancelRepairOrderRequestRefund) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCancelRepairOrderRequestRefund) Get() (v CancelRepairOrderRequestRefund, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCancelRepairOrderRequestRefund) Or(d CancelRepairOrderRequestRefund) CancelRepairOrderRequestRefund {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateRepairOrderRequestDownPayment returns new OptCreateRepairOrderRequestDownPayment with value set to v.
+func NewOptCreateRepairOrderRequestDownPayment(v CreateRepairOrderRequestDownPayment) OptCreateRepairOrderRequestDownPayment {
+	return OptCreateRepairOrderRequestDownPayment{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateRepairOrderRequestDownPayment is optional CreateRepairOrderRequestDownPayment.
+type OptCreateRepairOrderRequestDownPayment struct {
+	Value CreateRepairOrderRequestDownPayment
+	Set   bool
+}
+
+// IsSet returns true if OptCreateRepairOrderRequestDownPayment was set.
+func (o OptCreateRepairOrderRequestDownPayment) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateRepairOrderRequestDownPayment) Reset() {
+	var v CreateRepairOrderRequestDownPayment
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateRepairOrderRequestDownPayment) SetTo(v CreateRepairOrderRequestDownPayment) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateRepairOrderRequestDownPayment) Get() (v CreateRepairOrderRequestDownPayment, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateRepairOrderRequestDownPayment) Or(d CreateRepairOrderRequestDownPayment) CreateRepairOrderRequestDownPayment {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptCreateRepairOrderRequestPasscode returns new OptCreateRepairOrderRequestPasscode with value set to v.
+func NewOptCreateRepairOrderRequestPasscode(v CreateRepairOrderRequestPasscode) OptCreateRepairOrderRequestPasscode {
+	return OptCreateRepairOrderRequestPasscode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptCreateRepairOrderRequestPasscode is optional CreateRepairOrderRequestPasscode.
+type OptCreateRepairOrderRequestPasscode struct {
+	Value CreateRepairOrderRequestPasscode
+	Set   bool
+}
+
+// IsSet returns true if OptCreateRepairOrderRequestPasscode was set.
+func (o OptCreateRepairOrderRequestPasscode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptCreateRepairOrderRequestPasscode) Reset() {
+	var v CreateRepairOrderRequestPasscode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptCreateRepairOrderRequestPasscode) SetTo(v CreateRepairOrderRequestPasscode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptCreateRepairOrderRequestPasscode) Get() (v CreateRepairOrderRequestPasscode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptCreateRepairOrderRequestPasscode) Or(d CreateRepairOrderRequestPasscode) CreateRepairOrderRequestPasscode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptDateTime returns new OptDateTime with value set to v.
+func NewOptDateTime(v time.Time) OptDateTime {
+	return OptDateTime{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptDateTime is optional time.Time.
+type OptDateTime struct {
+	Value time.Time
+	Set   bool
+}
+
+// IsSet returns true if OptDateTime was set.
+func (o OptDateTime) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptDateTime) Reset() {
+	var v time.Time
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptDateTime) SetTo(v time.Time) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptDateTime) Get() (v time.Time, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptDateTime) Or(d time.Time) time.Time {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetRepairOrderLabelFormat returns new OptGetRepairOrderLabelFormat with value set to v.
+func NewOptGetRepairOrderLabelFormat(v GetRepairOrderLabelFormat) OptGetRepairOrderLabelFormat {
+	return OptGetRepairOrderLabelFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetRepairOrderLabelFormat is optional GetRepairOrderLabelFormat.
+type OptGetRepairOrderLabelFormat struct {
+	Value GetRepairOrderLabelFormat
+	Set   bool
+}
+
+// IsSet returns true if OptGetRepairOrderLabelFormat was set.
+func (o OptGetRepairOrderLabelFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetRepairOrderLabelFormat) Reset() {
+	var v GetRepairOrderLabelFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetRepairOrderLabelFormat) SetTo(v GetRepairOrderLabelFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetRepairOrderLabelFormat) Get() (v GetRepairOrderLabelFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetRepairOrderLabelFormat) Or(d GetRepairOrderLabelFormat) GetRepairOrderLabelFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptGetRepairOrderReceiptFormat returns new OptGetRepairOrderReceiptFormat with value set to v.
+func NewOptGetRepairOrderReceiptFormat(v GetRepairOrderReceiptFormat) OptGetRepairOrderReceiptFormat {
+	return OptGetRepairOrderReceiptFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptGetRepairOrderReceiptFormat is optional GetRepairOrderReceiptFormat.
+type OptGetRepairOrderReceiptFormat struct {
+	Value GetRepairOrderReceiptFormat
+	Set   bool
+}
+
+// IsSet returns true if OptGetRepairOrderReceiptFormat was set.
+func (o OptGetRepairOrderReceiptFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptGetRepairOrderReceiptFormat) Reset() {
+	var v GetRepairOrderReceiptFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptGetRepairOrderReceiptFormat) SetTo(v GetRepairOrderReceiptFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptGetRepairOrderReceiptFormat) Get() (v GetRepairOrderReceiptFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptGetRepairOrderReceiptFormat) Or(d GetRepairOrderReceiptFormat) GetRepairOrderReceiptFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptInt returns new OptInt with value set to v.
+func NewOptInt(v int) OptInt {
+	return OptInt{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptInt is optional int.
+type OptInt struct {
+	Value int
+	Set   bool
+}
+
+// IsSet returns true if OptInt was set.
+func (o OptInt) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptInt) Reset() {
+	var v int
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptInt) SetTo(v int) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptInt) Get() (v int, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptInt) Or(d int) int {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptListRepairOrdersStatus returns new OptListRepairOrdersStatus with value set to v.
+func NewOptListRepairOrdersStatus(v ListRepairOrdersStatus) OptListRepairOrdersStatus {
+	return OptListRepairOrdersStatus{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptListRepairOrdersStatus is optional ListRepairOrdersStatus.
+type OptListRepairOrdersStatus struct {
+	Value ListRepairOrdersStatus
+	Set   bool
+}
+
+// IsSet returns true if OptListRepairOrdersStatus was set.
+func (o OptListRepairOrdersStatus) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptListRepairOrdersStatus) Reset() {
+	var v ListRepairOrdersStatus
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptListRepairOrdersStatus) SetTo(v ListRepairOrdersStatus) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptListRepairOrdersStatus) Get() (v ListRepairOrdersStatus, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptListRepairOrdersStatus) Or(d ListRepairOrdersStatus) ListRepairOrdersStatus {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPickUpRepairOrderRequest returns new OptPickUpRepairOrderRequest with value set to v.
+func NewOptPickUpRepairOrderRequest(v PickUpRepairOrderRequest) OptPickUpRepairOrderRequest {
+	return OptPickUpRepairOrderRequest{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPickUpRepairOrderRequest is optional PickUpRepairOrderRequest.
+type OptPickUpRepairOrderRequest struct {
+	Value PickUpRepairOrderRequest
+	Set   bool
+}
+
+// IsSet returns true if OptPickUpRepairOrderRequest was set.
+func (o OptPickUpRepairOrderRequest) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPickUpRepairOrderRequest) Reset() {
+	var v PickUpRepairOrderRequest
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPickUpRepairOrderRequest) SetTo(v PickUpRepairOrderRequest) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPickUpRepairOrderRequest) Get() (v PickUpRepairOrderRequest, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPickUpRepairOrderRequest) Or(d PickUpRepairOrderRequest) PickUpRepairOrderRequest {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptPickUpRepairOrderRequestRepayment returns new OptPickUpRepairOrderRequestRepayment with value set to v.
+func NewOptPickUpRepairOrderRequestRepayment(v PickUpRepairOrderRequestRepayment) OptPickUpRepairOrderRequestRepayment {
+	return OptPickUpRepairOrderRequestRepayment{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptPickUpRepairOrderRequestRepayment is optional PickUpRepairOrderRequestRepayment.
+type OptPickUpRepairOrderRequestRepayment struct {
+	Value PickUpRepairOrderRequestRepayment
+	Set   bool
+}
+
+// IsSet returns true if OptPickUpRepairOrderRequestRepayment was set.
+func (o OptPickUpRepairOrderRequestRepayment) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptPickUpRepairOrderRequestRepayment) Reset() {
+	var v PickUpRepairOrderRequestRepayment
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptPickUpRepairOrderRequestRepayment) SetTo(v PickUpRepairOrderRequestRepayment) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptPickUpRepairOrderRequestRepayment) Get() (v PickUpRepairOrderRequestRepayment, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptPickUpRepairOrderRequestRepayment) Or(d PickUpRepairOrderRequestRepayment) PickUpRepairOrderRequestRepayment {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRenderRepairOrderLabelsRequestFormat returns new OptRenderRepairOrderLabelsRequestFormat with value set to v.
+func NewOptRenderRepairOrderLabelsRequestFormat(v RenderRepairOrderLabelsRequestFormat) OptRenderRepairOrderLabelsRequestFormat {
+	return OptRenderRepairOrderLabelsRequestFormat{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRenderRepairOrderLabelsRequestFormat is optional RenderRepairOrderLabelsRequestFormat.
+type OptRenderRepairOrderLabelsRequestFormat struct {
+	Value RenderRepairOrderLabelsRequestFormat
+	Set   bool
+}
+
+// IsSet returns true if OptRenderRepairOrderLabelsRequestFormat was set.
+func (o OptRenderRepairOrderLabelsRequestFormat) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRenderRepairOrderLabelsRequestFormat) Reset() {
+	var v RenderRepairOrderLabelsRequestFormat
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRenderRepairOrderLabelsRequestFormat) SetTo(v RenderRepairOrderLabelsRequestFormat) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRenderRepairOrderLabelsRequestFormat) Get() (v RenderRepairOrderLabelsRequestFormat, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRenderRepairOrderLabelsRequestFormat) Or(d RenderRepairOrderLabelsRequestFormat) RenderRepairOrderLabelsRequestFormat {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepairOrderCancellation returns new OptRepairOrderCancellation with value set to v.
+func NewOptRepairOrderCancellation(v RepairOrderCancellation) OptRepairOrderCancellation {
+	return OptRepairOrderCancellation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepairOrderCancellation is optional RepairOrderCancellation.
+type OptRepairOrderCancellation struct {
+	Value RepairOrderCancellation
+	Set   bool
+}
+
+// IsSet returns true if OptRepairOrderCancellation was set.
+func (o OptRepairOrderCancellation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepairOrderCancellation) Reset() {
+	var v RepairOrderCancellation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepairOrderCancellation) SetTo(v RepairOrderCancellation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepairOrderCancellation) Get() (v RepairOrderCancellation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepairOrderCancellation) Or(d RepairOrderCancellation) RepairOrderCancellation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepairOrderConfirmation returns new OptRepairOrderConfirmation with value set to v.
+func NewOptRepairOrderConfirmation(v RepairOrderConfirmation) OptRepairOrderConfirmation {
+	return OptRepairOrderConfirmation{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepairOrderConfirmation is optional RepairOrderConfirmation.
+type OptRepairOrderConfirmation struct {
+	Value RepairOrderConfirmation
+	Set   bool
+}
+
+// IsSet returns true if OptRepairOrderConfirmation was set.
+func (o OptRepairOrderConfirmation) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepairOrderConfirmation) Reset() {
+	var v RepairOrderConfirmation
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepairOrderConfirmation) SetTo(v RepairOrderConfirmation) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepairOrderConfirmation) Get() (v RepairOrderConfirmation, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepairOrderConfirmation) Or(d RepairOrderConfirmation) RepairOrderConfirmation {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepairOrderPasscode returns new OptRepairOrderPasscode with value set to v.
+func NewOptRepairOrderPasscode(v RepairOrderPasscode) OptRepairOrderPasscode {
+	return OptRepairOrderPasscode{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepairOrderPasscode is optional RepairOrderPasscode.
+type OptRepairOrderPasscode struct {
+	Value RepairOrderPasscode
+	Set   bool
+}
+
+// IsSet returns true if OptRepairOrderPasscode was set.
+func (o OptRepairOrderPasscode) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepairOrderPasscode) Reset() {
+	var v RepairOrderPasscode
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepairOrderPasscode) SetTo(v RepairOrderPasscode) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepairOrderPasscode) Get() (v RepairOrderPasscode, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepairOrderPasscode) Or(d RepairOrderPasscode) RepairOrderPasscode {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptRepairOrderWriteOff returns new OptRepairOrderWriteOff with value set to v.
+func NewOptRepairOrderWriteOff(v RepairOrderWriteOff) OptRepairOrderWriteOff {
+	return OptRepairOrderWriteOff{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptRepairOrderWriteOff is optional RepairOrderWriteOff.
+type OptRepairOrderWriteOff struct {
+	Value RepairOrderWriteOff
+	Set   bool
+}
+
+// IsSet returns true if OptRepairOrderWriteOff was set.
+func (o OptRepairOrderWriteOff) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptRepairOrderWriteOff) Reset() {
+	var v RepairOrderWriteOff
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptRepairOrderWriteOff) SetTo(v RepairOrderWriteOff) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptRepairOrderWriteOff) Get() (v RepairOrderWriteOff, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptRepairOrderWriteOff) Or(d RepairOrderWriteOff) RepairOrderWriteOff {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptString returns new OptString with value set to v.
+func NewOptString(v string) OptString {
+	return OptString{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptString is optional string.
+type OptString struct {
+	Value string
+	Set   bool
+}
+
+// IsSet returns true if OptString was set.
+func (o OptString) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptString) Reset() {
+	var v string
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptString) SetTo(v string) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptString) Get() (v string, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptString) Or(d string) string {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// NewOptUUID returns new OptUUID with value set to v.
+func NewOptUUID(v uuid.UUID) OptUUID {
+	return OptUUID{
+		Value: v,
+		Set:   true,
+	}
+}
+
+// OptUUID is optional uuid.UUID.
+type OptUUID struct {
+	Value uuid.UUID
+	Set   bool
+}
+
+// IsSet returns true if OptUUID was set.
+func (o OptUUID) IsSet() bool { return o.Set }
+
+// Reset unsets value.
+func (o *OptUUID) Reset() {
+	var v uuid.UUID
+	o.Value = v
+	o.Set = false
+}
+
+// SetTo sets value to v.
+func (o *OptUUID) SetTo(v uuid.UUID) {
+	o.Set = true
+	o.Value = v
+}
+
+// Get returns value and boolean that denotes whether value was set.
+func (o OptUUID) Get() (v uuid.UUID, ok bool) {
+	if !o.Set {
+		return v, false
+	}
+	return o.Value, true
+}
+
+// Or returns value if set, or given parameter if does not.
+func (o OptUUID) Or(d uuid.UUID) uuid.UUID {
+	if v, ok := o.Get(); ok {
+		return v
+	}
+	return d
+}
+
+// Ref: #/components/schemas/Photo
+type Photo struct {
+	ID           uuid.UUID `json:"id"`
+	ContentType  string    `json:"content_type"`
+	HasThumbnail bool      `json:"has_thumbnail"`
+	CreationTime time.Time `json:"creation_time"`
+}
+
+// GetID returns the value of ID.
+func (s *Photo) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetContentType returns the value of ContentType.
+func (s *Photo) GetContentType() string {
+	return s.ContentType
+}
+
+// GetHasThumbnail returns the value of HasThumbnail.
+func (s *Photo) GetHasThumbnail() bool {
+	return s.HasThumbnail
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *Photo) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// SetID sets the value of ID.
+func (s *Photo) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetContentType sets the value of ContentType.
+func (s *Photo) SetContentType(val string) {
+	s.ContentType = val
+}
+
+// SetHasThumbnail sets the value of HasThumbnail.
+func (s *Photo) SetHasThumbnail(val bool) {
+	s.HasThumbnail = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *Photo) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// PhotoHeaders wraps Photo with response headers.
+type PhotoHeaders struct {
+	Location url.URL
+	Response Photo
+}
+
+// GetLocation returns the value of Location.
+func (s *PhotoHeaders) GetLocation() url.URL {
+	return s.Location
+}
+
+// GetResponse returns the value of Response.
+func (s *PhotoHeaders) GetResponse() Photo {
+	return s.Response
+}
+
+// SetLocation sets the value of Location.
+func (s *PhotoHeaders) SetLocation(val url.URL) {
+	s.Location = val
+}
+
+// SetResponse sets the value of Response.
+func (s *PhotoHeaders) SetResponse(val Photo) {
+	s.Response = val
+}
+
+type PickUpRepairOrderRequest struct {
+	Repayment OptPickUpRepairOrderRequestRepayment `json:"repayment"`
+	// Required when the payments do not add up to the total cost.
+	WriteOffReason OptString `json:"write_off_reason"`
+}
+
+// GetRepayment returns the value of Repayment.
+func (s *PickUpRepairOrderRequest) GetRepayment() OptPickUpRepairOrderRequestRepayment {
+	return s.Repayment
+}
+
+// GetWriteOffReason returns the value of WriteOffReason.
+func (s *PickUpRepairOrderRequest) GetWriteOffReason() OptString {
+	return s.WriteOffReason
+}
+
+// SetRepayment sets the value of Repayment.
+func (s *PickUpRepairOrderRequest) SetRepayment(val OptPickUpRepairOrderRequestRepayment) {
+	s.Repayment = val
+}
+
+// SetWriteOffReason sets the value of WriteOffReason.
+func (s *PickUpRepairOrderRequest) SetWriteOffReason(val OptString) {
+	s.WriteOffReason = val
+}
+
+type PickUpRepairOrderRequestRepayment struct {
+	Amount int       `json:"amount"`
+	Method uuid.UUID `json:"method"`
+}
+
+// GetAmount returns the value of Amount.
+func (s *PickUpRepairOrderRequestRepayment) GetAmount() int {
+	return s.Amount
+}
+
+// GetMethod returns the value of Method.
+func (s *PickUpRepairOrderRequestRepayment) GetMethod() uuid.UUID {
+	return s.Method
+}
+
+// SetAmount sets the value of Amount.
+func (s *PickUpRepairOrderRequestRepayment) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetMethod sets the value of Method.
+func (s *PickUpRepairOrderRequestRepayment) SetMethod(val uuid.UUID) {
+	s.Method = val
+}
+
+// Status of a repair order that is safe to show to the customer.
+type PublicRepairOrder struct {
+	Slug                    string                  `json:"slug"`
+	Status                  PublicRepairOrderStatus `json:"status"`
+	CreationTime            time.Time               `json:"creation_time"`
+	PhoneType               string                  `json:"phone_type"`
+	EstimatedCompletionTime OptDateTime             `json:"estimated_completion_time"`
+	CompletionTime          OptDateTime             `json:"completion_time"`
+	PickUpTime              OptDateTime             `json:"pick_up_time"`
+	// What the customer still owes.
+	OutstandingAmount int `json:"outstanding_amount"`
+	// Notes the store has shared with the customer, oldest first.
+	Notes []PublicRepairOrderNotesItem `json:"notes"`
+}
+
+// GetSlug returns the value of Slug.
+func (s *PublicRepairOrder) GetSlug() string {
+	return s.Slug
+}
+
+// GetStatus returns the value of Status.
+func (s *PublicRepairOrder) GetStatus() PublicRepairOrderStatus {
+	return s.Status
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *PublicRepairOrder) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// GetPhoneType returns the value of PhoneType.
+func (s *PublicRepairOrder) GetPhoneType() string {
+	return s.PhoneType
+}
+
+// GetEstimatedCompletionTime returns the value of EstimatedCompletionTime.
+func (s *PublicRepairOrder) GetEstimatedCompletionTime() OptDateTime {
+	return s.EstimatedCompletionTime
+}
+
+// GetCompletionTime returns the value of CompletionTime.
+func (s *PublicRepairOrder) GetCompletionTime() OptDateTime {
+	return s.CompletionTime
+}
+
+// GetPickUpTime returns the value of PickUpTime.
+func (s *PublicRepairOrder) GetPickUpTime() OptDateTime {
+	return s.PickUpTime
+}
+
+// GetOutstandingAmount returns the value of OutstandingAmount.
+func (s *PublicRepairOrder) GetOutstandingAmount() int {
+	return s.OutstandingAmount
+}
+
+// GetNotes returns the value of Notes.
+func (s *PublicRepairOrder) GetNotes() []PublicRepairOrderNotesItem {
+	return s.Notes
+}
+
+// SetSlug sets the value of Slug.
+func (s *PublicRepairOrder) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetStatus sets the value of Status.
+func (s *PublicRepairOrder) SetStatus(val PublicRepairOrderStatus) {
+	s.Status = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *PublicRepairOrder) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// SetPhoneType sets the value of PhoneType.
+func (s *PublicRepairOrder) SetPhoneType(val string) {
+	s.PhoneType = val
+}
+
+// SetEstimatedCompletionTime sets the value of EstimatedCompletionTime.
+func (s *PublicRepairOrder) SetEstimatedCompletionTime(val OptDateTime) {
+	s.EstimatedCompletionTime = val
+}
+
+// SetCompletionTime sets the value of CompletionTime.
+func (s *PublicRepairOrder) SetCompletionTime(val OptDateTime) {
+	s.CompletionTime = val
+}
+
+// SetPickUpTime sets the value of PickUpTime.
+func (s *PublicRepairOrder) SetPickUpTime(val OptDateTime) {
+	s.PickUpTime = val
+}
+
+// SetOutstandingAmount sets the value of OutstandingAmount.
+func (s *PublicRepairOrder) SetOutstandingAmount(val int) {
+	s.OutstandingAmount = val
+}
+
+// SetNotes sets the value of Notes.
+func (s *PublicRepairOrder) SetNotes(val []PublicRepairOrderNotesItem) {
+	s.Notes = val
+}
+
+type PublicRepairOrderNotesItem struct {
+	Body         string    `json:"body"`
+	CreationTime time.Time `json:"creation_time"`
+}
+
+// GetBody returns the value of Body.
+func (s *PublicRepairOrderNotesItem) GetBody() string {
+	return s.Body
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *PublicRepairOrderNotesItem) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// SetBody sets the value of Body.
+func (s *PublicRepairOrderNotesItem) SetBody(val string) {
+	s.Body = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *PublicRepairOrderNotesItem) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+type PublicRepairOrderStatus string
+
+const (
+	PublicRepairOrderStatusOpen      PublicRepairOrderStatus = "open"
+	PublicRepairOrderStatusConfirmed PublicRepairOrderStatus = "confirmed"
+	PublicRepairOrderStatusCompleted PublicRepairOrderStatus = "completed"
+	PublicRepairOrderStatusPickedUp  PublicRepairOrderStatus = "picked_up"
+	PublicRepairOrderStatusCancelled PublicRepairOrderStatus = "cancelled"
+)
+
+// AllValues returns all PublicRepairOrderStatus values.
+func (PublicRepairOrderStatus) AllValues() []PublicRepairOrderStatus {
+	return []PublicRepairOrderStatus{
+		PublicRepairOrderStatusOpen,
+		PublicRepairOrderStatusConfirmed,
+		PublicRepairOrderStatusCompleted,
+		PublicRepairOrderStatusPickedUp,
+		PublicRepairOrderStatusCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s PublicRepairOrderStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case PublicRepairOrderStatusOpen:
+		return []byte(s), nil
+	case PublicRepairOrderStatusConfirmed:
+		return []byte(s), nil
+	case PublicRepairOrderStatusCompleted:
+		return []byte(s), nil
+	case PublicRepairOrderStatusPickedUp:
+		return []byte(s), nil
+	case PublicRepairOrderStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *PublicRepairOrderStatus) UnmarshalText(data []byte) error {
+	switch PublicRepairOrderStatus(data) {
+	case PublicRepairOrderStatusOpen:
+		*s = PublicRepairOrderStatusOpen
+		return nil
+	case PublicRepairOrderStatusConfirmed:
+		*s = PublicRepairOrderStatusConfirmed
+		return nil
+	case PublicRepairOrderStatusCompleted:
+		*s = PublicRepairOrderStatusCompleted
+		return nil
+	case PublicRepairOrderStatusPickedUp:
+		*s = PublicRepairOrderStatusPickedUp
+		return nil
+	case PublicRepairOrderStatusCancelled:
+		*s = PublicRepairOrderStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type RecordRepairOrderPaymentRequest struct {
+	// Final payments are recorded when the order is picked up.
+	Type   RecordRepairOrderPaymentRequestType `json:"type"`
+	Amount int                                 `json:"amount"`
+	Method uuid.UUID                           `json:"method"`
+}
+
+// GetType returns the value of Type.
+func (s *RecordRepairOrderPaymentRequest) GetType() RecordRepairOrderPaymentRequestType {
+	return s.Type
+}
+
+// GetAmount returns the value of Amount.
+func (s *RecordRepairOrderPaymentRequest) GetAmount() int {
+	return s.Amount
+}
+
+// GetMethod returns the value of Method.
+func (s *RecordRepairOrderPaymentRequest) GetMethod() uuid.UUID {
+	return s.Method
+}
+
+// SetType sets the value of Type.
+func (s *RecordRepairOrderPaymentRequest) SetType(val RecordRepairOrderPaymentRequestType) {
+	s.Type = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *RecordRepairOrderPaymentRequest) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetMethod sets the value of Method.
+func (s *RecordRepairOrderPaymentRequest) SetMethod(val uuid.UUID) {
+	s.Method = val
+}
+
+// Final payments are recorded when the order is picked up.
+type RecordRepairOrderPaymentRequestType string
+
+const (
+	RecordRepairOrderPaymentRequestTypeDownPayment RecordRepairOrderPaymentRequestType = "down_payment"
+	RecordRepairOrderPaymentRequestTypePartial     RecordRepairOrderPaymentRequestType = "partial"
+)
+
+// AllValues returns all RecordRepairOrderPaymentRequestType values.
+func (RecordRepairOrderPaymentRequestType) AllValues() []RecordRepairOrderPaymentRequestType {
+	return []RecordRepairOrderPaymentRequestType{
+		RecordRepairOrderPaymentRequestTypeDownPayment,
+		RecordRepairOrderPaymentRequestTypePartial,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RecordRepairOrderPaymentRequestType) MarshalText() ([]byte, error) {
+	switch s {
+	case RecordRepairOrderPaymentRequestTypeDownPayment:
+		return []byte(s), nil
+	case RecordRepairOrderPaymentRequestTypePartial:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RecordRepairOrderPaymentRequestType) UnmarshalText(data []byte) error {
+	switch RecordRepairOrderPaymentRequestType(data) {
+	case RecordRepairOrderPaymentRequestTypeDownPayment:
+		*s = RecordRepairOrderPaymentRequestTypeDownPayment
+		return nil
+	case RecordRepairOrderPaymentRequestTypePartial:
+		*s = RecordRepairOrderPaymentRequestTypePartial
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type RenderRepairOrderLabelsOKImagePNG struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s RenderRepairOrderLabelsOKImagePNG) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*RenderRepairOrderLabelsOKImagePNG) renderRepairOrderLabelsRes() {}
+
+type RenderRepairOrderLabelsOKImageSvgXML struct {
+	Data io.Reader
+}
+
+// Read reads data from the Data reader.
+//
+// Kept to satisfy the io.Reader interface.
+func (s RenderRepairOrderLabelsOKImageSvgXML) Read(p []byte) (n int, err error) {
+	if s.Data == nil {
+		return 0, io.EOF
+	}
+	return s.Data.Read(p)
+}
+
+func (*RenderRepairOrderLabelsOKImageSvgXML) renderRepairOrderLabelsRes() {}
+
+type RenderRepairOrderLabelsRequest struct {
+	// Repair orders to render a label for, in printing order.
+	RepairOrderIds []uuid.UUID                             `json:"repair_order_ids"`
+	Format         OptRenderRepairOrderLabelsRequestFormat `json:"format"`
+}
+
+// GetRepairOrderIds returns the value of RepairOrderIds.
+func (s *RenderRepairOrderLabelsRequest) GetRepairOrderIds() []uuid.UUID {
+	return s.RepairOrderIds
+}
+
+// GetFormat returns the value of Format.
+func (s *RenderRepairOrderLabelsRequest) GetFormat() OptRenderRepairOrderLabelsRequestFormat {
+	return s.Format
+}
+
+// SetRepairOrderIds sets the value of RepairOrderIds.
+func (s *RenderRepairOrderLabelsRequest) SetRepairOrderIds(val []uuid.UUID) {
+	s.RepairOrderIds = val
+}
+
+// SetFormat sets the value of Format.
+func (s *RenderRepairOrderLabelsRequest) SetFormat(val OptRenderRepairOrderLabelsRequestFormat) {
+	s.Format = val
+}
+
+type RenderRepairOrderLabelsRequestFormat string
+
+const (
+	RenderRepairOrderLabelsRequestFormatPNG RenderRepairOrderLabelsRequestFormat = "png"
+	RenderRepairOrderLabelsRequestFormatSvg RenderRepairOrderLabelsRequestFormat = "svg"
+)
+
+// AllValues returns all RenderRepairOrderLabelsRequestFormat values.
+func (RenderRepairOrderLabelsRequestFormat) AllValues() []RenderRepairOrderLabelsRequestFormat {
+	return []RenderRepairOrderLabelsRequestFormat{
+		RenderRepairOrderLabelsRequestFormatPNG,
+		RenderRepairOrderLabelsRequestFormatSvg,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RenderRepairOrderLabelsRequestFormat) MarshalText() ([]byte, error) {
+	switch s {
+	case RenderRepairOrderLabelsRequestFormatPNG:
+		return []byte(s), nil
+	case RenderRepairOrderLabelsRequestFormatSvg:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RenderRepairOrderLabelsRequestFormat) UnmarshalText(data []byte) error {
+	switch RenderRepairOrderLabelsRequestFormat(data) {
+	case RenderRepairOrderLabelsRequestFormatPNG:
+		*s = RenderRepairOrderLabelsRequestFormatPNG
+		return nil
+	case RenderRepairOrderLabelsRequestFormatSvg:
+		*s = RenderRepairOrderLabelsRequestFormatSvg
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/RepairOrder
+type RepairOrder struct {
+	ID                 uuid.UUID              `json:"id"`
+	Slug               string                 `json:"slug"`
+	CreationTime       time.Time              `json:"creation_time"`
+	CustomerName       string                 `json:"customer_name"`
+	ContactPhoneNumber string                 `json:"contact_phone_number"`
+	PhoneType          string                 `json:"phone_type"`
+	Color              string                 `json:"color"`
+	Imei               OptString              `json:"imei"`
+	PartsNotCheckedYet OptString              `json:"parts_not_checked_yet"`
+	Passcode           OptRepairOrderPasscode `json:"passcode"`
+	SalesPersonID      uuid.UUID              `json:"sales_person_id"`
+	TechnicianID       uuid.UUID              `json:"technician_id"`
+	// Reassignments of the order, oldest first.
+	TechnicianAssignments []RepairOrderTechnicianAssignmentsItem `json:"technician_assignments"`
+	Costs                 []RepairOrderCostsItem                 `json:"costs"`
+	// Sum of all costs.
+	TotalCost int `json:"total_cost"`
+	// Sum of all payments, minus refunds.
+	PaidAmount int `json:"paid_amount"`
+	// Total cost minus the paid amount and the write-off.
+	OutstandingAmount int                              `json:"outstanding_amount"`
+	Payments          []RepairOrderPayment             `json:"payments"`
+	Damages           []RepairOrderDamagesItem         `json:"damages"`
+	PhoneConditions   []RepairOrderPhoneConditionsItem `json:"phone_conditions"`
+	PhoneEquipments   []RepairOrderPhoneEquipmentsItem `json:"phone_equipments"`
+	Photos            []RepairOrderPhotosItem          `json:"photos"`
+	// Difference between the total cost and the paid amount that was settled at pick-up.
+	WriteOff                OptRepairOrderWriteOff     `json:"write_off"`
+	Confirmation            OptRepairOrderConfirmation `json:"confirmation"`
+	EstimatedCompletionTime OptDateTime                `json:"estimated_completion_time"`
+	CompletionTime          OptDateTime                `json:"completion_time"`
+	PickUpTime              OptDateTime                `json:"pick_up_time"`
+	Cancellation            OptRepairOrderCancellation `json:"cancellation"`
+}
+
+// GetID returns the value of ID.
+func (s *RepairOrder) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetSlug returns the value of Slug.
+func (s *RepairOrder) GetSlug() string {
+	return s.Slug
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *RepairOrder) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// GetCustomerName returns the value of CustomerName.
+func (s *RepairOrder) GetCustomerName() string {
+	return s.CustomerName
+}
+
+// GetContactPhoneNumber returns the value of ContactPhoneNumber.
+func (s *RepairOrder) GetContactPhoneNumber() string {
+	return s.ContactPhoneNumber
+}
+
+// GetPhoneType returns the value of PhoneType.
+func (s *RepairOrder) GetPhoneType() string {
+	return s.PhoneType
+}
+
+// GetColor returns the value of Color.
+func (s *RepairOrder) GetColor() string {
+	return s.Color
+}
+
+// GetImei returns the value of Imei.
+func (s *RepairOrder) GetImei() OptString {
+	return s.Imei
+}
+
+// GetPartsNotCheckedYet returns the value of PartsNotCheckedYet.
+func (s *RepairOrder) GetPartsNotCheckedYet() OptString {
+	return s.PartsNotCheckedYet
+}
+
+// GetPasscode returns the value of Passcode.
+func (s *RepairOrder) GetPasscode() OptRepairOrderPasscode {
+	return s.Passcode
+}
+
+// GetSalesPersonID returns the value of SalesPersonID.
+func (s *RepairOrder) GetSalesPersonID() uuid.UUID {
+	return s.SalesPersonID
+}
+
+// GetTechnicianID returns the value of TechnicianID.
+func (s *RepairOrder) GetTechnicianID() uuid.UUID {
+	return s.TechnicianID
+}
+
+// GetTechnicianAssignments returns the value of TechnicianAssignments.
+func (s *RepairOrder) GetTechnicianAssignments() []RepairOrderTechnicianAssignmentsItem {
+	return s.TechnicianAssignments
+}
+
+// GetCosts returns the value of Costs.
+func (s *RepairOrder) GetCosts() []RepairOrderCostsItem {
+	return s.Costs
+}
+
+// GetTotalCost returns the value of TotalCost.
+func (s *RepairOrder) GetTotalCost() int {
+	return s.TotalCost
+}
+
+// GetPaidAmount returns the value of PaidAmount.
+func (s *RepairOrder) GetPaidAmount() int {
+	return s.PaidAmount
+}
+
+// GetOutstandingAmount returns the value of OutstandingAmount.
+func (s *RepairOrder) GetOutstandingAmount() int {
+	return s.OutstandingAmount
+}
+
+// GetPayments returns the value of Payments.
+func (s *RepairOrder) GetPayments() []RepairOrderPayment {
+	return s.Payments
+}
+
+// GetDamages returns the value of Damages.
+func (s *RepairOrder) GetDamages() []RepairOrderDamagesItem {
+	return s.Damages
+}
+
+// GetPhoneConditions returns the value of PhoneConditions.
+func (s *RepairOrder) GetPhoneConditions() []RepairOrderPhoneConditionsItem {
+	return s.PhoneConditions
+}
+
+// GetPhoneEquipments returns the value of PhoneEquipments.
+func (s *RepairOrder) GetPhoneEquipments() []RepairOrderPhoneEquipmentsItem {
+	return s.PhoneEquipments
+}
+
+// GetPhotos returns the value of Photos.
+func (s *RepairOrder) GetPhotos() []RepairOrderPhotosItem {
+	return s.Photos
+}
+
+// GetWriteOff returns the value of WriteOff.
+func (s *RepairOrder) GetWriteOff() OptRepairOrderWriteOff {
+	return s.WriteOff
+}
+
+// GetConfirmation returns the value of Confirmation.
+func (s *RepairOrder) GetConfirmation() OptRepairOrderConfirmation {
+	return s.Confirmation
+}
+
+// GetEstimatedCompletionTime returns the value of EstimatedCompletionTime.
+func (s *RepairOrder) GetEstimatedCompletionTime() OptDateTime {
+	return s.EstimatedCompletionTime
+}
+
+// GetCompletionTime returns the value of CompletionTime.
+func (s *RepairOrder) GetCompletionTime() OptDateTime {
+	return s.CompletionTime
+}
+
+// GetPickUpTime returns the value of PickUpTime.
+func (s *RepairOrder) GetPickUpTime() OptDateTime {
+	return s.PickUpTime
+}
+
+// GetCancellation returns the value of Cancellation.
+func (s *RepairOrder) GetCancellation() OptRepairOrderCancellation {
+	return s.Cancellation
+}
+
+// SetID sets the value of ID.
+func (s *RepairOrder) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetSlug sets the value of Slug.
+func (s *RepairOrder) SetSlug(val string) {
+	s.Slug = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *RepairOrder) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// SetCustomerName sets the value of CustomerName.
+func (s *RepairOrder) SetCustomerName(val string) {
+	s.CustomerName = val
+}
+
+// SetContactPhoneNumber sets the value of ContactPhoneNumber.
+func (s *RepairOrder) SetContactPhoneNumber(val string) {
+	s.ContactPhoneNumber = val
+}
+
+// SetPhoneType sets the value of PhoneType.
+func (s *RepairOrder) SetPhoneType(val string) {
+	s.PhoneType = val
+}
+
+// SetColor sets the value of Color.
+func (s *RepairOrder) SetColor(val string) {
+	s.Color = val
+}
+
+// SetImei sets the value of Imei.
+func (s *RepairOrder) SetImei(val OptString) {
+	s.Imei = val
+}
+
+// SetPartsNotCheckedYet sets the value of PartsNotCheckedYet.
+func (s *RepairOrder) SetPartsNotCheckedYet(val OptString) {
+	s.PartsNotCheckedYet = val
+}
+
+// SetPasscode sets the value of Passcode.
+func (s *RepairOrder) SetPasscode(val OptRepairOrderPasscode) {
+	s.Passcode = val
+}
+
+// SetSalesPersonID sets the value of SalesPersonID.
+func (s *RepairOrder) SetSalesPersonID(val uuid.UUID) {
+	s.SalesPersonID = val
+}
+
+// SetTechnicianID sets the value of TechnicianID.
+func (s *RepairOrder) SetTechnicianID(val uuid.UUID) {
+	s.TechnicianID = val
+}
+
+// SetTechnicianAssignments sets the value of TechnicianAssignments.
+func (s *RepairOrder) SetTechnicianAssignments(val []RepairOrderTechnicianAssignmentsItem) {
+	s.TechnicianAssignments = val
+}
+
+// SetCosts sets the value of Costs.
+func (s *RepairOrder) SetCosts(val []RepairOrderCostsItem) {
+	s.Costs = val
+}
+
+// SetTotalCost sets the value of TotalCost.
+func (s *RepairOrder) SetTotalCost(val int) {
+	s.TotalCost = val
+}
+
+// SetPaidAmount sets the value of PaidAmount.
+func (s *RepairOrder) SetPaidAmount(val int) {
+	s.PaidAmount = val
+}
+
+// SetOutstandingAmount sets the value of OutstandingAmount.
+func (s *RepairOrder) SetOutstandingAmount(val int) {
+	s.OutstandingAmount = val
+}
+
+// SetPayments sets the value of Payments.
+func (s *RepairOrder) SetPayments(val []RepairOrderPayment) {
+	s.Payments = val
+}
+
+// SetDamages sets the value of Damages.
+func (s *RepairOrder) SetDamages(val []RepairOrderDamagesItem) {
+	s.Damages = val
+}
+
+// SetPhoneConditions sets the value of PhoneConditions.
+func (s *RepairOrder) SetPhoneConditions(val []RepairOrderPhoneConditionsItem) {
+	s.PhoneConditions = val
+}
+
+// SetPhoneEquipments sets the value of PhoneEquipments.
+func (s *RepairOrder) SetPhoneEquipments(val []RepairOrderPhoneEquipmentsItem) {
+	s.PhoneEquipments = val
+}
+
+// SetPhotos sets the value of Photos.
+func (s *RepairOrder) SetPhotos(val []RepairOrderPhotosItem) {
+	s.Photos = val
+}
+
+// SetWriteOff sets the value of WriteOff.
+func (s *RepairOrder) SetWriteOff(val OptRepairOrderWriteOff) {
+	s.WriteOff = val
+}
+
+// SetConfirmation sets the value of Confirmation.
+func (s *RepairOrder) SetConfirmation(val OptRepairOrderConfirmation) {
+	s.Confirmation = val
+}
+
+// SetEstimatedCompletionTime sets the value of EstimatedCompletionTime.
+func (s *RepairOrder) SetEstimatedCompletionTime(val OptDateTime) {
+	s.EstimatedCompletionTime = val
+}
+
+// SetCompletionTime sets the value of CompletionTime.
+func (s *RepairOrder) SetCompletionTime(val OptDateTime) {
+	s.CompletionTime = val
+}
+
+// SetPickUpTime sets the value of PickUpTime.
+func (s *RepairOrder) SetPickUpTime(val OptDateTime) {
+	s.PickUpTime = val
+}
+
+// SetCancellation sets the value of Cancellation.
+func (s *RepairOrder) SetCancellation(val OptRepairOrderCancellation) {
+	s.Cancellation = val
+}
+
+type RepairOrderCancellation struct {
+	Time   time.Time `json:"time"`
+	Reason string    `json:"reason"`
+	// Part of the paid amount kept by the store.
+	Fee OptInt `json:"fee"`
+}
+
+// GetTime returns the value of Time.
+func (s *RepairOrderCancellation) GetTime() time.Time {
+	return s.Time
+}
+
+// GetReason returns the value of Reason.
+func (s *RepairOrderCancellation) GetReason() string {
+	return s.Reason
+}
+
+// GetFee returns the value of Fee.
+func (s *RepairOrderCancellation) GetFee() OptInt {
+	return s.Fee
+}
+
+// SetTime sets the value of Time.
+func (s *RepairOrderCancellation) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetReason sets the value of Reason.
+func (s *RepairOrderCancellation) SetReason(val string) {
+	s.Reason = val
+}
+
+// SetFee sets the value of Fee.
+func (s *RepairOrderCancellation) SetFee(val OptInt) {
+	s.Fee = val
+}
+
+type RepairOrderConfirmation struct {
+	Time     time.Time `json:"time"`
+	Contents string    `json:"contents"`
+}
+
+// GetTime returns the value of Time.
+func (s *RepairOrderConfirmation) GetTime() time.Time {
+	return s.Time
+}
+
+// GetContents returns the value of Contents.
+func (s *RepairOrderConfirmation) GetContents() string {
+	return s.Contents
+}
+
+// SetTime sets the value of Time.
+func (s *RepairOrderConfirmation) SetTime(val time.Time) {
+	s.Time = val
+}
+
+// SetContents sets the value of Contents.
+func (s *RepairOrderConfirmation) SetContents(val string) {
+	s.Contents = val
+}
+
+type RepairOrderCostsItem struct {
+	ID           uuid.UUID `json:"id"`
+	Amount       int       `json:"amount"`
+	Reason       OptString `json:"reason"`
+	IsInitial    bool      `json:"is_initial"`
+	CreationTime time.Time `json:"creation_time"`
+}
+
+// GetID returns the value of ID.
+func (s *RepairOrderCostsItem) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetAmount returns the value of Amount.
+func (s *RepairOrderCostsItem) GetAmount() int {
+	return s.Amount
+}
+
+// GetReason returns the value of Reason.
+func (s *RepairOrderCostsItem) GetReason() OptString {
+	return s.Reason
+}
+
+// GetIsInitial returns the value of IsInitial.
+func (s *RepairOrderCostsItem) GetIsInitial() bool {
+	return s.IsInitial
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *RepairOrderCostsItem) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// SetID sets the value of ID.
+func (s *RepairOrderCostsItem) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *RepairOrderCostsItem) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetReason sets the value of Reason.
+func (s *RepairOrderCostsItem) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// SetIsInitial sets the value of IsInitial.
+func (s *RepairOrderCostsItem) SetIsInitial(val bool) {
+	s.IsInitial = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *RepairOrderCostsItem) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+type RepairOrderDamagesItem struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
+}
+
+// GetID returns the value of ID.
+func (s *RepairOrderDamagesItem) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetName returns the value of Name.
+func (s *RepairOrderDamagesItem) GetName() string {
+	return s.Name
+}
+
+// SetID sets the value of ID.
+func (s *RepairOrderDamagesItem) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetName sets the value of Name.
+func (s *RepairOrderDamagesItem) SetName(val string) {
+	s.Name = val
+}
+
+type RepairOrderList struct {
+	Items []RepairOrderSummary `json:"items"`
+	// Number of repair orders matching the filters, across all pages.
+	TotalCount int `json:"total_count"`
+	// Cursor of the next page, absent on the last page.
+	NextCursor OptString `json:"next_cursor"`
+}
+
+// GetItems returns the value of Items.
+func (s *RepairOrderList) GetItems() []RepairOrderSummary {
+	return s.Items
+}
+
+// GetTotalCount returns the value of TotalCount.
+func (s *RepairOrderList) GetTotalCount() int {
+	return s.TotalCount
+}
+
+// GetNextCursor returns the value of NextCursor.
+func (s *RepairOrderList) GetNextCursor() OptString {
+	return s.NextCursor
+}
+
+// SetItems sets the value of Items.
+func (s *RepairOrderList) SetItems(val []RepairOrderSummary) {
+	s.Items = val
+}
+
+// SetTotalCount sets the value of TotalCount.
+func (s *RepairOrderList) SetTotalCount(val int) {
+	s.TotalCount = val
+}
+
+// SetNextCursor sets the value of NextCursor.
+func (s *RepairOrderList) SetNextCursor(val OptString) {
+	s.NextCursor = val
+}
+
+// Ref: #/components/schemas/RepairOrderNote
+type RepairOrderNote struct {
+	ID             uuid.UUID                 `json:"id"`
+	Body           string                    `json:"body"`
+	Visibility     RepairOrderNoteVisibility `json:"visibility"`
+	AuthorID       uuid.UUID                 `json:"author_id"`
+	AuthorUsername string                    `json:"author_username"`
+	CreationTime   time.Time                 `json:"creation_time"`
+	EditTime       OptDateTime               `json:"edit_time"`
+}
+
+// GetID returns the value of ID.
+func (s *RepairOrderNote) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetBody returns the value of Body.
+func (s *RepairOrderNote) GetBody() string {
+	return s.Body
+}
+
+// GetVisibility returns the value of Visibility.
+func (s *RepairOrderNote) GetVisibility() RepairOrderNoteVisibility {
+	return s.Visibility
+}
+
+// GetAuthorID returns the value of AuthorID.
+func (s *RepairOrderNote) GetAuthorID() uuid.UUID {
+	return s.AuthorID
+}
+
+// GetAuthorUsername returns the value of AuthorUsername.
+func (s *RepairOrderNote) GetAuthorUsername() string {
+	return s.AuthorUsername
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *RepairOrderNote) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// GetEditTime returns the value of EditTime.
+func (s *RepairOrderNote) GetEditTime() OptDateTime {
+	return s.EditTime
+}
+
+// SetID sets the value of ID.
+func (s *RepairOrderNote) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetBody sets the value of Body.
+func (s *RepairOrderNote) SetBody(val string) {
+	s.Body = val
+}
+
+// SetVisibility sets the value of Visibility.
+func (s *RepairOrderNote) SetVisibility(val RepairOrderNoteVisibility) {
+	s.Visibility = val
+}
+
+// SetAuthorID sets the value of AuthorID.
+func (s *RepairOrderNote) SetAuthorID(val uuid.UUID) {
+	s.AuthorID = val
+}
+
+// SetAuthorUsername sets the value of AuthorUsername.
+func (s *RepairOrderNote) SetAuthorUsername(val string) {
+	s.AuthorUsername = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *RepairOrderNote) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// SetEditTime sets the value of EditTime.
+func (s *RepairOrderNote) SetEditTime(val OptDateTime) {
+	s.EditTime = val
+}
+
+type RepairOrderNoteList struct {
+	Items []RepairOrderNote `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *RepairOrderNoteList) GetItems() []RepairOrderNote {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *RepairOrderNoteList) SetItems(val []RepairOrderNote) {
+	s.Items = val
+}
+
+// Customer-visible notes are also shown on the receipt and public order tracking.
+// Ref: #/components/schemas/RepairOrderNoteVisibility
+type RepairOrderNoteVisibility string
+
+const (
+	RepairOrderNoteVisibilityInternal RepairOrderNoteVisibility = "internal"
+	RepairOrderNoteVisibilityCustomer RepairOrderNoteVisibility = "customer"
+)
+
+// AllValues returns all RepairOrderNoteVisibility values.
+func (RepairOrderNoteVisibility) AllValues() []RepairOrderNoteVisibility {
+	return []RepairOrderNoteVisibility{
+		RepairOrderNoteVisibilityInternal,
+		RepairOrderNoteVisibilityCustomer,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s RepairOrderNoteVisibility) MarshalText() ([]byte, error) {
+	switch s {
+	case RepairOrderNoteVisibilityInternal:
+		return []byte(s), nil
+	case RepairOrderNoteVisibilityCustomer:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepairOrderNoteVisibility) UnmarshalText(data []byte) error {
+	switch RepairOrderNoteVisibility(data) {
+	case RepairOrderNoteVisibilityInternal:
+		*s = RepairOrderNoteVisibilityInternal
+		return nil
+	case RepairOrderNoteVisibilityCustomer:
+		*s = RepairOrderNoteVisibilityCustomer
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type RepairOrderPasscode struct {
+	IsPatternLocked bool   `json:"is_pattern_locked"`
+	Value           string `json:"value"`
+}
+
+// GetIsPatternLocked returns the value of IsPatternLocked.
+func (s *RepairOrderPasscode) GetIsPatternLocked() bool {
+	return s.IsPatternLocked
+}
+
+// GetValue returns the value of Value.
+func (s *RepairOrderPasscode) GetValue() string {
+	return s.Value
+}
+
+// SetIsPatternLocked sets the value of IsPatternLocked.
+func (s *RepairOrderPasscode) SetIsPatternLocked(val bool) {
+	s.IsPatternLocked = val
+}
+
+// SetValue sets the value of Value.
+func (s *RepairOrderPasscode) SetValue(val string) {
+	s.Value = val
+}
+
+// Ref: #/components/schemas/RepairOrderPayment
+type RepairOrderPayment struct {
+	ID     uuid.UUID              `json:"id"`
+	Type   RepairOrderPaymentType `json:"type"`
+	Amount int                    `json:"amount"`
+	Method uuid.UUID              `json:"method"`
+	// Only set for refunds.
+	Reason       OptString `json:"reason"`
+	CreationTime time.Time `json:"creation_time"`
+}
+
+// GetID returns the value of ID.
+func (s *RepairOrderPayment) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetType returns the value of Type.
+func (s *RepairOrderPayment) GetType() RepairOrderPaymentType {
+	return s.Type
+}
+
+// GetAmount returns the value of Amount.
+func (s *RepairOrderPayment) GetAmount() int {
+	return s.Amount
+}
+
+// GetMethod returns the value of Method.
+func (s *RepairOrderPayment) GetMethod() uuid.UUID {
+	return s.Method
+}
+
+// GetReason returns the value of Reason.
+func (s *RepairOrderPayment) GetReason() OptString {
+	return s.Reason
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *RepairOrderPayment) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// SetID sets the value of ID.
+func (s *RepairOrderPayment) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetType sets the value of Type.
+func (s *RepairOrderPayment) SetType(val RepairOrderPaymentType) {
+	s.Type = val
+}
+
+// SetAmount sets the value of Amount.
+func (s *RepairOrderPayment) SetAmount(val int) {
+	s.Amount = val
+}
+
+// SetMethod sets the value of Method.
+func (s *RepairOrderPayment) SetMethod(val uuid.UUID) {
+	s.Method = val
+}
+
+// SetReason sets the value of Reason.
+func (s *RepairOrderPayment) SetReason(val OptString) {
+	s.Reason = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *RepairOrderPayment) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+type RepairOrderPaymentList struct {
+	Items             []RepairOrderPayment `json:"items"`
+	TotalCost         int                  `json:"total_cost"`
+	PaidAmount        int                  `json:"paid_amount"`
+	OutstandingAmount int                  `json:"outstanding_amount"`
+}
+
+// GetItems returns the value of Items.
+func (s *RepairOrderPaymentList) GetItems() []RepairOrderPayment {
+	return s.Items
 }
 
-// GetLocation returns the value of Location.
-func (s *CreatePhoneEquipmentCreated) GetLocation() url.URL {
-	return s.Location
+// GetTotalCost returns the value of TotalCost.
+func (s *RepairOrderPaymentList) GetTotalCost() int {
+	return s.TotalCost
 }
 
-// SetLocation sets the value of Location.
-func (s *CreatePhoneEquipmentCreated) SetLocation(val url.URL) {
-	s.Location = val
+// GetPaidAmount returns the value of PaidAmount.
+func (s *RepairOrderPaymentList) GetPaidAmount() int {
+	return s.PaidAmount
 }
 
-type CreatePhoneEquipmentRequest struct {
-	Name string `json:"name"`
+// GetOutstandingAmount returns the value of OutstandingAmount.
+func (s *RepairOrderPaymentList) GetOutstandingAmount() int {
+	return s.OutstandingAmount
 }
 
-// GetName returns the value of Name.
-func (s *CreatePhoneEquipmentRequest) GetName() string {
-	return s.Name
+// SetItems sets the value of Items.
+func (s *RepairOrderPaymentList) SetItems(val []RepairOrderPayment) {
+	s.Items = val
 }
 
-// SetName sets the value of Name.
-func (s *CreatePhoneEquipmentRequest) SetName(val string) {
-	s.Name = val
+// SetTotalCost sets the value of TotalCost.
+func (s *RepairOrderPaymentList) SetTotalCost(val int) {
+	s.TotalCost = val
 }
 
-// CreateRepairOrderCreated is response for CreateRepairOrder operation.
-type CreateRepairOrderCreated struct {
-	Location url.URL
+// SetPaidAmount sets the value of PaidAmount.
+func (s *RepairOrderPaymentList) SetPaidAmount(val int) {
+	s.PaidAmount = val
 }
 
-// GetLocation returns the value of Location.
-func (s *CreateRepairOrderCreated) GetLocation() url.URL {
-	return s.Location
+// SetOutstandingAmount sets the value of OutstandingAmount.
+func (s *RepairOrderPaymentList) SetOutstandingAmount(val int) {
+	s.OutstandingAmount = val
 }
 
-// SetLocation sets the value of Location.
-func (s *CreateRepairOrderCreated) SetLocation(val url.URL) {
-	s.Location = val
+type RepairOrderPaymentType string
+
+const (
+	RepairOrderPaymentTypeDownPayment RepairOrderPaymentType = "down_payment"
+	RepairOrderPaymentTypePartial     RepairOrderPaymentType = "partial"
+	RepairOrderPaymentTypeFinal       RepairOrderPaymentType = "final"
+	RepairOrderPaymentTypeRefund      RepairOrderPaymentType = "refund"
+)
+
+// AllValues returns all RepairOrderPaymentType values.
+func (RepairOrderPaymentType) AllValues() []RepairOrderPaymentType {
+	return []RepairOrderPaymentType{
+		RepairOrderPaymentTypeDownPayment,
+		RepairOrderPaymentTypePartial,
+		RepairOrderPaymentTypeFinal,
+		RepairOrderPaymentTypeRefund,
+	}
 }
 
-type CreateRepairOrderRequest struct {
-	CustomerName       string                                 `json:"customer_name"`
-	ContactPhoneNumber string                                 `json:"contact_phone_number"`
-	PhoneType          string                                 `json:"phone_type"`
-	Imei               OptString                              `json:"imei"`
-	PartsNotCheckedYet OptString                              `json:"parts_not_checked_yet"`
-	Passcode           OptCreateRepairOrderRequestPasscode    `json:"passcode"`
-	Color              string                                 `json:"color"`
-	InitialCost        int                                    `json:"initial_cost"`
-	DownPayment        OptCreateRepairOrderRequestDownPayment `json:"down_payment"`
-	SalesPersonID      uuid.UUID                              `json:"sales_person_id"`
-	TechnicianID       uuid.UUID                              `json:"technician_id"`
-	PhoneConditions    []uuid.UUID                            `json:"phone_conditions"`
-	DamageTypes        []uuid.UUID                            `json:"damage_types"`
-	PhoneEquipments    []uuid.UUID                            `json:"phone_equipments"`
-	Photos             []url.URL                              `json:"photos"`
+// MarshalText implements encoding.TextMarshaler.
+func (s RepairOrderPaymentType) MarshalText() ([]byte, error) {
+	switch s {
+	case RepairOrderPaymentTypeDownPayment:
+		return []byte(s), nil
+	case RepairOrderPaymentTypePartial:
+		return []byte(s), nil
+	case RepairOrderPaymentTypeFinal:
+		return []byte(s), nil
+	case RepairOrderPaymentTypeRefund:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetCustomerName returns the value of CustomerName.
-func (s *CreateRepairOrderRequest) GetCustomerName() string {
-	return s.CustomerName
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepairOrderPaymentType) UnmarshalText(data []byte) error {
+	switch RepairOrderPaymentType(data) {
+	case RepairOrderPaymentTypeDownPayment:
+		*s = RepairOrderPaymentTypeDownPayment
+		return nil
+	case RepairOrderPaymentTypePartial:
+		*s = RepairOrderPaymentTypePartial
+		return nil
+	case RepairOrderPaymentTypeFinal:
+		*s = RepairOrderPaymentTypeFinal
+		return nil
+	case RepairOrderPaymentTypeRefund:
+		*s = RepairOrderPaymentTypeRefund
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetContactPhoneNumber returns the value of ContactPhoneNumber.
-func (s *CreateRepairOrderRequest) GetContactPhoneNumber() string {
-	return s.ContactPhoneNumber
+type RepairOrderPhoneConditionsItem struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 }
 
-// GetPhoneType returns the value of PhoneType.
-func (s *CreateRepairOrderRequest) GetPhoneType() string {
-	return s.PhoneType
+// GetID returns the value of ID.
+func (s *RepairOrderPhoneConditionsItem) GetID() uuid.UUID {
+	return s.ID
 }
 
-// GetImei returns the value of Imei.
-func (s *CreateRepairOrderRequest) GetImei() OptString {
-	return s.Imei
+// GetName returns the value of Name.
+func (s *RepairOrderPhoneConditionsItem) GetName() string {
+	return s.Name
 }
 
-// GetPartsNotCheckedYet returns the value of PartsNotCheckedYet.
-func (s *CreateRepairOrderRequest) GetPartsNotCheckedYet() OptString {
-	return s.PartsNotCheckedYet
+// SetID sets the value of ID.
+func (s *RepairOrderPhoneConditionsItem) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// GetPasscode returns the value of Passcode.
-func (s *CreateRepairOrderRequest) GetPasscode() OptCreateRepairOrderRequestPasscode {
-	return s.Passcode
+// SetName sets the value of Name.
+func (s *RepairOrderPhoneConditionsItem) SetName(val string) {
+	s.Name = val
 }
 
-// GetColor returns the value of Color.
-func (s *CreateRepairOrderRequest) GetColor() string {
-	return s.Color
+type RepairOrderPhoneEquipmentsItem struct {
+	ID   uuid.UUID `json:"id"`
+	Name string    `json:"name"`
 }
 
-// GetInitialCost returns the value of InitialCost.
-func (s *CreateRepairOrderRequest) GetInitialCost() int {
-	return s.InitialCost
+// GetID returns the value of ID.
+func (s *RepairOrderPhoneEquipmentsItem) GetID() uuid.UUID {
+	return s.ID
 }
 
-// GetDownPayment returns the value of DownPayment.
-func (s *CreateRepairOrderRequest) GetDownPayment() OptCreateRepairOrderRequestDownPayment {
-	return s.DownPayment
+// GetName returns the value of Name.
+func (s *RepairOrderPhoneEquipmentsItem) GetName() string {
+	return s.Name
 }
 
-// GetSalesPersonID returns the value of SalesPersonID.
-func (s *CreateRepairOrderRequest) GetSalesPersonID() uuid.UUID {
-	return s.SalesPersonID
+// SetID sets the value of ID.
+func (s *RepairOrderPhoneEquipmentsItem) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// GetTechnicianID returns the value of TechnicianID.
-func (s *CreateRepairOrderRequest) GetTechnicianID() uuid.UUID {
-	return s.TechnicianID
+// SetName sets the value of Name.
+func (s *RepairOrderPhoneEquipmentsItem) SetName(val string) {
+	s.Name = val
 }
 
-// GetPhoneConditions returns the value of PhoneConditions.
-func (s *CreateRepairOrderRequest) GetPhoneConditions() []uuid.UUID {
-	return s.PhoneConditions
+// Point in the repair the photo was taken at. Photos taken when the order is created are intake
+// photos.
+// Ref: #/components/schemas/RepairOrderPhotoStage
+type RepairOrderPhotoStage string
+
+const (
+	RepairOrderPhotoStageIntake    RepairOrderPhotoStage = "intake"
+	RepairOrderPhotoStageDiagnosis RepairOrderPhotoStage = "diagnosis"
+	RepairOrderPhotoStageRepair    RepairOrderPhotoStage = "repair"
+	RepairOrderPhotoStageCompleted RepairOrderPhotoStage = "completed"
+)
+
+// AllValues returns all RepairOrderPhotoStage values.
+func (RepairOrderPhotoStage) AllValues() []RepairOrderPhotoStage {
+	return []RepairOrderPhotoStage{
+		RepairOrderPhotoStageIntake,
+		RepairOrderPhotoStageDiagnosis,
+		RepairOrderPhotoStageRepair,
+		RepairOrderPhotoStageCompleted,
+	}
 }
 
-// GetDamageTypes returns the value of DamageTypes.
-func (s *CreateRepairOrderRequest) GetDamageTypes() []uuid.UUID {
-	return s.DamageTypes
+// MarshalText implements encoding.TextMarshaler.
+func (s RepairOrderPhotoStage) MarshalText() ([]byte, error) {
+	switch s {
+	case RepairOrderPhotoStageIntake:
+		return []byte(s), nil
+	case RepairOrderPhotoStageDiagnosis:
+		return []byte(s), nil
+	case RepairOrderPhotoStageRepair:
+		return []byte(s), nil
+	case RepairOrderPhotoStageCompleted:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-// GetPhoneEquipments returns the value of PhoneEquipments.
-func (s *CreateRepairOrderRequest) GetPhoneEquipments() []uuid.UUID {
-	return s.PhoneEquipments
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepairOrderPhotoStage) UnmarshalText(data []byte) error {
+	switch RepairOrderPhotoStage(data) {
+	case RepairOrderPhotoStageIntake:
+		*s = RepairOrderPhotoStageIntake
+		return nil
+	case RepairOrderPhotoStageDiagnosis:
+		*s = RepairOrderPhotoStageDiagnosis
+		return nil
+	case RepairOrderPhotoStageRepair:
+		*s = RepairOrderPhotoStageRepair
+		return nil
+	case RepairOrderPhotoStageCompleted:
+		*s = RepairOrderPhotoStageCompleted
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetPhotos returns the value of Photos.
-func (s *CreateRepairOrderRequest) GetPhotos() []url.URL {
-	return s.Photos
+type RepairOrderPhotosItem struct {
+	ID           uuid.UUID             `json:"id"`
+	PhotoID      uuid.UUID             `json:"photo_id"`
+	Stage        RepairOrderPhotoStage `json:"stage"`
+	Caption      OptString             `json:"caption"`
+	CreationTime time.Time             `json:"creation_time"`
 }
 
-// SetCustomerName sets the value of CustomerName.
-func (s *CreateRepairOrderRequest) SetCustomerName(val string) {
-	s.CustomerName = val
+// GetID returns the value of ID.
+func (s *RepairOrderPhotosItem) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetContactPhoneNumber sets the value of ContactPhoneNumber.
-func (s *CreateRepairOrderRequest) SetContactPhoneNumber(val string) {
-	s.ContactPhoneNumber = val
+// GetPhotoID returns the value of PhotoID.
+func (s *RepairOrderPhotosItem) GetPhotoID() uuid.UUID {
+	return s.PhotoID
 }
 
-// SetPhoneType sets the value of PhoneType.
-func (s *CreateRepairOrderRequest) SetPhoneType(val string) {
-	s.PhoneType = val
+// GetStage returns the value of Stage.
+func (s *RepairOrderPhotosItem) GetStage() RepairOrderPhotoStage {
+	return s.Stage
 }
 
-// SetImei sets the value of Imei.
-func (s *CreateRepairOrderRequest) SetImei(val OptString) {
-	s.Imei = val
+// GetCaption returns the value of Caption.
+func (s *RepairOrderPhotosItem) GetCaption() OptString {
+	return s.Caption
 }
 
-// SetPartsNotCheckedYet sets the value of PartsNotCheckedYet.
-func (s *CreateRepairOrderRequest) SetPartsNotCheckedYet(val OptString) {
-	s.PartsNotCheckedYet = val
+// GetCreationTime returns the value of CreationTime.
+func (s *RepairOrderPhotosItem) GetCreationTime() time.Time {
+	return s.CreationTime
 }
 
-// SetPasscode sets the value of Passcode.
-func (s *CreateRepairOrderRequest) SetPasscode(val OptCreateRepairOrderRequestPasscode) {
-	s.Passcode = val
+// SetID sets the value of ID.
+func (s *RepairOrderPhotosItem) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// SetColor sets the value of Color.
-func (s *CreateRepairOrderRequest) SetColor(val string) {
-	s.Color = val
+// SetPhotoID sets the value of PhotoID.
+func (s *RepairOrderPhotosItem) SetPhotoID(val uuid.UUID) {
+	s.PhotoID = val
 }
 
-// SetInitialCost sets the value of InitialCost.
-func (s *CreateRepairOrderRequest) SetInitialCost(val int) {
-	s.InitialCost = val
+// SetStage sets the value of Stage.
+func (s *RepairOrderPhotosItem) SetStage(val RepairOrderPhotoStage) {
+	s.Stage = val
 }
 
-// SetDownPayment sets the value of DownPayment.
-func (s *CreateRepairOrderRequest) SetDownPayment(val OptCreateRepairOrderRequestDownPayment) {
-	s.DownPayment = val
+// SetCaption sets the value of Caption.
+func (s *RepairOrderPhotosItem) SetCaption(val OptString) {
+	s.Caption = val
 }
 
-// SetSalesPersonID sets the value of SalesPersonID.
-func (s *CreateRepairOrderRequest) SetSalesPersonID(val uuid.UUID) {
-	s.SalesPersonID = val
+// SetCreationTime sets the value of CreationTime.
+func (s *RepairOrderPhotosItem) SetCreationTime(val time.Time) {
+	s.CreationTime = val
 }
 
-// SetTechnicianID sets the value of TechnicianID.
-func (s *CreateRepairOrderRequest) SetTechnicianID(val uuid.UUID) {
-	s.TechnicianID = val
+// Ref: #/components/schemas/RepairOrderSummary
+type RepairOrderSummary struct {
+	ID                 uuid.UUID                `json:"id"`
+	Slug               string                   `json:"slug"`
+	Status             RepairOrderSummaryStatus `json:"status"`
+	CreationTime       time.Time                `json:"creation_time"`
+	CustomerName       string                   `json:"customer_name"`
+	ContactPhoneNumber string                   `json:"contact_phone_number"`
+	PhoneType          string                   `json:"phone_type"`
+	Color              string                   `json:"color"`
+	Imei               OptString                `json:"imei"`
+	TechnicianID       uuid.UUID                `json:"technician_id"`
+	SalesPersonID      uuid.UUID                `json:"sales_person_id"`
 }
 
-// SetPhoneConditions sets the value of PhoneConditions.
-func (s *CreateRepairOrderRequest) SetPhoneConditions(val []uuid.UUID) {
-	s.PhoneConditions = val
+// GetID returns the value of ID.
+func (s *RepairOrderSummary) GetID() uuid.UUID {
+	return s.ID
 }
 
-// SetDamageTypes sets the value of DamageTypes.
-func (s *CreateRepairOrderRequest) SetDamageTypes(val []uuid.UUID) {
-	s.DamageTypes = val
+// GetSlug returns the value of Slug.
+func (s *RepairOrderSummary) GetSlug() string {
+	return s.Slug
 }
 
-// SetPhoneEquipments sets the value of PhoneEquipments.
-func (s *CreateRepairOrderRequest) SetPhoneEquipments(val []uuid.UUID) {
-	s.PhoneEquipments = val
+// GetStatus returns the value of Status.
+func (s *RepairOrderSummary) GetStatus() RepairOrderSummaryStatus {
+	return s.Status
 }
 
-// SetPhotos sets the value of Photos.
-func (s *CreateRepairOrderRequest) SetPhotos(val []url.URL) {
-	s.Photos = val
+// GetCreationTime returns the value of CreationTime.
+func (s *RepairOrderSummary) GetCreationTime() time.Time {
+	return s.CreationTime
 }
 
-type CreateRepairOrderRequestDownPayment struct {
-	Amount int       `json:"amount"`
-	Method uuid.UUID `json:"method"`
+// GetCustomerName returns the value of CustomerName.
+func (s *RepairOrderSummary) GetCustomerName() string {
+	return s.CustomerName
 }
 
-// GetAmount returns the value of Amount.
-func (s *CreateRepairOrderRequestDownPayment) GetAmount() int {
-	return s.Amount
+// GetContactPhoneNumber returns the value of ContactPhoneNumber.
+func (s *RepairOrderSummary) GetContactPhoneNumber() string {
+	return s.ContactPhoneNumber
 }
 
-// GetMethod returns the value of Method.
-func (s *CreateRepairOrderRequestDownPayment) GetMethod() uuid.UUID {
-	return s.Method
+// GetPhoneType returns the value of PhoneType.
+func (s *RepairOrderSummary) GetPhoneType() string {
+	return s.PhoneType
 }
 
-// SetAmount sets the value of Amount.
-func (s *CreateRepairOrderRequestDownPayment) SetAmount(val int) {
-	s.Amount = val
+// GetColor returns the value of Color.
+func (s *RepairOrderSummary) GetColor() string {
+	return s.Color
 }
 
-// SetMethod sets the value of Method.
-func (s *CreateRepairOrderRequestDownPayment) SetMethod(val uuid.UUID) {
-	s.Method = val
+// GetImei returns the value of Imei.
+func (s *RepairOrderSummary) GetImei() OptString {
+	return s.Imei
 }
 
-type CreateRepairOrderRequestPasscode struct {
-	IsPatternLocked bool   `json:"is_pattern_locked"`
-	Value           string `json:"value"`
+// GetTechnicianID returns the value of TechnicianID.
+func (s *RepairOrderSummary) GetTechnicianID() uuid.UUID {
+	return s.TechnicianID
 }
 
-// GetIsPatternLocked returns the value of IsPatternLocked.
-func (s *CreateRepairOrderRequestPasscode) GetIsPatternLocked() bool {
-	return s.IsPatternLocked
+// GetSalesPersonID returns the value of SalesPersonID.
+func (s *RepairOrderSummary) GetSalesPersonID() uuid.UUID {
+	return s.SalesPersonID
 }
 
-// GetValue returns the value of Value.
-func (s *CreateRepairOrderRequestPasscode) GetValue() string {
-	return s.Value
+// SetID sets the value of ID.
+func (s *RepairOrderSummary) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// SetIsPatternLocked sets the value of IsPatternLocked.
-func (s *CreateRepairOrderRequestPasscode) SetIsPatternLocked(val bool) {
-	s.IsPatternLocked = val
+// SetSlug sets the value of Slug.
+func (s *RepairOrderSummary) SetSlug(val string) {
+	s.Slug = val
 }
 
-// SetValue sets the value of Value.
-func (s *CreateRepairOrderRequestPasscode) SetValue(val string) {
-	s.Value = val
+// SetStatus sets the value of Status.
+func (s *RepairOrderSummary) SetStatus(val RepairOrderSummaryStatus) {
+	s.Status = val
 }
 
-// CreateRoleCreated is response for CreateRole operation.
-type CreateRoleCreated struct {
-	Location url.URL
+// SetCreationTime sets the value of CreationTime.
+func (s *RepairOrderSummary) SetCreationTime(val time.Time) {
+	s.CreationTime = val
 }
 
-// GetLocation returns the value of Location.
-func (s *CreateRoleCreated) GetLocation() url.URL {
-	return s.Location
+// SetCustomerName sets the value of CustomerName.
+func (s *RepairOrderSummary) SetCustomerName(val string) {
+	s.CustomerName = val
 }
 
-// SetLocation sets the value of Location.
-func (s *CreateRoleCreated) SetLocation(val url.URL) {
-	s.Location = val
+// SetContactPhoneNumber sets the value of ContactPhoneNumber.
+func (s *RepairOrderSummary) SetContactPhoneNumber(val string) {
+	s.ContactPhoneNumber = val
 }
 
-type CreateRoleRequest struct {
-	Name         string `json:"name"`
-	IsStoreAdmin bool   `json:"is_store_admin"`
+// SetPhoneType sets the value of PhoneType.
+func (s *RepairOrderSummary) SetPhoneType(val string) {
+	s.PhoneType = val
 }
 
-// GetName returns the value of Name.
-func (s *CreateRoleRequest) GetName() string {
-	return s.Name
+// SetColor sets the value of Color.
+func (s *RepairOrderSummary) SetColor(val string) {
+	s.Color = val
 }
 
-// GetIsStoreAdmin returns the value of IsStoreAdmin.
-func (s *CreateRoleRequest) GetIsStoreAdmin() bool {
-	return s.IsStoreAdmin
+// SetImei sets the value of Imei.
+func (s *RepairOrderSummary) SetImei(val OptString) {
+	s.Imei = val
 }
 
-// SetName sets the value of Name.
-func (s *CreateRoleRequest) SetName(val string) {
-	s.Name = val
+// SetTechnicianID sets the value of TechnicianID.
+func (s *RepairOrderSummary) SetTechnicianID(val uuid.UUID) {
+	s.TechnicianID = val
 }
 
-// SetIsStoreAdmin sets the value of IsStoreAdmin.
-func (s *CreateRoleRequest) SetIsStoreAdmin(val bool) {
-	s.IsStoreAdmin = val
+// SetSalesPersonID sets the value of SalesPersonID.
+func (s *RepairOrderSummary) SetSalesPersonID(val uuid.UUID) {
+	s.SalesPersonID = val
 }
 
-// CreateSalesPersonCreated is response for CreateSalesPerson operation.
-type CreateSalesPersonCreated struct {
-	Location url.URL
-}
+type RepairOrderSummaryStatus string
 
-// GetLocation returns the value of Location.
-func (s *CreateSalesPersonCreated) GetLocation() url.URL {
-	return s.Location
+const (
+	RepairOrderSummaryStatusOpen      RepairOrderSummaryStatus = "open"
+	RepairOrderSummaryStatusConfirmed RepairOrderSummaryStatus = "confirmed"
+	RepairOrderSummaryStatusCompleted RepairOrderSummaryStatus = "completed"
+	RepairOrderSummaryStatusPickedUp  RepairOrderSummaryStatus = "picked_up"
+	RepairOrderSummaryStatusCancelled RepairOrderSummaryStatus = "cancelled"
+)
+
+// AllValues returns all RepairOrderSummaryStatus values.
+func (RepairOrderSummaryStatus) AllValues() []RepairOrderSummaryStatus {
+	return []RepairOrderSummaryStatus{
+		RepairOrderSummaryStatusOpen,
+		RepairOrderSummaryStatusConfirmed,
+		RepairOrderSummaryStatusCompleted,
+		RepairOrderSummaryStatusPickedUp,
+		RepairOrderSummaryStatusCancelled,
+	}
 }
 
-// SetLocation sets the value of Location.
-func (s *CreateSalesPersonCreated) SetLocation(val url.URL) {
-	s.Location = val
+// MarshalText implements encoding.TextMarshaler.
+func (s RepairOrderSummaryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case RepairOrderSummaryStatusOpen:
+		return []byte(s), nil
+	case RepairOrderSummaryStatusConfirmed:
+		return []byte(s), nil
+	case RepairOrderSummaryStatusCompleted:
+		return []byte(s), nil
+	case RepairOrderSummaryStatusPickedUp:
+		return []byte(s), nil
+	case RepairOrderSummaryStatusCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
 }
 
-type CreateSalesPersonRequest struct {
-	Name string `json:"name"`
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *RepairOrderSummaryStatus) UnmarshalText(data []byte) error {
+	switch RepairOrderSummaryStatus(data) {
+	case RepairOrderSummaryStatusOpen:
+		*s = RepairOrderSummaryStatusOpen
+		return nil
+	case RepairOrderSummaryStatusConfirmed:
+		*s = RepairOrderSummaryStatusConfirmed
+		return nil
+	case RepairOrderSummaryStatusCompleted:
+		*s = RepairOrderSummaryStatusCompleted
+		return nil
+	case RepairOrderSummaryStatusPickedUp:
+		*s = RepairOrderSummaryStatusPickedUp
+		return nil
+	case RepairOrderSummaryStatusCancelled:
+		*s = RepairOrderSummaryStatusCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
 }
 
-// GetName returns the value of Name.
-func (s *CreateSalesPersonRequest) GetName() string {
-	return s.Name
+type RepairOrderTechnicianAssignmentsItem struct {
+	ID                   uuid.UUID `json:"id"`
+	PreviousTechnicianID uuid.UUID `json:"previous_technician_id"`
+	TechnicianID         uuid.UUID `json:"technician_id"`
+	Reason               string    `json:"reason"`
+	CreationTime         time.Time `json:"creation_time"`
 }
 
-// SetName sets the value of Name.
-func (s *CreateSalesPersonRequest) SetName(val string) {
-	s.Name = val
+// GetID returns the value of ID.
+func (s *RepairOrderTechnicianAssignmentsItem) GetID() uuid.UUID {
+	return s.ID
 }
 
-// CreateTechnicianCreated is response for CreateTechnician operation.
-type CreateTechnicianCreated struct {
-	Location url.URL
+// GetPreviousTechnicianID returns the value of PreviousTechnicianID.
+func (s *RepairOrderTechnicianAssignmentsItem) GetPreviousTechnicianID() uuid.UUID {
+	return s.PreviousTechnicianID
 }
 
-// GetLocation returns the value of Location.
-func (s *CreateTechnicianCreated) GetLocation() url.URL {
-	return s.Location
+// GetTechnicianID returns the value of TechnicianID.
+func (s *RepairOrderTechnicianAssignmentsItem) GetTechnicianID() uuid.UUID {
+	return s.TechnicianID
 }
 
-// SetLocation sets the value of Location.
-func (s *CreateTechnicianCreated) SetLocation(val url.URL) {
-	s.Location = val
+// GetReason returns the value of Reason.
+func (s *RepairOrderTechnicianAssignmentsItem) GetReason() string {
+	return s.Reason
 }
 
-type CreateTechnicianRequest struct {
-	Name string `json:"name"`
+// GetCreationTime returns the value of CreationTime.
+func (s *RepairOrderTechnicianAssignmentsItem) GetCreationTime() time.Time {
+	return s.CreationTime
 }
 
-// GetName returns the value of Name.
-func (s *CreateTechnicianRequest) GetName() string {
-	return s.Name
+// SetID sets the value of ID.
+func (s *RepairOrderTechnicianAssignmentsItem) SetID(val uuid.UUID) {
+	s.ID = val
 }
 
-// SetName sets the value of Name.
-func (s *CreateTechnicianRequest) SetName(val string) {
-	s.Name = val
+// SetPreviousTechnicianID sets the value of PreviousTechnicianID.
+func (s *RepairOrderTechnicianAssignmentsItem) SetPreviousTechnicianID(val uuid.UUID) {
+	s.PreviousTechnicianID = val
 }
 
-type Error struct {
-	Message string `json:"message"`
+// SetTechnicianID sets the value of TechnicianID.
+func (s *RepairOrderTechnicianAssignmentsItem) SetTechnicianID(val uuid.UUID) {
+	s.TechnicianID = val
 }
 
-// GetMessage returns the value of Message.
-func (s *Error) GetMessage() string {
-	return s.Message
+// SetReason sets the value of Reason.
+func (s *RepairOrderTechnicianAssignmentsItem) SetReason(val string) {
+	s.Reason = val
 }
 
-// SetMessage sets the value of Message.
-func (s *Error) SetMessage(val string) {
-	s.Message = val
+// SetCreationTime sets the value of CreationTime.
+func (s *RepairOrderTechnicianAssignmentsItem) SetCreationTime(val time.Time) {
+	s.CreationTime = val
 }
 
-// ErrorStatusCode wraps Error with StatusCode.
-type ErrorStatusCode struct {
-	StatusCode int
-	Response   Error
+// Difference between the total cost and the paid amount that was settled at pick-up.
+type RepairOrderWriteOff struct {
+	// Negative when the customer paid more than the total cost.
+	Amount int    `json:"amount"`
+	Reason string `json:"reason"`
 }
 
-// GetStatusCode returns the value of StatusCode.
-func (s *ErrorStatusCode) GetStatusCode() int {
-	return s.StatusCode
+// GetAmount returns the value of Amount.
+func (s *RepairOrderWriteOff) GetAmount() int {
+	return s.Amount
 }
 
-// GetResponse returns the value of Response.
-func (s *ErrorStatusCode) GetResponse() Error {
-	return s.Response
+// GetReason returns the value of Reason.
+func (s *RepairOrderWriteOff) GetReason() string {
+	return s.Reason
 }
 
-// SetStatusCode sets the value of StatusCode.
-func (s *ErrorStatusCode) SetStatusCode(val int) {
-	s.StatusCode = val
+// SetAmount sets the value of Amount.
+func (s *RepairOrderWriteOff) SetAmount(val int) {
+	s.Amount = val
 }
 
-// SetResponse sets the value of Response.
-func (s *ErrorStatusCode) SetResponse(val Error) {
-	s.Response = val
+// SetReason sets the value of Reason.
+func (s *RepairOrderWriteOff) SetReason(val string) {
+	s.Reason = val
 }
 
-// GetHealthNoContent is response for GetHealth operation.
-type GetHealthNoContent struct{}
+// RevokeLoginCodeNoContent is response for RevokeLoginCode operation.
+type RevokeLoginCodeNoContent struct{}
 
-type LoginCodePrompt struct {
-	LoginCode string `json:"login_code"`
+// RevokeMyOtherSessionsNoContent is response for RevokeMyOtherSessions operation.
+type RevokeMyOtherSessionsNoContent struct{}
+
+// RevokeMySessionNoContent is response for RevokeMySession operation.
+type RevokeMySessionNoContent struct{}
+
+// RevokeUserSessionsNoContent is response for RevokeUserSessions operation.
+type RevokeUserSessionsNoContent struct{}
+
+type SessionCookie struct {
+	APIKey string
 }
 
-// GetLoginCode returns the value of LoginCode.
-func (s *LoginCodePrompt) GetLoginCode() string {
-	return s.LoginCode
+// GetAPIKey returns the value of APIKey.
+func (s *SessionCookie) GetAPIKey() string {
+	return s.APIKey
 }
 
-// SetLoginCode sets the value of LoginCode.
-func (s *LoginCodePrompt) SetLoginCode(val string) {
-	s.LoginCode = val
+// SetAPIKey sets the value of APIKey.
+func (s *SessionCookie) SetAPIKey(val string) {
+	s.APIKey = val
 }
 
-// LoginCodePromptNoContent is response for LoginCodePrompt operation.
-type LoginCodePromptNoContent struct{}
+type TOTPEnrollment struct {
+	// Base32 secret for authenticator apps that can't scan QR codes.
+	Secret string `json:"secret"`
+	// `otpauth://` URI to show as a QR code for authenticator apps to scan.
+	ProvisioningURI string `json:"provisioning_uri"`
+}
 
-type LoginCredentials struct {
-	Username  string `json:"username"`
-	Password  string `json:"password"`
-	StoreCode string `json:"store_code"`
+// GetSecret returns the value of Secret.
+func (s *TOTPEnrollment) GetSecret() string {
+	return s.Secret
 }
 
-// GetUsername returns the value of Username.
-func (s *LoginCredentials) GetUsername() string {
-	return s.Username
+// GetProvisioningURI returns the value of ProvisioningURI.
+func (s *TOTPEnrollment) GetProvisioningURI() string {
+	return s.ProvisioningURI
 }
 
-// GetPassword returns the value of Password.
-func (s *LoginCredentials) GetPassword() string {
-	return s.Password
+// SetSecret sets the value of Secret.
+func (s *TOTPEnrollment) SetSecret(val string) {
+	s.Secret = val
 }
 
-// GetStoreCode returns the value of StoreCode.
-func (s *LoginCredentials) GetStoreCode() string {
-	return s.StoreCode
+// SetProvisioningURI sets the value of ProvisioningURI.
+func (s *TOTPEnrollment) SetProvisioningURI(val string) {
+	s.ProvisioningURI = val
 }
 
-// SetUsername sets the value of Username.
-func (s *LoginCredentials) SetUsername(val string) {
-	s.Username = val
+type TOTPRecoveryCodes struct {
+	// Single-use codes to log in with when the authenticator isn't available. They can't be retrieved
+	// again.
+	RecoveryCodes []string `json:"recovery_codes"`
 }
 
-// SetPassword sets the value of Password.
-func (s *LoginCredentials) SetPassword(val string) {
-	s.Password = val
+// GetRecoveryCodes returns the value of RecoveryCodes.
+func (s *TOTPRecoveryCodes) GetRecoveryCodes() []string {
+	return s.RecoveryCodes
 }
 
-// SetStoreCode sets the value of StoreCode.
-func (s *LoginCredentials) SetStoreCode(val string) {
-	s.StoreCode = val
+// SetRecoveryCodes sets the value of RecoveryCodes.
+func (s *TOTPRecoveryCodes) SetRecoveryCodes(val []string) {
+	s.RecoveryCodes = val
 }
 
-type LoginResponse struct {
-	// The type of user that logged in:
-	// * `admin` - Store admin. The session ID is returned in a cookie named
-	// `session_id`. You need to include this cookie in subsequent requests.
-	// * `employee` - Store employee. The user needs to log in with a login
-	// code given by the store admin. The login code prompt ID is returned in
-	// a cookie named `login_code_prompt_id`. You need to visit [/auth/login-code](#/auth/loginCodePrompt)
-	// with the login code to log in.
-	Type LoginResponseType `json:"type"`
+type TOTPStatus struct {
+	// Whether the user needs a TOTP code to log in.
+	Enabled bool `json:"enabled"`
+	// Number of recovery codes that haven't been used. Only set if TOTP is enabled.
+	RemainingRecoveryCodes OptInt `json:"remaining_recovery_codes"`
 }
 
-// GetType returns the value of Type.
-func (s *LoginResponse) GetType() LoginResponseType {
-	return s.Type
+// GetEnabled returns the value of Enabled.
+func (s *TOTPStatus) GetEnabled() bool {
+	return s.Enabled
 }
 
-// SetType sets the value of Type.
-func (s *LoginResponse) SetType(val LoginResponseType) {
-	s.Type = val
+// GetRemainingRecoveryCodes returns the value of RemainingRecoveryCodes.
+func (s *TOTPStatus) GetRemainingRecoveryCodes() OptInt {
+	return s.RemainingRecoveryCodes
 }
 
-// The type of user that logged in:
-// * `admin` - Store admin. The session ID is returned in a cookie named
-// `session_id`. You need to include this cookie in subsequent requests.
-// * `employee` - Store employee. The user needs to log in with a login
-// code given by the store admin. The login code prompt ID is returned in
-// a cookie named `login_code_prompt_id`. You need to visit [/auth/login-code](#/auth/loginCodePrompt)
-// with the login code to log in.
-type LoginResponseType string
+// SetEnabled sets the value of Enabled.
+func (s *TOTPStatus) SetEnabled(val bool) {
+	s.Enabled = val
+}
 
-const (
-	LoginResponseTypeAdmin    LoginResponseType = "admin"
-	LoginResponseTypeEmployee LoginResponseType = "employee"
-)
+// SetRemainingRecoveryCodes sets the value of RemainingRecoveryCodes.
+func (s *TOTPStatus) SetRemainingRecoveryCodes(val OptInt) {
+	s.RemainingRecoveryCodes = val
+}
 
-// AllValues returns all LoginResponseType values.
-func (LoginResponseType) AllValues() []LoginResponseType {
-	return []LoginResponseType{
-		LoginResponseTypeAdmin,
-		LoginResponseTypeEmployee,
-	}
+type TechnicianQueue struct {
+	// Open and confirmed repair orders of the technician, oldest first.
+	Items []RepairOrderSummary `json:"items"`
 }
 
-// MarshalText implements encoding.TextMarshaler.
-func (s LoginResponseType) MarshalText() ([]byte, error) {
-	switch s {
-	case LoginResponseTypeAdmin:
-		return []byte(s), nil
-	case LoginResponseTypeEmployee:
-		return []byte(s), nil
-	default:
-		return nil, errors.Errorf("invalid value: %q", s)
-	}
+// GetItems returns the value of Items.
+func (s *TechnicianQueue) GetItems() []RepairOrderSummary {
+	return s.Items
 }
 
-// UnmarshalText implements encoding.TextUnmarshaler.
-func (s *LoginResponseType) UnmarshalText(data []byte) error {
-	switch LoginResponseType(data) {
-	case LoginResponseTypeAdmin:
-		*s = LoginResponseTypeAdmin
-		return nil
-	case LoginResponseTypeEmployee:
-		*s = LoginResponseTypeEmployee
-		return nil
-	default:
-		return errors.Errorf("invalid value: %q", data)
-	}
+// SetItems sets the value of Items.
+func (s *TechnicianQueue) SetItems(val []RepairOrderSummary) {
+	s.Items = val
 }
 
-// LogoutResetContent is response for Logout operation.
-type LogoutResetContent struct{}
+type TechnicianWorkload struct {
+	Items []TechnicianWorkloadItem `json:"items"`
+}
 
-// NewOptCreateRepairOrderRequestDownPayment returns new OptCreateRepairOrderRequestDownPayment with value set to v.
-func NewOptCreateRepairOrderRequestDownPayment(v CreateRepairOrderRequestDownPayment) OptCreateRepairOrderRequestDownPayment {
-	return OptCreateRepairOrderRequestDownPayment{
-		Value: v,
-		Set:   true,
-	}
+// GetItems returns the value of Items.
+func (s *TechnicianWorkload) GetItems() []TechnicianWorkloadItem {
+	return s.Items
 }
 
-// OptCreateRepairOrderRequestDownPayment is optional CreateRepairOrderRequestDownPayment.
-type OptCreateRepairOrderRequestDownPayment struct {
-	Value CreateRepairOrderRequestDownPayment
-	Set   bool
+// SetItems sets the value of Items.
+func (s *TechnicianWorkload) SetItems(val []TechnicianWorkloadItem) {
+	s.Items = val
 }
 
-// IsSet returns true if OptCreateRepairOrderRequestDownPayment was set.
-func (o OptCreateRepairOrderRequestDownPayment) IsSet() bool { return o.Set }
+type TechnicianWorkloadItem struct {
+	TechnicianID   uuid.UUID `json:"technician_id"`
+	TechnicianName string    `json:"technician_name"`
+	// Number of repair orders that haven't been confirmed to the customer yet.
+	OpenCount int `json:"open_count"`
+	// Number of repair orders that have been confirmed but not completed yet.
+	ConfirmedCount int `json:"confirmed_count"`
+	// Creation time of the oldest open or confirmed repair order, absent when there is none.
+	OldestOrderCreationTime OptDateTime `json:"oldest_order_creation_time"`
+}
 
-// Reset unsets value.
-func (o *OptCreateRepairOrderRequestDownPayment) Reset() {
-	var v CreateRepairOrderRequestDownPayment
-	o.Value = v
-	o.Set = false
+// GetTechnicianID returns the value of TechnicianID.
+func (s *TechnicianWorkloadItem) GetTechnicianID() uuid.UUID {
+	return s.TechnicianID
 }
 
-// SetTo sets value to v.
-func (o *OptCreateRepairOrderRequestDownPayment) SetTo(v CreateRepairOrderRequestDownPayment) {
-	o.Set = true
-	o.Value = v
+// GetTechnicianName returns the value of TechnicianName.
+func (s *TechnicianWorkloadItem) GetTechnicianName() string {
+	return s.TechnicianName
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCreateRepairOrderRequestDownPayment) Get() (v CreateRepairOrderRequestDownPayment, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// GetOpenCount returns the value of OpenCount.
+func (s *TechnicianWorkloadItem) GetOpenCount() int {
+	return s.OpenCount
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCreateRepairOrderRequestDownPayment) Or(d CreateRepairOrderRequestDownPayment) CreateRepairOrderRequestDownPayment {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetConfirmedCount returns the value of ConfirmedCount.
+func (s *TechnicianWorkloadItem) GetConfirmedCount() int {
+	return s.ConfirmedCount
 }
 
-// NewOptCreateRepairOrderRequestPasscode returns new OptCreateRepairOrderRequestPasscode with value set to v.
-func NewOptCreateRepairOrderRequestPasscode(v CreateRepairOrderRequestPasscode) OptCreateRepairOrderRequestPasscode {
-	return OptCreateRepairOrderRequestPasscode{
-		Value: v,
-		Set:   true,
-	}
+// GetOldestOrderCreationTime returns the value of OldestOrderCreationTime.
+func (s *TechnicianWorkloadItem) GetOldestOrderCreationTime() OptDateTime {
+	return s.OldestOrderCreationTime
 }
 
-// OptCreateRepairOrderRequestPasscode is optional CreateRepairOrderRequestPasscode.
-type OptCreateRepairOrderRequestPasscode struct {
-	Value CreateRepairOrderRequestPasscode
-	Set   bool
+// SetTechnicianID sets the value of TechnicianID.
+func (s *TechnicianWorkloadItem) SetTechnicianID(val uuid.UUID) {
+	s.TechnicianID = val
+}
+
+// SetTechnicianName sets the value of TechnicianName.
+func (s *TechnicianWorkloadItem) SetTechnicianName(val string) {
+	s.TechnicianName = val
 }
 
-// IsSet returns true if OptCreateRepairOrderRequestPasscode was set.
-func (o OptCreateRepairOrderRequestPasscode) IsSet() bool { return o.Set }
+// SetOpenCount sets the value of OpenCount.
+func (s *TechnicianWorkloadItem) SetOpenCount(val int) {
+	s.OpenCount = val
+}
 
-// Reset unsets value.
-func (o *OptCreateRepairOrderRequestPasscode) Reset() {
-	var v CreateRepairOrderRequestPasscode
-	o.Value = v
-	o.Set = false
+// SetConfirmedCount sets the value of ConfirmedCount.
+func (s *TechnicianWorkloadItem) SetConfirmedCount(val int) {
+	s.ConfirmedCount = val
 }
 
-// SetTo sets value to v.
-func (o *OptCreateRepairOrderRequestPasscode) SetTo(v CreateRepairOrderRequestPasscode) {
-	o.Set = true
-	o.Value = v
+// SetOldestOrderCreationTime sets the value of OldestOrderCreationTime.
+func (s *TechnicianWorkloadItem) SetOldestOrderCreationTime(val OptDateTime) {
+	s.OldestOrderCreationTime = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptCreateRepairOrderRequestPasscode) Get() (v CreateRepairOrderRequestPasscode, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// UnlockUserLoginNoContent is response for UnlockUserLogin operation.
+type UnlockUserLoginNoContent struct{}
+
+type UpdateWebhookRequest struct {
+	URL url.URL `json:"url"`
+	// Replaces the signing key if set.
+	Secret OptString `json:"secret"`
+	// Events to subscribe to. Empty means every event.
+	EventTypes []WebhookEventType `json:"event_types"`
+	// Enabling a disabled webhook resumes its pending deliveries.
+	Enabled bool `json:"enabled"`
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptCreateRepairOrderRequestPasscode) Or(d CreateRepairOrderRequestPasscode) CreateRepairOrderRequestPasscode {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// GetURL returns the value of URL.
+func (s *UpdateWebhookRequest) GetURL() url.URL {
+	return s.URL
 }
 
-// NewOptString returns new OptString with value set to v.
-func NewOptString(v string) OptString {
-	return OptString{
-		Value: v,
-		Set:   true,
-	}
+// GetSecret returns the value of Secret.
+func (s *UpdateWebhookRequest) GetSecret() OptString {
+	return s.Secret
 }
 
-// OptString is optional string.
-type OptString struct {
-	Value string
-	Set   bool
+// GetEventTypes returns the value of EventTypes.
+func (s *UpdateWebhookRequest) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
 }
 
-// IsSet returns true if OptString was set.
-func (o OptString) IsSet() bool { return o.Set }
+// GetEnabled returns the value of Enabled.
+func (s *UpdateWebhookRequest) GetEnabled() bool {
+	return s.Enabled
+}
 
-// Reset unsets value.
-func (o *OptString) Reset() {
-	var v string
-	o.Value = v
-	o.Set = false
+// SetURL sets the value of URL.
+func (s *UpdateWebhookRequest) SetURL(val url.URL) {
+	s.URL = val
 }
 
-// SetTo sets value to v.
-func (o *OptString) SetTo(v string) {
-	o.Set = true
-	o.Value = v
+// SetSecret sets the value of Secret.
+func (s *UpdateWebhookRequest) SetSecret(val OptString) {
+	s.Secret = val
 }
 
-// Get returns value and boolean that denotes whether value was set.
-func (o OptString) Get() (v string, ok bool) {
-	if !o.Set {
-		return v, false
-	}
-	return o.Value, true
+// SetEventTypes sets the value of EventTypes.
+func (s *UpdateWebhookRequest) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
 }
 
-// Or returns value if set, or given parameter if does not.
-func (o OptString) Or(d string) string {
-	if v, ok := o.Get(); ok {
-		return v
-	}
-	return d
+// SetEnabled sets the value of Enabled.
+func (s *UpdateWebhookRequest) SetEnabled(val bool) {
+	s.Enabled = val
 }
 
-type SessionCookie struct {
-	APIKey string
+type UploadPhotoReq struct {
+	File ht.MultipartFile `json:"file"`
 }
 
-// GetAPIKey returns the value of APIKey.
-func (s *SessionCookie) GetAPIKey() string {
-	return s.APIKey
+// GetFile returns the value of File.
+func (s *UploadPhotoReq) GetFile() ht.MultipartFile {
+	return s.File
 }
 
-// SetAPIKey sets the value of APIKey.
-func (s *SessionCookie) SetAPIKey(val string) {
-	s.APIKey = val
+// SetFile sets the value of File.
+func (s *UploadPhotoReq) SetFile(val ht.MultipartFile) {
+	s.File = val
 }
 
 type UserDetails struct {
@@ -941,3 +5112,445 @@ func (s *UserDetailsStore) SetName(val string) {
 func (s *UserDetailsStore) SetCode(val string) {
 	s.Code = val
 }
+
+// Ref: #/components/schemas/UserSession
+type UserSession struct {
+	ID uuid.UUID `json:"id"`
+	// User agent of the device that logged in.
+	UserAgent OptString `json:"user_agent"`
+	// IP address the session was created from.
+	IPAddress OptString `json:"ip_address"`
+	// Whether this is the session making the request.
+	IsCurrent    bool      `json:"is_current"`
+	CreationTime time.Time `json:"creation_time"`
+	LastSeenTime time.Time `json:"last_seen_time"`
+}
+
+// GetID returns the value of ID.
+func (s *UserSession) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetUserAgent returns the value of UserAgent.
+func (s *UserSession) GetUserAgent() OptString {
+	return s.UserAgent
+}
+
+// GetIPAddress returns the value of IPAddress.
+func (s *UserSession) GetIPAddress() OptString {
+	return s.IPAddress
+}
+
+// GetIsCurrent returns the value of IsCurrent.
+func (s *UserSession) GetIsCurrent() bool {
+	return s.IsCurrent
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *UserSession) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// GetLastSeenTime returns the value of LastSeenTime.
+func (s *UserSession) GetLastSeenTime() time.Time {
+	return s.LastSeenTime
+}
+
+// SetID sets the value of ID.
+func (s *UserSession) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetUserAgent sets the value of UserAgent.
+func (s *UserSession) SetUserAgent(val OptString) {
+	s.UserAgent = val
+}
+
+// SetIPAddress sets the value of IPAddress.
+func (s *UserSession) SetIPAddress(val OptString) {
+	s.IPAddress = val
+}
+
+// SetIsCurrent sets the value of IsCurrent.
+func (s *UserSession) SetIsCurrent(val bool) {
+	s.IsCurrent = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *UserSession) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// SetLastSeenTime sets the value of LastSeenTime.
+func (s *UserSession) SetLastSeenTime(val time.Time) {
+	s.LastSeenTime = val
+}
+
+type UserSessionList struct {
+	Items []UserSession `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *UserSessionList) GetItems() []UserSession {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *UserSessionList) SetItems(val []UserSession) {
+	s.Items = val
+}
+
+// Ref: #/components/schemas/Webhook
+type Webhook struct {
+	ID  uuid.UUID `json:"id"`
+	URL url.URL   `json:"url"`
+	// Events the webhook is subscribed to. Empty means every event.
+	EventTypes []WebhookEventType `json:"event_types"`
+	Enabled    bool               `json:"enabled"`
+	// Failed delivery attempts since the last successful one.
+	ConsecutiveFailures int `json:"consecutive_failures"`
+	// Only set if the webhook was disabled.
+	DisabledTime OptDateTime `json:"disabled_time"`
+	CreationTime time.Time   `json:"creation_time"`
+}
+
+// GetID returns the value of ID.
+func (s *Webhook) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetURL returns the value of URL.
+func (s *Webhook) GetURL() url.URL {
+	return s.URL
+}
+
+// GetEventTypes returns the value of EventTypes.
+func (s *Webhook) GetEventTypes() []WebhookEventType {
+	return s.EventTypes
+}
+
+// GetEnabled returns the value of Enabled.
+func (s *Webhook) GetEnabled() bool {
+	return s.Enabled
+}
+
+// GetConsecutiveFailures returns the value of ConsecutiveFailures.
+func (s *Webhook) GetConsecutiveFailures() int {
+	return s.ConsecutiveFailures
+}
+
+// GetDisabledTime returns the value of DisabledTime.
+func (s *Webhook) GetDisabledTime() OptDateTime {
+	return s.DisabledTime
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *Webhook) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// SetID sets the value of ID.
+func (s *Webhook) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetURL sets the value of URL.
+func (s *Webhook) SetURL(val url.URL) {
+	s.URL = val
+}
+
+// SetEventTypes sets the value of EventTypes.
+func (s *Webhook) SetEventTypes(val []WebhookEventType) {
+	s.EventTypes = val
+}
+
+// SetEnabled sets the value of Enabled.
+func (s *Webhook) SetEnabled(val bool) {
+	s.Enabled = val
+}
+
+// SetConsecutiveFailures sets the value of ConsecutiveFailures.
+func (s *Webhook) SetConsecutiveFailures(val int) {
+	s.ConsecutiveFailures = val
+}
+
+// SetDisabledTime sets the value of DisabledTime.
+func (s *Webhook) SetDisabledTime(val OptDateTime) {
+	s.DisabledTime = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *Webhook) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// Ref: #/components/schemas/WebhookDelivery
+type WebhookDelivery struct {
+	ID        uuid.UUID        `json:"id"`
+	EventID   uuid.UUID        `json:"event_id"`
+	EventType WebhookEventType `json:"event_type"`
+	// Failed deliveries have run out of attempts and can only be replayed.
+	Status WebhookDeliveryStatus `json:"status"`
+	// Only set if the delivery is a replay of another delivery.
+	ReplayOf OptUUID `json:"replay_of"`
+	Attempts int     `json:"attempts"`
+	// HTTP status code returned by the receiver on the last attempt.
+	LastStatusCode OptInt      `json:"last_status_code"`
+	LastError      OptString   `json:"last_error"`
+	CreationTime   time.Time   `json:"creation_time"`
+	DeliveredTime  OptDateTime `json:"delivered_time"`
+}
+
+// GetID returns the value of ID.
+func (s *WebhookDelivery) GetID() uuid.UUID {
+	return s.ID
+}
+
+// GetEventID returns the value of EventID.
+func (s *WebhookDelivery) GetEventID() uuid.UUID {
+	return s.EventID
+}
+
+// GetEventType returns the value of EventType.
+func (s *WebhookDelivery) GetEventType() WebhookEventType {
+	return s.EventType
+}
+
+// GetStatus returns the value of Status.
+func (s *WebhookDelivery) GetStatus() WebhookDeliveryStatus {
+	return s.Status
+}
+
+// GetReplayOf returns the value of ReplayOf.
+func (s *WebhookDelivery) GetReplayOf() OptUUID {
+	return s.ReplayOf
+}
+
+// GetAttempts returns the value of Attempts.
+func (s *WebhookDelivery) GetAttempts() int {
+	return s.Attempts
+}
+
+// GetLastStatusCode returns the value of LastStatusCode.
+func (s *WebhookDelivery) GetLastStatusCode() OptInt {
+	return s.LastStatusCode
+}
+
+// GetLastError returns the value of LastError.
+func (s *WebhookDelivery) GetLastError() OptString {
+	return s.LastError
+}
+
+// GetCreationTime returns the value of CreationTime.
+func (s *WebhookDelivery) GetCreationTime() time.Time {
+	return s.CreationTime
+}
+
+// GetDeliveredTime returns the value of DeliveredTime.
+func (s *WebhookDelivery) GetDeliveredTime() OptDateTime {
+	return s.DeliveredTime
+}
+
+// SetID sets the value of ID.
+func (s *WebhookDelivery) SetID(val uuid.UUID) {
+	s.ID = val
+}
+
+// SetEventID sets the value of EventID.
+func (s *WebhookDelivery) SetEventID(val uuid.UUID) {
+	s.EventID = val
+}
+
+// SetEventType sets the value of EventType.
+func (s *WebhookDelivery) SetEventType(val WebhookEventType) {
+	s.EventType = val
+}
+
+// SetStatus sets the value of Status.
+func (s *WebhookDelivery) SetStatus(val WebhookDeliveryStatus) {
+	s.Status = val
+}
+
+// SetReplayOf sets the value of ReplayOf.
+func (s *WebhookDelivery) SetReplayOf(val OptUUID) {
+	s.ReplayOf = val
+}
+
+// SetAttempts sets the value of Attempts.
+func (s *WebhookDelivery) SetAttempts(val int) {
+	s.Attempts = val
+}
+
+// SetLastStatusCode sets the value of LastStatusCode.
+func (s *WebhookDelivery) SetLastStatusCode(val OptInt) {
+	s.LastStatusCode = val
+}
+
+// SetLastError sets the value of LastError.
+func (s *WebhookDelivery) SetLastError(val OptString) {
+	s.LastError = val
+}
+
+// SetCreationTime sets the value of CreationTime.
+func (s *WebhookDelivery) SetCreationTime(val time.Time) {
+	s.CreationTime = val
+}
+
+// SetDeliveredTime sets the value of DeliveredTime.
+func (s *WebhookDelivery) SetDeliveredTime(val OptDateTime) {
+	s.DeliveredTime = val
+}
+
+type WebhookDeliveryList struct {
+	Items []WebhookDelivery `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *WebhookDeliveryList) GetItems() []WebhookDelivery {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *WebhookDeliveryList) SetItems(val []WebhookDelivery) {
+	s.Items = val
+}
+
+// Failed deliveries have run out of attempts and can only be replayed.
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusPending   WebhookDeliveryStatus = "pending"
+	WebhookDeliveryStatusDelivered WebhookDeliveryStatus = "delivered"
+	WebhookDeliveryStatusFailed    WebhookDeliveryStatus = "failed"
+)
+
+// AllValues returns all WebhookDeliveryStatus values.
+func (WebhookDeliveryStatus) AllValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusPending,
+		WebhookDeliveryStatusDelivered,
+		WebhookDeliveryStatusFailed,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookDeliveryStatus) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookDeliveryStatusPending:
+		return []byte(s), nil
+	case WebhookDeliveryStatusDelivered:
+		return []byte(s), nil
+	case WebhookDeliveryStatusFailed:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookDeliveryStatus) UnmarshalText(data []byte) error {
+	switch WebhookDeliveryStatus(data) {
+	case WebhookDeliveryStatusPending:
+		*s = WebhookDeliveryStatusPending
+		return nil
+	case WebhookDeliveryStatusDelivered:
+		*s = WebhookDeliveryStatusDelivered
+		return nil
+	case WebhookDeliveryStatusFailed:
+		*s = WebhookDeliveryStatusFailed
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+// Ref: #/components/schemas/WebhookEventType
+type WebhookEventType string
+
+const (
+	WebhookEventTypeOrderCreated           WebhookEventType = "order_created"
+	WebhookEventTypeOrderCostMutated       WebhookEventType = "order_cost_mutated"
+	WebhookEventTypeOrderTechnicianChanged WebhookEventType = "order_technician_changed"
+	WebhookEventTypeOrderConfirmed         WebhookEventType = "order_confirmed"
+	WebhookEventTypeOrderCompleted         WebhookEventType = "order_completed"
+	WebhookEventTypeOrderPickedUp          WebhookEventType = "order_picked_up"
+	WebhookEventTypeOrderCancelled         WebhookEventType = "order_cancelled"
+)
+
+// AllValues returns all WebhookEventType values.
+func (WebhookEventType) AllValues() []WebhookEventType {
+	return []WebhookEventType{
+		WebhookEventTypeOrderCreated,
+		WebhookEventTypeOrderCostMutated,
+		WebhookEventTypeOrderTechnicianChanged,
+		WebhookEventTypeOrderConfirmed,
+		WebhookEventTypeOrderCompleted,
+		WebhookEventTypeOrderPickedUp,
+		WebhookEventTypeOrderCancelled,
+	}
+}
+
+// MarshalText implements encoding.TextMarshaler.
+func (s WebhookEventType) MarshalText() ([]byte, error) {
+	switch s {
+	case WebhookEventTypeOrderCreated:
+		return []byte(s), nil
+	case WebhookEventTypeOrderCostMutated:
+		return []byte(s), nil
+	case WebhookEventTypeOrderTechnicianChanged:
+		return []byte(s), nil
+	case WebhookEventTypeOrderConfirmed:
+		return []byte(s), nil
+	case WebhookEventTypeOrderCompleted:
+		return []byte(s), nil
+	case WebhookEventTypeOrderPickedUp:
+		return []byte(s), nil
+	case WebhookEventTypeOrderCancelled:
+		return []byte(s), nil
+	default:
+		return nil, errors.Errorf("invalid value: %q", s)
+	}
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (s *WebhookEventType) UnmarshalText(data []byte) error {
+	switch WebhookEventType(data) {
+	case WebhookEventTypeOrderCreated:
+		*s = WebhookEventTypeOrderCreated
+		return nil
+	case WebhookEventTypeOrderCostMutated:
+		*s = WebhookEventTypeOrderCostMutated
+		return nil
+	case WebhookEventTypeOrderTechnicianChanged:
+		*s = WebhookEventTypeOrderTechnicianChanged
+		return nil
+	case WebhookEventTypeOrderConfirmed:
+		*s = WebhookEventTypeOrderConfirmed
+		return nil
+	case WebhookEventTypeOrderCompleted:
+		*s = WebhookEventTypeOrderCompleted
+		return nil
+	case WebhookEventTypeOrderPickedUp:
+		*s = WebhookEventTypeOrderPickedUp
+		return nil
+	case WebhookEventTypeOrderCancelled:
+		*s = WebhookEventTypeOrderCancelled
+		return nil
+	default:
+		return errors.Errorf("invalid value: %q", data)
+	}
+}
+
+type WebhookList struct {
+	Items []Webhook `json:"items"`
+}
+
+// GetItems returns the value of Items.
+func (s *WebhookList) GetItems() []Webhook {
+	return s.Items
+}
+
+// SetItems sets the value of Items.
+func (s *WebhookList) SetItems(val []Webhook) {
+	s.Items = val
+}
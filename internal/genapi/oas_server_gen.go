@@ -8,12 +8,88 @@ import (
 
 // Handler handles operations described by OpenAPI v3 specification.
 type Handler interface {
+	// AddRepairOrderCost implements addRepairOrderCost operation.
+	//
+	// Adds a positive or negative cost adjustment to a repair order. The total cost can't go below what
+	// has already been paid.
+	//
+	// POST /repair-orders/{repairOrderId}/costs
+	AddRepairOrderCost(ctx context.Context, req *AddRepairOrderCostRequest, params AddRepairOrderCostParams) (*RepairOrder, error)
+	// AddRepairOrderDamages implements addRepairOrderDamages operation.
+	//
+	// Adds damages found after intake to a repair order. Damages can't be changed once the order is
+	// picked up or cancelled.
+	//
+	// POST /repair-orders/{repairOrderId}/damages
+	AddRepairOrderDamages(ctx context.Context, req *AddRepairOrderDamagesRequest, params AddRepairOrderDamagesParams) (*RepairOrder, error)
+	// AddRepairOrderNote implements addRepairOrderNote operation.
+	//
+	// Leaves a timestamped note on a repair order. Customer-visible notes are also shown on the receipt
+	// and public order tracking.
+	//
+	// POST /repair-orders/{repairOrderId}/notes
+	AddRepairOrderNote(ctx context.Context, req *AddRepairOrderNoteRequest, params AddRepairOrderNoteParams) (*RepairOrderNote, error)
+	// AddRepairOrderPhoneConditions implements addRepairOrderPhoneConditions operation.
+	//
+	// Adds phone conditions found after intake to a repair order. Phone conditions can't be changed once
+	// the order is picked up or cancelled.
+	//
+	// POST /repair-orders/{repairOrderId}/phone-conditions
+	AddRepairOrderPhoneConditions(ctx context.Context, req *AddRepairOrderPhoneConditionsRequest, params AddRepairOrderPhoneConditionsParams) (*RepairOrder, error)
+	// AddRepairOrderPhoneEquipments implements addRepairOrderPhoneEquipments operation.
+	//
+	// Adds phone equipments found after intake to a repair order. Phone equipments can't be changed once
+	// the order is picked up or cancelled.
+	//
+	// POST /repair-orders/{repairOrderId}/phone-equipments
+	AddRepairOrderPhoneEquipments(ctx context.Context, req *AddRepairOrderPhoneEquipmentsRequest, params AddRepairOrderPhoneEquipmentsParams) (*RepairOrder, error)
+	// AddRepairOrderPhoto implements addRepairOrderPhoto operation.
+	//
+	// Attaches an uploaded photo to a repair order, e.g. of damage found after opening the phone. Photos
+	// can't be added once the order is picked up or cancelled.
+	//
+	// POST /repair-orders/{repairOrderId}/photos
+	AddRepairOrderPhoto(ctx context.Context, req *AddRepairOrderPhotoRequest, params AddRepairOrderPhotoParams) (*RepairOrder, error)
 	// AssignPermissionsToRole implements assignPermissionsToRole operation.
 	//
 	// Assigns permissions to a role.
 	//
 	// POST /roles/{roleId}/permissions
 	AssignPermissionsToRole(ctx context.Context, req *AssignPermissionsToRoleRequest, params AssignPermissionsToRoleParams) error
+	// CancelRepairOrder implements cancelRepairOrder operation.
+	//
+	// Cancels a repair order that has not been picked up yet. Whatever was paid beyond the cancellation
+	// fee has to be refunded, which requires the refund permission.
+	//
+	// POST /repair-orders/{repairOrderId}/cancel
+	CancelRepairOrder(ctx context.Context, req *CancelRepairOrderRequest, params CancelRepairOrderParams) (*RepairOrder, error)
+	// ChangeRepairOrderTechnician implements changeRepairOrderTechnician operation.
+	//
+	// Hands a repair order over to another technician of the same store. The reason is kept in the
+	// order's technician history.
+	//
+	// POST /repair-orders/{repairOrderId}/technician
+	ChangeRepairOrderTechnician(ctx context.Context, req *ChangeRepairOrderTechnicianRequest, params ChangeRepairOrderTechnicianParams) (*RepairOrder, error)
+	// CompleteRepairOrder implements completeRepairOrder operation.
+	//
+	// Marks the repair as completed. Stores that require confirmation only allow confirmed orders to be
+	// completed.
+	//
+	// POST /repair-orders/{repairOrderId}/complete
+	CompleteRepairOrder(ctx context.Context, params CompleteRepairOrderParams) (*RepairOrder, error)
+	// ConfirmMyTOTP implements confirmMyTOTP operation.
+	//
+	// Enables TOTP for the current user after checking a code from the authenticator app. Returns
+	// recovery codes, which are only shown once.
+	//
+	// POST /users/me/totp/confirm
+	ConfirmMyTOTP(ctx context.Context, req *ConfirmTOTPRequest) (*TOTPRecoveryCodes, error)
+	// ConfirmRepairOrder implements confirmRepairOrder operation.
+	//
+	// Records that the repair details have been confirmed to the customer.
+	//
+	// POST /repair-orders/{repairOrderId}/confirm
+	ConfirmRepairOrder(ctx context.Context, req *ConfirmRepairOrderRequest, params ConfirmRepairOrderParams) (*RepairOrder, error)
 	// CreateDamageType implements createDamageType operation.
 	//
 	// Creates a new damage type.
@@ -62,18 +138,185 @@ type Handler interface {
 	//
 	// POST /technicians
 	CreateTechnician(ctx context.Context, req *CreateTechnicianRequest) (*CreateTechnicianCreated, error)
+	// CreateWebhook implements createWebhook operation.
+	//
+	// Subscribes a URL to the order events of the current store. Every delivery is a POST signed with
+	// HMAC-SHA256 using the secret of the webhook.
+	//
+	// POST /webhooks
+	CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (*CreateWebhookCreated, error)
+	// DeleteWebhook implements deleteWebhook operation.
+	//
+	// Deletes a webhook along with its delivery log.
+	//
+	// DELETE /webhooks/{webhookId}
+	DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error
+	// DisableMyTOTP implements disableMyTOTP operation.
+	//
+	// Disables TOTP for the current user and deletes their recovery codes. Needs a code from the
+	// authenticator app or a recovery code once TOTP is enabled, so a stolen session can't turn it off.
+	//
+	// DELETE /users/me/totp
+	DisableMyTOTP(ctx context.Context, req *DisableTOTPRequest) error
+	// EditRepairOrderNote implements editRepairOrderNote operation.
+	//
+	// Changes the body and visibility of a note. Notes can only be edited by their author within 15
+	// minutes of being written.
+	//
+	// PUT /repair-orders/{repairOrderId}/notes/{noteId}
+	EditRepairOrderNote(ctx context.Context, req *EditRepairOrderNoteRequest, params EditRepairOrderNoteParams) (*RepairOrderNote, error)
+	// EnrollMyTOTP implements enrollMyTOTP operation.
+	//
+	// Generates a new TOTP secret for the current user. TOTP isn't enabled until it's confirmed through
+	// [/users/me/totp/confirm](#/user/confirmMyTOTP). Calling this again before confirming replaces the
+	// secret.
+	//
+	// POST /users/me/totp
+	EnrollMyTOTP(ctx context.Context) (*TOTPEnrollment, error)
 	// GetHealth implements getHealth operation.
 	//
 	// Returns the health status of the service.
 	//
 	// GET /healthz
 	GetHealth(ctx context.Context) error
+	// GetMyTOTPStatus implements getMyTOTPStatus operation.
+	//
+	// Gets whether the current user has enabled TOTP.
+	//
+	// GET /users/me/totp
+	GetMyTOTPStatus(ctx context.Context) (*TOTPStatus, error)
 	// GetMyUserDetails implements getMyUserDetails operation.
 	//
 	// Returns details of the currently logged in user.
 	//
 	// GET /users/me
 	GetMyUserDetails(ctx context.Context) (*UserDetails, error)
+	// GetPhoto implements getPhoto operation.
+	//
+	// Returns an uploaded photo, or redirects to the original URL of a photo attached before uploads
+	// were supported.
+	//
+	// GET /photos/{photoId}
+	GetPhoto(ctx context.Context, params GetPhotoParams) (GetPhotoRes, error)
+	// GetPhotoThumbnail implements getPhotoThumbnail operation.
+	//
+	// Returns a JPEG thumbnail that fits within 320 by 320 pixels. Photos attached before uploads were
+	// supported have no thumbnail.
+	//
+	// GET /photos/{photoId}/thumbnail
+	GetPhotoThumbnail(ctx context.Context, params GetPhotoThumbnailParams) (GetPhotoThumbnailOK, error)
+	// GetPublicRepairOrder implements getPublicRepairOrder operation.
+	//
+	// Returns the status of a repair order for the customer who owns it. The customer identifies the
+	// order with the slug printed on their ticket and the last four digits of the contact phone number.
+	// Requests are rate limited per IP address and per slug.
+	//
+	// GET /public/orders/{slug}
+	GetPublicRepairOrder(ctx context.Context, params GetPublicRepairOrderParams) (*PublicRepairOrder, error)
+	// GetRepairOrder implements getRepairOrder operation.
+	//
+	// Returns a repair order along with its costs, damages, phone conditions, equipments and photos.
+	//
+	// GET /repair-orders/{repairOrderId}
+	GetRepairOrder(ctx context.Context, params GetRepairOrderParams) (*RepairOrder, error)
+	// GetRepairOrderBySlug implements getRepairOrderBySlug operation.
+	//
+	// Returns a repair order by its slug (e.g. `R123-45678-9012`), which is printed on the ticket given
+	// to the customer.
+	//
+	// GET /repair-orders/by-slug/{slug}
+	GetRepairOrderBySlug(ctx context.Context, params GetRepairOrderBySlugParams) (*RepairOrder, error)
+	// GetRepairOrderHistory implements getRepairOrderHistory operation.
+	//
+	// Returns every recorded change to a repair order, oldest first, along with who made it and the
+	// state before and after.
+	//
+	// GET /repair-orders/{repairOrderId}/history
+	GetRepairOrderHistory(ctx context.Context, params GetRepairOrderHistoryParams) (*AuditLogEntryList, error)
+	// GetRepairOrderLabel implements getRepairOrderLabel operation.
+	//
+	// Renders a label with the slug as a QR code and a Code 128 barcode, the customer's initials, the
+	// phone type and its color.
+	//
+	// GET /repair-orders/{repairOrderId}/label
+	GetRepairOrderLabel(ctx context.Context, params GetRepairOrderLabelParams) (GetRepairOrderLabelRes, error)
+	// GetRepairOrderReceipt implements getRepairOrderReceipt operation.
+	//
+	// Renders the intake receipt of a repair order, or its invoice once it has been picked up, using the
+	// store's receipt template. The escpos format returns a raw ESC/POS byte stream for thermal printers,
+	//  sized for the store's paper width.
+	//
+	// GET /repair-orders/{repairOrderId}/receipt
+	GetRepairOrderReceipt(ctx context.Context, params GetRepairOrderReceiptParams) (GetRepairOrderReceiptRes, error)
+	// GetTechnicianQueue implements getTechnicianQueue operation.
+	//
+	// Returns the open and confirmed repair orders assigned to a technician, oldest first.
+	//
+	// GET /technicians/{technicianId}/queue
+	GetTechnicianQueue(ctx context.Context, params GetTechnicianQueueParams) (*TechnicianQueue, error)
+	// GetTechnicianWorkload implements getTechnicianWorkload operation.
+	//
+	// Returns how many repair orders each technician of the store is working on, to help balance the
+	// load between them.
+	//
+	// GET /technicians/workload
+	GetTechnicianWorkload(ctx context.Context) (*TechnicianWorkload, error)
+	// GetWebhook implements getWebhook operation.
+	//
+	// Returns a webhook. The secret is never returned.
+	//
+	// GET /webhooks/{webhookId}
+	GetWebhook(ctx context.Context, params GetWebhookParams) (*Webhook, error)
+	// IssueLoginCode implements issueLoginCode operation.
+	//
+	// Issues a login code for a user in the current store. The code is only returned in this response.
+	//
+	// POST /users/{userId}/login-codes
+	IssueLoginCode(ctx context.Context, req *IssueLoginCodeRequest, params IssueLoginCodeParams) (*IssuedLoginCode, error)
+	// ListLoginCodes implements listLoginCodes operation.
+	//
+	// Lists the login codes of a user that haven't been used up or expired, newest first.
+	//
+	// GET /users/{userId}/login-codes
+	ListLoginCodes(ctx context.Context, params ListLoginCodesParams) (*LoginCodeList, error)
+	// ListMySessions implements listMySessions operation.
+	//
+	// Lists the devices the current user is logged in on, most recently used first.
+	//
+	// GET /users/me/sessions
+	ListMySessions(ctx context.Context) (*UserSessionList, error)
+	// ListRepairOrderNotes implements listRepairOrderNotes operation.
+	//
+	// Returns both internal and customer-visible notes of a repair order, oldest first.
+	//
+	// GET /repair-orders/{repairOrderId}/notes
+	ListRepairOrderNotes(ctx context.Context, params ListRepairOrderNotesParams) (*RepairOrderNoteList, error)
+	// ListRepairOrderPayments implements listRepairOrderPayments operation.
+	//
+	// Returns the payment ledger of a repair order in the order they were recorded, along with the paid
+	// and outstanding totals.
+	//
+	// GET /repair-orders/{repairOrderId}/payments
+	ListRepairOrderPayments(ctx context.Context, params ListRepairOrderPaymentsParams) (*RepairOrderPaymentList, error)
+	// ListRepairOrders implements listRepairOrders operation.
+	//
+	// Lists the repair orders of the current store, newest first. The list is paginated using an opaque
+	// cursor: pass the `next_cursor` of a page as the `cursor` of the next request to continue.
+	//
+	// GET /repair-orders
+	ListRepairOrders(ctx context.Context, params ListRepairOrdersParams) (*RepairOrderList, error)
+	// ListWebhookDeliveries implements listWebhookDeliveries operation.
+	//
+	// Returns the delivery log of a webhook, newest first.
+	//
+	// GET /webhooks/{webhookId}/deliveries
+	ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (*WebhookDeliveryList, error)
+	// ListWebhooks implements listWebhooks operation.
+	//
+	// Lists the webhooks of the current store in the order they were created.
+	//
+	// GET /webhooks
+	ListWebhooks(ctx context.Context) (*WebhookList, error)
 	// Login implements login operation.
 	//
 	// Logs in with credentials.
@@ -82,8 +325,9 @@ type Handler interface {
 	Login(ctx context.Context, req *LoginCredentials) (*LoginResponse, error)
 	// LoginCodePrompt implements loginCodePrompt operation.
 	//
-	// Logs store employees in with the login code given by the store admin. Should only be called after
-	// [/auth/login](#/auth/login) has been called.
+	// Logs store employees in with the login code given by the store admin. Users who have enabled TOTP
+	// can use a code from their authenticator app or a recovery code instead. Should only be called
+	// after [/auth/login](#/auth/login) has been called.
 	//
 	// POST /auth/login-code
 	LoginCodePrompt(ctx context.Context, req *LoginCodePrompt) error
@@ -93,6 +337,108 @@ type Handler interface {
 	//
 	// POST /auth/logout
 	Logout(ctx context.Context) error
+	// PickUpRepairOrder implements pickUpRepairOrder operation.
+	//
+	// Records that the customer has picked up the phone. The down payment and repayment must add up to
+	// the total cost unless the difference is written off with a reason.
+	//
+	// POST /repair-orders/{repairOrderId}/pick-up
+	PickUpRepairOrder(ctx context.Context, req OptPickUpRepairOrderRequest, params PickUpRepairOrderParams) (*RepairOrder, error)
+	// RecordRepairOrderPayment implements recordRepairOrderPayment operation.
+	//
+	// Records a down payment or a partial payment for a repair order. The payment can't be greater than
+	// the outstanding amount.
+	//
+	// POST /repair-orders/{repairOrderId}/payments
+	RecordRepairOrderPayment(ctx context.Context, req *RecordRepairOrderPaymentRequest, params RecordRepairOrderPaymentParams) (*RepairOrder, error)
+	// RemoveRepairOrderDamage implements removeRepairOrderDamage operation.
+	//
+	// The order always keeps at least one damage. Damages can't be changed once the order is picked up
+	// or cancelled.
+	//
+	// DELETE /repair-orders/{repairOrderId}/damages/{repairOrderDamageId}
+	RemoveRepairOrderDamage(ctx context.Context, params RemoveRepairOrderDamageParams) (*RepairOrder, error)
+	// RemoveRepairOrderPhoneCondition implements removeRepairOrderPhoneCondition operation.
+	//
+	// Phone conditions can't be changed once the order is picked up or cancelled.
+	//
+	// DELETE /repair-orders/{repairOrderId}/phone-conditions/{repairOrderPhoneConditionId}
+	RemoveRepairOrderPhoneCondition(ctx context.Context, params RemoveRepairOrderPhoneConditionParams) (*RepairOrder, error)
+	// RemoveRepairOrderPhoneEquipment implements removeRepairOrderPhoneEquipment operation.
+	//
+	// Phone equipments can't be changed once the order is picked up or cancelled.
+	//
+	// DELETE /repair-orders/{repairOrderId}/phone-equipments/{repairOrderPhoneEquipmentId}
+	RemoveRepairOrderPhoneEquipment(ctx context.Context, params RemoveRepairOrderPhoneEquipmentParams) (*RepairOrder, error)
+	// RemoveRepairOrderPhoto implements removeRepairOrderPhoto operation.
+	//
+	// Detaches a photo from a repair order. The order always keeps at least one intake photo. The
+	// uploaded photo itself is not deleted.
+	//
+	// DELETE /repair-orders/{repairOrderId}/photos/{repairOrderPhotoId}
+	RemoveRepairOrderPhoto(ctx context.Context, params RemoveRepairOrderPhotoParams) (*RepairOrder, error)
+	// RenderRepairOrderLabels implements renderRepairOrderLabels operation.
+	//
+	// Renders the labels of the given repair orders stacked into a single image, for batch printing.
+	//
+	// POST /repair-orders/labels
+	RenderRepairOrderLabels(ctx context.Context, req *RenderRepairOrderLabelsRequest) (RenderRepairOrderLabelsRes, error)
+	// ReplayWebhookDelivery implements replayWebhookDelivery operation.
+	//
+	// Queues a new delivery with the same payload as an earlier one. The webhook has to be enabled.
+	//
+	// POST /webhooks/{webhookId}/deliveries/{deliveryId}/replay
+	ReplayWebhookDelivery(ctx context.Context, params ReplayWebhookDeliveryParams) (*WebhookDelivery, error)
+	// RevokeLoginCode implements revokeLoginCode operation.
+	//
+	// Revokes a login code so it can no longer be used.
+	//
+	// DELETE /users/{userId}/login-codes/{loginCodeId}
+	RevokeLoginCode(ctx context.Context, params RevokeLoginCodeParams) error
+	// RevokeMyOtherSessions implements revokeMyOtherSessions operation.
+	//
+	// Logs out every session of the current user except the one making the request.
+	//
+	// POST /users/me/sessions/revoke-others
+	RevokeMyOtherSessions(ctx context.Context) error
+	// RevokeMySession implements revokeMySession operation.
+	//
+	// Logs out one of the current user's sessions. Revoking the current session logs the user out.
+	//
+	// DELETE /users/me/sessions/{sessionId}
+	RevokeMySession(ctx context.Context, params RevokeMySessionParams) error
+	// RevokeUserSessions implements revokeUserSessions operation.
+	//
+	// Revokes every session of a user in the current store.
+	//
+	// DELETE /users/{userId}/sessions
+	RevokeUserSessions(ctx context.Context, params RevokeUserSessionsParams) error
+	// SearchAuditLog implements searchAuditLog operation.
+	//
+	// Searches the audit log of the current store, newest first. The list is paginated using an opaque
+	// cursor: pass the `next_cursor` of a page as the `cursor` of the next request to continue.
+	//
+	// GET /audit-log
+	SearchAuditLog(ctx context.Context, params SearchAuditLogParams) (*AuditLogEntryList, error)
+	// UnlockUserLogin implements unlockUserLogin operation.
+	//
+	// Clears the failed login attempts counted against a user so they can log in again without waiting.
+	//
+	// DELETE /users/{userId}/login-lockout
+	UnlockUserLogin(ctx context.Context, params UnlockUserLoginParams) error
+	// UpdateWebhook implements updateWebhook operation.
+	//
+	// Updates a webhook. Enabling a webhook also resets its failure count.
+	//
+	// PUT /webhooks/{webhookId}
+	UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest, params UpdateWebhookParams) (*Webhook, error)
+	// UploadPhoto implements uploadPhoto operation.
+	//
+	// Stores a JPEG or PNG photo for the current store so it can be attached to repair orders. GPS data
+	// is stripped from the EXIF metadata and a thumbnail is generated.
+	//
+	// POST /photos
+	UploadPhoto(ctx context.Context, req *UploadPhotoReq) (*PhotoHeaders, error)
 	// NewError creates *ErrorStatusCode from error returned by handler.
 	//
 	// Used for common default response.
@@ -16,6 +16,78 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+func TestAddRepairOrderCostRequest_EncodeDecode(t *testing.T) {
+	var typ AddRepairOrderCostRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AddRepairOrderCostRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAddRepairOrderDamagesRequest_EncodeDecode(t *testing.T) {
+	var typ AddRepairOrderDamagesRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AddRepairOrderDamagesRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAddRepairOrderNoteRequest_EncodeDecode(t *testing.T) {
+	var typ AddRepairOrderNoteRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AddRepairOrderNoteRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAddRepairOrderPhoneConditionsRequest_EncodeDecode(t *testing.T) {
+	var typ AddRepairOrderPhoneConditionsRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AddRepairOrderPhoneConditionsRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAddRepairOrderPhoneEquipmentsRequest_EncodeDecode(t *testing.T) {
+	var typ AddRepairOrderPhoneEquipmentsRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AddRepairOrderPhoneEquipmentsRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAddRepairOrderPhotoRequest_EncodeDecode(t *testing.T) {
+	var typ AddRepairOrderPhotoRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AddRepairOrderPhotoRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestAssignPermissionsToRoleRequest_EncodeDecode(t *testing.T) {
 	var typ AssignPermissionsToRoleRequest
 	typ.SetFake()
@@ -40,6 +112,172 @@ func TestAssignPermissionsToRoleRequestPermissionsItem_EncodeDecode(t *testing.T
 	var typ2 AssignPermissionsToRoleRequestPermissionsItem
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestAuditLogAction_EncodeDecode(t *testing.T) {
+	var typ AuditLogAction
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AuditLogAction
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestAuditLogAction_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"repair_order_cost_added\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ AuditLogAction
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 AuditLogAction
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestAuditLogEntityType_EncodeDecode(t *testing.T) {
+	var typ AuditLogEntityType
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AuditLogEntityType
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestAuditLogEntityType_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"repair_order\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ AuditLogEntityType
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 AuditLogEntityType
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestAuditLogEntry_EncodeDecode(t *testing.T) {
+	var typ AuditLogEntry
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AuditLogEntry
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestAuditLogEntryList_EncodeDecode(t *testing.T) {
+	var typ AuditLogEntryList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 AuditLogEntryList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestCancelRepairOrderRequest_EncodeDecode(t *testing.T) {
+	var typ CancelRepairOrderRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 CancelRepairOrderRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestCancelRepairOrderRequestRefund_EncodeDecode(t *testing.T) {
+	var typ CancelRepairOrderRequestRefund
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 CancelRepairOrderRequestRefund
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestChangeRepairOrderTechnicianRequest_EncodeDecode(t *testing.T) {
+	var typ ChangeRepairOrderTechnicianRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ChangeRepairOrderTechnicianRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestConfirmRepairOrderRequest_EncodeDecode(t *testing.T) {
+	var typ ConfirmRepairOrderRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ConfirmRepairOrderRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestConfirmTOTPRequest_EncodeDecode(t *testing.T) {
+	var typ ConfirmTOTPRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 ConfirmTOTPRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestCreateDamageTypeRequest_EncodeDecode(t *testing.T) {
 	var typ CreateDamageTypeRequest
 	typ.SetFake()
@@ -160,6 +398,42 @@ func TestCreateTechnicianRequest_EncodeDecode(t *testing.T) {
 	var typ2 CreateTechnicianRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestCreateWebhookRequest_EncodeDecode(t *testing.T) {
+	var typ CreateWebhookRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 CreateWebhookRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestDisableTOTPRequest_EncodeDecode(t *testing.T) {
+	var typ DisableTOTPRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 DisableTOTPRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestEditRepairOrderNoteRequest_EncodeDecode(t *testing.T) {
+	var typ EditRepairOrderNoteRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 EditRepairOrderNoteRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
 func TestError_EncodeDecode(t *testing.T) {
 	var typ Error
 	typ.SetFake()
@@ -172,8 +446,8 @@ func TestError_EncodeDecode(t *testing.T) {
 	var typ2 Error
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestLoginCodePrompt_EncodeDecode(t *testing.T) {
-	var typ LoginCodePrompt
+func TestIssueLoginCodeRequest_EncodeDecode(t *testing.T) {
+	var typ IssueLoginCodeRequest
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -181,11 +455,11 @@ func TestLoginCodePrompt_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 LoginCodePrompt
+	var typ2 IssueLoginCodeRequest
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestLoginCredentials_EncodeDecode(t *testing.T) {
-	var typ LoginCredentials
+func TestIssuedLoginCode_EncodeDecode(t *testing.T) {
+	var typ IssuedLoginCode
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -193,11 +467,11 @@ func TestLoginCredentials_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 LoginCredentials
+	var typ2 IssuedLoginCode
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestLoginResponse_EncodeDecode(t *testing.T) {
-	var typ LoginResponse
+func TestLoginCode_EncodeDecode(t *testing.T) {
+	var typ LoginCode
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -205,11 +479,11 @@ func TestLoginResponse_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 LoginResponse
+	var typ2 LoginCode
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestLoginResponseType_EncodeDecode(t *testing.T) {
-	var typ LoginResponseType
+func TestLoginCodeList_EncodeDecode(t *testing.T) {
+	var typ LoginCodeList
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -217,20 +491,44 @@ func TestLoginResponseType_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 LoginResponseType
+	var typ2 LoginCodeList
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestLoginCodePrompt_EncodeDecode(t *testing.T) {
+	var typ LoginCodePrompt
+	typ.SetFake()
 
-func TestLoginResponseType_Examples(t *testing.T) {
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LoginCodePrompt
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestLoginCodeType_EncodeDecode(t *testing.T) {
+	var typ LoginCodeType
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LoginCodeType
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestLoginCodeType_Examples(t *testing.T) {
 
 	for i, tc := range []struct {
 		Input string
 	}{
-		{Input: "\"admin\""},
+		{Input: "\"single_use\""},
 	} {
 		tc := tc
 		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
-			var typ LoginResponseType
+			var typ LoginCodeType
 
 			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
 				if validateErr, ok := errors.Into[*validate.Error](err); ok {
@@ -244,13 +542,13 @@ func TestLoginResponseType_Examples(t *testing.T) {
 			typ.Encode(&e)
 			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
 
-			var typ2 LoginResponseType
+			var typ2 LoginCodeType
 			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
 		})
 	}
 }
-func TestUserDetails_EncodeDecode(t *testing.T) {
-	var typ UserDetails
+func TestLoginCredentials_EncodeDecode(t *testing.T) {
+	var typ LoginCredentials
 	typ.SetFake()
 
 	e := jx.Encoder{}
@@ -258,10 +556,705 @@ func TestUserDetails_EncodeDecode(t *testing.T) {
 	data := e.Bytes()
 	require.True(t, std.Valid(data), "Encoded: %s", data)
 
-	var typ2 UserDetails
+	var typ2 LoginCredentials
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
-func TestUserDetailsRole_EncodeDecode(t *testing.T) {
+func TestLoginResponse_EncodeDecode(t *testing.T) {
+	var typ LoginResponse
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LoginResponse
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestLoginResponseType_EncodeDecode(t *testing.T) {
+	var typ LoginResponseType
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 LoginResponseType
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestLoginResponseType_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"admin\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ LoginResponseType
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 LoginResponseType
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestPhoto_EncodeDecode(t *testing.T) {
+	var typ Photo
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Photo
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPickUpRepairOrderRequest_EncodeDecode(t *testing.T) {
+	var typ PickUpRepairOrderRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PickUpRepairOrderRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPickUpRepairOrderRequestRepayment_EncodeDecode(t *testing.T) {
+	var typ PickUpRepairOrderRequestRepayment
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PickUpRepairOrderRequestRepayment
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPublicRepairOrder_EncodeDecode(t *testing.T) {
+	var typ PublicRepairOrder
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PublicRepairOrder
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPublicRepairOrderNotesItem_EncodeDecode(t *testing.T) {
+	var typ PublicRepairOrderNotesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PublicRepairOrderNotesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestPublicRepairOrderStatus_EncodeDecode(t *testing.T) {
+	var typ PublicRepairOrderStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 PublicRepairOrderStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestPublicRepairOrderStatus_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"open\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ PublicRepairOrderStatus
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 PublicRepairOrderStatus
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestRecordRepairOrderPaymentRequest_EncodeDecode(t *testing.T) {
+	var typ RecordRepairOrderPaymentRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RecordRepairOrderPaymentRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRecordRepairOrderPaymentRequestType_EncodeDecode(t *testing.T) {
+	var typ RecordRepairOrderPaymentRequestType
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RecordRepairOrderPaymentRequestType
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestRecordRepairOrderPaymentRequestType_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"partial\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ RecordRepairOrderPaymentRequestType
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 RecordRepairOrderPaymentRequestType
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestRenderRepairOrderLabelsRequest_EncodeDecode(t *testing.T) {
+	var typ RenderRepairOrderLabelsRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RenderRepairOrderLabelsRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRenderRepairOrderLabelsRequestFormat_EncodeDecode(t *testing.T) {
+	var typ RenderRepairOrderLabelsRequestFormat
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RenderRepairOrderLabelsRequestFormat
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrder_EncodeDecode(t *testing.T) {
+	var typ RepairOrder
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrder
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderCancellation_EncodeDecode(t *testing.T) {
+	var typ RepairOrderCancellation
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderCancellation
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderConfirmation_EncodeDecode(t *testing.T) {
+	var typ RepairOrderConfirmation
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderConfirmation
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderCostsItem_EncodeDecode(t *testing.T) {
+	var typ RepairOrderCostsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderCostsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderDamagesItem_EncodeDecode(t *testing.T) {
+	var typ RepairOrderDamagesItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderDamagesItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderList_EncodeDecode(t *testing.T) {
+	var typ RepairOrderList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderNote_EncodeDecode(t *testing.T) {
+	var typ RepairOrderNote
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderNote
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderNoteList_EncodeDecode(t *testing.T) {
+	var typ RepairOrderNoteList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderNoteList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderNoteVisibility_EncodeDecode(t *testing.T) {
+	var typ RepairOrderNoteVisibility
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderNoteVisibility
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestRepairOrderNoteVisibility_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"internal\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ RepairOrderNoteVisibility
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 RepairOrderNoteVisibility
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestRepairOrderPasscode_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPasscode
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPasscode
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderPayment_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPayment
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPayment
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderPaymentList_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPaymentList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPaymentList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderPaymentType_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPaymentType
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPaymentType
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestRepairOrderPaymentType_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"partial\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ RepairOrderPaymentType
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 RepairOrderPaymentType
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestRepairOrderPhoneConditionsItem_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPhoneConditionsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPhoneConditionsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderPhoneEquipmentsItem_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPhoneEquipmentsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPhoneEquipmentsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderPhotoStage_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPhotoStage
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPhotoStage
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestRepairOrderPhotoStage_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"diagnosis\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ RepairOrderPhotoStage
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 RepairOrderPhotoStage
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestRepairOrderPhotosItem_EncodeDecode(t *testing.T) {
+	var typ RepairOrderPhotosItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderPhotosItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderSummary_EncodeDecode(t *testing.T) {
+	var typ RepairOrderSummary
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderSummary
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderSummaryStatus_EncodeDecode(t *testing.T) {
+	var typ RepairOrderSummaryStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderSummaryStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestRepairOrderSummaryStatus_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"open\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ RepairOrderSummaryStatus
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 RepairOrderSummaryStatus
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestRepairOrderTechnicianAssignmentsItem_EncodeDecode(t *testing.T) {
+	var typ RepairOrderTechnicianAssignmentsItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderTechnicianAssignmentsItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestRepairOrderWriteOff_EncodeDecode(t *testing.T) {
+	var typ RepairOrderWriteOff
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 RepairOrderWriteOff
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTOTPEnrollment_EncodeDecode(t *testing.T) {
+	var typ TOTPEnrollment
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TOTPEnrollment
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTOTPRecoveryCodes_EncodeDecode(t *testing.T) {
+	var typ TOTPRecoveryCodes
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TOTPRecoveryCodes
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTOTPStatus_EncodeDecode(t *testing.T) {
+	var typ TOTPStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TOTPStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTechnicianQueue_EncodeDecode(t *testing.T) {
+	var typ TechnicianQueue
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TechnicianQueue
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTechnicianWorkload_EncodeDecode(t *testing.T) {
+	var typ TechnicianWorkload
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TechnicianWorkload
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestTechnicianWorkloadItem_EncodeDecode(t *testing.T) {
+	var typ TechnicianWorkloadItem
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 TechnicianWorkloadItem
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestUpdateWebhookRequest_EncodeDecode(t *testing.T) {
+	var typ UpdateWebhookRequest
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 UpdateWebhookRequest
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestUserDetails_EncodeDecode(t *testing.T) {
+	var typ UserDetails
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 UserDetails
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestUserDetailsRole_EncodeDecode(t *testing.T) {
 	var typ UserDetailsRole
 	typ.SetFake()
 
@@ -285,3 +1278,157 @@ func TestUserDetailsStore_EncodeDecode(t *testing.T) {
 	var typ2 UserDetailsStore
 	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
 }
+func TestUserSession_EncodeDecode(t *testing.T) {
+	var typ UserSession
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 UserSession
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestUserSessionList_EncodeDecode(t *testing.T) {
+	var typ UserSessionList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 UserSessionList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestWebhook_EncodeDecode(t *testing.T) {
+	var typ Webhook
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 Webhook
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestWebhookDelivery_EncodeDecode(t *testing.T) {
+	var typ WebhookDelivery
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 WebhookDelivery
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestWebhookDeliveryList_EncodeDecode(t *testing.T) {
+	var typ WebhookDeliveryList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 WebhookDeliveryList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+func TestWebhookDeliveryStatus_EncodeDecode(t *testing.T) {
+	var typ WebhookDeliveryStatus
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 WebhookDeliveryStatus
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestWebhookDeliveryStatus_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"delivered\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ WebhookDeliveryStatus
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 WebhookDeliveryStatus
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestWebhookEventType_EncodeDecode(t *testing.T) {
+	var typ WebhookEventType
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 WebhookEventType
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
+
+func TestWebhookEventType_Examples(t *testing.T) {
+
+	for i, tc := range []struct {
+		Input string
+	}{
+		{Input: "\"order_confirmed\""},
+	} {
+		tc := tc
+		t.Run(fmt.Sprintf("Test%d", i+1), func(t *testing.T) {
+			var typ WebhookEventType
+
+			if err := typ.Decode(jx.DecodeStr(tc.Input)); err != nil {
+				if validateErr, ok := errors.Into[*validate.Error](err); ok {
+					t.Skipf("Validation error: %v", validateErr)
+					return
+				}
+				require.NoErrorf(t, err, "Input: %s", tc.Input)
+			}
+
+			e := jx.Encoder{}
+			typ.Encode(&e)
+			require.True(t, std.Valid(e.Bytes()), "Encoded: %s", e.Bytes())
+
+			var typ2 WebhookEventType
+			require.NoError(t, typ2.Decode(jx.DecodeBytes(e.Bytes())))
+		})
+	}
+}
+func TestWebhookList_EncodeDecode(t *testing.T) {
+	var typ WebhookList
+	typ.SetFake()
+
+	e := jx.Encoder{}
+	typ.Encode(&e)
+	data := e.Bytes()
+	require.True(t, std.Valid(data), "Encoded: %s", data)
+
+	var typ2 WebhookList
+	require.NoError(t, typ2.Decode(jx.DecodeBytes(data)))
+}
@@ -13,6 +13,66 @@ type UnimplementedHandler struct{}
 
 var _ Handler = UnimplementedHandler{}
 
+// AddRepairOrderCost implements addRepairOrderCost operation.
+//
+// Adds a positive or negative cost adjustment to a repair order. The total cost can't go below what
+// has already been paid.
+//
+// POST /repair-orders/{repairOrderId}/costs
+func (UnimplementedHandler) AddRepairOrderCost(ctx context.Context, req *AddRepairOrderCostRequest, params AddRepairOrderCostParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddRepairOrderDamages implements addRepairOrderDamages operation.
+//
+// Adds damages found after intake to a repair order. Damages can't be changed once the order is
+// picked up or cancelled.
+//
+// POST /repair-orders/{repairOrderId}/damages
+func (UnimplementedHandler) AddRepairOrderDamages(ctx context.Context, req *AddRepairOrderDamagesRequest, params AddRepairOrderDamagesParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddRepairOrderNote implements addRepairOrderNote operation.
+//
+// Leaves a timestamped note on a repair order. Customer-visible notes are also shown on the receipt
+// and public order tracking.
+//
+// POST /repair-orders/{repairOrderId}/notes
+func (UnimplementedHandler) AddRepairOrderNote(ctx context.Context, req *AddRepairOrderNoteRequest, params AddRepairOrderNoteParams) (r *RepairOrderNote, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddRepairOrderPhoneConditions implements addRepairOrderPhoneConditions operation.
+//
+// Adds phone conditions found after intake to a repair order. Phone conditions can't be changed once
+// the order is picked up or cancelled.
+//
+// POST /repair-orders/{repairOrderId}/phone-conditions
+func (UnimplementedHandler) AddRepairOrderPhoneConditions(ctx context.Context, req *AddRepairOrderPhoneConditionsRequest, params AddRepairOrderPhoneConditionsParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddRepairOrderPhoneEquipments implements addRepairOrderPhoneEquipments operation.
+//
+// Adds phone equipments found after intake to a repair order. Phone equipments can't be changed once
+// the order is picked up or cancelled.
+//
+// POST /repair-orders/{repairOrderId}/phone-equipments
+func (UnimplementedHandler) AddRepairOrderPhoneEquipments(ctx context.Context, req *AddRepairOrderPhoneEquipmentsRequest, params AddRepairOrderPhoneEquipmentsParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// AddRepairOrderPhoto implements addRepairOrderPhoto operation.
+//
+// Attaches an uploaded photo to a repair order, e.g. of damage found after opening the phone. Photos
+// can't be added once the order is picked up or cancelled.
+//
+// POST /repair-orders/{repairOrderId}/photos
+func (UnimplementedHandler) AddRepairOrderPhoto(ctx context.Context, req *AddRepairOrderPhotoRequest, params AddRepairOrderPhotoParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // AssignPermissionsToRole implements assignPermissionsToRole operation.
 //
 // Assigns permissions to a role.
@@ -22,6 +82,55 @@ func (UnimplementedHandler) AssignPermissionsToRole(ctx context.Context, req *As
 	return ht.ErrNotImplemented
 }
 
+// CancelRepairOrder implements cancelRepairOrder operation.
+//
+// Cancels a repair order that has not been picked up yet. Whatever was paid beyond the cancellation
+// fee has to be refunded, which requires the refund permission.
+//
+// POST /repair-orders/{repairOrderId}/cancel
+func (UnimplementedHandler) CancelRepairOrder(ctx context.Context, req *CancelRepairOrderRequest, params CancelRepairOrderParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ChangeRepairOrderTechnician implements changeRepairOrderTechnician operation.
+//
+// Hands a repair order over to another technician of the same store. The reason is kept in the
+// order's technician history.
+//
+// POST /repair-orders/{repairOrderId}/technician
+func (UnimplementedHandler) ChangeRepairOrderTechnician(ctx context.Context, req *ChangeRepairOrderTechnicianRequest, params ChangeRepairOrderTechnicianParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// CompleteRepairOrder implements completeRepairOrder operation.
+//
+// Marks the repair as completed. Stores that require confirmation only allow confirmed orders to be
+// completed.
+//
+// POST /repair-orders/{repairOrderId}/complete
+func (UnimplementedHandler) CompleteRepairOrder(ctx context.Context, params CompleteRepairOrderParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConfirmMyTOTP implements confirmMyTOTP operation.
+//
+// Enables TOTP for the current user after checking a code from the authenticator app. Returns
+// recovery codes, which are only shown once.
+//
+// POST /users/me/totp/confirm
+func (UnimplementedHandler) ConfirmMyTOTP(ctx context.Context, req *ConfirmTOTPRequest) (r *TOTPRecoveryCodes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ConfirmRepairOrder implements confirmRepairOrder operation.
+//
+// Records that the repair details have been confirmed to the customer.
+//
+// POST /repair-orders/{repairOrderId}/confirm
+func (UnimplementedHandler) ConfirmRepairOrder(ctx context.Context, req *ConfirmRepairOrderRequest, params ConfirmRepairOrderParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // CreateDamageType implements createDamageType operation.
 //
 // Creates a new damage type.
@@ -94,6 +203,56 @@ func (UnimplementedHandler) CreateTechnician(ctx context.Context, req *CreateTec
 	return r, ht.ErrNotImplemented
 }
 
+// CreateWebhook implements createWebhook operation.
+//
+// Subscribes a URL to the order events of the current store. Every delivery is a POST signed with
+// HMAC-SHA256 using the secret of the webhook.
+//
+// POST /webhooks
+func (UnimplementedHandler) CreateWebhook(ctx context.Context, req *CreateWebhookRequest) (r *CreateWebhookCreated, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// DeleteWebhook implements deleteWebhook operation.
+//
+// Deletes a webhook along with its delivery log.
+//
+// DELETE /webhooks/{webhookId}
+func (UnimplementedHandler) DeleteWebhook(ctx context.Context, params DeleteWebhookParams) error {
+	return ht.ErrNotImplemented
+}
+
+// DisableMyTOTP implements disableMyTOTP operation.
+//
+// Disables TOTP for the current user and deletes their recovery codes. Needs a code from the
+// authenticator app or a recovery code once TOTP is enabled, so a stolen session can't turn it off.
+//
+// DELETE /users/me/totp
+func (UnimplementedHandler) DisableMyTOTP(ctx context.Context, req *DisableTOTPRequest) error {
+	return ht.ErrNotImplemented
+}
+
+// EditRepairOrderNote implements editRepairOrderNote operation.
+//
+// Changes the body and visibility of a note. Notes can only be edited by their author within 15
+// minutes of being written.
+//
+// PUT /repair-orders/{repairOrderId}/notes/{noteId}
+func (UnimplementedHandler) EditRepairOrderNote(ctx context.Context, req *EditRepairOrderNoteRequest, params EditRepairOrderNoteParams) (r *RepairOrderNote, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// EnrollMyTOTP implements enrollMyTOTP operation.
+//
+// Generates a new TOTP secret for the current user. TOTP isn't enabled until it's confirmed through
+// [/users/me/totp/confirm](#/user/confirmMyTOTP). Calling this again before confirming replaces the
+// secret.
+//
+// POST /users/me/totp
+func (UnimplementedHandler) EnrollMyTOTP(ctx context.Context) (r *TOTPEnrollment, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetHealth implements getHealth operation.
 //
 // Returns the health status of the service.
@@ -103,6 +262,15 @@ func (UnimplementedHandler) GetHealth(ctx context.Context) error {
 	return ht.ErrNotImplemented
 }
 
+// GetMyTOTPStatus implements getMyTOTPStatus operation.
+//
+// Gets whether the current user has enabled TOTP.
+//
+// GET /users/me/totp
+func (UnimplementedHandler) GetMyTOTPStatus(ctx context.Context) (r *TOTPStatus, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // GetMyUserDetails implements getMyUserDetails operation.
 //
 // Returns details of the currently logged in user.
@@ -112,6 +280,190 @@ func (UnimplementedHandler) GetMyUserDetails(ctx context.Context) (r *UserDetail
 	return r, ht.ErrNotImplemented
 }
 
+// GetPhoto implements getPhoto operation.
+//
+// Returns an uploaded photo, or redirects to the original URL of a photo attached before uploads
+// were supported.
+//
+// GET /photos/{photoId}
+func (UnimplementedHandler) GetPhoto(ctx context.Context, params GetPhotoParams) (r GetPhotoRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPhotoThumbnail implements getPhotoThumbnail operation.
+//
+// Returns a JPEG thumbnail that fits within 320 by 320 pixels. Photos attached before uploads were
+// supported have no thumbnail.
+//
+// GET /photos/{photoId}/thumbnail
+func (UnimplementedHandler) GetPhotoThumbnail(ctx context.Context, params GetPhotoThumbnailParams) (r GetPhotoThumbnailOK, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetPublicRepairOrder implements getPublicRepairOrder operation.
+//
+// Returns the status of a repair order for the customer who owns it. The customer identifies the
+// order with the slug printed on their ticket and the last four digits of the contact phone number.
+// Requests are rate limited per IP address and per slug.
+//
+// GET /public/orders/{slug}
+func (UnimplementedHandler) GetPublicRepairOrder(ctx context.Context, params GetPublicRepairOrderParams) (r *PublicRepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRepairOrder implements getRepairOrder operation.
+//
+// Returns a repair order along with its costs, damages, phone conditions, equipments and photos.
+//
+// GET /repair-orders/{repairOrderId}
+func (UnimplementedHandler) GetRepairOrder(ctx context.Context, params GetRepairOrderParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRepairOrderBySlug implements getRepairOrderBySlug operation.
+//
+// Returns a repair order by its slug (e.g. `R123-45678-9012`), which is printed on the ticket given
+// to the customer.
+//
+// GET /repair-orders/by-slug/{slug}
+func (UnimplementedHandler) GetRepairOrderBySlug(ctx context.Context, params GetRepairOrderBySlugParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRepairOrderHistory implements getRepairOrderHistory operation.
+//
+// Returns every recorded change to a repair order, oldest first, along with who made it and the
+// state before and after.
+//
+// GET /repair-orders/{repairOrderId}/history
+func (UnimplementedHandler) GetRepairOrderHistory(ctx context.Context, params GetRepairOrderHistoryParams) (r *AuditLogEntryList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRepairOrderLabel implements getRepairOrderLabel operation.
+//
+// Renders a label with the slug as a QR code and a Code 128 barcode, the customer's initials, the
+// phone type and its color.
+//
+// GET /repair-orders/{repairOrderId}/label
+func (UnimplementedHandler) GetRepairOrderLabel(ctx context.Context, params GetRepairOrderLabelParams) (r GetRepairOrderLabelRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetRepairOrderReceipt implements getRepairOrderReceipt operation.
+//
+// Renders the intake receipt of a repair order, or its invoice once it has been picked up, using the
+// store's receipt template. The escpos format returns a raw ESC/POS byte stream for thermal printers,
+//
+//	sized for the store's paper width.
+//
+// GET /repair-orders/{repairOrderId}/receipt
+func (UnimplementedHandler) GetRepairOrderReceipt(ctx context.Context, params GetRepairOrderReceiptParams) (r GetRepairOrderReceiptRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTechnicianQueue implements getTechnicianQueue operation.
+//
+// Returns the open and confirmed repair orders assigned to a technician, oldest first.
+//
+// GET /technicians/{technicianId}/queue
+func (UnimplementedHandler) GetTechnicianQueue(ctx context.Context, params GetTechnicianQueueParams) (r *TechnicianQueue, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetTechnicianWorkload implements getTechnicianWorkload operation.
+//
+// Returns how many repair orders each technician of the store is working on, to help balance the
+// load between them.
+//
+// GET /technicians/workload
+func (UnimplementedHandler) GetTechnicianWorkload(ctx context.Context) (r *TechnicianWorkload, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// GetWebhook implements getWebhook operation.
+//
+// Returns a webhook. The secret is never returned.
+//
+// GET /webhooks/{webhookId}
+func (UnimplementedHandler) GetWebhook(ctx context.Context, params GetWebhookParams) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// IssueLoginCode implements issueLoginCode operation.
+//
+// Issues a login code for a user in the current store. The code is only returned in this response.
+//
+// POST /users/{userId}/login-codes
+func (UnimplementedHandler) IssueLoginCode(ctx context.Context, req *IssueLoginCodeRequest, params IssueLoginCodeParams) (r *IssuedLoginCode, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListLoginCodes implements listLoginCodes operation.
+//
+// Lists the login codes of a user that haven't been used up or expired, newest first.
+//
+// GET /users/{userId}/login-codes
+func (UnimplementedHandler) ListLoginCodes(ctx context.Context, params ListLoginCodesParams) (r *LoginCodeList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListMySessions implements listMySessions operation.
+//
+// Lists the devices the current user is logged in on, most recently used first.
+//
+// GET /users/me/sessions
+func (UnimplementedHandler) ListMySessions(ctx context.Context) (r *UserSessionList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRepairOrderNotes implements listRepairOrderNotes operation.
+//
+// Returns both internal and customer-visible notes of a repair order, oldest first.
+//
+// GET /repair-orders/{repairOrderId}/notes
+func (UnimplementedHandler) ListRepairOrderNotes(ctx context.Context, params ListRepairOrderNotesParams) (r *RepairOrderNoteList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRepairOrderPayments implements listRepairOrderPayments operation.
+//
+// Returns the payment ledger of a repair order in the order they were recorded, along with the paid
+// and outstanding totals.
+//
+// GET /repair-orders/{repairOrderId}/payments
+func (UnimplementedHandler) ListRepairOrderPayments(ctx context.Context, params ListRepairOrderPaymentsParams) (r *RepairOrderPaymentList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListRepairOrders implements listRepairOrders operation.
+//
+// Lists the repair orders of the current store, newest first. The list is paginated using an opaque
+// cursor: pass the `next_cursor` of a page as the `cursor` of the next request to continue.
+//
+// GET /repair-orders
+func (UnimplementedHandler) ListRepairOrders(ctx context.Context, params ListRepairOrdersParams) (r *RepairOrderList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhookDeliveries implements listWebhookDeliveries operation.
+//
+// Returns the delivery log of a webhook, newest first.
+//
+// GET /webhooks/{webhookId}/deliveries
+func (UnimplementedHandler) ListWebhookDeliveries(ctx context.Context, params ListWebhookDeliveriesParams) (r *WebhookDeliveryList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ListWebhooks implements listWebhooks operation.
+//
+// Lists the webhooks of the current store in the order they were created.
+//
+// GET /webhooks
+func (UnimplementedHandler) ListWebhooks(ctx context.Context) (r *WebhookList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // Login implements login operation.
 //
 // Logs in with credentials.
@@ -123,8 +475,9 @@ func (UnimplementedHandler) Login(ctx context.Context, req *LoginCredentials) (r
 
 // LoginCodePrompt implements loginCodePrompt operation.
 //
-// Logs store employees in with the login code given by the store admin. Should only be called after
-// [/auth/login](#/auth/login) has been called.
+// Logs store employees in with the login code given by the store admin. Users who have enabled TOTP
+// can use a code from their authenticator app or a recovery code instead. Should only be called
+// after [/auth/login](#/auth/login) has been called.
 //
 // POST /auth/login-code
 func (UnimplementedHandler) LoginCodePrompt(ctx context.Context, req *LoginCodePrompt) error {
@@ -140,6 +493,156 @@ func (UnimplementedHandler) Logout(ctx context.Context) error {
 	return ht.ErrNotImplemented
 }
 
+// PickUpRepairOrder implements pickUpRepairOrder operation.
+//
+// Records that the customer has picked up the phone. The down payment and repayment must add up to
+// the total cost unless the difference is written off with a reason.
+//
+// POST /repair-orders/{repairOrderId}/pick-up
+func (UnimplementedHandler) PickUpRepairOrder(ctx context.Context, req OptPickUpRepairOrderRequest, params PickUpRepairOrderParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RecordRepairOrderPayment implements recordRepairOrderPayment operation.
+//
+// Records a down payment or a partial payment for a repair order. The payment can't be greater than
+// the outstanding amount.
+//
+// POST /repair-orders/{repairOrderId}/payments
+func (UnimplementedHandler) RecordRepairOrderPayment(ctx context.Context, req *RecordRepairOrderPaymentRequest, params RecordRepairOrderPaymentParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveRepairOrderDamage implements removeRepairOrderDamage operation.
+//
+// The order always keeps at least one damage. Damages can't be changed once the order is picked up
+// or cancelled.
+//
+// DELETE /repair-orders/{repairOrderId}/damages/{repairOrderDamageId}
+func (UnimplementedHandler) RemoveRepairOrderDamage(ctx context.Context, params RemoveRepairOrderDamageParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveRepairOrderPhoneCondition implements removeRepairOrderPhoneCondition operation.
+//
+// Phone conditions can't be changed once the order is picked up or cancelled.
+//
+// DELETE /repair-orders/{repairOrderId}/phone-conditions/{repairOrderPhoneConditionId}
+func (UnimplementedHandler) RemoveRepairOrderPhoneCondition(ctx context.Context, params RemoveRepairOrderPhoneConditionParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveRepairOrderPhoneEquipment implements removeRepairOrderPhoneEquipment operation.
+//
+// Phone equipments can't be changed once the order is picked up or cancelled.
+//
+// DELETE /repair-orders/{repairOrderId}/phone-equipments/{repairOrderPhoneEquipmentId}
+func (UnimplementedHandler) RemoveRepairOrderPhoneEquipment(ctx context.Context, params RemoveRepairOrderPhoneEquipmentParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RemoveRepairOrderPhoto implements removeRepairOrderPhoto operation.
+//
+// Detaches a photo from a repair order. The order always keeps at least one intake photo. The
+// uploaded photo itself is not deleted.
+//
+// DELETE /repair-orders/{repairOrderId}/photos/{repairOrderPhotoId}
+func (UnimplementedHandler) RemoveRepairOrderPhoto(ctx context.Context, params RemoveRepairOrderPhotoParams) (r *RepairOrder, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RenderRepairOrderLabels implements renderRepairOrderLabels operation.
+//
+// Renders the labels of the given repair orders stacked into a single image, for batch printing.
+//
+// POST /repair-orders/labels
+func (UnimplementedHandler) RenderRepairOrderLabels(ctx context.Context, req *RenderRepairOrderLabelsRequest) (r RenderRepairOrderLabelsRes, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// ReplayWebhookDelivery implements replayWebhookDelivery operation.
+//
+// Queues a new delivery with the same payload as an earlier one. The webhook has to be enabled.
+//
+// POST /webhooks/{webhookId}/deliveries/{deliveryId}/replay
+func (UnimplementedHandler) ReplayWebhookDelivery(ctx context.Context, params ReplayWebhookDeliveryParams) (r *WebhookDelivery, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// RevokeLoginCode implements revokeLoginCode operation.
+//
+// Revokes a login code so it can no longer be used.
+//
+// DELETE /users/{userId}/login-codes/{loginCodeId}
+func (UnimplementedHandler) RevokeLoginCode(ctx context.Context, params RevokeLoginCodeParams) error {
+	return ht.ErrNotImplemented
+}
+
+// RevokeMyOtherSessions implements revokeMyOtherSessions operation.
+//
+// Logs out every session of the current user except the one making the request.
+//
+// POST /users/me/sessions/revoke-others
+func (UnimplementedHandler) RevokeMyOtherSessions(ctx context.Context) error {
+	return ht.ErrNotImplemented
+}
+
+// RevokeMySession implements revokeMySession operation.
+//
+// Logs out one of the current user's sessions. Revoking the current session logs the user out.
+//
+// DELETE /users/me/sessions/{sessionId}
+func (UnimplementedHandler) RevokeMySession(ctx context.Context, params RevokeMySessionParams) error {
+	return ht.ErrNotImplemented
+}
+
+// RevokeUserSessions implements revokeUserSessions operation.
+//
+// Revokes every session of a user in the current store.
+//
+// DELETE /users/{userId}/sessions
+func (UnimplementedHandler) RevokeUserSessions(ctx context.Context, params RevokeUserSessionsParams) error {
+	return ht.ErrNotImplemented
+}
+
+// SearchAuditLog implements searchAuditLog operation.
+//
+// Searches the audit log of the current store, newest first. The list is paginated using an opaque
+// cursor: pass the `next_cursor` of a page as the `cursor` of the next request to continue.
+//
+// GET /audit-log
+func (UnimplementedHandler) SearchAuditLog(ctx context.Context, params SearchAuditLogParams) (r *AuditLogEntryList, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UnlockUserLogin implements unlockUserLogin operation.
+//
+// Clears the failed login attempts counted against a user so they can log in again without waiting.
+//
+// DELETE /users/{userId}/login-lockout
+func (UnimplementedHandler) UnlockUserLogin(ctx context.Context, params UnlockUserLoginParams) error {
+	return ht.ErrNotImplemented
+}
+
+// UpdateWebhook implements updateWebhook operation.
+//
+// Updates a webhook. Enabling a webhook also resets its failure count.
+//
+// PUT /webhooks/{webhookId}
+func (UnimplementedHandler) UpdateWebhook(ctx context.Context, req *UpdateWebhookRequest, params UpdateWebhookParams) (r *Webhook, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
+// UploadPhoto implements uploadPhoto operation.
+//
+// Stores a JPEG or PNG photo for the current store so it can be attached to repair orders. GPS data
+// is stripped from the EXIF metadata and a thumbnail is generated.
+//
+// POST /photos
+func (UnimplementedHandler) UploadPhoto(ctx context.Context, req *UploadPhotoReq) (r *PhotoHeaders, _ error) {
+	return r, ht.ErrNotImplemented
+}
+
 // NewError creates *ErrorStatusCode from error returned by handler.
 //
 // Used for common default response.
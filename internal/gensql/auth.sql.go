@@ -11,36 +11,67 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const deleteLoginCodeByID = `-- name: DeleteLoginCodeByID :exec
+const deleteLoginCodeByID = `-- name: DeleteLoginCodeByID :execrows
 DELETE FROM login_codes
 WHERE login_codes.login_code_id = $1
 `
 
-func (q *Queries) DeleteLoginCodeByID(ctx context.Context, loginCodeID pgtype.UUID) error {
-	_, err := q.db.Exec(ctx, deleteLoginCodeByID, loginCodeID)
-	return err
+func (q *Queries) DeleteLoginCodeByID(ctx context.Context, loginCodeID pgtype.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteLoginCodeByID, loginCodeID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const getConfirmedTOTPSecret = `-- name: GetConfirmedTOTPSecret :one
+SELECT user_totps.totp_secret
+FROM user_totps
+WHERE user_totps.user_id = $1 AND user_totps.confirmation_time IS NOT NULL
+`
+
+func (q *Queries) GetConfirmedTOTPSecret(ctx context.Context, userID pgtype.UUID) (string, error) {
+	row := q.db.QueryRow(ctx, getConfirmedTOTPSecret, userID)
+	var totp_secret string
+	err := row.Scan(&totp_secret)
+	return totp_secret, err
 }
 
 const getLoginCodeByUserIDAndCode = `-- name: GetLoginCodeByUserIDAndCode :one
-SELECT login_codes.login_code_id
+SELECT login_codes.login_code_id, login_codes.is_single_use
 FROM login_codes
-WHERE login_codes.user_id = $1 AND login_codes.login_code = $2
+WHERE login_codes.user_id = $1
+  AND login_codes.login_code = $2
+  AND (login_codes.expiry_time IS NULL OR login_codes.expiry_time > $3::TIMESTAMPTZ)
 `
 
 type GetLoginCodeByUserIDAndCodeParams struct {
 	UserID    pgtype.UUID
 	LoginCode string
+	Now       pgtype.Timestamptz
+}
+
+type GetLoginCodeByUserIDAndCodeRow struct {
+	LoginCodeID pgtype.UUID
+	IsSingleUse bool
 }
 
-func (q *Queries) GetLoginCodeByUserIDAndCode(ctx context.Context, arg GetLoginCodeByUserIDAndCodeParams) (pgtype.UUID, error) {
-	row := q.db.QueryRow(ctx, getLoginCodeByUserIDAndCode, arg.UserID, arg.LoginCode)
-	var login_code_id pgtype.UUID
-	err := row.Scan(&login_code_id)
-	return login_code_id, err
+func (q *Queries) GetLoginCodeByUserIDAndCode(ctx context.Context, arg GetLoginCodeByUserIDAndCodeParams) (GetLoginCodeByUserIDAndCodeRow, error) {
+	row := q.db.QueryRow(ctx, getLoginCodeByUserIDAndCode, arg.UserID, arg.LoginCode, arg.Now)
+	var i GetLoginCodeByUserIDAndCodeRow
+	err := row.Scan(&i.LoginCodeID, &i.IsSingleUse)
+	return i, err
 }
 
 const getUserByUsernameAndStoreCode = `-- name: GetUserByUsernameAndStoreCode :one
-SELECT users.user_id, users.user_password, roles.is_store_admin
+SELECT
+  users.user_id,
+  users.user_password,
+  roles.is_store_admin,
+  EXISTS (
+    SELECT 1 FROM user_totps
+    WHERE user_totps.user_id = users.user_id AND user_totps.confirmation_time IS NOT NULL
+  ) AS has_totp
 FROM users
 LEFT JOIN stores ON stores.store_id = users.store_id
 LEFT JOIN roles ON roles.role_id = users.role_id
@@ -57,11 +88,60 @@ type GetUserByUsernameAndStoreCodeRow struct {
 	UserID       pgtype.UUID
 	UserPassword string
 	IsStoreAdmin pgtype.Bool
+	HasTotp      bool
 }
 
 func (q *Queries) GetUserByUsernameAndStoreCode(ctx context.Context, arg GetUserByUsernameAndStoreCodeParams) (GetUserByUsernameAndStoreCodeRow, error) {
 	row := q.db.QueryRow(ctx, getUserByUsernameAndStoreCode, arg.Username, arg.StoreCode)
 	var i GetUserByUsernameAndStoreCodeRow
-	err := row.Scan(&i.UserID, &i.UserPassword, &i.IsStoreAdmin)
+	err := row.Scan(
+		&i.UserID,
+		&i.UserPassword,
+		&i.IsStoreAdmin,
+		&i.HasTotp,
+	)
 	return i, err
 }
+
+const useTOTPRecoveryCode = `-- name: UseTOTPRecoveryCode :execrows
+UPDATE totp_recovery_codes
+SET use_time = $3
+WHERE totp_recovery_codes.user_id = $1
+  AND totp_recovery_codes.code_hash = $2
+  AND totp_recovery_codes.use_time IS NULL
+`
+
+type UseTOTPRecoveryCodeParams struct {
+	UserID   pgtype.UUID
+	CodeHash string
+	UseTime  pgtype.Timestamptz
+}
+
+func (q *Queries) UseTOTPRecoveryCode(ctx context.Context, arg UseTOTPRecoveryCodeParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useTOTPRecoveryCode, arg.UserID, arg.CodeHash, arg.UseTime)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const useTOTPStep = `-- name: UseTOTPStep :execrows
+UPDATE user_totps
+SET last_used_step = $1::BIGINT
+WHERE user_totps.user_id = $2
+  AND user_totps.confirmation_time IS NOT NULL
+  AND (user_totps.last_used_step IS NULL OR user_totps.last_used_step < $1::BIGINT)
+`
+
+type UseTOTPStepParams struct {
+	Step   int64
+	UserID pgtype.UUID
+}
+
+func (q *Queries) UseTOTPStep(ctx context.Context, arg UseTOTPStepParams) (int64, error) {
+	result, err := q.db.Exec(ctx, useTOTPStep, arg.Step, arg.UserID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -169,7 +169,10 @@ func (r iteratorForAddPhotosToRepairOrder) Values() ([]interface{}, error) {
 	return []interface{}{
 		r.rows[0].RepairOrderPhotoID,
 		r.rows[0].RepairOrderID,
-		r.rows[0].PhotoUrl,
+		r.rows[0].PhotoID,
+		r.rows[0].Stage,
+		r.rows[0].Caption,
+		r.rows[0].CreationTime,
 	}, nil
 }
 
@@ -178,7 +181,7 @@ func (r iteratorForAddPhotosToRepairOrder) Err() error {
 }
 
 func (q *Queries) AddPhotosToRepairOrder(ctx context.Context, arg []AddPhotosToRepairOrderParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"repair_order_photos"}, []string{"repair_order_photo_id", "repair_order_id", "photo_url"}, &iteratorForAddPhotosToRepairOrder{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"repair_order_photos"}, []string{"repair_order_photo_id", "repair_order_id", "photo_id", "stage", "caption", "creation_time"}, &iteratorForAddPhotosToRepairOrder{rows: arg})
 }
 
 // iteratorForAssignPermissionsToRole implements pgx.CopyFromSource.
@@ -8,6 +8,20 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
+type AuditLogEntry struct {
+	AuditLogEntryID pgtype.UUID
+	StoreID         pgtype.UUID
+	UserID          pgtype.UUID
+	Username        string
+	CorrelationID   pgtype.Text
+	Action          string
+	EntityType      string
+	EntityID        pgtype.UUID
+	Before          []byte
+	After           []byte
+	CreationTime    pgtype.Timestamptz
+}
+
 type DamageType struct {
 	DamageTypeID   pgtype.UUID
 	StoreID        pgtype.UUID
@@ -15,9 +29,44 @@ type DamageType struct {
 }
 
 type LoginCode struct {
-	LoginCodeID pgtype.UUID
-	UserID      pgtype.UUID
-	LoginCode   string
+	LoginCodeID  pgtype.UUID
+	UserID       pgtype.UUID
+	LoginCode    string
+	IsSingleUse  bool
+	ExpiryTime   pgtype.Timestamptz
+	IssuedBy     pgtype.UUID
+	CreationTime pgtype.Timestamptz
+}
+
+type LoginThrottle struct {
+	ThrottleScope   string
+	ThrottleKey     string
+	FailureCount    int32
+	LastFailureTime pgtype.Timestamptz
+	LockedUntil     pgtype.Timestamptz
+}
+
+type Notification struct {
+	NotificationID  pgtype.UUID
+	StoreID         pgtype.UUID
+	RepairOrderID   pgtype.UUID
+	Event           string
+	Recipient       string
+	Message         string
+	CreationTime    pgtype.Timestamptz
+	Attempts        int32
+	NextAttemptTime pgtype.Timestamptz
+	LastError       pgtype.Text
+	SentTime        pgtype.Timestamptz
+	EventID         pgtype.UUID
+}
+
+type NotificationTemplate struct {
+	NotificationTemplateID pgtype.UUID
+	StoreID                pgtype.UUID
+	Event                  string
+	Language               string
+	Template               string
 }
 
 type PaymentMethod struct {
@@ -50,32 +99,44 @@ type PhoneEquipment struct {
 	PhoneEquipmentName string
 }
 
+type Photo struct {
+	PhotoID          pgtype.UUID
+	StoreID          pgtype.UUID
+	ContentType      string
+	BlobKey          pgtype.Text
+	ThumbnailBlobKey pgtype.Text
+	SourceUrl        pgtype.Text
+	UploadedBy       pgtype.UUID
+	CreationTime     pgtype.Timestamptz
+}
+
 type RepairOrder struct {
-	RepairOrderID       pgtype.UUID
-	CreationTime        pgtype.Timestamptz
-	Slug                string
-	StoreID             pgtype.UUID
-	CustomerName        string
-	ContactNumber       string
-	PhoneType           string
-	Imei                pgtype.Text
-	PartsNotCheckedYet  pgtype.Text
-	Color               string
-	PasscodeOrPattern   pgtype.Text
-	IsPatternLocked     pgtype.Bool
-	PickUpTime          pgtype.Timestamptz
-	CompletionTime      pgtype.Timestamptz
-	CancellationTime    pgtype.Timestamptz
-	CancellationReason  pgtype.Text
-	ConfirmationTime    pgtype.Timestamptz
-	ConfirmationContent pgtype.Text
-	WarrantyDays        pgtype.Int4
-	DownPaymentAmount   pgtype.Int4
-	DownPaymentMethodID pgtype.UUID
-	RepaymentAmount     pgtype.Int4
-	RepaymentMethodID   pgtype.UUID
-	TechnicianID        pgtype.UUID
-	SalesPersonID       pgtype.UUID
+	RepairOrderID           pgtype.UUID
+	CreationTime            pgtype.Timestamptz
+	Slug                    string
+	StoreID                 pgtype.UUID
+	CustomerName            string
+	ContactNumber           string
+	PhoneType               string
+	Imei                    pgtype.Text
+	PartsNotCheckedYet      pgtype.Text
+	Color                   string
+	PasscodeOrPattern       pgtype.Text
+	IsPatternLocked         pgtype.Bool
+	PickUpTime              pgtype.Timestamptz
+	CompletionTime          pgtype.Timestamptz
+	CancellationTime        pgtype.Timestamptz
+	CancellationReason      pgtype.Text
+	ConfirmationTime        pgtype.Timestamptz
+	ConfirmationContent     pgtype.Text
+	WarrantyDays            pgtype.Int4
+	TechnicianID            pgtype.UUID
+	SalesPersonID           pgtype.UUID
+	Version                 int32
+	WriteOffAmount          pgtype.Int4
+	WriteOffReason          pgtype.Text
+	CancellationFee         pgtype.Int4
+	EstimatedCompletionTime pgtype.Timestamptz
 }
 
 type RepairOrderCost struct {
@@ -92,6 +153,39 @@ type RepairOrderDamage struct {
 	DamageName          string
 }
 
+type RepairOrderEvent struct {
+	EventID         pgtype.UUID
+	StoreID         pgtype.UUID
+	RepairOrderID   pgtype.UUID
+	EventType       string
+	Payload         []byte
+	OccurredTime    pgtype.Timestamptz
+	Attempts        int32
+	NextAttemptTime pgtype.Timestamptz
+	LastError       pgtype.Text
+	DispatchedTime  pgtype.Timestamptz
+}
+
+type RepairOrderNote struct {
+	RepairOrderNoteID pgtype.UUID
+	RepairOrderID     pgtype.UUID
+	AuthorUserID      pgtype.UUID
+	Body              string
+	Visibility        string
+	CreationTime      pgtype.Timestamptz
+	EditTime          pgtype.Timestamptz
+}
+
+type RepairOrderPayment struct {
+	RepairOrderPaymentID pgtype.UUID
+	RepairOrderID        pgtype.UUID
+	PaymentType          string
+	Amount               int32
+	PaymentMethodID      pgtype.UUID
+	CreationTime         pgtype.Timestamptz
+	Reason               pgtype.Text
+}
+
 type RepairOrderPhoneCondition struct {
 	RepairOrderPhoneConditionID pgtype.UUID
 	RepairOrderID               pgtype.UUID
@@ -107,7 +201,19 @@ type RepairOrderPhoneEquipment struct {
 type RepairOrderPhoto struct {
 	RepairOrderPhotoID pgtype.UUID
 	RepairOrderID      pgtype.UUID
-	PhotoUrl           string
+	PhotoID            pgtype.UUID
+	Stage              string
+	Caption            pgtype.Text
+	CreationTime       pgtype.Timestamptz
+}
+
+type RepairOrderTechnicianAssignment struct {
+	RepairOrderTechnicianAssignmentID pgtype.UUID
+	RepairOrderID                     pgtype.UUID
+	PreviousTechnicianID              pgtype.UUID
+	TechnicianID                      pgtype.UUID
+	Reason                            string
+	CreationTime                      pgtype.Timestamptz
 }
 
 type Role struct {
@@ -128,12 +234,24 @@ type SalesPerson struct {
 	SalesPersonName string
 }
 
+type Session struct {
+	Token  string
+	Data   []byte
+	Expiry pgtype.Timestamptz
+}
+
 type Store struct {
-	StoreID      pgtype.UUID
-	StoreName    string
-	StoreCode    string
-	StoreAddress string
-	PhoneNumber  string
+	StoreID                              pgtype.UUID
+	StoreName                            string
+	StoreCode                            string
+	StoreAddress                         string
+	PhoneNumber                          string
+	RequiresConfirmationBeforeCompletion bool
+	WarrantyTerms                        pgtype.Text
+	ReceiptHtmlTemplate                  pgtype.Text
+	ReceiptPdfTemplate                   pgtype.Text
+	ReceiptPaperWidth                    int32
+	NotificationLanguage                 string
 }
 
 type Technician struct {
@@ -142,6 +260,14 @@ type Technician struct {
 	TechnicianName string
 }
 
+type TotpRecoveryCode struct {
+	TotpRecoveryCodeID pgtype.UUID
+	UserID             pgtype.UUID
+	CodeHash           string
+	CreationTime       pgtype.Timestamptz
+	UseTime            pgtype.Timestamptz
+}
+
 type User struct {
 	UserID       pgtype.UUID
 	Username     string
@@ -149,3 +275,49 @@ type User struct {
 	RoleID       pgtype.UUID
 	StoreID      pgtype.UUID
 }
+
+type UserSession struct {
+	UserSessionID pgtype.UUID
+	Token         string
+	UserID        pgtype.UUID
+	UserAgent     pgtype.Text
+	IpAddress     pgtype.Text
+	CreationTime  pgtype.Timestamptz
+	LastSeenTime  pgtype.Timestamptz
+}
+
+type UserTotp struct {
+	UserID           pgtype.UUID
+	TotpSecret       string
+	LastUsedStep     pgtype.Int8
+	CreationTime     pgtype.Timestamptz
+	ConfirmationTime pgtype.Timestamptz
+}
+
+type Webhook struct {
+	WebhookID           pgtype.UUID
+	StoreID             pgtype.UUID
+	Url                 string
+	Secret              string
+	EventTypes          []string
+	IsEnabled           bool
+	ConsecutiveFailures int32
+	DisabledTime        pgtype.Timestamptz
+	CreationTime        pgtype.Timestamptz
+}
+
+type WebhookDelivery struct {
+	WebhookDeliveryID pgtype.UUID
+	WebhookID         pgtype.UUID
+	EventID           pgtype.UUID
+	EventType         string
+	Payload           []byte
+	ReplayOf          pgtype.UUID
+	CreationTime      pgtype.Timestamptz
+	Attempts          int32
+	NextAttemptTime   pgtype.Timestamptz
+	LastStatusCode    pgtype.Int4
+	LastError         pgtype.Text
+	DeliveredTime     pgtype.Timestamptz
+	FailedTime        pgtype.Timestamptz
+}
@@ -40,7 +40,84 @@ type AddPhoneEquipmentsToRepairOrderParams struct {
 type AddPhotosToRepairOrderParams struct {
 	RepairOrderPhotoID pgtype.UUID
 	RepairOrderID      pgtype.UUID
-	PhotoUrl           string
+	PhotoID            pgtype.UUID
+	Stage              string
+	Caption            pgtype.Text
+	CreationTime       pgtype.Timestamptz
+}
+
+const countPhotosByIDs = `-- name: CountPhotosByIDs :one
+SELECT COUNT(*)
+FROM photos
+WHERE photos.store_id = $1 AND photos.photo_id = ANY($2::UUID[])
+`
+
+type CountPhotosByIDsParams struct {
+	StoreID pgtype.UUID
+	Ids     []pgtype.UUID
+}
+
+func (q *Queries) CountPhotosByIDs(ctx context.Context, arg CountPhotosByIDsParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countPhotosByIDs, arg.StoreID, arg.Ids)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRepairOrders = `-- name: CountRepairOrders :one
+SELECT COUNT(*)
+FROM repair_orders
+WHERE
+  repair_orders.store_id = $1 AND
+  (
+    $2::TEXT IS NULL OR
+    (
+      CASE
+        WHEN repair_orders.cancellation_time IS NOT NULL THEN 'cancelled'
+        WHEN repair_orders.pick_up_time IS NOT NULL THEN 'picked_up'
+        WHEN repair_orders.completion_time IS NOT NULL THEN 'completed'
+        WHEN repair_orders.confirmation_time IS NOT NULL THEN 'confirmed'
+        ELSE 'open'
+      END
+    ) = $2::TEXT
+  ) AND
+  ($3::UUID IS NULL OR repair_orders.technician_id = $3::UUID) AND
+  ($4::UUID IS NULL OR repair_orders.sales_person_id = $4::UUID) AND
+  ($5::TIMESTAMPTZ IS NULL OR repair_orders.creation_time >= $5::TIMESTAMPTZ) AND
+  ($6::TIMESTAMPTZ IS NULL OR repair_orders.creation_time < $6::TIMESTAMPTZ) AND
+  (
+    $7::TEXT IS NULL OR
+    repair_orders.customer_name ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.contact_number ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.imei ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.phone_type ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.slug ILIKE '%' || $7::TEXT || '%'
+  )
+`
+
+type CountRepairOrdersParams struct {
+	StoreID       pgtype.UUID
+	Status        pgtype.Text
+	TechnicianID  pgtype.UUID
+	SalesPersonID pgtype.UUID
+	CreatedFrom   pgtype.Timestamptz
+	CreatedTo     pgtype.Timestamptz
+	Search        pgtype.Text
+}
+
+func (q *Queries) CountRepairOrders(ctx context.Context, arg CountRepairOrdersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, countRepairOrders,
+		arg.StoreID,
+		arg.Status,
+		arg.TechnicianID,
+		arg.SalesPersonID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.Search,
+	)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
 const createRepairOrder = `-- name: CreateRepairOrder :exec
@@ -59,8 +136,7 @@ INSERT INTO repair_orders (
   parts_not_checked_yet,
   passcode_or_pattern,
   is_pattern_locked,
-  down_payment_amount,
-  down_payment_method_id
+  estimated_completion_time
 ) VALUES (
   $1,
   $2,
@@ -76,28 +152,26 @@ INSERT INTO repair_orders (
   $12,
   $13,
   $14,
-  $15,
-  $16
+  $15
 )
 `
 
 type CreateRepairOrderParams struct {
-	RepairOrderID       pgtype.UUID
-	CreationTime        pgtype.Timestamptz
-	Slug                string
-	StoreID             pgtype.UUID
-	CustomerName        string
-	ContactNumber       string
-	PhoneType           string
-	Color               string
-	SalesPersonID       pgtype.UUID
-	TechnicianID        pgtype.UUID
-	Imei                pgtype.Text
-	PartsNotCheckedYet  pgtype.Text
-	PasscodeOrPattern   pgtype.Text
-	IsPatternLocked     pgtype.Bool
-	DownPaymentAmount   pgtype.Int4
-	DownPaymentMethodID pgtype.UUID
+	RepairOrderID           pgtype.UUID
+	CreationTime            pgtype.Timestamptz
+	Slug                    string
+	StoreID                 pgtype.UUID
+	CustomerName            string
+	ContactNumber           string
+	PhoneType               string
+	Color                   string
+	SalesPersonID           pgtype.UUID
+	TechnicianID            pgtype.UUID
+	Imei                    pgtype.Text
+	PartsNotCheckedYet      pgtype.Text
+	PasscodeOrPattern       pgtype.Text
+	IsPatternLocked         pgtype.Bool
+	EstimatedCompletionTime pgtype.Timestamptz
 }
 
 func (q *Queries) CreateRepairOrder(ctx context.Context, arg CreateRepairOrderParams) error {
@@ -116,12 +190,79 @@ func (q *Queries) CreateRepairOrder(ctx context.Context, arg CreateRepairOrderPa
 		arg.PartsNotCheckedYet,
 		arg.PasscodeOrPattern,
 		arg.IsPatternLocked,
-		arg.DownPaymentAmount,
-		arg.DownPaymentMethodID,
+		arg.EstimatedCompletionTime,
 	)
 	return err
 }
 
+const deleteRepairOrderDamagesExcept = `-- name: DeleteRepairOrderDamagesExcept :exec
+DELETE FROM repair_order_damages
+WHERE
+  repair_order_damages.repair_order_id = $1
+  AND NOT (repair_order_damages.repair_order_damage_id = ANY($2::UUID[]))
+`
+
+type DeleteRepairOrderDamagesExceptParams struct {
+	RepairOrderID pgtype.UUID
+	KeepIds       []pgtype.UUID
+}
+
+func (q *Queries) DeleteRepairOrderDamagesExcept(ctx context.Context, arg DeleteRepairOrderDamagesExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteRepairOrderDamagesExcept, arg.RepairOrderID, arg.KeepIds)
+	return err
+}
+
+const deleteRepairOrderPhoneConditionsExcept = `-- name: DeleteRepairOrderPhoneConditionsExcept :exec
+DELETE FROM repair_order_phone_conditions
+WHERE
+  repair_order_phone_conditions.repair_order_id = $1
+  AND NOT (repair_order_phone_conditions.repair_order_phone_condition_id = ANY($2::UUID[]))
+`
+
+type DeleteRepairOrderPhoneConditionsExceptParams struct {
+	RepairOrderID pgtype.UUID
+	KeepIds       []pgtype.UUID
+}
+
+func (q *Queries) DeleteRepairOrderPhoneConditionsExcept(ctx context.Context, arg DeleteRepairOrderPhoneConditionsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteRepairOrderPhoneConditionsExcept, arg.RepairOrderID, arg.KeepIds)
+	return err
+}
+
+const deleteRepairOrderPhoneEquipmentsExcept = `-- name: DeleteRepairOrderPhoneEquipmentsExcept :exec
+DELETE FROM repair_order_phone_equipments
+WHERE
+  repair_order_phone_equipments.repair_order_id = $1
+  AND NOT (repair_order_phone_equipments.repair_order_phone_equipment_id = ANY($2::UUID[]))
+`
+
+type DeleteRepairOrderPhoneEquipmentsExceptParams struct {
+	RepairOrderID pgtype.UUID
+	KeepIds       []pgtype.UUID
+}
+
+func (q *Queries) DeleteRepairOrderPhoneEquipmentsExcept(ctx context.Context, arg DeleteRepairOrderPhoneEquipmentsExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteRepairOrderPhoneEquipmentsExcept, arg.RepairOrderID, arg.KeepIds)
+	return err
+}
+
+const deleteRepairOrderPhotosExcept = `-- name: DeleteRepairOrderPhotosExcept :exec
+DELETE FROM repair_order_photos
+WHERE
+  repair_order_photos.repair_order_id = $1
+  AND NOT (repair_order_photos.repair_order_photo_id = ANY($2::UUID[]))
+`
+
+type DeleteRepairOrderPhotosExceptParams struct {
+	RepairOrderID pgtype.UUID
+	KeepIds       []pgtype.UUID
+}
+
+func (q *Queries) DeleteRepairOrderPhotosExcept(ctx context.Context, arg DeleteRepairOrderPhotosExceptParams) error {
+	_, err := q.db.Exec(ctx, deleteRepairOrderPhotosExcept, arg.RepairOrderID, arg.KeepIds)
+	return err
+}
+
 const doesPaymentMethodExist = `-- name: DoesPaymentMethodExist :one
 SELECT 1
 FROM payment_methods
@@ -158,6 +299,19 @@ func (q *Queries) DoesSalesPersonExist(ctx context.Context, arg DoesSalesPersonE
 	return column_1, err
 }
 
+const doesStoreRequireConfirmationBeforeCompletion = `-- name: DoesStoreRequireConfirmationBeforeCompletion :one
+SELECT stores.requires_confirmation_before_completion
+FROM stores
+WHERE stores.store_id = $1
+`
+
+func (q *Queries) DoesStoreRequireConfirmationBeforeCompletion(ctx context.Context, storeID pgtype.UUID) (bool, error) {
+	row := q.db.QueryRow(ctx, doesStoreRequireConfirmationBeforeCompletion, storeID)
+	var requires_confirmation_before_completion bool
+	err := row.Scan(&requires_confirmation_before_completion)
+	return requires_confirmation_before_completion, err
+}
+
 const doesTechnicianExist = `-- name: DoesTechnicianExist :one
 SELECT 1
 FROM technicians
@@ -269,6 +423,534 @@ func (q *Queries) GetPhoneEquipmentNamesByIDs(ctx context.Context, arg GetPhoneE
 	return items, nil
 }
 
+const getRepairOrderByID = `-- name: GetRepairOrderByID :one
+SELECT
+  repair_orders.repair_order_id, repair_orders.creation_time, repair_orders.slug, repair_orders.store_id, repair_orders.customer_name, repair_orders.contact_number, repair_orders.phone_type, repair_orders.imei, repair_orders.parts_not_checked_yet, repair_orders.color, repair_orders.passcode_or_pattern, repair_orders.is_pattern_locked, repair_orders.pick_up_time, repair_orders.completion_time, repair_orders.cancellation_time, repair_orders.cancellation_reason, repair_orders.confirmation_time, repair_orders.confirmation_content, repair_orders.warranty_days, repair_orders.technician_id, repair_orders.sales_person_id, repair_orders.version, repair_orders.write_off_amount, repair_orders.write_off_reason, repair_orders.cancellation_fee, repair_orders.estimated_completion_time
+FROM repair_orders
+WHERE repair_orders.store_id = $1 AND repair_orders.repair_order_id = $2
+LIMIT 1
+`
+
+type GetRepairOrderByIDParams struct {
+	StoreID       pgtype.UUID
+	RepairOrderID pgtype.UUID
+}
+
+func (q *Queries) GetRepairOrderByID(ctx context.Context, arg GetRepairOrderByIDParams) (RepairOrder, error) {
+	row := q.db.QueryRow(ctx, getRepairOrderByID, arg.StoreID, arg.RepairOrderID)
+	var i RepairOrder
+	err := row.Scan(
+		&i.RepairOrderID,
+		&i.CreationTime,
+		&i.Slug,
+		&i.StoreID,
+		&i.CustomerName,
+		&i.ContactNumber,
+		&i.PhoneType,
+		&i.Imei,
+		&i.PartsNotCheckedYet,
+		&i.Color,
+		&i.PasscodeOrPattern,
+		&i.IsPatternLocked,
+		&i.PickUpTime,
+		&i.CompletionTime,
+		&i.CancellationTime,
+		&i.CancellationReason,
+		&i.ConfirmationTime,
+		&i.ConfirmationContent,
+		&i.WarrantyDays,
+		&i.TechnicianID,
+		&i.SalesPersonID,
+		&i.Version,
+		&i.WriteOffAmount,
+		&i.WriteOffReason,
+		&i.CancellationFee,
+		&i.EstimatedCompletionTime,
+	)
+	return i, err
+}
+
+const getRepairOrderBySlug = `-- name: GetRepairOrderBySlug :one
+SELECT
+  repair_orders.repair_order_id, repair_orders.creation_time, repair_orders.slug, repair_orders.store_id, repair_orders.customer_name, repair_orders.contact_number, repair_orders.phone_type, repair_orders.imei, repair_orders.parts_not_checked_yet, repair_orders.color, repair_orders.passcode_or_pattern, repair_orders.is_pattern_locked, repair_orders.pick_up_time, repair_orders.completion_time, repair_orders.cancellation_time, repair_orders.cancellation_reason, repair_orders.confirmation_time, repair_orders.confirmation_content, repair_orders.warranty_days, repair_orders.technician_id, repair_orders.sales_person_id, repair_orders.version, repair_orders.write_off_amount, repair_orders.write_off_reason, repair_orders.cancellation_fee, repair_orders.estimated_completion_time
+FROM repair_orders
+WHERE repair_orders.store_id = $1 AND repair_orders.slug = $2
+LIMIT 1
+`
+
+type GetRepairOrderBySlugParams struct {
+	StoreID pgtype.UUID
+	Slug    string
+}
+
+func (q *Queries) GetRepairOrderBySlug(ctx context.Context, arg GetRepairOrderBySlugParams) (RepairOrder, error) {
+	row := q.db.QueryRow(ctx, getRepairOrderBySlug, arg.StoreID, arg.Slug)
+	var i RepairOrder
+	err := row.Scan(
+		&i.RepairOrderID,
+		&i.CreationTime,
+		&i.Slug,
+		&i.StoreID,
+		&i.CustomerName,
+		&i.ContactNumber,
+		&i.PhoneType,
+		&i.Imei,
+		&i.PartsNotCheckedYet,
+		&i.Color,
+		&i.PasscodeOrPattern,
+		&i.IsPatternLocked,
+		&i.PickUpTime,
+		&i.CompletionTime,
+		&i.CancellationTime,
+		&i.CancellationReason,
+		&i.ConfirmationTime,
+		&i.ConfirmationContent,
+		&i.WarrantyDays,
+		&i.TechnicianID,
+		&i.SalesPersonID,
+		&i.Version,
+		&i.WriteOffAmount,
+		&i.WriteOffReason,
+		&i.CancellationFee,
+		&i.EstimatedCompletionTime,
+	)
+	return i, err
+}
+
+const getRepairOrderBySlugInAnyStore = `-- name: GetRepairOrderBySlugInAnyStore :one
+SELECT
+  repair_orders.repair_order_id, repair_orders.creation_time, repair_orders.slug, repair_orders.store_id, repair_orders.customer_name, repair_orders.contact_number, repair_orders.phone_type, repair_orders.imei, repair_orders.parts_not_checked_yet, repair_orders.color, repair_orders.passcode_or_pattern, repair_orders.is_pattern_locked, repair_orders.pick_up_time, repair_orders.completion_time, repair_orders.cancellation_time, repair_orders.cancellation_reason, repair_orders.confirmation_time, repair_orders.confirmation_content, repair_orders.warranty_days, repair_orders.technician_id, repair_orders.sales_person_id, repair_orders.version, repair_orders.write_off_amount, repair_orders.write_off_reason, repair_orders.cancellation_fee, repair_orders.estimated_completion_time
+FROM repair_orders
+WHERE repair_orders.slug = $1
+LIMIT 1
+`
+
+func (q *Queries) GetRepairOrderBySlugInAnyStore(ctx context.Context, slug string) (RepairOrder, error) {
+	row := q.db.QueryRow(ctx, getRepairOrderBySlugInAnyStore, slug)
+	var i RepairOrder
+	err := row.Scan(
+		&i.RepairOrderID,
+		&i.CreationTime,
+		&i.Slug,
+		&i.StoreID,
+		&i.CustomerName,
+		&i.ContactNumber,
+		&i.PhoneType,
+		&i.Imei,
+		&i.PartsNotCheckedYet,
+		&i.Color,
+		&i.PasscodeOrPattern,
+		&i.IsPatternLocked,
+		&i.PickUpTime,
+		&i.CompletionTime,
+		&i.CancellationTime,
+		&i.CancellationReason,
+		&i.ConfirmationTime,
+		&i.ConfirmationContent,
+		&i.WarrantyDays,
+		&i.TechnicianID,
+		&i.SalesPersonID,
+		&i.Version,
+		&i.WriteOffAmount,
+		&i.WriteOffReason,
+		&i.CancellationFee,
+		&i.EstimatedCompletionTime,
+	)
+	return i, err
+}
+
+const getRepairOrderCosts = `-- name: GetRepairOrderCosts :many
+SELECT
+  repair_order_costs.repair_order_cost_id, repair_order_costs.repair_order_id, repair_order_costs.amount, repair_order_costs.reason, repair_order_costs.creation_time
+FROM repair_order_costs
+WHERE repair_order_costs.repair_order_id = $1
+ORDER BY repair_order_costs.creation_time ASC
+`
+
+func (q *Queries) GetRepairOrderCosts(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderCost, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderCosts, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderCost
+	for rows.Next() {
+		var i RepairOrderCost
+		if err := rows.Scan(
+			&i.RepairOrderCostID,
+			&i.RepairOrderID,
+			&i.Amount,
+			&i.Reason,
+			&i.CreationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepairOrderDamages = `-- name: GetRepairOrderDamages :many
+SELECT
+  repair_order_damages.repair_order_damage_id, repair_order_damages.repair_order_id, repair_order_damages.damage_name
+FROM repair_order_damages
+WHERE repair_order_damages.repair_order_id = $1
+`
+
+func (q *Queries) GetRepairOrderDamages(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderDamage, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderDamages, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderDamage
+	for rows.Next() {
+		var i RepairOrderDamage
+		if err := rows.Scan(&i.RepairOrderDamageID, &i.RepairOrderID, &i.DamageName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepairOrderPayments = `-- name: GetRepairOrderPayments :many
+SELECT
+  repair_order_payments.repair_order_payment_id, repair_order_payments.repair_order_id, repair_order_payments.payment_type, repair_order_payments.amount, repair_order_payments.payment_method_id, repair_order_payments.creation_time, repair_order_payments.reason
+FROM repair_order_payments
+WHERE repair_order_payments.repair_order_id = $1
+ORDER BY repair_order_payments.creation_time ASC
+`
+
+func (q *Queries) GetRepairOrderPayments(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderPayment, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderPayments, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderPayment
+	for rows.Next() {
+		var i RepairOrderPayment
+		if err := rows.Scan(
+			&i.RepairOrderPaymentID,
+			&i.RepairOrderID,
+			&i.PaymentType,
+			&i.Amount,
+			&i.PaymentMethodID,
+			&i.CreationTime,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepairOrderPhoneConditions = `-- name: GetRepairOrderPhoneConditions :many
+SELECT
+  repair_order_phone_conditions.repair_order_phone_condition_id, repair_order_phone_conditions.repair_order_id, repair_order_phone_conditions.phone_condition_name
+FROM repair_order_phone_conditions
+WHERE repair_order_phone_conditions.repair_order_id = $1
+`
+
+func (q *Queries) GetRepairOrderPhoneConditions(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderPhoneCondition, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderPhoneConditions, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderPhoneCondition
+	for rows.Next() {
+		var i RepairOrderPhoneCondition
+		if err := rows.Scan(&i.RepairOrderPhoneConditionID, &i.RepairOrderID, &i.PhoneConditionName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepairOrderPhoneEquipments = `-- name: GetRepairOrderPhoneEquipments :many
+SELECT
+  repair_order_phone_equipments.repair_order_phone_equipment_id, repair_order_phone_equipments.repair_order_id, repair_order_phone_equipments.phone_equipment_name
+FROM repair_order_phone_equipments
+WHERE repair_order_phone_equipments.repair_order_id = $1
+`
+
+func (q *Queries) GetRepairOrderPhoneEquipments(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderPhoneEquipment, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderPhoneEquipments, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderPhoneEquipment
+	for rows.Next() {
+		var i RepairOrderPhoneEquipment
+		if err := rows.Scan(&i.RepairOrderPhoneEquipmentID, &i.RepairOrderID, &i.PhoneEquipmentName); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepairOrderPhotos = `-- name: GetRepairOrderPhotos :many
+SELECT
+  repair_order_photos.repair_order_photo_id, repair_order_photos.repair_order_id, repair_order_photos.photo_id, repair_order_photos.stage, repair_order_photos.caption, repair_order_photos.creation_time
+FROM repair_order_photos
+WHERE repair_order_photos.repair_order_id = $1
+ORDER BY repair_order_photos.creation_time ASC
+`
+
+func (q *Queries) GetRepairOrderPhotos(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderPhoto, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderPhotos, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderPhoto
+	for rows.Next() {
+		var i RepairOrderPhoto
+		if err := rows.Scan(
+			&i.RepairOrderPhotoID,
+			&i.RepairOrderID,
+			&i.PhotoID,
+			&i.Stage,
+			&i.Caption,
+			&i.CreationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRepairOrderTechnicianAssignments = `-- name: GetRepairOrderTechnicianAssignments :many
+SELECT
+  repair_order_technician_assignments.repair_order_technician_assignment_id, repair_order_technician_assignments.repair_order_id, repair_order_technician_assignments.previous_technician_id, repair_order_technician_assignments.technician_id, repair_order_technician_assignments.reason, repair_order_technician_assignments.creation_time
+FROM repair_order_technician_assignments
+WHERE repair_order_technician_assignments.repair_order_id = $1
+ORDER BY repair_order_technician_assignments.creation_time ASC
+`
+
+func (q *Queries) GetRepairOrderTechnicianAssignments(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderTechnicianAssignment, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderTechnicianAssignments, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderTechnicianAssignment
+	for rows.Next() {
+		var i RepairOrderTechnicianAssignment
+		if err := rows.Scan(
+			&i.RepairOrderTechnicianAssignmentID,
+			&i.RepairOrderID,
+			&i.PreviousTechnicianID,
+			&i.TechnicianID,
+			&i.Reason,
+			&i.CreationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getStoreReceiptDetails = `-- name: GetStoreReceiptDetails :one
+SELECT
+  stores.store_name,
+  stores.store_address,
+  stores.phone_number,
+  stores.warranty_terms,
+  stores.receipt_html_template,
+  stores.receipt_pdf_template,
+  stores.receipt_paper_width
+FROM stores
+WHERE stores.store_id = $1
+`
+
+type GetStoreReceiptDetailsRow struct {
+	StoreName           string
+	StoreAddress        string
+	PhoneNumber         string
+	WarrantyTerms       pgtype.Text
+	ReceiptHtmlTemplate pgtype.Text
+	ReceiptPdfTemplate  pgtype.Text
+	ReceiptPaperWidth   int32
+}
+
+func (q *Queries) GetStoreReceiptDetails(ctx context.Context, storeID pgtype.UUID) (GetStoreReceiptDetailsRow, error) {
+	row := q.db.QueryRow(ctx, getStoreReceiptDetails, storeID)
+	var i GetStoreReceiptDetailsRow
+	err := row.Scan(
+		&i.StoreName,
+		&i.StoreAddress,
+		&i.PhoneNumber,
+		&i.WarrantyTerms,
+		&i.ReceiptHtmlTemplate,
+		&i.ReceiptPdfTemplate,
+		&i.ReceiptPaperWidth,
+	)
+	return i, err
+}
+
+const getTechnicianQueue = `-- name: GetTechnicianQueue :many
+SELECT
+  repair_orders.repair_order_id,
+  repair_orders.creation_time,
+  repair_orders.slug,
+  repair_orders.customer_name,
+  repair_orders.contact_number,
+  repair_orders.phone_type,
+  repair_orders.color,
+  repair_orders.imei,
+  repair_orders.technician_id,
+  repair_orders.sales_person_id,
+  repair_orders.confirmation_time,
+  repair_orders.completion_time,
+  repair_orders.pick_up_time,
+  repair_orders.cancellation_time
+FROM repair_orders
+WHERE
+  repair_orders.store_id = $1 AND
+  repair_orders.technician_id = $2 AND
+  repair_orders.completion_time IS NULL AND
+  repair_orders.cancellation_time IS NULL
+ORDER BY repair_orders.creation_time ASC, repair_orders.repair_order_id ASC
+`
+
+type GetTechnicianQueueParams struct {
+	StoreID      pgtype.UUID
+	TechnicianID pgtype.UUID
+}
+
+type GetTechnicianQueueRow struct {
+	RepairOrderID    pgtype.UUID
+	CreationTime     pgtype.Timestamptz
+	Slug             string
+	CustomerName     string
+	ContactNumber    string
+	PhoneType        string
+	Color            string
+	Imei             pgtype.Text
+	TechnicianID     pgtype.UUID
+	SalesPersonID    pgtype.UUID
+	ConfirmationTime pgtype.Timestamptz
+	CompletionTime   pgtype.Timestamptz
+	PickUpTime       pgtype.Timestamptz
+	CancellationTime pgtype.Timestamptz
+}
+
+func (q *Queries) GetTechnicianQueue(ctx context.Context, arg GetTechnicianQueueParams) ([]GetTechnicianQueueRow, error) {
+	rows, err := q.db.Query(ctx, getTechnicianQueue, arg.StoreID, arg.TechnicianID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTechnicianQueueRow
+	for rows.Next() {
+		var i GetTechnicianQueueRow
+		if err := rows.Scan(
+			&i.RepairOrderID,
+			&i.CreationTime,
+			&i.Slug,
+			&i.CustomerName,
+			&i.ContactNumber,
+			&i.PhoneType,
+			&i.Color,
+			&i.Imei,
+			&i.TechnicianID,
+			&i.SalesPersonID,
+			&i.ConfirmationTime,
+			&i.CompletionTime,
+			&i.PickUpTime,
+			&i.CancellationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTechnicianWorkload = `-- name: GetTechnicianWorkload :many
+SELECT
+  technicians.technician_id,
+  technicians.technician_name,
+  COUNT(repair_orders.repair_order_id) FILTER (WHERE repair_orders.confirmation_time IS NULL) AS open_count,
+  COUNT(repair_orders.repair_order_id) FILTER (WHERE repair_orders.confirmation_time IS NOT NULL) AS confirmed_count,
+  MIN(repair_orders.creation_time)::TIMESTAMPTZ AS oldest_order_creation_time
+FROM technicians
+LEFT JOIN repair_orders ON
+  repair_orders.technician_id = technicians.technician_id AND
+  repair_orders.completion_time IS NULL AND
+  repair_orders.cancellation_time IS NULL
+WHERE technicians.store_id = $1
+GROUP BY technicians.technician_id, technicians.technician_name
+ORDER BY technicians.technician_name ASC
+`
+
+type GetTechnicianWorkloadRow struct {
+	TechnicianID            pgtype.UUID
+	TechnicianName          string
+	OpenCount               int64
+	ConfirmedCount          int64
+	OldestOrderCreationTime pgtype.Timestamptz
+}
+
+func (q *Queries) GetTechnicianWorkload(ctx context.Context, storeID pgtype.UUID) ([]GetTechnicianWorkloadRow, error) {
+	rows, err := q.db.Query(ctx, getTechnicianWorkload, storeID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTechnicianWorkloadRow
+	for rows.Next() {
+		var i GetTechnicianWorkloadRow
+		if err := rows.Scan(
+			&i.TechnicianID,
+			&i.TechnicianName,
+			&i.OpenCount,
+			&i.ConfirmedCount,
+			&i.OldestOrderCreationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const isRepairOrderSlugTaken = `-- name: IsRepairOrderSlugTaken :one
 SELECT 1
 FROM repair_orders
@@ -286,3 +968,421 @@ func (q *Queries) IsRepairOrderSlugTaken(ctx context.Context, arg IsRepairOrderS
 	err := row.Scan(&column_1)
 	return column_1, err
 }
+
+const listRepairOrders = `-- name: ListRepairOrders :many
+SELECT
+  repair_orders.repair_order_id,
+  repair_orders.creation_time,
+  repair_orders.slug,
+  repair_orders.customer_name,
+  repair_orders.contact_number,
+  repair_orders.phone_type,
+  repair_orders.color,
+  repair_orders.imei,
+  repair_orders.technician_id,
+  repair_orders.sales_person_id,
+  repair_orders.confirmation_time,
+  repair_orders.completion_time,
+  repair_orders.pick_up_time,
+  repair_orders.cancellation_time
+FROM repair_orders
+WHERE
+  repair_orders.store_id = $1 AND
+  (
+    $2::TEXT IS NULL OR
+    (
+      CASE
+        WHEN repair_orders.cancellation_time IS NOT NULL THEN 'cancelled'
+        WHEN repair_orders.pick_up_time IS NOT NULL THEN 'picked_up'
+        WHEN repair_orders.completion_time IS NOT NULL THEN 'completed'
+        WHEN repair_orders.confirmation_time IS NOT NULL THEN 'confirmed'
+        ELSE 'open'
+      END
+    ) = $2::TEXT
+  ) AND
+  ($3::UUID IS NULL OR repair_orders.technician_id = $3::UUID) AND
+  ($4::UUID IS NULL OR repair_orders.sales_person_id = $4::UUID) AND
+  ($5::TIMESTAMPTZ IS NULL OR repair_orders.creation_time >= $5::TIMESTAMPTZ) AND
+  ($6::TIMESTAMPTZ IS NULL OR repair_orders.creation_time < $6::TIMESTAMPTZ) AND
+  (
+    $7::TEXT IS NULL OR
+    repair_orders.customer_name ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.contact_number ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.imei ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.phone_type ILIKE '%' || $7::TEXT || '%' OR
+    repair_orders.slug ILIKE '%' || $7::TEXT || '%'
+  ) AND
+  (
+    $8::TIMESTAMPTZ IS NULL OR
+    (repair_orders.creation_time, repair_orders.repair_order_id) <
+      ($8::TIMESTAMPTZ, $9::UUID)
+  )
+ORDER BY repair_orders.creation_time DESC, repair_orders.repair_order_id DESC
+LIMIT $10
+`
+
+type ListRepairOrdersParams struct {
+	StoreID             pgtype.UUID
+	Status              pgtype.Text
+	TechnicianID        pgtype.UUID
+	SalesPersonID       pgtype.UUID
+	CreatedFrom         pgtype.Timestamptz
+	CreatedTo           pgtype.Timestamptz
+	Search              pgtype.Text
+	CursorCreationTime  pgtype.Timestamptz
+	CursorRepairOrderID pgtype.UUID
+	PageSize            int32
+}
+
+type ListRepairOrdersRow struct {
+	RepairOrderID    pgtype.UUID
+	CreationTime     pgtype.Timestamptz
+	Slug             string
+	CustomerName     string
+	ContactNumber    string
+	PhoneType        string
+	Color            string
+	Imei             pgtype.Text
+	TechnicianID     pgtype.UUID
+	SalesPersonID    pgtype.UUID
+	ConfirmationTime pgtype.Timestamptz
+	CompletionTime   pgtype.Timestamptz
+	PickUpTime       pgtype.Timestamptz
+	CancellationTime pgtype.Timestamptz
+}
+
+func (q *Queries) ListRepairOrders(ctx context.Context, arg ListRepairOrdersParams) ([]ListRepairOrdersRow, error) {
+	rows, err := q.db.Query(ctx, listRepairOrders,
+		arg.StoreID,
+		arg.Status,
+		arg.TechnicianID,
+		arg.SalesPersonID,
+		arg.CreatedFrom,
+		arg.CreatedTo,
+		arg.Search,
+		arg.CursorCreationTime,
+		arg.CursorRepairOrderID,
+		arg.PageSize,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListRepairOrdersRow
+	for rows.Next() {
+		var i ListRepairOrdersRow
+		if err := rows.Scan(
+			&i.RepairOrderID,
+			&i.CreationTime,
+			&i.Slug,
+			&i.CustomerName,
+			&i.ContactNumber,
+			&i.PhoneType,
+			&i.Color,
+			&i.Imei,
+			&i.TechnicianID,
+			&i.SalesPersonID,
+			&i.ConfirmationTime,
+			&i.CompletionTime,
+			&i.PickUpTime,
+			&i.CancellationTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const saveRepairOrderCost = `-- name: SaveRepairOrderCost :exec
+INSERT INTO repair_order_costs (
+  repair_order_cost_id,
+  repair_order_id,
+  amount,
+  reason,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5
+)
+ON CONFLICT (repair_order_cost_id) DO NOTHING
+`
+
+type SaveRepairOrderCostParams struct {
+	RepairOrderCostID pgtype.UUID
+	RepairOrderID     pgtype.UUID
+	Amount            int32
+	Reason            pgtype.Text
+	CreationTime      pgtype.Timestamptz
+}
+
+func (q *Queries) SaveRepairOrderCost(ctx context.Context, arg SaveRepairOrderCostParams) error {
+	_, err := q.db.Exec(ctx, saveRepairOrderCost,
+		arg.RepairOrderCostID,
+		arg.RepairOrderID,
+		arg.Amount,
+		arg.Reason,
+		arg.CreationTime,
+	)
+	return err
+}
+
+const saveRepairOrderDamage = `-- name: SaveRepairOrderDamage :exec
+INSERT INTO repair_order_damages (
+  repair_order_damage_id,
+  repair_order_id,
+  damage_name
+) VALUES (
+  $1,
+  $2,
+  $3
+)
+ON CONFLICT (repair_order_damage_id) DO NOTHING
+`
+
+type SaveRepairOrderDamageParams struct {
+	RepairOrderDamageID pgtype.UUID
+	RepairOrderID       pgtype.UUID
+	DamageName          string
+}
+
+func (q *Queries) SaveRepairOrderDamage(ctx context.Context, arg SaveRepairOrderDamageParams) error {
+	_, err := q.db.Exec(ctx, saveRepairOrderDamage, arg.RepairOrderDamageID, arg.RepairOrderID, arg.DamageName)
+	return err
+}
+
+const saveRepairOrderPayment = `-- name: SaveRepairOrderPayment :exec
+INSERT INTO repair_order_payments (
+  repair_order_payment_id,
+  repair_order_id,
+  payment_type,
+  amount,
+  payment_method_id,
+  reason,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6,
+  $7
+)
+ON CONFLICT (repair_order_payment_id) DO NOTHING
+`
+
+type SaveRepairOrderPaymentParams struct {
+	RepairOrderPaymentID pgtype.UUID
+	RepairOrderID        pgtype.UUID
+	PaymentType          string
+	Amount               int32
+	PaymentMethodID      pgtype.UUID
+	Reason               pgtype.Text
+	CreationTime         pgtype.Timestamptz
+}
+
+func (q *Queries) SaveRepairOrderPayment(ctx context.Context, arg SaveRepairOrderPaymentParams) error {
+	_, err := q.db.Exec(ctx, saveRepairOrderPayment,
+		arg.RepairOrderPaymentID,
+		arg.RepairOrderID,
+		arg.PaymentType,
+		arg.Amount,
+		arg.PaymentMethodID,
+		arg.Reason,
+		arg.CreationTime,
+	)
+	return err
+}
+
+const saveRepairOrderPhoneCondition = `-- name: SaveRepairOrderPhoneCondition :exec
+INSERT INTO repair_order_phone_conditions (
+  repair_order_phone_condition_id,
+  repair_order_id,
+  phone_condition_name
+) VALUES (
+  $1,
+  $2,
+  $3
+)
+ON CONFLICT (repair_order_phone_condition_id) DO NOTHING
+`
+
+type SaveRepairOrderPhoneConditionParams struct {
+	RepairOrderPhoneConditionID pgtype.UUID
+	RepairOrderID               pgtype.UUID
+	PhoneConditionName          string
+}
+
+func (q *Queries) SaveRepairOrderPhoneCondition(ctx context.Context, arg SaveRepairOrderPhoneConditionParams) error {
+	_, err := q.db.Exec(ctx, saveRepairOrderPhoneCondition, arg.RepairOrderPhoneConditionID, arg.RepairOrderID, arg.PhoneConditionName)
+	return err
+}
+
+const saveRepairOrderPhoneEquipment = `-- name: SaveRepairOrderPhoneEquipment :exec
+INSERT INTO repair_order_phone_equipments (
+  repair_order_phone_equipment_id,
+  repair_order_id,
+  phone_equipment_name
+) VALUES (
+  $1,
+  $2,
+  $3
+)
+ON CONFLICT (repair_order_phone_equipment_id) DO NOTHING
+`
+
+type SaveRepairOrderPhoneEquipmentParams struct {
+	RepairOrderPhoneEquipmentID pgtype.UUID
+	RepairOrderID               pgtype.UUID
+	PhoneEquipmentName          string
+}
+
+func (q *Queries) SaveRepairOrderPhoneEquipment(ctx context.Context, arg SaveRepairOrderPhoneEquipmentParams) error {
+	_, err := q.db.Exec(ctx, saveRepairOrderPhoneEquipment, arg.RepairOrderPhoneEquipmentID, arg.RepairOrderID, arg.PhoneEquipmentName)
+	return err
+}
+
+const saveRepairOrderPhoto = `-- name: SaveRepairOrderPhoto :exec
+INSERT INTO repair_order_photos (
+  repair_order_photo_id,
+  repair_order_id,
+  photo_id,
+  stage,
+  caption,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+ON CONFLICT (repair_order_photo_id) DO NOTHING
+`
+
+type SaveRepairOrderPhotoParams struct {
+	RepairOrderPhotoID pgtype.UUID
+	RepairOrderID      pgtype.UUID
+	PhotoID            pgtype.UUID
+	Stage              string
+	Caption            pgtype.Text
+	CreationTime       pgtype.Timestamptz
+}
+
+func (q *Queries) SaveRepairOrderPhoto(ctx context.Context, arg SaveRepairOrderPhotoParams) error {
+	_, err := q.db.Exec(ctx, saveRepairOrderPhoto,
+		arg.RepairOrderPhotoID,
+		arg.RepairOrderID,
+		arg.PhotoID,
+		arg.Stage,
+		arg.Caption,
+		arg.CreationTime,
+	)
+	return err
+}
+
+const saveRepairOrderTechnicianAssignment = `-- name: SaveRepairOrderTechnicianAssignment :exec
+INSERT INTO repair_order_technician_assignments (
+  repair_order_technician_assignment_id,
+  repair_order_id,
+  previous_technician_id,
+  technician_id,
+  reason,
+  creation_time
+) VALUES (
+  $1,
+  $2,
+  $3,
+  $4,
+  $5,
+  $6
+)
+ON CONFLICT (repair_order_technician_assignment_id) DO NOTHING
+`
+
+type SaveRepairOrderTechnicianAssignmentParams struct {
+	RepairOrderTechnicianAssignmentID pgtype.UUID
+	RepairOrderID                     pgtype.UUID
+	PreviousTechnicianID              pgtype.UUID
+	TechnicianID                      pgtype.UUID
+	Reason                            string
+	CreationTime                      pgtype.Timestamptz
+}
+
+func (q *Queries) SaveRepairOrderTechnicianAssignment(ctx context.Context, arg SaveRepairOrderTechnicianAssignmentParams) error {
+	_, err := q.db.Exec(ctx, saveRepairOrderTechnicianAssignment,
+		arg.RepairOrderTechnicianAssignmentID,
+		arg.RepairOrderID,
+		arg.PreviousTechnicianID,
+		arg.TechnicianID,
+		arg.Reason,
+		arg.CreationTime,
+	)
+	return err
+}
+
+const updateRepairOrderProgress = `-- name: UpdateRepairOrderProgress :execrows
+UPDATE repair_orders
+SET
+  version = repair_orders.version + 1,
+  technician_id = $1,
+  confirmation_time = $2,
+  confirmation_content = $3,
+  completion_time = $4,
+  pick_up_time = $5,
+  cancellation_time = $6,
+  cancellation_reason = $7,
+  cancellation_fee = $8,
+  write_off_amount = $9,
+  write_off_reason = $10
+WHERE
+  repair_orders.store_id = $11 AND
+  repair_orders.repair_order_id = $12 AND
+  repair_orders.version = $13
+`
+
+type UpdateRepairOrderProgressParams struct {
+	TechnicianID        pgtype.UUID
+	ConfirmationTime    pgtype.Timestamptz
+	ConfirmationContent pgtype.Text
+	CompletionTime      pgtype.Timestamptz
+	PickUpTime          pgtype.Timestamptz
+	CancellationTime    pgtype.Timestamptz
+	CancellationReason  pgtype.Text
+	CancellationFee     pgtype.Int4
+	WriteOffAmount      pgtype.Int4
+	WriteOffReason      pgtype.Text
+	StoreID             pgtype.UUID
+	RepairOrderID       pgtype.UUID
+	Version             int32
+}
+
+func (q *Queries) UpdateRepairOrderProgress(ctx context.Context, arg UpdateRepairOrderProgressParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateRepairOrderProgress,
+		arg.TechnicianID,
+		arg.ConfirmationTime,
+		arg.ConfirmationContent,
+		arg.CompletionTime,
+		arg.PickUpTime,
+		arg.CancellationTime,
+		arg.CancellationReason,
+		arg.CancellationFee,
+		arg.WriteOffAmount,
+		arg.WriteOffReason,
+		arg.StoreID,
+		arg.RepairOrderID,
+		arg.Version,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
@@ -47,6 +47,47 @@ func (q *Queries) GetDamageTypeForTesting(ctx context.Context, damageTypeID pgty
 	return i, err
 }
 
+const getNotificationsForTesting = `-- name: GetNotificationsForTesting :many
+SELECT
+  notifications.notification_id, notifications.store_id, notifications.repair_order_id, notifications.event, notifications.recipient, notifications.message, notifications.creation_time, notifications.attempts, notifications.next_attempt_time, notifications.last_error, notifications.sent_time, notifications.event_id
+FROM notifications
+WHERE notifications.repair_order_id = $1
+ORDER BY notifications.creation_time ASC
+`
+
+func (q *Queries) GetNotificationsForTesting(ctx context.Context, repairOrderID pgtype.UUID) ([]Notification, error) {
+	rows, err := q.db.Query(ctx, getNotificationsForTesting, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Notification
+	for rows.Next() {
+		var i Notification
+		if err := rows.Scan(
+			&i.NotificationID,
+			&i.StoreID,
+			&i.RepairOrderID,
+			&i.Event,
+			&i.Recipient,
+			&i.Message,
+			&i.CreationTime,
+			&i.Attempts,
+			&i.NextAttemptTime,
+			&i.LastError,
+			&i.SentTime,
+			&i.EventID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getPaymentMethodForTesting = `-- name: GetPaymentMethodForTesting :one
 SELECT
   payment_methods.payment_method_id, payment_methods.store_id, payment_methods.payment_method_name
@@ -152,9 +193,48 @@ func (q *Queries) GetRepairOrderDamagesForTesting(ctx context.Context, repairOrd
 	return items, nil
 }
 
+const getRepairOrderEventsForTesting = `-- name: GetRepairOrderEventsForTesting :many
+SELECT
+  repair_order_events.event_id, repair_order_events.store_id, repair_order_events.repair_order_id, repair_order_events.event_type, repair_order_events.payload, repair_order_events.occurred_time, repair_order_events.attempts, repair_order_events.next_attempt_time, repair_order_events.last_error, repair_order_events.dispatched_time
+FROM repair_order_events
+WHERE repair_order_events.repair_order_id = $1
+ORDER BY repair_order_events.occurred_time ASC
+`
+
+func (q *Queries) GetRepairOrderEventsForTesting(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderEvent, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderEventsForTesting, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderEvent
+	for rows.Next() {
+		var i RepairOrderEvent
+		if err := rows.Scan(
+			&i.EventID,
+			&i.StoreID,
+			&i.RepairOrderID,
+			&i.EventType,
+			&i.Payload,
+			&i.OccurredTime,
+			&i.Attempts,
+			&i.NextAttemptTime,
+			&i.LastError,
+			&i.DispatchedTime,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRepairOrderForTesting = `-- name: GetRepairOrderForTesting :one
 SELECT
-  repair_orders.repair_order_id, repair_orders.creation_time, repair_orders.slug, repair_orders.store_id, repair_orders.customer_name, repair_orders.contact_number, repair_orders.phone_type, repair_orders.imei, repair_orders.parts_not_checked_yet, repair_orders.color, repair_orders.passcode_or_pattern, repair_orders.is_pattern_locked, repair_orders.pick_up_time, repair_orders.completion_time, repair_orders.cancellation_time, repair_orders.cancellation_reason, repair_orders.confirmation_time, repair_orders.confirmation_content, repair_orders.warranty_days, repair_orders.down_payment_amount, repair_orders.down_payment_method_id, repair_orders.repayment_amount, repair_orders.repayment_method_id, repair_orders.technician_id, repair_orders.sales_person_id
+  repair_orders.repair_order_id, repair_orders.creation_time, repair_orders.slug, repair_orders.store_id, repair_orders.customer_name, repair_orders.contact_number, repair_orders.phone_type, repair_orders.imei, repair_orders.parts_not_checked_yet, repair_orders.color, repair_orders.passcode_or_pattern, repair_orders.is_pattern_locked, repair_orders.pick_up_time, repair_orders.completion_time, repair_orders.cancellation_time, repair_orders.cancellation_reason, repair_orders.confirmation_time, repair_orders.confirmation_content, repair_orders.warranty_days, repair_orders.technician_id, repair_orders.sales_person_id, repair_orders.version, repair_orders.write_off_amount, repair_orders.write_off_reason, repair_orders.cancellation_fee, repair_orders.estimated_completion_time
 FROM repair_orders
 WHERE repair_orders.repair_order_id = $1
 LIMIT 1
@@ -183,16 +263,52 @@ func (q *Queries) GetRepairOrderForTesting(ctx context.Context, repairOrderID pg
 		&i.ConfirmationTime,
 		&i.ConfirmationContent,
 		&i.WarrantyDays,
-		&i.DownPaymentAmount,
-		&i.DownPaymentMethodID,
-		&i.RepaymentAmount,
-		&i.RepaymentMethodID,
 		&i.TechnicianID,
 		&i.SalesPersonID,
+		&i.Version,
+		&i.WriteOffAmount,
+		&i.WriteOffReason,
+		&i.CancellationFee,
+		&i.EstimatedCompletionTime,
 	)
 	return i, err
 }
 
+const getRepairOrderPaymentsForTesting = `-- name: GetRepairOrderPaymentsForTesting :many
+SELECT
+  repair_order_payments.repair_order_payment_id, repair_order_payments.repair_order_id, repair_order_payments.payment_type, repair_order_payments.amount, repair_order_payments.payment_method_id, repair_order_payments.creation_time, repair_order_payments.reason
+FROM repair_order_payments
+WHERE repair_order_payments.repair_order_id = $1
+`
+
+func (q *Queries) GetRepairOrderPaymentsForTesting(ctx context.Context, repairOrderID pgtype.UUID) ([]RepairOrderPayment, error) {
+	rows, err := q.db.Query(ctx, getRepairOrderPaymentsForTesting, repairOrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RepairOrderPayment
+	for rows.Next() {
+		var i RepairOrderPayment
+		if err := rows.Scan(
+			&i.RepairOrderPaymentID,
+			&i.RepairOrderID,
+			&i.PaymentType,
+			&i.Amount,
+			&i.PaymentMethodID,
+			&i.CreationTime,
+			&i.Reason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRepairOrderPhoneConditionsForTesting = `-- name: GetRepairOrderPhoneConditionsForTesting :many
 SELECT
   repair_order_phone_conditions.repair_order_phone_condition_id, repair_order_phone_conditions.repair_order_id, repair_order_phone_conditions.phone_condition_name
@@ -249,7 +365,7 @@ func (q *Queries) GetRepairOrderPhoneEquipmentsForTesting(ctx context.Context, r
 
 const getRepairOrderPhotosForTesting = `-- name: GetRepairOrderPhotosForTesting :many
 SELECT
-  repair_order_photos.repair_order_photo_id, repair_order_photos.repair_order_id, repair_order_photos.photo_url
+  repair_order_photos.repair_order_photo_id, repair_order_photos.repair_order_id, repair_order_photos.photo_id, repair_order_photos.stage, repair_order_photos.caption, repair_order_photos.creation_time
 FROM repair_order_photos
 WHERE repair_order_photos.repair_order_id = $1
 `
@@ -263,7 +379,14 @@ func (q *Queries) GetRepairOrderPhotosForTesting(ctx context.Context, repairOrde
 	var items []RepairOrderPhoto
 	for rows.Next() {
 		var i RepairOrderPhoto
-		if err := rows.Scan(&i.RepairOrderPhotoID, &i.RepairOrderID, &i.PhotoUrl); err != nil {
+		if err := rows.Scan(
+			&i.RepairOrderPhotoID,
+			&i.RepairOrderID,
+			&i.PhotoID,
+			&i.Stage,
+			&i.Caption,
+			&i.CreationTime,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -344,8 +467,8 @@ func (q *Queries) SeedDamageType(ctx context.Context, arg SeedDamageTypeParams)
 }
 
 const seedLoginCode = `-- name: SeedLoginCode :one
-INSERT INTO login_codes (login_code_id, user_id, login_code)
-VALUES ($1, $2, $3)
+INSERT INTO login_codes (login_code_id, user_id, login_code, is_single_use, creation_time)
+VALUES ($1, $2, $3, TRUE, NOW())
 RETURNING login_code_id
 `
 
@@ -362,6 +485,30 @@ func (q *Queries) SeedLoginCode(ctx context.Context, arg SeedLoginCodeParams) (p
 	return login_code_id, err
 }
 
+const seedNotificationTemplate = `-- name: SeedNotificationTemplate :exec
+INSERT INTO notification_templates (notification_template_id, store_id, event, language, template)
+VALUES ($1, $2, $3, $4, $5)
+`
+
+type SeedNotificationTemplateParams struct {
+	NotificationTemplateID pgtype.UUID
+	StoreID                pgtype.UUID
+	Event                  string
+	Language               string
+	Template               string
+}
+
+func (q *Queries) SeedNotificationTemplate(ctx context.Context, arg SeedNotificationTemplateParams) error {
+	_, err := q.db.Exec(ctx, seedNotificationTemplate,
+		arg.NotificationTemplateID,
+		arg.StoreID,
+		arg.Event,
+		arg.Language,
+		arg.Template,
+	)
+	return err
+}
+
 const seedPaymentMethod = `-- name: SeedPaymentMethod :one
 INSERT INTO payment_methods (payment_method_id, payment_method_name, store_id)
 VALUES ($1, $2, $3)
@@ -381,6 +528,25 @@ func (q *Queries) SeedPaymentMethod(ctx context.Context, arg SeedPaymentMethodPa
 	return payment_method_id, err
 }
 
+const seedPhoto = `-- name: SeedPhoto :one
+INSERT INTO photos (photo_id, store_id, content_type, blob_key, creation_time)
+VALUES ($1, $2, 'image/jpeg', $3, NOW())
+RETURNING photo_id
+`
+
+type SeedPhotoParams struct {
+	PhotoID pgtype.UUID
+	StoreID pgtype.UUID
+	BlobKey pgtype.Text
+}
+
+func (q *Queries) SeedPhoto(ctx context.Context, arg SeedPhotoParams) (pgtype.UUID, error) {
+	row := q.db.QueryRow(ctx, seedPhoto, arg.PhotoID, arg.StoreID, arg.BlobKey)
+	var photo_id pgtype.UUID
+	err := row.Scan(&photo_id)
+	return photo_id, err
+}
+
 const seedPermission = `-- name: SeedPermission :one
 INSERT INTO permissions (permission_id, permission_name, permission_display_name, permission_group_id)
 VALUES ($1, $2, $3, $4)
@@ -593,3 +759,77 @@ func (q *Queries) SeedUser(ctx context.Context, arg SeedUserParams) (pgtype.UUID
 	err := row.Scan(&user_id)
 	return user_id, err
 }
+
+const setStoreNotificationLanguage = `-- name: SetStoreNotificationLanguage :exec
+UPDATE stores
+SET notification_language = $2
+WHERE store_id = $1
+`
+
+type SetStoreNotificationLanguageParams struct {
+	StoreID              pgtype.UUID
+	NotificationLanguage string
+}
+
+func (q *Queries) SetStoreNotificationLanguage(ctx context.Context, arg SetStoreNotificationLanguageParams) error {
+	_, err := q.db.Exec(ctx, setStoreNotificationLanguage, arg.StoreID, arg.NotificationLanguage)
+	return err
+}
+
+const setStoreReceiptPaperWidth = `-- name: SetStoreReceiptPaperWidth :exec
+UPDATE stores
+SET receipt_paper_width = $2
+WHERE store_id = $1
+`
+
+type SetStoreReceiptPaperWidthParams struct {
+	StoreID           pgtype.UUID
+	ReceiptPaperWidth int32
+}
+
+func (q *Queries) SetStoreReceiptPaperWidth(ctx context.Context, arg SetStoreReceiptPaperWidthParams) error {
+	_, err := q.db.Exec(ctx, setStoreReceiptPaperWidth, arg.StoreID, arg.ReceiptPaperWidth)
+	return err
+}
+
+const setStoreReceiptSettings = `-- name: SetStoreReceiptSettings :exec
+UPDATE stores
+SET
+  warranty_terms = $2,
+  receipt_html_template = $3,
+  receipt_pdf_template = $4
+WHERE store_id = $1
+`
+
+type SetStoreReceiptSettingsParams struct {
+	StoreID             pgtype.UUID
+	WarrantyTerms       pgtype.Text
+	ReceiptHtmlTemplate pgtype.Text
+	ReceiptPdfTemplate  pgtype.Text
+}
+
+func (q *Queries) SetStoreReceiptSettings(ctx context.Context, arg SetStoreReceiptSettingsParams) error {
+	_, err := q.db.Exec(ctx, setStoreReceiptSettings,
+		arg.StoreID,
+		arg.WarrantyTerms,
+		arg.ReceiptHtmlTemplate,
+		arg.ReceiptPdfTemplate,
+	)
+	return err
+}
+
+const setStoreRequiresConfirmationBeforeCompletion = `-- name: SetStoreRequiresConfirmationBeforeCompletion :exec
+UPDATE stores
+SET requires_confirmation_before_completion = $2
+WHERE store_id = $1
+`
+
+type SetStoreRequiresConfirmationBeforeCompletionParams struct {
+	StoreID                              pgtype.UUID
+	RequiresConfirmationBeforeCompletion bool
+}
+
+func (q *Queries) SetStoreRequiresConfirmationBeforeCompletion(ctx context.Context, arg SetStoreRequiresConfirmationBeforeCompletionParams) error {
+	_, err := q.db.Exec(ctx, setStoreRequiresConfirmationBeforeCompletion, arg.StoreID, arg.RequiresConfirmationBeforeCompletion)
+	return err
+}
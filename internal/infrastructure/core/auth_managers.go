@@ -6,27 +6,38 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/alexedwards/scs/v2"
 	"github.com/google/uuid"
 )
 
 const (
-	userIDKey = "user_id"
+	userIDKey    = "user_id"
+	sessionIDKey = "session_id"
 )
 
+type userSessionRepository interface {
+	CreateUserSession(ctx context.Context, token string, session user.Session) error
+}
+
 type authSessionManager struct {
-	sm *scs.SessionManager
+	sm   *scs.SessionManager
+	repo userSessionRepository
 }
 
-func newAuthSessionManager() *authSessionManager {
+func newAuthSessionManager(store scs.Store, repo userSessionRepository) *authSessionManager {
 	sm := scs.New()
 
+	sm.Store = store
 	sm.Cookie.Name = "session_id"
 	sm.Cookie.Secure = true
 
 	return &authSessionManager{
-		sm: sm,
+		sm:   sm,
+		repo: repo,
 	}
 }
 
@@ -35,7 +46,36 @@ func (a *authSessionManager) NewSession(ctx context.Context, userID uuid.UUID) e
 		return fmt.Errorf("failed to renew session token: %w", err)
 	}
 
+	session := user.Session{
+		ID:           uuid.New(),
+		UserID:       userID,
+		UserAgent:    optional.None[string](),
+		IPAddress:    optional.None[string](),
+		CreationTime: timeProvider{}.Now(),
+	}
+	session.LastSeenTime = session.CreationTime
+
+	if userAgent, ok := appcontext.GetUserAgentFromContext(ctx); ok {
+		session.UserAgent = optional.Some(userAgent)
+	}
+
+	if ip, ok := appcontext.GetClientIPFromContext(ctx); ok {
+		session.IPAddress = optional.Some(ip)
+	}
+
 	a.sm.Put(ctx, userIDKey, userID.String())
+	a.sm.Put(ctx, sessionIDKey, session.ID.String())
+
+	// The session has to be in the store before it can be tracked.
+	token, _, err := a.sm.Commit(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to commit session: %w", err)
+	}
+
+	if err = a.repo.CreateUserSession(ctx, token, session); err != nil {
+		return fmt.Errorf("failed to create user session: %w", err)
+	}
+
 	return nil
 }
 
@@ -60,6 +100,23 @@ func (a *authSessionManager) GetUserID(ctx context.Context) (uuid.UUID, error) {
 	return parsedUserID, nil
 }
 
+func (a *authSessionManager) GetSessionID(ctx context.Context) (uuid.UUID, error) {
+	sessionID := a.sm.GetString(ctx, sessionIDKey)
+	if sessionID == "" {
+		return uuid.UUID{}, fmt.Errorf(
+			"failed to get session ID from session: %w",
+			apperror.ErrMissingSession,
+		)
+	}
+
+	parsedSessionID, err := uuid.Parse(sessionID)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("failed to parse session ID from session: %w", err)
+	}
+
+	return parsedSessionID, nil
+}
+
 func (a *authSessionManager) middleware(next http.Handler) http.Handler {
 	return a.sm.LoadAndSave(next)
 }
@@ -73,9 +130,10 @@ type loginCodePromptManager struct {
 	sm *scs.SessionManager
 }
 
-func newLoginCodePromptManager() *loginCodePromptManager {
+func newLoginCodePromptManager(store scs.Store) *loginCodePromptManager {
 	sm := scs.New()
 
+	sm.Store = store
 	sm.Lifetime = loginCodePromptCookieLifetime
 	sm.IdleTimeout = loginCodePromptCookieIdleTimeout
 	sm.Cookie.Name = "login_code_prompt_id"
@@ -0,0 +1,73 @@
+package core
+
+import (
+	"net"
+	"net/http"
+	"net/netip"
+	"strings"
+
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+)
+
+// NewClientIPMiddleware returns a middleware that stores the IP address of the
+// client in the request context.
+//
+// X-Forwarded-For is only read when the request comes from one of
+// trustedProxies, since clients can set it themselves. The header is then read
+// from right to left and the first address that isn't a trusted proxy is the
+// client. Without trusted proxies the connecting address is always used.
+func NewClientIPMiddleware(trustedProxies []netip.Prefix) Middleware {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			ip := clientIP(r, trustedProxies)
+			next.ServeHTTP(w, r.WithContext(appcontext.NewContextWithClientIP(r.Context(), ip)))
+		})
+	}
+}
+
+func clientIP(r *http.Request, trustedProxies []netip.Prefix) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	if !isTrustedProxy(ip, trustedProxies) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if hop == "" {
+			continue
+		}
+
+		if _, parseErr := netip.ParseAddr(hop); parseErr != nil {
+			break
+		}
+
+		if !isTrustedProxy(hop, trustedProxies) {
+			return hop
+		}
+
+		ip = hop
+	}
+
+	return ip
+}
+
+func isTrustedProxy(ip string, trustedProxies []netip.Prefix) bool {
+	addr, err := netip.ParseAddr(ip)
+	if err != nil {
+		return false
+	}
+
+	addr = addr.Unmap()
+	for _, prefix := range trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
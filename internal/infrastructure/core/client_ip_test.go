@@ -0,0 +1,94 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClientIPMiddleware(t *testing.T) {
+	t.Parallel()
+
+	trustedProxies := []netip.Prefix{netip.MustParsePrefix("10.0.0.0/8")}
+
+	tests := []struct {
+		name           string
+		trustedProxies []netip.Prefix
+		remoteAddr     string
+		forwardedFor   []string
+		want           string
+	}{
+		{
+			name:       "uses the connecting address",
+			remoteAddr: "203.0.113.7:51234",
+			want:       "203.0.113.7",
+		},
+		{
+			name:         "ignores X-Forwarded-For without trusted proxies",
+			remoteAddr:   "10.0.0.1:51234",
+			forwardedFor: []string{"198.51.100.1"},
+			want:         "10.0.0.1",
+		},
+		{
+			name:           "ignores X-Forwarded-For from untrusted addresses",
+			trustedProxies: trustedProxies,
+			remoteAddr:     "203.0.113.7:51234",
+			forwardedFor:   []string{"198.51.100.1"},
+			want:           "203.0.113.7",
+		},
+		{
+			name:           "uses X-Forwarded-For from trusted proxies",
+			trustedProxies: trustedProxies,
+			remoteAddr:     "10.0.0.1:51234",
+			forwardedFor:   []string{"198.51.100.1"},
+			want:           "198.51.100.1",
+		},
+		{
+			name:           "skips trusted proxies in X-Forwarded-For",
+			trustedProxies: trustedProxies,
+			remoteAddr:     "10.0.0.1:51234",
+			forwardedFor:   []string{"192.0.2.9, 198.51.100.1", "10.0.0.2"},
+			want:           "198.51.100.1",
+		},
+		{
+			name:           "uses the last trusted proxy when X-Forwarded-For is invalid",
+			trustedProxies: trustedProxies,
+			remoteAddr:     "10.0.0.1:51234",
+			forwardedFor:   []string{"not-an-ip"},
+			want:           "10.0.0.1",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got string
+
+			handler := core.NewClientIPMiddleware(tc.trustedProxies)(
+				http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+					got, _ = appcontext.GetClientIPFromContext(r.Context())
+				}),
+			)
+
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+
+			for _, value := range tc.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+
+			handler.ServeHTTP(httptest.NewRecorder(), req)
+
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
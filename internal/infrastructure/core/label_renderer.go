@@ -0,0 +1,273 @@
+package core
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"strings"
+	"unicode"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/boombuler/barcode"
+	"github.com/boombuler/barcode/code128"
+	"github.com/boombuler/barcode/qr"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Labels are laid out in pixels for 50x30mm labels on 203 dpi printers.
+const (
+	labelWidth   = 400
+	labelHeight  = 240
+	labelMargin  = 12
+	labelSpacing = 16
+
+	labelQRSize        = 128
+	labelBarcodeHeight = 56
+
+	// Glyph size of basicfont.Face7x13, which text is scaled from.
+	labelGlyphWidth  = 7
+	labelGlyphHeight = 13
+	labelGlyphAscent = 11
+)
+
+type labelCanvas interface {
+	fillRect(x, y, width, height int)
+	drawText(x, y, scale int, text string)
+}
+
+type LabelRenderer struct{}
+
+func NewLabelRenderer() LabelRenderer {
+	return LabelRenderer{}
+}
+
+// RenderPNG renders the label of each order, stacked vertically in a single image.
+func (r LabelRenderer) RenderPNG(orders []domain.Order) ([]byte, error) {
+	labels, err := newOrderLabels(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	canvas := &pngLabelCanvas{
+		img: image.NewGray(image.Rect(0, 0, labelWidth, labelsHeight(len(labels)))),
+	}
+
+	draw.Draw(canvas.img, canvas.img.Bounds(), image.White, image.Point{}, draw.Src)
+	drawLabels(canvas, labels)
+
+	var buf bytes.Buffer
+	if err = png.Encode(&buf, canvas.img); err != nil {
+		return nil, fmt.Errorf("failed to encode label PNG: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RenderSVG renders the label of each order, stacked vertically in a single image.
+func (r LabelRenderer) RenderSVG(orders []domain.Order) ([]byte, error) {
+	labels, err := newOrderLabels(orders)
+	if err != nil {
+		return nil, err
+	}
+
+	height := labelsHeight(len(labels))
+	canvas := &svgLabelCanvas{}
+
+	fmt.Fprintf(
+		&canvas.buf,
+		`<svg xmlns="http://www.w3.org/2000/svg" width="%d" height="%d" viewBox="0 0 %d %d">`,
+		labelWidth, height, labelWidth, height,
+	)
+	fmt.Fprintf(&canvas.buf, `<rect width="%d" height="%d" fill="#fff"/>`, labelWidth, height)
+
+	drawLabels(canvas, labels)
+
+	canvas.buf.WriteString("</svg>")
+
+	return canvas.buf.Bytes(), nil
+}
+
+type orderLabel struct {
+	slug      string
+	initials  string
+	phoneType string
+	color     string
+	qrCode    barcode.Barcode
+	barcode   barcode.Barcode
+}
+
+func newOrderLabels(orders []domain.Order) ([]orderLabel, error) {
+	labels := make([]orderLabel, 0, len(orders))
+
+	for _, order := range orders {
+		qrCode, err := qr.Encode(order.Slug(), qr.M, qr.Auto)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode QR code of %s: %w", order.Slug(), err)
+		}
+
+		code, err := code128.Encode(order.Slug())
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode barcode of %s: %w", order.Slug(), err)
+		}
+
+		labels = append(labels, orderLabel{
+			slug:      order.Slug(),
+			initials:  initials(order.CustomerName()),
+			phoneType: order.PhoneType(),
+			color:     order.Color(),
+			qrCode:    qrCode,
+			barcode:   code,
+		})
+	}
+
+	return labels, nil
+}
+
+func labelsHeight(count int) int {
+	return count*labelHeight + max(count-1, 0)*labelSpacing
+}
+
+func drawLabels(canvas labelCanvas, labels []orderLabel) {
+	for i, label := range labels {
+		top := i * (labelHeight + labelSpacing)
+
+		qrModules := label.qrCode.Bounds().Dx()
+		qrScale := max(labelQRSize/qrModules, 1)
+		drawModules(canvas, label.qrCode, labelMargin, top+labelMargin, qrScale, qrScale)
+
+		textLeft := labelMargin + qrModules*qrScale + labelMargin
+		textColumns := (labelWidth - textLeft - labelMargin) / labelGlyphWidth
+
+		canvas.drawText(textLeft, top+labelMargin, 4, truncate(label.initials, textColumns/4))
+		canvas.drawText(textLeft, top+labelMargin+4*labelGlyphHeight+8, 2, truncate(label.phoneType, textColumns/2))
+		canvas.drawText(textLeft, top+labelMargin+6*labelGlyphHeight+12, 2, truncate(label.color, textColumns/2))
+
+		barcodeModules := label.barcode.Bounds().Dx()
+		barcodeScale := max((labelWidth-2*labelMargin)/barcodeModules, 1)
+		barcodeLeft := (labelWidth - barcodeModules*barcodeScale) / 2
+		barcodeTop := top + labelHeight - labelMargin - labelGlyphHeight - 4 - labelBarcodeHeight
+		drawModules(canvas, label.barcode, barcodeLeft, barcodeTop, barcodeScale, labelBarcodeHeight)
+
+		slugLeft := (labelWidth - len(label.slug)*labelGlyphWidth) / 2
+		canvas.drawText(slugLeft, top+labelHeight-labelMargin-labelGlyphHeight, 1, label.slug)
+	}
+}
+
+// drawModules draws the dark modules of a barcode, merging horizontal runs into a single rectangle.
+func drawModules(canvas labelCanvas, code barcode.Barcode, left, top, moduleWidth, moduleHeight int) {
+	bounds := code.Bounds()
+
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; {
+			if !isDark(code.At(x, y)) {
+				x++
+				continue
+			}
+
+			start := x
+			for x < bounds.Max.X && isDark(code.At(x, y)) {
+				x++
+			}
+
+			canvas.fillRect(
+				left+(start-bounds.Min.X)*moduleWidth,
+				top+(y-bounds.Min.Y)*moduleHeight,
+				(x-start)*moduleWidth,
+				moduleHeight,
+			)
+		}
+	}
+}
+
+func isDark(c color.Color) bool {
+	return color.GrayModel.Convert(c).(color.Gray).Y < 128
+}
+
+func initials(name string) string {
+	const maxInitials = 3
+
+	var letters []rune
+	for _, word := range strings.Fields(name) {
+		if len(letters) == maxInitials {
+			break
+		}
+
+		letters = append(letters, unicode.ToUpper([]rune(word)[0]))
+	}
+
+	return string(letters)
+}
+
+func truncate(text string, columns int) string {
+	runes := []rune(text)
+	if len(runes) <= columns {
+		return text
+	}
+
+	return string(runes[:max(columns, 0)])
+}
+
+type pngLabelCanvas struct {
+	img *image.Gray
+}
+
+func (c *pngLabelCanvas) fillRect(x, y, width, height int) {
+	draw.Draw(c.img, image.Rect(x, y, x+width, y+height), image.Black, image.Point{}, draw.Src)
+}
+
+// drawText draws the text in basicfont.Face7x13 scaled up by an integer factor,
+// which keeps the glyphs crisp on thermal printers.
+func (c *pngLabelCanvas) drawText(x, y, scale int, text string) {
+	if text == "" {
+		return
+	}
+
+	glyphs := image.NewGray(image.Rect(0, 0, len([]rune(text))*labelGlyphWidth, labelGlyphHeight))
+
+	d := font.Drawer{
+		Dst:  glyphs,
+		Src:  image.White,
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(0, labelGlyphAscent),
+	}
+	d.DrawString(text)
+
+	bounds := glyphs.Bounds()
+	for gy := bounds.Min.Y; gy < bounds.Max.Y; gy++ {
+		for gx := bounds.Min.X; gx < bounds.Max.X; gx++ {
+			if glyphs.GrayAt(gx, gy).Y >= 128 {
+				c.fillRect(x+gx*scale, y+gy*scale, scale, scale)
+			}
+		}
+	}
+}
+
+type svgLabelCanvas struct {
+	buf bytes.Buffer
+}
+
+func (c *svgLabelCanvas) fillRect(x, y, width, height int) {
+	fmt.Fprintf(&c.buf, `<rect x="%d" y="%d" width="%d" height="%d"/>`, x, y, width, height)
+}
+
+func (c *svgLabelCanvas) drawText(x, y, scale int, text string) {
+	if text == "" {
+		return
+	}
+
+	length := len([]rune(text)) * labelGlyphWidth * scale
+
+	fmt.Fprintf(
+		&c.buf,
+		`<text x="%d" y="%d" font-family="monospace" font-size="%d" textLength="%d" lengthAdjust="spacingAndGlyphs">`,
+		x, y+labelGlyphAscent*scale, labelGlyphHeight*scale, length,
+	)
+	_ = xml.EscapeText(&c.buf, []byte(text))
+	c.buf.WriteString("</text>")
+}
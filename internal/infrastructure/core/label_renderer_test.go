@@ -0,0 +1,51 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"bytes"
+	"image/png"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRenderLabels(t *testing.T) {
+	t.Parallel()
+
+	renderer := core.NewLabelRenderer()
+
+	t.Run("stacks PNG labels vertically", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestReceipt(t, 58).Order
+
+		got, err := renderer.RenderPNG([]domain.Order{order, order})
+		require.NoError(t, err)
+
+		img, err := png.Decode(bytes.NewReader(got))
+		require.NoError(t, err)
+
+		assert.Equal(t, 400, img.Bounds().Dx())
+		assert.Equal(t, 2*240+16, img.Bounds().Dy())
+	})
+
+	t.Run("renders SVG label with the order details", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestReceipt(t, 58).Order
+
+		got, err := renderer.RenderSVG([]domain.Order{order})
+		require.NoError(t, err)
+
+		assert.True(t, bytes.HasPrefix(got, []byte("<svg ")))
+		assert.Contains(t, string(got), `height="240"`)
+		assert.Contains(t, string(got), ">JD</text>")
+		assert.Contains(t, string(got), ">Phantom Black</text>")
+		assert.Contains(t, string(got), ">R123-45678-9012</text>")
+	})
+}
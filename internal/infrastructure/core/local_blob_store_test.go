@@ -0,0 +1,96 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalBlobStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("stores and returns blobs under nested keys", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		s, err := core.NewLocalBlobStore(dir)
+		require.NoError(t, err)
+
+		require.NoError(t, s.Put(ctx, "photos/store/photo", "image/jpeg", []byte("photo")))
+
+		got, err := s.Get(ctx, "photos/store/photo")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("photo"), got)
+
+		entries, err := os.ReadDir(filepath.Join(dir, "photos", "store"))
+		require.NoError(t, err)
+		assert.Len(t, entries, 1, "temporary file was left behind")
+	})
+
+	t.Run("overwrites existing blobs", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := core.NewLocalBlobStore(t.TempDir())
+		require.NoError(t, err)
+
+		require.NoError(t, s.Put(ctx, "photo", "image/jpeg", []byte("old")))
+		require.NoError(t, s.Put(ctx, "photo", "image/jpeg", []byte("new")))
+
+		got, err := s.Get(ctx, "photo")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("new"), got)
+	})
+
+	t.Run("deletes blobs", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := core.NewLocalBlobStore(t.TempDir())
+		require.NoError(t, err)
+
+		require.NoError(t, s.Put(ctx, "photo", "image/jpeg", []byte("photo")))
+		require.NoError(t, s.Delete(ctx, "photo"))
+
+		_, err = s.Get(ctx, "photo")
+		assert.ErrorIs(t, err, apperror.ErrBlobNotFound)
+	})
+
+	t.Run("returns blob not found when blob does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		s, err := core.NewLocalBlobStore(t.TempDir())
+		require.NoError(t, err)
+
+		_, err = s.Get(ctx, "missing")
+		assert.ErrorIs(t, err, apperror.ErrBlobNotFound)
+
+		err = s.Delete(ctx, "missing")
+		assert.ErrorIs(t, err, apperror.ErrBlobNotFound)
+	})
+
+	t.Run("rejects keys outside of the directory", func(t *testing.T) {
+		t.Parallel()
+
+		dir := t.TempDir()
+		s, err := core.NewLocalBlobStore(filepath.Join(dir, "blobs"))
+		require.NoError(t, err)
+
+		for _, key := range []string{"", "../escaped", "photos/../../escaped", "/etc/passwd"} {
+			err = s.Put(ctx, key, "image/jpeg", []byte("photo"))
+			assert.ErrorIs(t, err, apperror.ErrInvalidInput, "key %q", key)
+		}
+
+		_, err = os.Stat(filepath.Join(dir, "escaped"))
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+}
@@ -0,0 +1,36 @@
+package core
+
+import (
+	"crypto/rand"
+	"fmt"
+	"math/big"
+)
+
+// Ambiguous characters (0/O, 1/I/L) are left out since codes are read out
+// to employees.
+const (
+	loginCodeAlphabet = "ABCDEFGHJKMNPQRSTUVWXYZ23456789"
+	loginCodeLength   = 8
+)
+
+type loginCodeGenerator struct{}
+
+func (g loginCodeGenerator) Generate() (string, error) {
+	return randomCode(loginCodeLength)
+}
+
+func randomCode(length int) (string, error) {
+	code := make([]byte, length)
+	alphabetSize := big.NewInt(int64(len(loginCodeAlphabet)))
+
+	for i := range code {
+		n, err := rand.Int(rand.Reader, alphabetSize)
+		if err != nil {
+			return "", fmt.Errorf("failed to generate random number: %w", err)
+		}
+
+		code[i] = loginCodeAlphabet[n.Int64()]
+	}
+
+	return string(code), nil
+}
@@ -0,0 +1,314 @@
+package core
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"image"
+	"image/jpeg"
+	_ "image/png" // Registers the PNG decoder.
+	"net/http"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/modules/photo"
+	"golang.org/x/image/draw"
+)
+
+const (
+	photoThumbnailSize    = 320
+	photoThumbnailQuality = 80
+
+	// photoMaxPixels guards against images that are small on disk but decode
+	// into a huge bitmap.
+	photoMaxPixels = 50_000_000
+)
+
+const (
+	tiffTagGPSInfo = 0x8825
+
+	jpegMarkerSOS  = 0xda
+	jpegMarkerEOI  = 0xd9
+	jpegMarkerAPP1 = 0xe1
+)
+
+var (
+	exifHeader = []byte("Exif\x00\x00")
+	xmpHeader  = []byte("http://ns.adobe.com/xap/1.0/\x00")
+	pngHeader  = []byte("\x89PNG\r\n\x1a\n")
+)
+
+// PhotoProcessor accepts JPEG and PNG photos. Location data is removed from
+// the photo before it is stored: the GPS directory of the EXIF metadata is
+// emptied and XMP packets, which can repeat it, are dropped.
+type PhotoProcessor struct{}
+
+func NewPhotoProcessor() PhotoProcessor {
+	return PhotoProcessor{}
+}
+
+func (p PhotoProcessor) Process(data []byte) (photo.ProcessedImage, error) {
+	contentType := http.DetectContentType(data)
+
+	var (
+		stripped []byte
+		err      error
+	)
+
+	switch contentType {
+	case photo.ContentTypeJPEG:
+		stripped, err = stripJPEGLocation(data)
+	case photo.ContentTypePNG:
+		stripped, err = stripPNGLocation(data)
+	default:
+		return photo.ProcessedImage{}, fmt.Errorf("%w: unsupported content type %s", apperror.ErrInvalidInput, contentType)
+	}
+
+	if err != nil {
+		return photo.ProcessedImage{}, err
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(stripped))
+	if err != nil {
+		return photo.ProcessedImage{}, fmt.Errorf("%w: failed to decode image: %s", apperror.ErrInvalidInput, err.Error())
+	}
+
+	if config.Width*config.Height > photoMaxPixels {
+		return photo.ProcessedImage{}, fmt.Errorf("%w: image dimensions are too large", apperror.ErrInvalidInput)
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(stripped))
+	if err != nil {
+		return photo.ProcessedImage{}, fmt.Errorf("%w: failed to decode image: %s", apperror.ErrInvalidInput, err.Error())
+	}
+
+	thumbnail, err := encodeThumbnail(img)
+	if err != nil {
+		return photo.ProcessedImage{}, err
+	}
+
+	return photo.ProcessedImage{
+		ContentType: contentType,
+		Data:        stripped,
+		Thumbnail:   thumbnail,
+	}, nil
+}
+
+func encodeThumbnail(img image.Image) ([]byte, error) {
+	bounds := img.Bounds()
+	width, height := bounds.Dx(), bounds.Dy()
+
+	if width > photoThumbnailSize || height > photoThumbnailSize {
+		if width >= height {
+			height = max(1, height*photoThumbnailSize/width)
+			width = photoThumbnailSize
+		} else {
+			width = max(1, width*photoThumbnailSize/height)
+			height = photoThumbnailSize
+		}
+	}
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+
+	var buf bytes.Buffer
+	if err := jpeg.Encode(&buf, dst, &jpeg.Options{Quality: photoThumbnailQuality}); err != nil {
+		return nil, fmt.Errorf("failed to encode thumbnail: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func stripJPEGLocation(data []byte) ([]byte, error) {
+	if len(data) < 2 || data[0] != 0xff || data[1] != 0xd8 {
+		return nil, fmt.Errorf("%w: not a JPEG image", apperror.ErrInvalidInput)
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, data[:2]...)
+
+	pos := 2
+	for {
+		if pos+4 > len(data) || data[pos] != 0xff {
+			return nil, fmt.Errorf("%w: malformed JPEG segment", apperror.ErrInvalidInput)
+		}
+
+		marker := data[pos+1]
+		if marker == jpegMarkerSOS || marker == jpegMarkerEOI {
+			// Everything from here on is image data.
+			return append(out, data[pos:]...), nil
+		}
+
+		length := int(binary.BigEndian.Uint16(data[pos+2:]))
+		end := pos + 2 + length
+
+		if length < 2 || end > len(data) {
+			return nil, fmt.Errorf("%w: malformed JPEG segment", apperror.ErrInvalidInput)
+		}
+
+		segment := data[pos:end]
+		payload := segment[4:]
+
+		switch {
+		case marker == jpegMarkerAPP1 && bytes.HasPrefix(payload, xmpHeader):
+			// Dropped.
+		case marker == jpegMarkerAPP1 && bytes.HasPrefix(payload, exifHeader):
+			segment = bytes.Clone(segment)
+			if !stripTIFFLocation(segment[4+len(exifHeader):]) {
+				// Unreadable EXIF data can't be cleaned, so drop all of it.
+				break
+			}
+
+			out = append(out, segment...)
+		default:
+			out = append(out, segment...)
+		}
+
+		pos = end
+	}
+}
+
+func stripPNGLocation(data []byte) ([]byte, error) {
+	if !bytes.HasPrefix(data, pngHeader) {
+		return nil, fmt.Errorf("%w: not a PNG image", apperror.ErrInvalidInput)
+	}
+
+	out := make([]byte, 0, len(data))
+	out = append(out, pngHeader...)
+
+	pos := len(pngHeader)
+	for pos < len(data) {
+		if pos+8 > len(data) {
+			return nil, fmt.Errorf("%w: malformed PNG chunk", apperror.ErrInvalidInput)
+		}
+
+		length := int(binary.BigEndian.Uint32(data[pos:]))
+		end := pos + 12 + length
+
+		if end > len(data) {
+			return nil, fmt.Errorf("%w: malformed PNG chunk", apperror.ErrInvalidInput)
+		}
+
+		chunkType := string(data[pos+4 : pos+8])
+		chunk := data[pos:end]
+
+		switch {
+		case chunkType == "iTXt" && bytes.HasPrefix(chunk[8:], []byte("XML:com.adobe.xmp\x00")):
+			// Dropped.
+		case chunkType == "eXIf":
+			chunk = bytes.Clone(chunk)
+			if !stripTIFFLocation(chunk[8 : 8+length]) {
+				break
+			}
+
+			binary.BigEndian.PutUint32(chunk[8+length:], crc32.ChecksumIEEE(chunk[4:8+length]))
+			out = append(out, chunk...)
+		default:
+			out = append(out, chunk...)
+		}
+
+		pos = end
+	}
+
+	return out, nil
+}
+
+// stripTIFFLocation empties the GPS directory of the EXIF data, which is laid
+// out as a TIFF file, in place. It returns false if the data can't be read.
+func stripTIFFLocation(tiff []byte) bool {
+	if len(tiff) < 8 {
+		return false
+	}
+
+	var order binary.ByteOrder
+
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return false
+	}
+
+	ifd0 := int(order.Uint32(tiff[4:]))
+	entries, ok := tiffEntries(tiff, order, ifd0)
+	if !ok {
+		return false
+	}
+
+	for _, entry := range entries {
+		if order.Uint16(entry) != tiffTagGPSInfo {
+			continue
+		}
+
+		gps := int(order.Uint32(entry[8:]))
+		gpsEntries, gpsOK := tiffEntries(tiff, order, gps)
+		if !gpsOK {
+			return false
+		}
+
+		for _, gpsEntry := range gpsEntries {
+			if offset, size, external := tiffEntryData(order, gpsEntry); external {
+				if offset+size > len(tiff) || offset+size < offset {
+					return false
+				}
+
+				clear(tiff[offset : offset+size])
+			}
+
+			clear(gpsEntry)
+		}
+
+		order.PutUint16(tiff[gps:], 0)
+	}
+
+	return true
+}
+
+func tiffEntries(tiff []byte, order binary.ByteOrder, offset int) ([][]byte, bool) {
+	if offset < 8 || offset+2 > len(tiff) {
+		return nil, false
+	}
+
+	count := int(order.Uint16(tiff[offset:]))
+	start := offset + 2
+
+	if start+count*12 > len(tiff) {
+		return nil, false
+	}
+
+	entries := make([][]byte, 0, count)
+	for i := 0; i < count; i++ {
+		entries = append(entries, tiff[start+i*12:start+(i+1)*12])
+	}
+
+	return entries, true
+}
+
+// tiffEntryData returns where the value of an entry is stored when it doesn't
+// fit in the entry itself.
+func tiffEntryData(order binary.ByteOrder, entry []byte) (int, int, bool) {
+	var unitSize int
+
+	switch order.Uint16(entry[2:]) {
+	case 1, 2, 6, 7:
+		unitSize = 1
+	case 3, 8:
+		unitSize = 2
+	case 4, 9, 11:
+		unitSize = 4
+	case 5, 10, 12:
+		unitSize = 8
+	default:
+		return 0, 0, false
+	}
+
+	size := unitSize * int(order.Uint32(entry[4:]))
+	if size <= 4 {
+		return 0, 0, false
+	}
+
+	return int(order.Uint32(entry[8:])), size, true
+}
@@ -0,0 +1,220 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/jpeg"
+	"image/png"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/JosephJoshua/remana-backend/internal/modules/photo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhotoProcessor(t *testing.T) {
+	t.Parallel()
+
+	p := core.NewPhotoProcessor()
+
+	t.Run("removes GPS data from JPEG EXIF but keeps the rest", func(t *testing.T) {
+		t.Parallel()
+
+		exif := append([]byte("Exif\x00\x00"), newTestTIFFWithGPS()...)
+		data := insertJPEGSegment(newTestJPEG(t, 640, 480), 0xe1, exif)
+		require.True(t, bytes.Contains(data, testGPSLatitude))
+
+		got, err := p.Process(data)
+		require.NoError(t, err)
+
+		assert.Equal(t, photo.ContentTypeJPEG, got.ContentType)
+		assert.False(t, bytes.Contains(got.Data, testGPSLatitude), "GPS latitude wasn't removed")
+		assert.True(t, bytes.Contains(got.Data, []byte("Exif\x00\x00II")), "EXIF segment was removed")
+
+		_, err = jpeg.Decode(bytes.NewReader(got.Data))
+		require.NoError(t, err)
+	})
+
+	t.Run("removes XMP packets from JPEG", func(t *testing.T) {
+		t.Parallel()
+
+		xmp := []byte("http://ns.adobe.com/xap/1.0/\x00<x:xmpmeta>exif:GPSLatitude</x:xmpmeta>")
+		data := insertJPEGSegment(newTestJPEG(t, 64, 64), 0xe1, xmp)
+
+		got, err := p.Process(data)
+		require.NoError(t, err)
+
+		assert.False(t, bytes.Contains(got.Data, []byte("GPSLatitude")))
+	})
+
+	t.Run("removes GPS data from PNG eXIf chunk", func(t *testing.T) {
+		t.Parallel()
+
+		data := insertPNGChunk(newTestPNG(t, 640, 480), "eXIf", newTestTIFFWithGPS())
+		require.True(t, bytes.Contains(data, testGPSLatitude))
+
+		got, err := p.Process(data)
+		require.NoError(t, err)
+
+		assert.Equal(t, photo.ContentTypePNG, got.ContentType)
+		assert.False(t, bytes.Contains(got.Data, testGPSLatitude), "GPS latitude wasn't removed")
+		assert.True(t, bytes.Contains(got.Data, []byte("eXIf")), "eXIf chunk was removed")
+
+		_, err = png.Decode(bytes.NewReader(got.Data))
+		require.NoError(t, err, "chunk checksum wasn't updated")
+	})
+
+	t.Run("creates a JPEG thumbnail that keeps the aspect ratio", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := p.Process(newTestPNG(t, 640, 480))
+		require.NoError(t, err)
+
+		thumbnail, err := jpeg.Decode(bytes.NewReader(got.Thumbnail))
+		require.NoError(t, err)
+
+		assert.Equal(t, 320, thumbnail.Bounds().Dx())
+		assert.Equal(t, 240, thumbnail.Bounds().Dy())
+	})
+
+	t.Run("doesn't enlarge small photos", func(t *testing.T) {
+		t.Parallel()
+
+		got, err := p.Process(newTestJPEG(t, 100, 50))
+		require.NoError(t, err)
+
+		thumbnail, err := jpeg.Decode(bytes.NewReader(got.Thumbnail))
+		require.NoError(t, err)
+
+		assert.Equal(t, 100, thumbnail.Bounds().Dx())
+		assert.Equal(t, 50, thumbnail.Bounds().Dy())
+	})
+
+	t.Run("returns invalid input error", func(t *testing.T) {
+		t.Parallel()
+
+		var gifData bytes.Buffer
+		require.NoError(t, gif.Encode(&gifData, image.NewPaletted(image.Rect(0, 0, 8, 8), color.Palette{color.Black}), nil))
+
+		jpegData := newTestJPEG(t, 64, 64)
+
+		tests := []struct {
+			name string
+			data []byte
+		}{
+			{name: "when photo is not an image", data: []byte("definitely not an image")},
+			{name: "when photo is a GIF", data: gifData.Bytes()},
+			{name: "when photo is truncated", data: jpegData[:len(jpegData)/2]},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := p.Process(tc.data)
+				assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+			})
+		}
+	})
+}
+
+var testGPSLatitude = []byte{
+	0x30, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00,
+	0x33, 0x00, 0x00, 0x00, 0x01, 0x00, 0x00, 0x00,
+	0x76, 0x09, 0x00, 0x00, 0x64, 0x00, 0x00, 0x00,
+}
+
+// newTestTIFFWithGPS returns little-endian EXIF data with an orientation tag
+// and a GPS directory holding a latitude stored outside of its entry.
+func newTestTIFFWithGPS() []byte {
+	const (
+		ifd0Offset     = 8
+		gpsOffset      = ifd0Offset + 2 + 2*12 + 4
+		latitudeOffset = gpsOffset + 2 + 2*12 + 4
+	)
+
+	le := binary.LittleEndian
+	entry := func(tag uint16, typ uint16, count uint32, value uint32) []byte {
+		b := make([]byte, 12)
+		le.PutUint16(b, tag)
+		le.PutUint16(b[2:], typ)
+		le.PutUint32(b[4:], count)
+		le.PutUint32(b[8:], value)
+
+		return b
+	}
+
+	tiff := []byte{'I', 'I', 0x2a, 0x00, ifd0Offset, 0, 0, 0}
+
+	tiff = append(tiff, 2, 0)
+	tiff = append(tiff, entry(0x0112, 3, 1, 6)...)
+	tiff = append(tiff, entry(0x8825, 4, 1, gpsOffset)...)
+	tiff = append(tiff, 0, 0, 0, 0)
+
+	tiff = append(tiff, 2, 0)
+	tiff = append(tiff, entry(0x0001, 2, 2, 'N')...)
+	tiff = append(tiff, entry(0x0002, 5, 3, latitudeOffset)...)
+	tiff = append(tiff, 0, 0, 0, 0)
+
+	return append(tiff, testGPSLatitude...)
+}
+
+func newTestImage(width int, height int) image.Image {
+	img := image.NewRGBA(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.Set(x, x%height, color.RGBA{R: 255, A: 255})
+	}
+
+	return img
+}
+
+func newTestJPEG(t *testing.T, width int, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, jpeg.Encode(&buf, newTestImage(width, height), nil))
+
+	return buf.Bytes()
+}
+
+func newTestPNG(t *testing.T, width int, height int) []byte {
+	var buf bytes.Buffer
+	require.NoError(t, png.Encode(&buf, newTestImage(width, height)))
+
+	return buf.Bytes()
+}
+
+// insertJPEGSegment inserts a segment right after the start of image marker.
+func insertJPEGSegment(data []byte, marker byte, payload []byte) []byte {
+	segment := []byte{0xff, marker, 0, 0}
+	binary.BigEndian.PutUint16(segment[2:], uint16(len(payload)+2))
+	segment = append(segment, payload...)
+
+	out := append([]byte{}, data[:2]...)
+	out = append(out, segment...)
+
+	return append(out, data[2:]...)
+}
+
+// insertPNGChunk inserts a chunk right after the IHDR chunk.
+func insertPNGChunk(data []byte, chunkType string, payload []byte) []byte {
+	const ihdrEnd = 8 + 12 + 13
+
+	chunk := make([]byte, 4, 12+len(payload))
+	binary.BigEndian.PutUint32(chunk, uint32(len(payload)))
+	chunk = append(chunk, chunkType...)
+	chunk = append(chunk, payload...)
+	chunk = binary.BigEndian.AppendUint32(chunk, crc32.ChecksumIEEE(chunk[4:]))
+
+	out := append([]byte{}, data[:ihdrEnd]...)
+	out = append(out, chunk...)
+
+	return append(out, data[ihdrEnd:]...)
+}
@@ -0,0 +1,83 @@
+package core
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	publicOrderIPRateLimit    = 30
+	publicOrderIPRateWindow   = time.Minute
+	publicOrderSlugRateLimit  = 10
+	publicOrderSlugRateWindow = 15 * time.Minute
+)
+
+type TimeProvider interface {
+	Now() time.Time
+}
+
+// RateLimiter allows up to limit calls per key in each fixed window. State is
+// kept in memory, so it only enforces the limit when the server runs as a
+// single instance. Behind a load balancer each instance limits separately,
+// which multiplies the effective limit by the number of instances.
+type RateLimiter struct {
+	mu           sync.Mutex
+	timeProvider TimeProvider
+	limit        int
+	window       time.Duration
+	windows      map[string]rateLimitWindow
+	lastPrune    time.Time
+}
+
+type rateLimitWindow struct {
+	start time.Time
+	count int
+}
+
+func NewRateLimiter(limit int, window time.Duration, timeProvider TimeProvider) *RateLimiter {
+	return &RateLimiter{
+		timeProvider: timeProvider,
+		limit:        limit,
+		window:       window,
+		windows:      make(map[string]rateLimitWindow),
+		lastPrune:    timeProvider.Now(),
+	}
+}
+
+func (r *RateLimiter) Allow(key string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	now := r.timeProvider.Now()
+	r.prune(now)
+
+	w, ok := r.windows[key]
+	if !ok || !now.Before(w.start.Add(r.window)) {
+		w = rateLimitWindow{start: now}
+	}
+
+	if w.count >= r.limit {
+		return false
+	}
+
+	w.count++
+	r.windows[key] = w
+
+	return true
+}
+
+// prune drops expired windows at most once per window so that keys which are
+// never seen again don't pile up.
+func (r *RateLimiter) prune(now time.Time) {
+	if now.Before(r.lastPrune.Add(r.window)) {
+		return
+	}
+
+	for key, w := range r.windows {
+		if !now.Before(w.start.Add(r.window)) {
+			delete(r.windows, key)
+		}
+	}
+
+	r.lastPrune = now
+}
@@ -0,0 +1,73 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	const (
+		theLimit  = 3
+		theWindow = time.Minute
+	)
+
+	t.Run("allows up to the limit in a window", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &clockStub{now: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)}
+		limiter := core.NewRateLimiter(theLimit, theWindow, clock)
+
+		for range theLimit {
+			assert.True(t, limiter.Allow("a"))
+		}
+
+		assert.False(t, limiter.Allow("a"))
+	})
+
+	t.Run("limits each key separately", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &clockStub{now: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)}
+		limiter := core.NewRateLimiter(theLimit, theWindow, clock)
+
+		for range theLimit {
+			limiter.Allow("a")
+		}
+
+		assert.False(t, limiter.Allow("a"))
+		assert.True(t, limiter.Allow("b"))
+	})
+
+	t.Run("allows again once the window has passed", func(t *testing.T) {
+		t.Parallel()
+
+		clock := &clockStub{now: time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)}
+		limiter := core.NewRateLimiter(theLimit, theWindow, clock)
+
+		for range theLimit {
+			limiter.Allow("a")
+		}
+
+		clock.now = clock.now.Add(theWindow - time.Second)
+		assert.False(t, limiter.Allow("a"))
+
+		clock.now = clock.now.Add(time.Second)
+		assert.True(t, limiter.Allow("a"))
+	})
+}
+
+type clockStub struct {
+	now time.Time
+}
+
+func (c *clockStub) Now() time.Time {
+	return c.now
+}
@@ -0,0 +1,420 @@
+package core
+
+import (
+	"bufio"
+	"bytes"
+	"embed"
+	"fmt"
+	htmltemplate "html/template"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/escpos"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+	"github.com/jung-kurt/gofpdf"
+)
+
+//go:embed templates/receipt.html.tmpl templates/receipt.pdf.tmpl
+var receiptTemplates embed.FS
+
+// receiptTemplateData is the data available to receipt templates, including per-store overrides.
+type receiptTemplateData struct {
+	Title          string
+	GenerationTime time.Time
+	Store          struct {
+		Name        string
+		Address     string
+		PhoneNumber string
+	}
+	WarrantyTerms      string
+	Slug               string
+	CreationTime       time.Time
+	PickUpTime         *time.Time
+	CancellationTime   *time.Time
+	CancellationReason string
+	CustomerName       string
+	ContactNumber      string
+	PhoneType          string
+	Color              string
+	IMEI               string
+	PartsNotCheckedYet string
+	Damages            []string
+	PhoneConditions    []string
+	PhoneEquipments    []string
+	Costs              []receiptTemplateCost
+	Payments           []receiptTemplatePayment
+	Notes              []receiptTemplateNote
+	TotalCost          int
+	PaidAmount         int
+	OutstandingAmount  int
+	CancellationFee    int
+	WriteOffAmount     int
+}
+
+type receiptTemplateCost struct {
+	Amount       int
+	Reason       string
+	IsInitial    bool
+	CreationTime time.Time
+}
+
+type receiptTemplatePayment struct {
+	Type         string
+	Amount       int
+	Reason       string
+	IsRefund     bool
+	CreationTime time.Time
+}
+
+type receiptTemplateNote struct {
+	Body         string
+	CreationTime time.Time
+}
+
+var receiptTemplateFuncs = map[string]any{
+	"amount":   formatReceiptAmount,
+	"date":     func(t time.Time) string { return t.Format("02 Jan 2006") },
+	"datetime": func(t time.Time) string { return t.Format("02 Jan 2006 15:04") },
+	"join":     strings.Join,
+}
+
+type ReceiptRenderer struct {
+	defaultHTML *htmltemplate.Template
+	defaultPDF  *texttemplate.Template
+}
+
+func NewReceiptRenderer() (ReceiptRenderer, error) {
+	defaultHTML, err := htmltemplate.New("receipt.html.tmpl").
+		Funcs(receiptTemplateFuncs).
+		ParseFS(receiptTemplates, "templates/receipt.html.tmpl")
+	if err != nil {
+		return ReceiptRenderer{}, fmt.Errorf("failed to parse default HTML receipt template: %w", err)
+	}
+
+	defaultPDF, err := texttemplate.New("receipt.pdf.tmpl").
+		Funcs(receiptTemplateFuncs).
+		ParseFS(receiptTemplates, "templates/receipt.pdf.tmpl")
+	if err != nil {
+		return ReceiptRenderer{}, fmt.Errorf("failed to parse default PDF receipt template: %w", err)
+	}
+
+	return ReceiptRenderer{
+		defaultHTML: defaultHTML,
+		defaultPDF:  defaultPDF,
+	}, nil
+}
+
+func (r ReceiptRenderer) RenderHTML(receipt readmodel.RepairOrderReceipt) ([]byte, error) {
+	tmpl := r.defaultHTML
+
+	if override := receipt.Store.HTMLTemplate; override.IsSet() {
+		var err error
+
+		tmpl, err = htmltemplate.New("receipt").Funcs(receiptTemplateFuncs).Parse(override.MustGet())
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse store HTML receipt template: %w", err)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, newReceiptTemplateData(receipt)); err != nil {
+		return nil, fmt.Errorf("failed to execute HTML receipt template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RenderPDF lays out the output of the line-based receipt template, see receiptLayout.
+func (r ReceiptRenderer) RenderPDF(receipt readmodel.RepairOrderReceipt) ([]byte, error) {
+	text, err := r.executeLayoutTemplate(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	const (
+		margin     = 10.0
+		lineHeight = 5.0
+	)
+
+	pdf := gofpdf.New("P", "mm", "A5", "")
+	pdf.SetMargins(margin, margin, margin)
+	pdf.SetAutoPageBreak(true, margin)
+	pdf.SetCreationDate(receipt.GenerationTime)
+	pdf.SetTitle(fmt.Sprintf("%s %s", receiptTitle(receipt.Order), receipt.Order.Slug()), true)
+	pdf.AddPage()
+
+	// Core fonts only cover cp1252, which keeps the output free of external font files.
+	tr := pdf.UnicodeTranslatorFromDescriptor("")
+	pageWidth, _ := pdf.GetPageSize()
+	contentWidth := pageWidth - 2*margin
+
+	err = layOutReceipt(text, receiptLayout{
+		blank: func() {
+			pdf.Ln(lineHeight / 2)
+		},
+		heading: func(text string) {
+			pdf.SetFont("Helvetica", "B", 14)
+			pdf.MultiCell(contentWidth, lineHeight+2, tr(text), "", "C", false)
+		},
+		title: func(text string) {
+			pdf.SetFont("Helvetica", "B", 10)
+			pdf.Ln(lineHeight / 2)
+			pdf.MultiCell(contentWidth, lineHeight, tr(text), "", "L", false)
+		},
+		rule: func() {
+			y := pdf.GetY() + lineHeight/4
+			pdf.Line(margin, y, pageWidth-margin, y)
+			pdf.Ln(lineHeight / 2)
+		},
+		row: func(label string, value string) {
+			valueWidth := contentWidth / 2
+
+			pdf.SetFont("Helvetica", "", 9)
+			pdf.CellFormat(contentWidth-valueWidth, lineHeight, tr(label), "", 0, "L", false, 0, "")
+			pdf.CellFormat(valueWidth, lineHeight, tr(value), "", 1, "R", false, 0, "")
+		},
+		text: func(text string) {
+			pdf.SetFont("Helvetica", "", 9)
+			pdf.MultiCell(contentWidth, lineHeight, tr(text), "", "C", false)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err = pdf.Output(&buf); err != nil {
+		return nil, fmt.Errorf("failed to write PDF receipt: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// RenderESCPOS lays out the same line-based template as RenderPDF for the
+// store's thermal printer and ends the ticket with a QR code of the slug.
+func (r ReceiptRenderer) RenderESCPOS(receipt readmodel.RepairOrderReceipt) ([]byte, error) {
+	width, err := escpos.NewPaperWidth(receipt.Store.PaperWidth)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receipt paper width: %w", err)
+	}
+
+	text, err := r.executeLayoutTemplate(receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	doc := escpos.NewDocument(width)
+
+	err = layOutReceipt(text, receiptLayout{
+		blank:   func() { doc.Feed(1) },
+		heading: doc.Heading,
+		title:   doc.Title,
+		rule:    doc.Rule,
+		row:     doc.Row,
+		text:    doc.Text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	doc.Feed(1)
+	doc.QRCode(receipt.Order.Slug())
+	doc.Text(receipt.Order.Slug())
+	doc.Feed(3)
+	doc.Cut()
+
+	return doc.Bytes(), nil
+}
+
+func (r ReceiptRenderer) executeLayoutTemplate(receipt readmodel.RepairOrderReceipt) (string, error) {
+	tmpl := r.defaultPDF
+
+	if override := receipt.Store.PDFTemplate; override.IsSet() {
+		var err error
+
+		tmpl, err = texttemplate.New("receipt").Funcs(receiptTemplateFuncs).Parse(override.MustGet())
+		if err != nil {
+			return "", fmt.Errorf("failed to parse store PDF receipt template: %w", err)
+		}
+	}
+
+	var text strings.Builder
+	if err := tmpl.Execute(&text, newReceiptTemplateData(receipt)); err != nil {
+		return "", fmt.Errorf("failed to execute PDF receipt template: %w", err)
+	}
+
+	return text.String(), nil
+}
+
+// receiptLayout receives each line of the line-based receipt template output:
+// "# " starts a heading, "## " a section title, "---" draws a rule, and
+// "label | value" is a row with the value aligned to the right.
+type receiptLayout struct {
+	blank   func()
+	heading func(text string)
+	title   func(text string)
+	rule    func()
+	row     func(label string, value string)
+	text    func(text string)
+}
+
+func layOutReceipt(text string, layout receiptLayout) error {
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case line == "":
+			layout.blank()
+
+		case strings.HasPrefix(line, "## "):
+			layout.title(strings.TrimPrefix(line, "## "))
+
+		case strings.HasPrefix(line, "# "):
+			layout.heading(strings.TrimPrefix(line, "# "))
+
+		case line == "---":
+			layout.rule()
+
+		case strings.Contains(line, " | "):
+			label, value, _ := strings.Cut(line, " | ")
+			layout.row(label, value)
+
+		default:
+			layout.text(line)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return fmt.Errorf("failed to read receipt template output: %w", err)
+	}
+
+	return nil
+}
+
+func newReceiptTemplateData(receipt readmodel.RepairOrderReceipt) receiptTemplateData {
+	order := receipt.Order
+
+	data := receiptTemplateData{
+		Title:             receiptTitle(order),
+		GenerationTime:    receipt.GenerationTime,
+		Slug:              order.Slug(),
+		CreationTime:      order.CreationTime(),
+		CustomerName:      order.CustomerName(),
+		ContactNumber:     order.ContactNumber().Value(),
+		PhoneType:         order.PhoneType(),
+		Color:             order.Color(),
+		TotalCost:         order.TotalCost(),
+		PaidAmount:        order.PaidAmount(),
+		OutstandingAmount: order.OutstandingAmount(),
+	}
+
+	data.Store.Name = receipt.Store.Name
+	data.Store.Address = receipt.Store.Address
+	data.Store.PhoneNumber = receipt.Store.PhoneNumber
+
+	warrantyTerms := receipt.Store.WarrantyTerms
+	data.WarrantyTerms = warrantyTerms.GetOrElse("")
+
+	imei := order.IMEI()
+	data.IMEI = imei.GetOrElse("")
+
+	partsNotCheckedYet := order.PartsNotCheckedYet()
+	data.PartsNotCheckedYet = partsNotCheckedYet.GetOrElse("")
+
+	if pickUpTime := order.PickUpTime(); pickUpTime.IsSet() {
+		t := pickUpTime.MustGet()
+		data.PickUpTime = &t
+	}
+
+	if cancellationTime := order.CancellationTime(); cancellationTime.IsSet() {
+		t := cancellationTime.MustGet()
+		data.CancellationTime = &t
+
+		reason := order.CancellationReason()
+		data.CancellationReason = reason.GetOrElse("")
+	}
+
+	if fee := order.CancellationFee(); fee.IsSet() {
+		data.CancellationFee = int(fee.MustGet())
+	}
+
+	if writeOff := order.WriteOff(); writeOff.IsSet() {
+		data.WriteOffAmount = writeOff.MustGet().Amount()
+	}
+
+	for _, damage := range order.Damages() {
+		data.Damages = append(data.Damages, damage.Name())
+	}
+
+	for _, condition := range order.PhoneConditions() {
+		data.PhoneConditions = append(data.PhoneConditions, condition.Name())
+	}
+
+	for _, equipment := range order.PhoneEquipments() {
+		data.PhoneEquipments = append(data.PhoneEquipments, equipment.Name())
+	}
+
+	for _, cost := range order.Costs() {
+		reason := cost.Reason()
+
+		data.Costs = append(data.Costs, receiptTemplateCost{
+			Amount:       cost.Amount(),
+			Reason:       reason.GetOrElse(""),
+			IsInitial:    cost.IsInitial(),
+			CreationTime: cost.CreationTime(),
+		})
+	}
+
+	for _, payment := range order.Payments() {
+		reason := payment.Reason()
+
+		data.Payments = append(data.Payments, receiptTemplatePayment{
+			Type:         strings.ReplaceAll(string(payment.Type()), "_", " "),
+			Amount:       int(payment.Amount()),
+			Reason:       reason.GetOrElse(""),
+			IsRefund:     payment.Type() == domain.OrderPaymentTypeRefund,
+			CreationTime: payment.CreationTime(),
+		})
+	}
+
+	for _, note := range receipt.Notes {
+		data.Notes = append(data.Notes, receiptTemplateNote{
+			Body:         note.Body(),
+			CreationTime: note.CreationTime(),
+		})
+	}
+
+	return data
+}
+
+func receiptTitle(order domain.Order) string {
+	if order.Status() == domain.OrderStatusPickedUp {
+		return "Invoice"
+	}
+
+	return "Receipt"
+}
+
+func formatReceiptAmount(amount int) string {
+	digits := strconv.Itoa(amount)
+
+	sign := ""
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteRune(',')
+		}
+
+		b.WriteRune(d)
+	}
+
+	return sign + b.String()
+}
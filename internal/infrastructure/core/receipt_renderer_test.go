@@ -0,0 +1,160 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/escpos"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+	shareddomain "github.com/JosephJoshua/remana-backend/internal/modules/shared/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var updateGolden = flag.Bool("update", false, "update golden files")
+
+func TestRenderESCPOS(t *testing.T) {
+	t.Parallel()
+
+	renderer, err := core.NewReceiptRenderer()
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		paperWidth escpos.PaperWidth
+		golden     string
+	}{
+		{name: "58mm paper", paperWidth: escpos.PaperWidth58mm, golden: "receipt_58mm.escpos.golden"},
+		{name: "80mm paper", paperWidth: escpos.PaperWidth80mm, golden: "receipt_80mm.escpos.golden"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, renderErr := renderer.RenderESCPOS(newTestReceipt(t, int(tc.paperWidth)))
+			require.NoError(t, renderErr)
+
+			path := filepath.Join("testdata", tc.golden)
+			if *updateGolden {
+				require.NoError(t, os.WriteFile(path, got, 0o600))
+			}
+
+			want, readErr := os.ReadFile(path)
+			require.NoError(t, readErr)
+
+			assert.True(t, bytes.Equal(want, got), "output does not match %s, run with -update to regenerate it", path)
+		})
+	}
+
+	t.Run("encodes the slug in a QR code", func(t *testing.T) {
+		t.Parallel()
+
+		got, renderErr := renderer.RenderESCPOS(newTestReceipt(t, int(escpos.PaperWidth58mm)))
+		require.NoError(t, renderErr)
+
+		// GS ( k storing the slug in the symbol storage area.
+		slug := "R123-45678-9012"
+		store := append([]byte{0x1d, '(', 'k', byte(len(slug) + 3), 0, '1', 'P', '0'}, slug...)
+		assert.True(t, bytes.Contains(got, store))
+	})
+
+	t.Run("returns error when paper width is not supported", func(t *testing.T) {
+		t.Parallel()
+
+		_, renderErr := renderer.RenderESCPOS(newTestReceipt(t, 72))
+		assert.ErrorIs(t, renderErr, escpos.ErrUnsupportedPaperWidth)
+	})
+
+	t.Run("uses the store template", func(t *testing.T) {
+		t.Parallel()
+
+		receipt := newTestReceipt(t, int(escpos.PaperWidth58mm))
+		receipt.Store.PDFTemplate = optional.Some("## Ticket {{ .Slug }}")
+
+		got, renderErr := renderer.RenderESCPOS(receipt)
+		require.NoError(t, renderErr)
+
+		assert.True(t, bytes.Contains(got, []byte("Ticket R123-45678-9012\n")))
+		assert.False(t, bytes.Contains(got, []byte("Some Store")))
+	})
+
+	t.Run("prints the notes", func(t *testing.T) {
+		t.Parallel()
+
+		receipt := newTestReceipt(t, int(escpos.PaperWidth80mm))
+		receipt.Notes = []domain.OrderNote{
+			domain.RestoreOrderNote(domain.RestoreOrderNoteParams{
+				ID:           uuid.New(),
+				OrderID:      receipt.Order.ID(),
+				AuthorID:     uuid.New(),
+				Body:         "Screen ordered",
+				Visibility:   domain.OrderNoteVisibilityCustomer,
+				CreationTime: receipt.GenerationTime,
+			}),
+		}
+
+		got, renderErr := renderer.RenderESCPOS(receipt)
+		require.NoError(t, renderErr)
+
+		assert.True(t, bytes.Contains(got, []byte("Notes")))
+		assert.True(t, bytes.Contains(got, []byte("06 May 2024 Screen ordered")))
+	})
+}
+
+func newTestReceipt(t *testing.T, paperWidth int) readmodel.RepairOrderReceipt {
+	t.Helper()
+
+	theTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	contactNumber, err := shareddomain.NewPhoneNumber("08123456789")
+	require.NoError(t, err)
+
+	order, err := domain.NewOrder(domain.NewOrderParams{
+		CreationTime:    theTime,
+		Slug:            "R123-45678-9012",
+		StoreID:         uuid.New(),
+		CustomerName:    "John Doe",
+		ContactNumber:   contactNumber,
+		PhoneType:       "Samsung Galaxy S21 Ultra 5G",
+		Color:           "Phantom Black",
+		InitialCost:     1250000,
+		PhoneConditions: []string{"Scratched back", "Dented frame"},
+		PhoneEquipments: []string{"Charger"},
+		Damages:         []string{"Broken screen", "Battery drains quickly"},
+		Photos:          []uuid.UUID{uuid.New()},
+		SalesPersonID:   uuid.New(),
+		TechnicianID:    uuid.New(),
+		Imei:            optional.Some("123456789012345"),
+		DownPayment: optional.Some(domain.NewOrderPaymentParams{
+			Amount:          500000,
+			PaymentMethodID: uuid.New(),
+		}),
+	})
+	require.NoError(t, err)
+
+	require.NoError(t, order.MutateCost(theTime.Add(time.Hour), 150000, "Replacement battery"))
+
+	return readmodel.RepairOrderReceipt{
+		Store: readmodel.StoreReceiptDetails{
+			Name:          "Some Store",
+			Address:       "Jl. Sudirman No. 1, Jakarta",
+			PhoneNumber:   "+6281234567890",
+			WarrantyTerms: optional.Some("30 days warranty on replaced parts. Water damage voids the warranty."),
+			PaperWidth:    paperWidth,
+		},
+		Order:          order,
+		GenerationTime: theTime,
+	}
+}
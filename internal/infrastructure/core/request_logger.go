@@ -1,17 +1,15 @@
 package core
 
 import (
-	"context"
 	"net/http"
 	"time"
 
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
 	"github.com/rs/xid"
 	"github.com/rs/zerolog"
 )
 
-type correlationIDKey struct{}
-
 type loggingResponseWriter struct {
 	http.ResponseWriter
 	statusCode int
@@ -33,7 +31,7 @@ func requestLoggerMiddleware(next http.Handler) http.Handler {
 		l := logger.MustGet()
 		correlationID := xid.New().String()
 
-		ctx := context.WithValue(r.Context(), correlationIDKey{}, correlationID)
+		ctx := appcontext.NewContextWithCorrelationID(r.Context(), correlationID)
 
 		r = r.WithContext(ctx)
 		l.UpdateContext(func(c zerolog.Context) zerolog.Context {
@@ -72,3 +72,19 @@ func (r resourceLocationProvider) PaymentMethod(id uuid.UUID) url.URL {
 
 	return url
 }
+
+func (r resourceLocationProvider) Webhook(id uuid.UUID) url.URL {
+	url := url.URL{
+		Path: fmt.Sprintf("/webhooks/%s", id.String()),
+	}
+
+	return url
+}
+
+func (r resourceLocationProvider) Photo(id uuid.UUID) url.URL {
+	url := url.URL{
+		Path: fmt.Sprintf("/photos/%s", id.String()),
+	}
+
+	return url
+}
@@ -0,0 +1,244 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+)
+
+const (
+	s3BlobStoreTimeout = 30 * time.Second
+
+	// s3BlobStoreMaxErrorBody is how much of an error response is kept in the
+	// returned error.
+	s3BlobStoreMaxErrorBody = 1 << 10
+)
+
+type S3BlobStoreConfig struct {
+	// Endpoint is the base URL of the S3-compatible service, e.g.
+	// https://s3.ap-southeast-1.amazonaws.com or http://localhost:9000.
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// S3BlobStore keeps blobs as objects in a bucket of an S3-compatible service.
+// Objects are addressed path-style so it also works with stand-ins like MinIO.
+type S3BlobStore struct {
+	endpoint *url.URL
+	config   S3BlobStoreConfig
+	client   *http.Client
+	now      func() time.Time
+}
+
+func NewS3BlobStore(config S3BlobStoreConfig) (*S3BlobStore, error) {
+	endpoint, err := url.Parse(config.Endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse S3 endpoint: %w", err)
+	}
+
+	if endpoint.Scheme == "" || endpoint.Host == "" {
+		return nil, fmt.Errorf("S3 endpoint must be an absolute URL: %q", config.Endpoint)
+	}
+
+	return &S3BlobStore{
+		endpoint: endpoint,
+		config:   config,
+		client:   &http.Client{Timeout: s3BlobStoreTimeout},
+		now:      time.Now,
+	}, nil
+}
+
+func (s *S3BlobStore) Put(ctx context.Context, key string, contentType string, data []byte) error {
+	header := http.Header{}
+	header.Set("Content-Type", contentType)
+
+	res, err := s.do(ctx, http.MethodPut, key, header, data)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return s3Error("put", res)
+	}
+
+	return nil
+}
+
+func (s *S3BlobStore) Get(ctx context.Context, key string) ([]byte, error) {
+	res, err := s.do(ctx, http.MethodGet, key, http.Header{}, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return nil, apperror.ErrBlobNotFound
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return nil, s3Error("get", res)
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read S3 object: %w", err)
+	}
+
+	return data, nil
+}
+
+// Delete removes the object under the key. S3 doesn't tell whether the object
+// existed, so it never returns apperror.ErrBlobNotFound.
+func (s *S3BlobStore) Delete(ctx context.Context, key string) error {
+	res, err := s.do(ctx, http.MethodDelete, key, http.Header{}, nil)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent && res.StatusCode != http.StatusOK {
+		return s3Error("delete", res)
+	}
+
+	return nil
+}
+
+func (s *S3BlobStore) do(
+	ctx context.Context,
+	method string,
+	key string,
+	header http.Header,
+	body []byte,
+) (*http.Response, error) {
+	u := *s.endpoint
+	u.Path = strings.TrimSuffix(u.Path, "/") + "/" + s.config.Bucket + "/" + key
+	u.RawPath = s3URIEncode(u.Path)
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create S3 request: %w", err)
+	}
+
+	req.Header = header
+	s.sign(req, body)
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send S3 request: %w", err)
+	}
+
+	return res, nil
+}
+
+// sign adds an AWS Signature Version 4 Authorization header to the request,
+// covering the host and every header already set on it.
+func (s *S3BlobStore) sign(req *http.Request, body []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+
+	payloadHash := sha256Hex(body)
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + s.config.Region + "/s3/aws4_request"
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	key = hmacSHA256(key, s.config.Region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf(
+		"AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.config.AccessKeyID,
+		scope,
+		signedHeaders,
+		signature,
+	))
+}
+
+func s3Error(op string, res *http.Response) error {
+	body, _ := io.ReadAll(io.LimitReader(res.Body, s3BlobStoreMaxErrorBody))
+	return fmt.Errorf("S3 %s failed with status %d: %s", op, res.StatusCode, strings.TrimSpace(string(body)))
+}
+
+// s3URIEncode percent-encodes everything in a path except the unreserved
+// characters and slashes, as required for the canonical request.
+func s3URIEncode(s string) string {
+	var b strings.Builder
+
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '_', c == '.', c == '~', c == '/':
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+
+	return b.String()
+}
+
+func sha256Hex(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+
+	return mac.Sum(nil)
+}
@@ -0,0 +1,174 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3BlobStore(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	newStore := func(t *testing.T, srv *httptest.Server) *core.S3BlobStore {
+		s, err := core.NewS3BlobStore(core.S3BlobStoreConfig{
+			Endpoint:        srv.URL,
+			Region:          "us-east-1",
+			Bucket:          "remana",
+			AccessKeyID:     "AKIDEXAMPLE",
+			SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		})
+		require.NoError(t, err)
+
+		return s
+	}
+
+	t.Run("stores, returns and deletes objects", func(t *testing.T) {
+		t.Parallel()
+
+		fake := newFakeS3(t, "remana")
+		srv := httptest.NewServer(fake)
+		t.Cleanup(srv.Close)
+
+		s := newStore(t, srv)
+
+		require.NoError(t, s.Put(ctx, "photos/store/photo", "image/jpeg", []byte("photo")))
+		assert.Equal(t, "image/jpeg", fake.contentTypes["photos/store/photo"])
+
+		got, err := s.Get(ctx, "photos/store/photo")
+		require.NoError(t, err)
+		assert.Equal(t, []byte("photo"), got)
+
+		require.NoError(t, s.Delete(ctx, "photos/store/photo"))
+
+		_, err = s.Get(ctx, "photos/store/photo")
+		assert.ErrorIs(t, err, apperror.ErrBlobNotFound)
+	})
+
+	t.Run("escapes keys in the request path", func(t *testing.T) {
+		t.Parallel()
+
+		fake := newFakeS3(t, "remana")
+		srv := httptest.NewServer(fake)
+		t.Cleanup(srv.Close)
+
+		s := newStore(t, srv)
+
+		require.NoError(t, s.Put(ctx, "photos/a b+c.thumbnail", "image/jpeg", []byte("photo")))
+		assert.Equal(t, []string{"/remana/photos/a%20b%2Bc.thumbnail"}, fake.rawPaths)
+	})
+
+	t.Run("returns error when request is rejected", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte("<Error><Code>SignatureDoesNotMatch</Code></Error>"))
+		}))
+		t.Cleanup(srv.Close)
+
+		s := newStore(t, srv)
+
+		err := s.Put(ctx, "photo", "image/jpeg", []byte("photo"))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "SignatureDoesNotMatch")
+
+		_, err = s.Get(ctx, "photo")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, apperror.ErrBlobNotFound)
+	})
+
+	t.Run("returns error when endpoint is not an absolute URL", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := core.NewS3BlobStore(core.S3BlobStoreConfig{Endpoint: "localhost:9000"})
+		assert.Error(t, err)
+	})
+}
+
+// fakeS3 is a stand-in for an S3-compatible service with a single bucket. It
+// checks that every request is signed with SigV4 for the right credential and
+// that the payload hash matches the body.
+type fakeS3 struct {
+	t      *testing.T
+	bucket string
+
+	mu           sync.Mutex
+	objects      map[string][]byte
+	contentTypes map[string]string
+	rawPaths     []string
+}
+
+func newFakeS3(t *testing.T, bucket string) *fakeS3 {
+	return &fakeS3{
+		t:            t,
+		bucket:       bucket,
+		objects:      make(map[string][]byte),
+		contentTypes: make(map[string]string),
+	}
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	body, err := io.ReadAll(r.Body)
+	require.NoError(f.t, err)
+
+	sum := sha256.Sum256(body)
+	assert.Equal(f.t, hex.EncodeToString(sum[:]), r.Header.Get("X-Amz-Content-Sha256"))
+	assert.NotEmpty(f.t, r.Header.Get("X-Amz-Date"))
+
+	auth := r.Header.Get("Authorization")
+	assert.True(f.t, strings.HasPrefix(auth, "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/"), auth)
+	assert.Contains(f.t, auth, "/us-east-1/s3/aws4_request")
+	assert.Contains(f.t, auth, "SignedHeaders=")
+	assert.Contains(f.t, auth, "host;x-amz-content-sha256;x-amz-date")
+
+	f.rawPaths = append(f.rawPaths, r.URL.EscapedPath())
+
+	key, ok := strings.CutPrefix(r.URL.Path, "/"+f.bucket+"/")
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		f.objects[key] = body
+		f.contentTypes[key] = r.Header.Get("Content-Type")
+		w.WriteHeader(http.StatusOK)
+
+	case http.MethodGet:
+		data, exists := f.objects[key]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte("<Error><Code>NoSuchKey</Code></Error>"))
+			return
+		}
+
+		_, _ = w.Write(data)
+
+	case http.MethodDelete:
+		delete(f.objects, key)
+		w.WriteHeader(http.StatusNoContent)
+
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
@@ -5,21 +5,28 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/netip"
 
 	"github.com/JosephJoshua/remana-backend/internal/apierror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth"
 	"github.com/JosephJoshua/remana-backend/internal/modules/damagetype"
 	"github.com/JosephJoshua/remana-backend/internal/modules/misc"
+	"github.com/JosephJoshua/remana-backend/internal/modules/notification"
+	"github.com/JosephJoshua/remana-backend/internal/modules/ordertracking"
+	"github.com/JosephJoshua/remana-backend/internal/modules/outbox"
 	"github.com/JosephJoshua/remana-backend/internal/modules/paymentmethod"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/modules/phonecondition"
 	"github.com/JosephJoshua/remana-backend/internal/modules/phoneequipment"
+	"github.com/JosephJoshua/remana-backend/internal/modules/photo"
 	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder"
 	"github.com/JosephJoshua/remana-backend/internal/modules/salesperson"
 	"github.com/JosephJoshua/remana-backend/internal/modules/technician"
 	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/modules/webhook"
 	"github.com/go-faster/jx"
 	"github.com/jackc/pgx/v5/pgxpool"
 	ht "github.com/ogen-go/ogen/http"
@@ -39,6 +46,10 @@ type phoneEquipmentService = phoneequipment.Service
 type paymentMethodService = paymentmethod.Service
 type repairOrderService = repairorder.Service
 type miscService = misc.Service
+type orderTrackingService = ordertracking.Service
+type webhookService = webhook.Service
+type auditService = audit.Service
+type photoService = photo.Service
 
 type server struct {
 	*authService
@@ -52,29 +63,57 @@ type server struct {
 	*paymentMethodService
 	*repairOrderService
 	*miscService
+	*orderTrackingService
+	*webhookService
+	*auditService
+	*photoService
 }
 
 type Middleware func(next http.Handler) http.Handler
 
-func NewAPIServer(db *pgxpool.Pool) (*genapi.Server, []Middleware, error) {
-	sm := newAuthSessionManager()
-	pm := newLoginCodePromptManager()
+func NewAPIServer(
+	db *pgxpool.Pool,
+	blobStore photo.BlobStore,
+	trustedProxies []netip.Prefix,
+) (*genapi.Server, []Middleware, error) {
+	sessionStore := repository.NewSQLSessionStore(db)
+	userSessionRepository := repository.NewSQLUserSessionRepository(db)
+	sm := newAuthSessionManager(sessionStore, userSessionRepository)
+	pm := newLoginCodePromptManager(sessionStore)
+
+	middlewares := []Middleware{
+		requestLoggerMiddleware,
+		NewClientIPMiddleware(trustedProxies),
+		userAgentMiddleware,
+		sm.middleware,
+		pm.middleware,
+	}
 
-	middlewares := []Middleware{requestLoggerMiddleware, sm.middleware, pm.middleware}
+	loginThrottleRepository := repository.NewSQLLoginThrottleRepository(db)
 
 	authService := auth.NewService(
+		timeProvider{},
 		sm,
 		pm,
 		repository.NewSQLAuthRepository(db),
 		&PasswordHasher{},
+		TOTPProvider{},
+		loginThrottleRepository,
 	)
 
+	receiptRenderer, err := NewReceiptRenderer()
+	if err != nil {
+		return nil, []Middleware{}, fmt.Errorf("error creating receipt renderer: %w", err)
+	}
+
 	permissionProvider := permission.NewProvider(repository.NewSQLPermissionRepository(db))
+	auditLog := audit.NewLog(repository.NewSQLAuditLogRepository(db), timeProvider{})
 
 	permissionService := permission.NewService(
 		resourceLocationProvider{},
 		repository.NewSQLPermissionRepository(db),
 		permissionProvider,
+		auditLog,
 	)
 
 	repairOrderService := repairorder.NewService(
@@ -83,45 +122,91 @@ func NewAPIServer(db *pgxpool.Pool) (*genapi.Server, []Middleware, error) {
 		repository.NewSQLRepairOrderRepository(db),
 		permissionProvider,
 		newRepairOrderSlugProvider(db),
+		receiptRenderer,
+		NewLabelRenderer(),
+		auditLog,
 	)
 
 	technicianService := technician.NewService(
 		resourceLocationProvider{},
 		permissionProvider,
 		repository.NewSQLTechnicianRepository(db),
+		auditLog,
 	)
 
 	salesPersonService := salesperson.NewService(
 		resourceLocationProvider{},
 		permissionProvider,
 		repository.NewSQLSalesPersonRepository(db),
+		auditLog,
 	)
 
 	damageTypeService := damagetype.NewService(
 		resourceLocationProvider{},
 		permissionProvider,
 		repository.NewSQLDamageTypeRepository(db),
+		auditLog,
 	)
 
 	phoneConditionService := phonecondition.NewService(
 		resourceLocationProvider{},
 		permissionProvider,
 		repository.NewSQLPhoneConditionRepository(db),
+		auditLog,
 	)
 
 	phoneEquipmentService := phoneequipment.NewService(
 		resourceLocationProvider{},
 		permissionProvider,
 		repository.NewSQLPhoneEquipmentRepository(db),
+		auditLog,
 	)
 
 	paymentMethodService := paymentmethod.NewService(
 		resourceLocationProvider{},
 		permissionProvider,
 		repository.NewSQLPaymentMethodRepository(db),
+		auditLog,
+	)
+
+	orderTrackingService := ordertracking.NewService(
+		repository.NewSQLRepairOrderRepository(db),
+		NewRateLimiter(publicOrderIPRateLimit, publicOrderIPRateWindow, timeProvider{}),
+		NewRateLimiter(publicOrderSlugRateLimit, publicOrderSlugRateWindow, timeProvider{}),
+	)
+
+	webhookService := webhook.NewService(
+		timeProvider{},
+		resourceLocationProvider{},
+		permissionProvider,
+		repository.NewSQLWebhookRepository(db),
+		auditLog,
+	)
+
+	auditService := audit.NewService(repository.NewSQLAuditLogRepository(db))
+
+	photoService := photo.NewService(
+		timeProvider{},
+		resourceLocationProvider{},
+		permissionProvider,
+		repository.NewSQLPhotoRepository(db),
+		blobStore,
+		NewPhotoProcessor(),
+		auditLog,
 	)
 
-	userService := user.NewService()
+	userService := user.NewService(
+		timeProvider{},
+		permissionProvider,
+		userSessionRepository,
+		repository.NewSQLLoginCodeRepository(db),
+		loginCodeGenerator{},
+		repository.NewSQLTOTPRepository(db),
+		TOTPProvider{},
+		recoveryCodeGenerator{},
+		loginThrottleRepository,
+		auditLog,
+	)
 	miscService := misc.NewService()
 
 	srv := server{
@@ -136,9 +221,13 @@ func NewAPIServer(db *pgxpool.Pool) (*genapi.Server, []Middleware, error) {
 		paymentMethodService:  paymentMethodService,
 		repairOrderService:    repairOrderService,
 		miscService:           miscService,
+		orderTrackingService:  orderTrackingService,
+		webhookService:        webhookService,
+		auditService:          auditService,
+		photoService:          photoService,
 	}
 
-	securityHandler := auth.NewSecurityHandler(sm, repository.NewSQLAuthRepository(db))
+	securityHandler := auth.NewSecurityHandler(timeProvider{}, sm, repository.NewSQLAuthRepository(db))
 
 	oasSrv, err := genapi.NewServer(srv, securityHandler, genapi.WithErrorHandler(handleServerError))
 	if err != nil {
@@ -205,3 +294,36 @@ func handleServerError(_ context.Context, w http.ResponseWriter, r *http.Request
 
 	_, _ = w.Write(e.Bytes())
 }
+
+// NewOrderEventDispatcher creates the dispatcher that delivers order events
+// from the outbox to their subscribers.
+func NewOrderEventDispatcher(db *pgxpool.Pool) *outbox.Dispatcher {
+	return outbox.NewDispatcher(
+		repository.NewSQLOrderEventRepository(db),
+		timeProvider{},
+		notification.NewPublisher(
+			repository.NewSQLNotificationRepository(db),
+			repository.NewSQLRepairOrderRepository(db),
+			timeProvider{},
+		),
+		webhook.NewPublisher(repository.NewSQLWebhookRepository(db), timeProvider{}),
+	)
+}
+
+// NewNotificationDispatcher creates the dispatcher that delivers queued
+// notifications through the notifier.
+func NewNotificationDispatcher(db *pgxpool.Pool, notifier notification.Notifier) *notification.Dispatcher {
+	return notification.NewDispatcher(repository.NewSQLNotificationRepository(db), notifier, timeProvider{})
+}
+
+// NewWebhookDispatcher creates the dispatcher that sends queued webhook
+// deliveries to store integrations.
+func NewWebhookDispatcher(db *pgxpool.Pool) *webhook.Dispatcher {
+	return webhook.NewDispatcher(repository.NewSQLWebhookRepository(db), NewWebhookSender(false), timeProvider{})
+}
+
+// NewLoginThrottleCleaner creates the cleaner that removes login throttles
+// which are no longer in effect.
+func NewLoginThrottleCleaner(db *pgxpool.Pool) *auth.ThrottleCleaner {
+	return auth.NewThrottleCleaner(repository.NewSQLLoginThrottleRepository(db), timeProvider{})
+}
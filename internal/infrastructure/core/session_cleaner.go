@@ -0,0 +1,44 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/rs/zerolog"
+)
+
+// SessionCleaner periodically removes expired sessions from the session store.
+type SessionCleaner struct {
+	store *repository.SQLSessionStore
+}
+
+func NewSessionCleaner(db *pgxpool.Pool) *SessionCleaner {
+	return &SessionCleaner{
+		store: repository.NewSQLSessionStore(db),
+	}
+}
+
+// Run deletes expired sessions every interval until ctx is done.
+func (c *SessionCleaner) Run(ctx context.Context, interval time.Duration) {
+	l := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		n, err := c.store.DeleteExpiredSessions(ctx, timeProvider{}.Now())
+		if err != nil {
+			l.Error().Err(err).Msg("failed to delete expired sessions")
+		} else if n > 0 {
+			l.Debug().Int64("count", n).Msg("deleted expired sessions")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
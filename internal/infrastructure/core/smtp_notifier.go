@@ -0,0 +1,103 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"mime"
+	"net"
+	"net/smtp"
+	"strings"
+)
+
+type SMTPNotifierConfig struct {
+	Addr string
+	From string
+	// RecipientDomain is appended to the recipient's phone number to form the
+	// address, which is how email-to-SMS gateways are addressed.
+	RecipientDomain string
+	Subject         string
+	Username        string
+	Password        string
+}
+
+// SMTPNotifier sends each message as a plain text email through an SMTP relay
+// on the local network.
+type SMTPNotifier struct {
+	config SMTPNotifierConfig
+}
+
+func NewSMTPNotifier(config SMTPNotifierConfig) *SMTPNotifier {
+	return &SMTPNotifier{config: config}
+}
+
+func (s *SMTPNotifier) Notify(ctx context.Context, recipient string, message string) error {
+	host, _, err := net.SplitHostPort(s.config.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to parse SMTP address: %w", err)
+	}
+
+	var dialer net.Dialer
+
+	conn, err := dialer.DialContext(ctx, "tcp", s.config.Addr)
+	if err != nil {
+		return fmt.Errorf("failed to connect to SMTP server: %w", err)
+	}
+
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+
+	client, err := smtp.NewClient(conn, host)
+	if err != nil {
+		conn.Close()
+		return fmt.Errorf("failed to start SMTP session: %w", err)
+	}
+	defer client.Close()
+
+	if s.config.Username != "" {
+		if err = client.Auth(smtp.PlainAuth("", s.config.Username, s.config.Password, host)); err != nil {
+			return fmt.Errorf("failed to authenticate to SMTP server: %w", err)
+		}
+	}
+
+	to := strings.TrimPrefix(recipient, "+") + "@" + s.config.RecipientDomain
+
+	if err = client.Mail(s.config.From); err != nil {
+		return fmt.Errorf("failed to set sender: %w", err)
+	}
+
+	if err = client.Rcpt(to); err != nil {
+		return fmt.Errorf("failed to set recipient: %w", err)
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return fmt.Errorf("failed to start message: %w", err)
+	}
+
+	if _, err = w.Write(s.buildMessage(to, message)); err != nil {
+		return fmt.Errorf("failed to write message: %w", err)
+	}
+
+	if err = w.Close(); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return client.Quit()
+}
+
+func (s *SMTPNotifier) buildMessage(to string, body string) []byte {
+	var b strings.Builder
+
+	b.WriteString("From: " + s.config.From + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + mime.QEncoding.Encode("utf-8", s.config.Subject) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n")
+	b.WriteString("Content-Transfer-Encoding: 8bit\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+	b.WriteString("\r\n")
+
+	return []byte(b.String())
+}
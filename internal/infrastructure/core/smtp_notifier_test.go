@@ -0,0 +1,175 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSMTPNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("sends the message to the recipient's address", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeSMTPServer(t, false)
+
+		n := core.NewSMTPNotifier(core.SMTPNotifierConfig{
+			Addr:            srv.addr,
+			From:            "noreply@remana.test",
+			RecipientDomain: "sms.remana.test",
+			Subject:         "Remana",
+		})
+		require.NoError(t, n.Notify(context.Background(), "+628123456789", "Halo John,\nservis Anda sudah selesai."))
+
+		got := <-srv.received
+		assert.Equal(t, "noreply@remana.test", got.from)
+		assert.Equal(t, "628123456789@sms.remana.test", got.to)
+		assert.Contains(t, got.data, "To: 628123456789@sms.remana.test\r\n")
+		assert.Contains(t, got.data, "Subject: Remana\r\n")
+		assert.Contains(t, got.data, "\r\n\r\nHalo John,\r\nservis Anda sudah selesai.\r\n")
+		assert.Empty(t, got.auth)
+	})
+
+	t.Run("authenticates when username is set", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeSMTPServer(t, false)
+
+		n := core.NewSMTPNotifier(core.SMTPNotifierConfig{
+			Addr:            srv.addr,
+			From:            "noreply@remana.test",
+			RecipientDomain: "sms.remana.test",
+			Username:        "user",
+			Password:        "pass",
+		})
+		require.NoError(t, n.Notify(context.Background(), "+628123456789", "Halo"))
+
+		got := <-srv.received
+		assert.Equal(t, "AUTH PLAIN AHVzZXIAcGFzcw==", got.auth)
+	})
+
+	t.Run("returns error when the server rejects the recipient", func(t *testing.T) {
+		t.Parallel()
+
+		srv := newFakeSMTPServer(t, true)
+
+		n := core.NewSMTPNotifier(core.SMTPNotifierConfig{
+			Addr:            srv.addr,
+			From:            "noreply@remana.test",
+			RecipientDomain: "sms.remana.test",
+		})
+		assert.Error(t, n.Notify(context.Background(), "+628123456789", "Halo"))
+	})
+}
+
+type fakeSMTPMessage struct {
+	auth string
+	from string
+	to   string
+	data string
+}
+
+// fakeSMTPServer accepts a single SMTP session and reports the message it received.
+type fakeSMTPServer struct {
+	addr     string
+	received chan fakeSMTPMessage
+}
+
+func newFakeSMTPServer(t *testing.T, rejectRecipient bool) *fakeSMTPServer {
+	t.Helper()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { ln.Close() })
+
+	srv := &fakeSMTPServer{
+		addr:     ln.Addr().String(),
+		received: make(chan fakeSMTPMessage, 1),
+	}
+
+	go func() {
+		conn, acceptErr := ln.Accept()
+		if acceptErr != nil {
+			return
+		}
+		defer conn.Close()
+
+		srv.serve(conn, rejectRecipient)
+	}()
+
+	return srv
+}
+
+func (s *fakeSMTPServer) serve(conn net.Conn, rejectRecipient bool) {
+	r := bufio.NewReader(conn)
+	reply := func(line string) { _, _ = conn.Write([]byte(line + "\r\n")) }
+
+	var msg fakeSMTPMessage
+
+	reply("220 localhost ESMTP")
+
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+
+		line = strings.TrimRight(line, "\r\n")
+		verb := strings.ToUpper(strings.SplitN(line, " ", 2)[0])
+
+		switch verb {
+		case "EHLO", "HELO":
+			reply("250-localhost")
+			reply("250 AUTH PLAIN")
+		case "AUTH":
+			msg.auth = line
+			reply("235 OK")
+		case "MAIL":
+			msg.from = strings.TrimSuffix(strings.TrimPrefix(line, "MAIL FROM:<"), ">")
+			reply("250 OK")
+		case "RCPT":
+			if rejectRecipient {
+				reply("550 No such user")
+				continue
+			}
+
+			msg.to = strings.TrimSuffix(strings.TrimPrefix(line, "RCPT TO:<"), ">")
+			reply("250 OK")
+		case "DATA":
+			reply("354 Go ahead")
+
+			var data strings.Builder
+			for {
+				dataLine, dataErr := r.ReadString('\n')
+				if dataErr != nil {
+					return
+				}
+
+				if dataLine == ".\r\n" {
+					break
+				}
+
+				data.WriteString(dataLine)
+			}
+
+			msg.data = data.String()
+			reply("250 OK")
+		case "QUIT":
+			reply("221 Bye")
+			s.received <- msg
+			return
+		default:
+			reply("250 OK")
+		}
+	}
+}
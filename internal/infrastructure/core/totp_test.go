@@ -0,0 +1,80 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/pquerna/otp/totp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPProvider(t *testing.T) {
+	t.Parallel()
+
+	// Base32 of the RFC 6238 SHA-1 test secret "12345678901234567890".
+	const rfcSecret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	t.Run("accepts the RFC 6238 test vectors", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			unix int64
+			code string
+		}{
+			{unix: 59, code: "287082"},
+			{unix: 1111111109, code: "081804"},
+			{unix: 1234567890, code: "005924"},
+		}
+
+		for _, tc := range testCases {
+			step, ok := core.TOTPProvider{}.Validate(rfcSecret, tc.code, time.Unix(tc.unix, 0))
+
+			assert.True(t, ok, "code at %d", tc.unix)
+			assert.Equal(t, tc.unix/30, step)
+		}
+	})
+
+	t.Run("accepts codes from adjacent time steps only", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1234567890, 0)
+
+		step, ok := core.TOTPProvider{}.Validate(rfcSecret, "005924", now.Add(30*time.Second))
+		assert.True(t, ok)
+		assert.Equal(t, now.Unix()/30, step)
+
+		_, ok = core.TOTPProvider{}.Validate(rfcSecret, "005924", now.Add(90*time.Second))
+		assert.False(t, ok)
+
+		_, ok = core.TOTPProvider{}.Validate(rfcSecret, "000000", now)
+		assert.False(t, ok)
+	})
+
+	t.Run("generates a secret that authenticator apps can use", func(t *testing.T) {
+		t.Parallel()
+
+		secret, provisioningURI, err := core.TOTPProvider{}.Generate("admin@store-a")
+		require.NoError(t, err)
+
+		uri, err := url.Parse(provisioningURI)
+		require.NoError(t, err)
+
+		assert.Equal(t, "otpauth", uri.Scheme)
+		assert.Equal(t, "totp", uri.Host)
+		assert.Equal(t, secret, uri.Query().Get("secret"))
+		assert.Equal(t, "Remana", uri.Query().Get("issuer"))
+
+		now := time.Now()
+		code, err := totp.GenerateCode(secret, now)
+		require.NoError(t, err)
+
+		_, ok := core.TOTPProvider{}.Validate(secret, code, now)
+		assert.True(t, ok)
+	})
+}
@@ -0,0 +1,20 @@
+package core
+
+import (
+	"net/http"
+
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+)
+
+// userAgentMiddleware stores the client's User-Agent header in the request
+// context so it can be shown alongside the sessions it creates.
+func userAgentMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		if userAgent := r.UserAgent(); userAgent != "" {
+			ctx = appcontext.NewContextWithUserAgent(ctx, userAgent)
+		}
+
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
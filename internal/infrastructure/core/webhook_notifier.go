@@ -0,0 +1,67 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+const webhookNotifierTimeout = 10 * time.Second
+
+// WebhookNotifier posts each message as JSON to a gateway, such as a WhatsApp
+// or SMS provider, which delivers it to the recipient.
+type WebhookNotifier struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+// NewWebhookNotifier creates a notifier that posts to url, authenticating
+// with token as a bearer token if it isn't empty.
+func NewWebhookNotifier(url string, token string) *WebhookNotifier {
+	return &WebhookNotifier{
+		url:    url,
+		token:  token,
+		client: &http.Client{Timeout: webhookNotifierTimeout},
+	}
+}
+
+type webhookNotification struct {
+	Recipient string `json:"recipient"`
+	Message   string `json:"message"`
+}
+
+func (w *WebhookNotifier) Notify(ctx context.Context, recipient string, message string) error {
+	body, err := json.Marshal(webhookNotification{
+		Recipient: recipient,
+		Message:   message,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal webhook body: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	if w.token != "" {
+		req.Header.Set("Authorization", "Bearer "+w.token)
+	}
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
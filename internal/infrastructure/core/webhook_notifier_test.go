@@ -0,0 +1,73 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookNotifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("posts the recipient and message as JSON", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			gotAuth string
+			gotBody map[string]string
+		)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotAuth = r.Header.Get("Authorization")
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&gotBody))
+
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer srv.Close()
+
+		n := core.NewWebhookNotifier(srv.URL, "secret")
+		require.NoError(t, n.Notify(context.Background(), "+628123456789", "Halo"))
+
+		assert.Equal(t, "Bearer secret", gotAuth)
+		assert.Equal(t, map[string]string{"recipient": "+628123456789", "message": "Halo"}, gotBody)
+	})
+
+	t.Run("does not send authorization header without token", func(t *testing.T) {
+		t.Parallel()
+
+		var gotAuth []string
+
+		srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, r *http.Request) {
+			gotAuth = r.Header.Values("Authorization")
+		}))
+		defer srv.Close()
+
+		n := core.NewWebhookNotifier(srv.URL, "")
+		require.NoError(t, n.Notify(context.Background(), "+628123456789", "Halo"))
+
+		assert.Empty(t, gotAuth)
+	})
+
+	t.Run("returns error when the webhook does not respond with 2xx", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		n := core.NewWebhookNotifier(srv.URL, "")
+		assert.Error(t, n.Notify(context.Background(), "+628123456789", "Halo"))
+	})
+}
@@ -0,0 +1,95 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"syscall"
+	"time"
+)
+
+const (
+	webhookSenderTimeout = 10 * time.Second
+
+	// webhookSenderMaxDrain is how much of a response body is read before it
+	// is closed, so the connection can be reused without reading a huge body.
+	webhookSenderMaxDrain = 64 << 10
+)
+
+var errWebhookAddressNotAllowed = errors.New("webhook address is not a public address")
+
+// WebhookSender posts webhook deliveries to the URLs of store integrations.
+// Redirects aren't followed, so a redirect counts as a failed delivery.
+type WebhookSender struct {
+	client *http.Client
+}
+
+// NewWebhookSender creates a sender that refuses to connect to loopback,
+// private and link-local addresses. The check runs on the resolved address of
+// every connection, so a public hostname that resolves to an internal address
+// is refused too. allowPrivateNetworks turns the check off, which is only
+// meant for tests.
+func NewWebhookSender(allowPrivateNetworks bool) *WebhookSender {
+	dialer := &net.Dialer{Timeout: webhookSenderTimeout}
+	if !allowPrivateNetworks {
+		dialer.Control = rejectNonPublicAddress
+	}
+
+	transport, _ := http.DefaultTransport.(*http.Transport)
+	transport = transport.Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &WebhookSender{
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   webhookSenderTimeout,
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+func (s *WebhookSender) Send(ctx context.Context, url string, header http.Header, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, fmt.Errorf("failed to create webhook request: %w", err)
+	}
+
+	req.Header = header.Clone()
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to send webhook request: %w", err)
+	}
+	defer res.Body.Close()
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, webhookSenderMaxDrain))
+
+	return res.StatusCode, nil
+}
+
+func rejectNonPublicAddress(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return fmt.Errorf("failed to parse webhook address: %w", err)
+	}
+
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return fmt.Errorf("failed to parse webhook address: %w", err)
+	}
+
+	addr = addr.Unmap()
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() {
+		return fmt.Errorf("%w: %s", errWebhookAddressNotAllowed, addr)
+	}
+
+	return nil
+}
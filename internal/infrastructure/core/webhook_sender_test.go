@@ -0,0 +1,111 @@
+//go:build unit
+// +build unit
+
+package core_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookSender(t *testing.T) {
+	t.Parallel()
+
+	t.Run("posts the body with the given headers", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			gotSignature string
+			gotBody      []byte
+		)
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			gotSignature = r.Header.Get("X-Remana-Signature")
+
+			var err error
+			gotBody, err = io.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		defer srv.Close()
+
+		header := http.Header{}
+		header.Set("X-Remana-Signature", "sha256=abc")
+
+		got, err := core.NewWebhookSender(true).Send(context.Background(), srv.URL, header, []byte(`{"id":"1"}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusAccepted, got)
+		assert.Equal(t, "sha256=abc", gotSignature)
+		assert.Equal(t, `{"id":"1"}`, string(gotBody))
+	})
+
+	t.Run("returns the status code of unsuccessful responses", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer srv.Close()
+
+		got, err := core.NewWebhookSender(true).Send(context.Background(), srv.URL, http.Header{}, []byte(`{}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusInternalServerError, got)
+	})
+
+	t.Run("does not follow redirects", func(t *testing.T) {
+		t.Parallel()
+
+		followed := false
+
+		target := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			followed = true
+		}))
+		defer target.Close()
+
+		srv := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+		defer srv.Close()
+
+		got, err := core.NewWebhookSender(true).Send(context.Background(), srv.URL, http.Header{}, []byte(`{}`))
+		require.NoError(t, err)
+
+		assert.Equal(t, http.StatusTemporaryRedirect, got)
+		assert.False(t, followed)
+	})
+
+	t.Run("returns error when the receiver is unreachable", func(t *testing.T) {
+		t.Parallel()
+
+		srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+		srv.Close()
+
+		_, err := core.NewWebhookSender(true).Send(context.Background(), srv.URL, http.Header{}, []byte(`{}`))
+		assert.Error(t, err)
+	})
+
+	t.Run("refuses to connect to loopback addresses", func(t *testing.T) {
+		t.Parallel()
+
+		called := false
+
+		srv := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {
+			called = true
+		}))
+		defer srv.Close()
+
+		_, err := core.NewWebhookSender(false).Send(context.Background(), srv.URL, http.Header{}, []byte(`{}`))
+		require.Error(t, err)
+
+		assert.False(t, called)
+	})
+}
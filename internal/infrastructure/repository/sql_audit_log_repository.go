@@ -0,0 +1,128 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SQLAuditLogRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLAuditLogRepository(db *pgxpool.Pool) *SQLAuditLogRepository {
+	return &SQLAuditLogRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLAuditLogRepository) CreateEntry(ctx context.Context, entry audit.Entry) error {
+	if err := r.queries.CreateAuditLogEntry(ctx, gensql.CreateAuditLogEntryParams{
+		AuditLogEntryID: typemapper.UUIDToPgtypeUUID(entry.ID),
+		StoreID:         typemapper.UUIDToPgtypeUUID(entry.StoreID),
+		UserID:          typemapper.UUIDToPgtypeUUID(entry.UserID),
+		Username:        entry.Username,
+		CorrelationID:   typemapper.OptionalStringToPgtypeText(entry.CorrelationID),
+		Action:          string(entry.Action),
+		EntityType:      string(entry.EntityType),
+		EntityID:        typemapper.UUIDToPgtypeUUID(entry.EntityID),
+		Before:          entry.Before,
+		After:           entry.After,
+		CreationTime:    typemapper.TimeToPgtypeTimestamptz(entry.CreationTime),
+	}); err != nil {
+		return fmt.Errorf("failed to create audit log entry: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLAuditLogRepository) GetEntriesByEntity(
+	ctx context.Context,
+	storeID uuid.UUID,
+	entityType audit.EntityType,
+	entityID uuid.UUID,
+) ([]audit.Entry, error) {
+	rows, err := r.queries.GetAuditLogEntriesByEntity(ctx, gensql.GetAuditLogEntriesByEntityParams{
+		StoreID:    typemapper.UUIDToPgtypeUUID(storeID),
+		EntityType: string(entityType),
+		EntityID:   typemapper.UUIDToPgtypeUUID(entityID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit log entries by entity: %w", err)
+	}
+
+	return toAuditLogEntries(rows), nil
+}
+
+func (r *SQLAuditLogRepository) SearchEntries(
+	ctx context.Context,
+	storeID uuid.UUID,
+	filter audit.SearchFilter,
+	cursor optional.Optional[audit.SearchCursor],
+	limit int,
+) ([]audit.Entry, error) {
+	if limit > math.MaxInt32 {
+		return nil, errors.New("limit is greater than MaxInt32")
+	}
+
+	params := gensql.SearchAuditLogEntriesParams{
+		StoreID:     typemapper.UUIDToPgtypeUUID(storeID),
+		EntityType:  optionalStringTypeToPgtypeText(filter.EntityType),
+		EntityID:    typemapper.OptionalUUIDToPgtypeUUID(filter.EntityID),
+		UserID:      typemapper.OptionalUUIDToPgtypeUUID(filter.UserID),
+		Action:      optionalStringTypeToPgtypeText(filter.Action),
+		CreatedFrom: typemapper.OptionalTimeToPgtypeTimestamptz(filter.CreatedFrom),
+		CreatedTo:   typemapper.OptionalTimeToPgtypeTimestamptz(filter.CreatedTo),
+		PageSize:    int32(limit),
+	}
+
+	if c, ok := cursor.Get(); ok {
+		params.CursorCreationTime = typemapper.TimeToPgtypeTimestamptz(c.CreationTime)
+		params.CursorAuditLogEntryID = typemapper.UUIDToPgtypeUUID(c.EntryID)
+	}
+
+	rows, err := r.queries.SearchAuditLogEntries(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to search audit log entries: %w", err)
+	}
+
+	return toAuditLogEntries(rows), nil
+}
+
+func optionalStringTypeToPgtypeText[T ~string](value optional.Optional[T]) pgtype.Text {
+	if v, ok := value.Get(); ok {
+		return typemapper.StringToPgtypeText(string(v))
+	}
+
+	return pgtype.Text{}
+}
+
+func toAuditLogEntries(rows []gensql.AuditLogEntry) []audit.Entry {
+	entries := make([]audit.Entry, 0, len(rows))
+	for _, row := range rows {
+		entries = append(entries, audit.Entry{
+			ID:            typemapper.MustPgtypeUUIDToUUID(row.AuditLogEntryID),
+			StoreID:       typemapper.MustPgtypeUUIDToUUID(row.StoreID),
+			UserID:        typemapper.MustPgtypeUUIDToUUID(row.UserID),
+			Username:      row.Username,
+			CorrelationID: typemapper.PgtypeTextToOptionalString(row.CorrelationID),
+			Action:        audit.Action(row.Action),
+			EntityType:    audit.EntityType(row.EntityType),
+			EntityID:      typemapper.MustPgtypeUUIDToUUID(row.EntityID),
+			Before:        row.Before,
+			After:         row.After,
+			CreationTime:  row.CreationTime.Time,
+		})
+	}
+
+	return entries
+}
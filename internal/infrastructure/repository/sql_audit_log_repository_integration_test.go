@@ -0,0 +1,183 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditLogRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime    = time.Unix(1713917762, 0)
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theUserID  = uuid.New()
+	)
+
+	queries := gensql.New(db)
+
+	_, initErr = queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		StoreName:    "Not important",
+		StoreCode:    "not-important",
+		StoreAddress: "Not important",
+		PhoneNumber:  "+6281234567890",
+	})
+	require.NoError(t, initErr)
+
+	_, initErr = queries.SeedRole(context.Background(), gensql.SeedRoleParams{
+		RoleID:       typemapper.UUIDToPgtypeUUID(theRoleID),
+		RoleName:     "Not important",
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		IsStoreAdmin: true,
+	})
+	require.NoError(t, initErr)
+
+	_, initErr = queries.SeedUser(context.Background(), gensql.SeedUserParams{
+		UserID:       typemapper.UUIDToPgtypeUUID(theUserID),
+		Username:     "cashier",
+		UserPassword: "not important",
+		RoleID:       typemapper.UUIDToPgtypeUUID(theRoleID),
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+	})
+	require.NoError(t, initErr)
+
+	repo := repository.NewSQLAuditLogRepository(db)
+
+	createEntry := func(t *testing.T, entityID uuid.UUID, action audit.Action, creationTime time.Time) audit.Entry {
+		entry := audit.Entry{
+			ID:            uuid.New(),
+			StoreID:       theStoreID,
+			UserID:        theUserID,
+			Username:      "cashier",
+			CorrelationID: optional.Some("cojb2s8r0l2b9r7kmvd0"),
+			Action:        action,
+			EntityType:    audit.EntityTypeRepairOrder,
+			EntityID:      entityID,
+			Before:        []byte(`{"total_cost": 200}`),
+			After:         []byte(`{"total_cost": 350}`),
+			CreationTime:  creationTime,
+		}
+
+		require.NoError(t, repo.CreateEntry(context.Background(), entry))
+		return entry
+	}
+
+	t.Run("returns the history of an entity oldest first", func(t *testing.T) {
+		entityID := uuid.New()
+
+		second := createEntry(t, entityID, audit.ActionRepairOrderCostAdded, theTime.Add(time.Minute))
+		first := createEntry(t, entityID, audit.ActionRepairOrderCreated, theTime)
+		createEntry(t, uuid.New(), audit.ActionRepairOrderCreated, theTime)
+
+		got, err := repo.GetEntriesByEntity(context.Background(), theStoreID, audit.EntityTypeRepairOrder, entityID)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		assert.Equal(t, first.ID, got[0].ID)
+		assert.Equal(t, second.ID, got[1].ID)
+
+		assert.Equal(t, "cashier", got[0].Username)
+		assert.Equal(t, theUserID, got[0].UserID)
+		assert.Equal(t, "cojb2s8r0l2b9r7kmvd0", got[0].CorrelationID.GetOrElse(""))
+		assert.JSONEq(t, `{"total_cost": 200}`, string(got[0].Before))
+		assert.JSONEq(t, `{"total_cost": 350}`, string(got[0].After))
+		assert.Equal(t, theTime, got[0].CreationTime)
+
+		got, err = repo.GetEntriesByEntity(context.Background(), uuid.New(), audit.EntityTypeRepairOrder, entityID)
+		require.NoError(t, err)
+		assert.Empty(t, got, "entries of other stores shouldn't be returned")
+	})
+
+	t.Run("searches with filters and cursor", func(t *testing.T) {
+		entityID := uuid.New()
+		searchTime := theTime.Add(time.Hour)
+
+		newest := createEntry(t, entityID, audit.ActionRepairOrderPaymentRecorded, searchTime.Add(2*time.Minute))
+		middle := createEntry(t, entityID, audit.ActionRepairOrderPaymentRecorded, searchTime.Add(time.Minute))
+		oldest := createEntry(t, entityID, audit.ActionRepairOrderPaymentRecorded, searchTime)
+		createEntry(t, entityID, audit.ActionRepairOrderConfirmed, searchTime)
+
+		filter := audit.SearchFilter{
+			EntityType: optional.Some(audit.EntityTypeRepairOrder),
+			EntityID:   optional.Some(entityID),
+			UserID:     optional.Some(theUserID),
+			Action:     optional.Some(audit.ActionRepairOrderPaymentRecorded),
+		}
+
+		got, err := repo.SearchEntries(context.Background(), theStoreID, filter, optional.None[audit.SearchCursor](), 2)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		assert.Equal(t, newest.ID, got[0].ID)
+		assert.Equal(t, middle.ID, got[1].ID)
+
+		got, err = repo.SearchEntries(context.Background(), theStoreID, filter, optional.Some(audit.SearchCursor{
+			CreationTime: middle.CreationTime,
+			EntryID:      middle.ID,
+		}), 2)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, oldest.ID, got[0].ID)
+
+		filter.CreatedFrom = optional.Some(searchTime.Add(time.Minute))
+		filter.CreatedTo = optional.Some(searchTime.Add(2 * time.Minute))
+
+		got, err = repo.SearchEntries(context.Background(), theStoreID, filter, optional.None[audit.SearchCursor](), 10)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, middle.ID, got[0].ID)
+	})
+
+	t.Run("rejects changes to recorded entries", func(t *testing.T) {
+		entry := createEntry(t, uuid.New(), audit.ActionRepairOrderCreated, theTime)
+
+		_, err := db.Exec(
+			context.Background(),
+			"UPDATE audit_log_entries SET after = NULL WHERE audit_log_entry_id = $1",
+			entry.ID,
+		)
+		require.Error(t, err)
+
+		_, err = db.Exec(
+			context.Background(),
+			"DELETE FROM audit_log_entries WHERE audit_log_entry_id = $1",
+			entry.ID,
+		)
+		require.Error(t, err)
+	})
+}
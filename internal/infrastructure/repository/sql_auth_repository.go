@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/gensql"
@@ -50,6 +51,7 @@ func (r *SQLAuthRepository) GetUserByUsernameAndStoreCode(
 		ID:           userID,
 		Password:     user.UserPassword,
 		IsStoreAdmin: user.IsStoreAdmin.Bool,
+		HasTOTP:      user.HasTotp,
 	}, nil
 }
 
@@ -57,10 +59,12 @@ func (r *SQLAuthRepository) CheckAndDeleteUserLoginCode(
 	ctx context.Context,
 	userID uuid.UUID,
 	loginCode string,
+	now time.Time,
 ) error {
-	loginCodeID, err := r.queries.GetLoginCodeByUserIDAndCode(ctx, gensql.GetLoginCodeByUserIDAndCodeParams{
+	code, err := r.queries.GetLoginCodeByUserIDAndCode(ctx, gensql.GetLoginCodeByUserIDAndCodeParams{
 		UserID:    typemapper.UUIDToPgtypeUUID(userID),
 		LoginCode: loginCode,
+		Now:       typemapper.TimeToPgtypeTimestamptz(now),
 	})
 	if errors.Is(err, pgx.ErrNoRows) {
 		return apperror.ErrLoginCodeMismatch
@@ -68,11 +72,72 @@ func (r *SQLAuthRepository) CheckAndDeleteUserLoginCode(
 		return fmt.Errorf("failed to get login code by user ID and code: %w", err)
 	}
 
-	err = r.queries.DeleteLoginCodeByID(ctx, loginCodeID)
+	// Time-boxed codes can be reused until they expire.
+	if !code.IsSingleUse {
+		return nil
+	}
+
+	affected, err := r.queries.DeleteLoginCodeByID(ctx, code.LoginCodeID)
 	if err != nil {
 		return fmt.Errorf("failed to delete login code by ID: %w", err)
 	}
 
+	// Someone else used the code between the check and the delete.
+	if affected == 0 {
+		return apperror.ErrLoginCodeMismatch
+	}
+
+	return nil
+}
+
+func (r *SQLAuthRepository) GetConfirmedTOTPSecret(ctx context.Context, userID uuid.UUID) (string, error) {
+	secret, err := r.queries.GetConfirmedTOTPSecret(ctx, typemapper.UUIDToPgtypeUUID(userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return "", apperror.ErrTOTPNotFound
+	} else if err != nil {
+		return "", fmt.Errorf("failed to get confirmed TOTP secret: %w", err)
+	}
+
+	return secret, nil
+}
+
+// UseTOTPStep fails if a code from the same or a later time step has already
+// been used, so an intercepted code can't be replayed.
+func (r *SQLAuthRepository) UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error {
+	affected, err := r.queries.UseTOTPStep(ctx, gensql.UseTOTPStepParams{
+		Step:   step,
+		UserID: typemapper.UUIDToPgtypeUUID(userID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to use TOTP step: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrLoginCodeMismatch
+	}
+
+	return nil
+}
+
+func (r *SQLAuthRepository) UseTOTPRecoveryCode(
+	ctx context.Context,
+	userID uuid.UUID,
+	recoveryCode string,
+	now time.Time,
+) error {
+	affected, err := r.queries.UseTOTPRecoveryCode(ctx, gensql.UseTOTPRecoveryCodeParams{
+		UserID:   typemapper.UUIDToPgtypeUUID(userID),
+		CodeHash: hashRecoveryCode(recoveryCode),
+		UseTime:  typemapper.TimeToPgtypeTimestamptz(now),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to use TOTP recovery code: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrLoginCodeMismatch
+	}
+
 	return nil
 }
 
@@ -116,3 +181,25 @@ func (r *SQLAuthRepository) GetUserDetailsByID(ctx context.Context, userID uuid.
 		},
 	}, nil
 }
+
+func (r *SQLAuthRepository) TouchUserSession(
+	ctx context.Context,
+	sessionID uuid.UUID,
+	userID uuid.UUID,
+	now time.Time,
+) error {
+	affected, err := r.queries.TouchUserSession(ctx, gensql.TouchUserSessionParams{
+		Now:           typemapper.TimeToPgtypeTimestamptz(now),
+		UserSessionID: typemapper.UUIDToPgtypeUUID(sessionID),
+		UserID:        typemapper.UUIDToPgtypeUUID(userID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to touch user session: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrUserSessionNotFound
+	}
+
+	return nil
+}
@@ -11,12 +11,15 @@ import (
 
 	"github.com/JosephJoshua/remana-backend/internal/appconstant"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/gensql"
 	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/testutil"
 	"github.com/JosephJoshua/remana-backend/internal/typemapper"
 	"github.com/google/uuid"
@@ -78,10 +81,13 @@ func TestLogin(t *testing.T) {
 
 		repo := repository.NewSQLAuthRepository(db)
 		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
 			serviceSessionManagerStub{},
 			loginCodePromptManagerStub{},
 			repo,
 			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			repository.NewSQLLoginThrottleRepository(db),
 		)
 
 		got, err := s.Login(requestCtx, req)
@@ -101,10 +107,13 @@ func TestLogin(t *testing.T) {
 
 		repo := repository.NewSQLAuthRepository(db)
 		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
 			serviceSessionManagerStub{},
 			loginCodePromptManagerStub{},
 			repo,
 			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			repository.NewSQLLoginThrottleRepository(db),
 		)
 
 		got, err := s.Login(requestCtx, req)
@@ -152,10 +161,13 @@ func TestLogin(t *testing.T) {
 
 				repo := repository.NewSQLAuthRepository(db)
 				s := auth.NewService(
+					testutil.NewTimeProviderStub(time.Now()),
 					serviceSessionManagerStub{},
 					loginCodePromptManagerStub{},
 					repo,
 					testutil.PasswordHasherStub{},
+					totpValidatorStub{},
+					repository.NewSQLLoginThrottleRepository(db),
 				)
 
 				_, err := s.Login(requestCtx, req)
@@ -212,10 +224,13 @@ func TestLoginCodePrompt(t *testing.T) {
 		loginCodePromptManager := &loginCodePromptManagerStub{userID: someRandomID}
 
 		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
 			serviceSessionManagerStub{},
 			loginCodePromptManager,
 			repo,
 			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			repository.NewSQLLoginThrottleRepository(db),
 		)
 
 		err := s.LoginCodePrompt(requestCtx, req)
@@ -231,10 +246,13 @@ func TestLoginCodePrompt(t *testing.T) {
 		loginCodePromptManager := &loginCodePromptManagerStub{userID: theUserID}
 
 		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
 			serviceSessionManagerStub{},
 			loginCodePromptManager,
 			repo,
 			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			repository.NewSQLLoginThrottleRepository(db),
 		)
 
 		err := s.LoginCodePrompt(requestCtx, req)
@@ -245,6 +263,7 @@ func TestLoginCodePrompt(t *testing.T) {
 		_, err := queries.GetLoginCodeByUserIDAndCode(context.Background(), gensql.GetLoginCodeByUserIDAndCodeParams{
 			UserID:    typemapper.UUIDToPgtypeUUID(theUserID),
 			LoginCode: theLoginCode,
+			Now:       typemapper.TimeToPgtypeTimestamptz(time.Now()),
 		})
 		require.NoError(t, err, pgx.ErrNoRows)
 
@@ -256,10 +275,13 @@ func TestLoginCodePrompt(t *testing.T) {
 		loginCodePromptManager := &loginCodePromptManagerStub{userID: theUserID}
 
 		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
 			serviceSessionManagerStub{},
 			loginCodePromptManager,
 			repo,
 			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			repository.NewSQLLoginThrottleRepository(db),
 		)
 
 		err = s.LoginCodePrompt(requestCtx, req)
@@ -268,9 +290,56 @@ func TestLoginCodePrompt(t *testing.T) {
 		_, err = queries.GetLoginCodeByUserIDAndCode(context.Background(), gensql.GetLoginCodeByUserIDAndCodeParams{
 			UserID:    typemapper.UUIDToPgtypeUUID(theUserID),
 			LoginCode: theLoginCode,
+			Now:       typemapper.TimeToPgtypeTimestamptz(time.Now()),
 		})
 		require.ErrorIs(t, err, pgx.ErrNoRows)
 	})
+
+	loginCodeRepo := repository.NewSQLLoginCodeRepository(db)
+	repo := repository.NewSQLAuthRepository(db)
+
+	createLoginCode := func(t *testing.T, code string, singleUse bool, expiryTime optional.Optional[time.Time]) {
+		t.Helper()
+
+		require.NoError(t, loginCodeRepo.CreateLoginCode(context.Background(), user.LoginCode{
+			ID:           uuid.New(),
+			UserID:       theUserID,
+			Code:         code,
+			SingleUse:    singleUse,
+			ExpiryTime:   expiryTime,
+			IssuedBy:     optional.None[uuid.UUID](),
+			CreationTime: time.Now(),
+		}))
+	}
+
+	t.Run("rejects expired login codes", func(t *testing.T) {
+		createLoginCode(t, "EXPIRED1", true, optional.Some(time.Now().Add(-time.Minute)))
+
+		err := repo.CheckAndDeleteUserLoginCode(context.Background(), theUserID, "EXPIRED1", time.Now())
+		require.ErrorIs(t, err, apperror.ErrLoginCodeMismatch)
+	})
+
+	t.Run("accepts time-boxed login codes until they expire", func(t *testing.T) {
+		createLoginCode(t, "TIMEBOX1", false, optional.Some(time.Now().Add(time.Hour)))
+
+		for range 2 {
+			err := repo.CheckAndDeleteUserLoginCode(context.Background(), theUserID, "TIMEBOX1", time.Now())
+			require.NoError(t, err)
+		}
+
+		err := repo.CheckAndDeleteUserLoginCode(context.Background(), theUserID, "TIMEBOX1", time.Now().Add(2*time.Hour))
+		require.ErrorIs(t, err, apperror.ErrLoginCodeMismatch)
+	})
+
+	t.Run("accepts single-use login codes only once", func(t *testing.T) {
+		createLoginCode(t, "SINGLE01", true, optional.Some(time.Now().Add(time.Hour)))
+
+		err := repo.CheckAndDeleteUserLoginCode(context.Background(), theUserID, "SINGLE01", time.Now())
+		require.NoError(t, err)
+
+		err = repo.CheckAndDeleteUserLoginCode(context.Background(), theUserID, "SINGLE01", time.Now())
+		require.ErrorIs(t, err, apperror.ErrLoginCodeMismatch)
+	})
 }
 
 func TestHandleSessionCookie(t *testing.T) {
@@ -318,11 +387,33 @@ func TestHandleSessionCookie(t *testing.T) {
 		theUserDetails,
 	)
 
+	sessionStore := repository.NewSQLSessionStore(db)
+	userSessionRepo := repository.NewSQLUserSessionRepository(db)
+	timeProvider := testutil.NewTimeProviderStub(time.Now())
+
+	createSession := func(t *testing.T, token string) uuid.UUID {
+		t.Helper()
+
+		sessionID := uuid.New()
+
+		require.NoError(t, sessionStore.CommitCtx(context.Background(), token, []byte("data"), time.Now().Add(time.Hour)))
+		require.NoError(t, userSessionRepo.CreateUserSession(context.Background(), token, user.Session{
+			ID:           sessionID,
+			UserID:       theUserDetails.ID,
+			CreationTime: time.Now(),
+			LastSeenTime: time.Now(),
+		}))
+
+		return sessionID
+	}
+
+	theSessionID := createSession(t, "the-token")
+
 	t.Run("returns context with user details when session is valid", func(t *testing.T) {
-		sm := securityHandlerSessionManagerStub{userID: theUserDetails.ID}
+		sm := securityHandlerSessionManagerStub{userID: theUserDetails.ID, sessionID: theSessionID}
 		repo := repository.NewSQLAuthRepository(db)
 
-		s := auth.NewSecurityHandler(sm, repo)
+		s := auth.NewSecurityHandler(timeProvider, sm, repo)
 
 		ctx, err := s.HandleSessionCookie(requestCtx, "", genapi.SessionCookie{APIKey: ""})
 		require.NoError(t, err)
@@ -337,10 +428,23 @@ func TestHandleSessionCookie(t *testing.T) {
 	t.Run("returns unauthorized when user is missing", func(t *testing.T) {
 		var someRandomID = uuid.New()
 
-		sm := securityHandlerSessionManagerStub{userID: someRandomID}
+		sm := securityHandlerSessionManagerStub{userID: someRandomID, sessionID: theSessionID}
+		repo := repository.NewSQLAuthRepository(db)
+
+		s := auth.NewSecurityHandler(timeProvider, sm, repo)
+		_, err := s.HandleSessionCookie(requestCtx, "", genapi.SessionCookie{APIKey: ""})
+
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+
+	t.Run("returns unauthorized when session has been revoked", func(t *testing.T) {
+		revokedSessionID := createSession(t, "the-revoked-token")
+		require.NoError(t, userSessionRepo.RevokeUserSession(context.Background(), theUserDetails.ID, revokedSessionID))
+
+		sm := securityHandlerSessionManagerStub{userID: theUserDetails.ID, sessionID: revokedSessionID}
 		repo := repository.NewSQLAuthRepository(db)
 
-		s := auth.NewSecurityHandler(sm, repo)
+		s := auth.NewSecurityHandler(timeProvider, sm, repo)
 		_, err := s.HandleSessionCookie(requestCtx, "", genapi.SessionCookie{APIKey: ""})
 
 		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
@@ -523,9 +627,20 @@ func (l loginCodePromptManagerStub) DeletePrompt(_ context.Context) error {
 }
 
 type securityHandlerSessionManagerStub struct {
-	userID uuid.UUID
+	userID    uuid.UUID
+	sessionID uuid.UUID
 }
 
 func (s securityHandlerSessionManagerStub) GetUserID(_ context.Context) (uuid.UUID, error) {
 	return s.userID, nil
 }
+
+func (s securityHandlerSessionManagerStub) GetSessionID(_ context.Context) (uuid.UUID, error) {
+	return s.sessionID, nil
+}
+
+type totpValidatorStub struct{}
+
+func (v totpValidatorStub) Validate(_ string, _ string, _ time.Time) (int64, bool) {
+	return 0, false
+}
@@ -74,6 +74,7 @@ func TestCreateDamageType(t *testing.T) {
 			locationProvider,
 			&permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateDamageTypeRequest{
@@ -121,6 +122,7 @@ func TestCreateDamageType(t *testing.T) {
 			locationProvider,
 			&permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateDamageTypeRequest{
@@ -151,6 +153,7 @@ func TestCreateDamageType(t *testing.T) {
 			locationProvider,
 			&permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateDamageTypeRequest{
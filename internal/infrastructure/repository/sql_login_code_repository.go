@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SQLLoginCodeRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLLoginCodeRepository(db *pgxpool.Pool) *SQLLoginCodeRepository {
+	return &SQLLoginCodeRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLLoginCodeRepository) CreateLoginCode(ctx context.Context, code user.LoginCode) error {
+	if err := r.queries.CreateLoginCode(ctx, gensql.CreateLoginCodeParams{
+		LoginCodeID:  typemapper.UUIDToPgtypeUUID(code.ID),
+		UserID:       typemapper.UUIDToPgtypeUUID(code.UserID),
+		LoginCode:    code.Code,
+		IsSingleUse:  code.SingleUse,
+		ExpiryTime:   typemapper.OptionalTimeToPgtypeTimestamptz(code.ExpiryTime),
+		IssuedBy:     typemapper.OptionalUUIDToPgtypeUUID(code.IssuedBy),
+		CreationTime: typemapper.TimeToPgtypeTimestamptz(code.CreationTime),
+	}); err != nil {
+		return fmt.Errorf("failed to create login code: %w", err)
+	}
+
+	return nil
+}
+
+// GetActiveLoginCodes doesn't return the codes themselves; they are only
+// shown when issued.
+func (r *SQLLoginCodeRepository) GetActiveLoginCodes(
+	ctx context.Context,
+	userID uuid.UUID,
+	now time.Time,
+) ([]user.LoginCode, error) {
+	rows, err := r.queries.GetActiveLoginCodesByUserID(ctx, gensql.GetActiveLoginCodesByUserIDParams{
+		UserID: typemapper.UUIDToPgtypeUUID(userID),
+		Now:    typemapper.TimeToPgtypeTimestamptz(now),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active login codes: %w", err)
+	}
+
+	codes := make([]user.LoginCode, 0, len(rows))
+	for _, row := range rows {
+		code := user.LoginCode{
+			ID:           typemapper.MustPgtypeUUIDToUUID(row.LoginCodeID),
+			UserID:       typemapper.MustPgtypeUUIDToUUID(row.UserID),
+			SingleUse:    row.IsSingleUse,
+			ExpiryTime:   typemapper.PgtypeTimestamptzToOptionalTime(row.ExpiryTime),
+			IssuedBy:     optional.None[uuid.UUID](),
+			CreationTime: row.CreationTime.Time,
+		}
+
+		if row.IssuedBy.Valid {
+			code.IssuedBy = optional.Some(typemapper.MustPgtypeUUIDToUUID(row.IssuedBy))
+		}
+
+		codes = append(codes, code)
+	}
+
+	return codes, nil
+}
+
+func (r *SQLLoginCodeRepository) DeleteLoginCode(ctx context.Context, userID uuid.UUID, loginCodeID uuid.UUID) error {
+	affected, err := r.queries.DeleteLoginCode(ctx, gensql.DeleteLoginCodeParams{
+		LoginCodeID: typemapper.UUIDToPgtypeUUID(loginCodeID),
+		UserID:      typemapper.UUIDToPgtypeUUID(userID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete login code: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrLoginCodeNotFound
+	}
+
+	return nil
+}
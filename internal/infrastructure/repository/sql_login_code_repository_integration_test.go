@@ -0,0 +1,151 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginCodeRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime     = time.Now().Truncate(time.Microsecond)
+		theStoreID  = uuid.New()
+		theUserID   = uuid.New()
+		theAdminID  = uuid.New()
+		theOtherID  = uuid.New()
+		createCount = 0
+	)
+
+	queries := gensql.New(db)
+
+	_, initErr = queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		StoreName:    "Not important",
+		StoreCode:    "store-a",
+		StoreAddress: "Not important",
+		PhoneNumber:  "+6281234567890",
+	})
+	require.NoError(t, initErr)
+
+	roleID, initErr := queries.SeedRole(context.Background(), gensql.SeedRoleParams{
+		RoleID:       typemapper.UUIDToPgtypeUUID(uuid.New()),
+		RoleName:     "Not important",
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		IsStoreAdmin: false,
+	})
+	require.NoError(t, initErr)
+
+	for i, userID := range []uuid.UUID{theUserID, theAdminID, theOtherID} {
+		_, initErr = queries.SeedUser(context.Background(), gensql.SeedUserParams{
+			UserID:       typemapper.UUIDToPgtypeUUID(userID),
+			Username:     []string{"user-a", "user-b", "user-c"}[i],
+			UserPassword: "notimportant",
+			RoleID:       roleID,
+			StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, initErr)
+	}
+
+	repo := repository.NewSQLLoginCodeRepository(db)
+
+	createLoginCode := func(t *testing.T, userID uuid.UUID, expiryTime optional.Optional[time.Time]) user.LoginCode {
+		t.Helper()
+
+		createCount++
+		code := user.LoginCode{
+			ID:           uuid.New(),
+			UserID:       userID,
+			Code:         fmt.Sprintf("CODE%04d", createCount),
+			SingleUse:    !expiryTime.IsSet(),
+			ExpiryTime:   expiryTime,
+			IssuedBy:     optional.Some(theAdminID),
+			CreationTime: theTime.Add(time.Duration(createCount) * time.Minute),
+		}
+
+		require.NoError(t, repo.CreateLoginCode(context.Background(), code))
+		return code
+	}
+
+	t.Run("lists active login codes of the user, newest first", func(t *testing.T) {
+		first := createLoginCode(t, theUserID, optional.None[time.Time]())
+		second := createLoginCode(t, theUserID, optional.Some(theTime.Add(time.Hour)))
+		_ = createLoginCode(t, theUserID, optional.Some(theTime.Add(-time.Minute)))
+		_ = createLoginCode(t, theOtherID, optional.None[time.Time]())
+
+		codes, err := repo.GetActiveLoginCodes(context.Background(), theUserID, theTime)
+		require.NoError(t, err)
+		require.Len(t, codes, 2)
+
+		assert.Equal(t, second.ID, codes[0].ID)
+		assert.False(t, codes[0].SingleUse)
+		assert.True(t, theTime.Add(time.Hour).Equal(codes[0].ExpiryTime.MustGet()))
+		assert.Equal(t, optional.Some(theAdminID), codes[0].IssuedBy)
+		assert.Empty(t, codes[0].Code)
+
+		assert.Equal(t, first.ID, codes[1].ID)
+		assert.True(t, codes[1].SingleUse)
+		assert.False(t, codes[1].ExpiryTime.IsSet())
+		assert.True(t, first.CreationTime.Equal(codes[1].CreationTime))
+	})
+
+	t.Run("revokes a login code of the user", func(t *testing.T) {
+		code := createLoginCode(t, theUserID, optional.None[time.Time]())
+
+		require.NoError(t, repo.DeleteLoginCode(context.Background(), theUserID, code.ID))
+
+		err := repo.DeleteLoginCode(context.Background(), theUserID, code.ID)
+		require.ErrorIs(t, err, apperror.ErrLoginCodeNotFound)
+	})
+
+	t.Run("does not revoke login codes of other users", func(t *testing.T) {
+		code := createLoginCode(t, theOtherID, optional.None[time.Time]())
+
+		err := repo.DeleteLoginCode(context.Background(), theUserID, code.ID)
+		require.ErrorIs(t, err, apperror.ErrLoginCodeNotFound)
+
+		codes, err := repo.GetActiveLoginCodes(context.Background(), theOtherID, theTime)
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, 0, len(codes))
+		for _, c := range codes {
+			ids = append(ids, c.ID)
+		}
+		assert.Contains(t, ids, code.ID)
+	})
+}
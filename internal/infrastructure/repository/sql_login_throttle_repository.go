@@ -0,0 +1,131 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SQLLoginThrottleRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLLoginThrottleRepository(db *pgxpool.Pool) *SQLLoginThrottleRepository {
+	return &SQLLoginThrottleRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLLoginThrottleRepository) GetLoginThrottle(
+	ctx context.Context,
+	key auth.ThrottleKey,
+) (auth.LoginThrottle, error) {
+	row, err := r.queries.GetLoginThrottle(ctx, gensql.GetLoginThrottleParams{
+		ThrottleScope: string(key.Scope),
+		ThrottleKey:   key.Value,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return auth.LoginThrottle{}, apperror.ErrLoginThrottleNotFound
+	} else if err != nil {
+		return auth.LoginThrottle{}, fmt.Errorf("failed to get login throttle: %w", err)
+	}
+
+	return auth.LoginThrottle{
+		FailureCount:    int(row.FailureCount),
+		LastFailureTime: row.LastFailureTime.Time,
+		LockedUntil:     typemapper.PgtypeTimestamptzToOptionalTime(row.LockedUntil),
+	}, nil
+}
+
+// RecordLoginFailure starts counting again from one if the last failure was
+// before forgetBefore or the key's lockout has expired, so a single failure
+// after a lockout doesn't lock the key again. It returns the new failure count.
+func (r *SQLLoginThrottleRepository) RecordLoginFailure(
+	ctx context.Context,
+	key auth.ThrottleKey,
+	now time.Time,
+	forgetBefore time.Time,
+) (int, error) {
+	count, err := r.queries.RecordLoginFailure(ctx, gensql.RecordLoginFailureParams{
+		ThrottleScope: string(key.Scope),
+		ThrottleKey:   key.Value,
+		Now:           typemapper.TimeToPgtypeTimestamptz(now),
+		ForgetBefore:  typemapper.TimeToPgtypeTimestamptz(forgetBefore),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to record login failure: %w", err)
+	}
+
+	return int(count), nil
+}
+
+func (r *SQLLoginThrottleRepository) LockLoginThrottle(
+	ctx context.Context,
+	key auth.ThrottleKey,
+	lockedUntil time.Time,
+) error {
+	if err := r.queries.LockLoginThrottle(ctx, gensql.LockLoginThrottleParams{
+		ThrottleScope: string(key.Scope),
+		ThrottleKey:   key.Value,
+		LockedUntil:   typemapper.TimeToPgtypeTimestamptz(lockedUntil),
+	}); err != nil {
+		return fmt.Errorf("failed to lock login throttle: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLLoginThrottleRepository) ResetLoginThrottle(ctx context.Context, key auth.ThrottleKey) error {
+	if _, err := r.queries.DeleteLoginThrottle(ctx, gensql.DeleteLoginThrottleParams{
+		ThrottleScope: string(key.Scope),
+		ThrottleKey:   key.Value,
+	}); err != nil {
+		return fmt.Errorf("failed to delete login throttle: %w", err)
+	}
+
+	return nil
+}
+
+// UnlockUserLogin clears the failed attempts counted against the user's
+// account and login codes. Those counted against client IPs are left alone.
+func (r *SQLLoginThrottleRepository) UnlockUserLogin(ctx context.Context, userID uuid.UUID) error {
+	details, err := r.queries.GetUserDetailsByID(ctx, typemapper.UUIDToPgtypeUUID(userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return apperror.ErrUserNotFound
+	} else if err != nil {
+		return fmt.Errorf("failed to get user details: %w", err)
+	}
+
+	keys := []auth.ThrottleKey{
+		auth.AccountThrottleKey(details.StoreCode.String, details.Username),
+		auth.LoginCodeThrottleKey(userID),
+	}
+
+	for _, key := range keys {
+		if err = r.ResetLoginThrottle(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DeleteStaleLoginThrottles removes throttles that haven't seen a failure or
+// been locked since before.
+func (r *SQLLoginThrottleRepository) DeleteStaleLoginThrottles(ctx context.Context, before time.Time) (int64, error) {
+	n, err := r.queries.DeleteStaleLoginThrottles(ctx, typemapper.TimeToPgtypeTimestamptz(before))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete stale login throttles: %w", err)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,182 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoginThrottleRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime      = time.Now().Truncate(time.Microsecond)
+		theStoreID   = uuid.New()
+		theUserID    = uuid.New()
+		theUsername  = "user-a"
+		theStoreCode = "store-a"
+		theClientIP  = "192.0.2.1"
+	)
+
+	queries := gensql.New(db)
+
+	_, initErr = queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		StoreName:    "Not important",
+		StoreCode:    theStoreCode,
+		StoreAddress: "Not important",
+		PhoneNumber:  "+6281234567890",
+	})
+	require.NoError(t, initErr)
+
+	roleID, initErr := queries.SeedRole(context.Background(), gensql.SeedRoleParams{
+		RoleID:       typemapper.UUIDToPgtypeUUID(uuid.New()),
+		RoleName:     "Not important",
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		IsStoreAdmin: false,
+	})
+	require.NoError(t, initErr)
+
+	_, initErr = queries.SeedUser(context.Background(), gensql.SeedUserParams{
+		UserID:       typemapper.UUIDToPgtypeUUID(theUserID),
+		Username:     theUsername,
+		UserPassword: "notimportant",
+		RoleID:       roleID,
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+	})
+	require.NoError(t, initErr)
+
+	repo := repository.NewSQLLoginThrottleRepository(db)
+	ctx := context.Background()
+
+	accountKey := auth.AccountThrottleKey(theStoreCode, theUsername)
+	loginCodeKey := auth.LoginCodeThrottleKey(theUserID)
+	clientIPKey := auth.ClientIPThrottleKey(theClientIP)
+
+	// ORDER MATTERS!
+
+	t.Run("returns not found when there are no failures", func(t *testing.T) {
+		_, err := repo.GetLoginThrottle(ctx, accountKey)
+		require.ErrorIs(t, err, apperror.ErrLoginThrottleNotFound)
+	})
+
+	t.Run("counts failures per key", func(t *testing.T) {
+		for i := 1; i <= 3; i++ {
+			count, err := repo.RecordLoginFailure(ctx, accountKey, theTime, theTime.Add(-time.Hour))
+			require.NoError(t, err)
+			assert.Equal(t, i, count)
+		}
+
+		count, err := repo.RecordLoginFailure(ctx, clientIPKey, theTime, theTime.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		throttle, err := repo.GetLoginThrottle(ctx, accountKey)
+		require.NoError(t, err)
+		assert.Equal(t, 3, throttle.FailureCount)
+		assert.True(t, theTime.Equal(throttle.LastFailureTime))
+		assert.False(t, throttle.LockedUntil.IsSet())
+	})
+
+	t.Run("starts counting again after old failures are forgotten", func(t *testing.T) {
+		later := theTime.Add(2 * time.Hour)
+
+		count, err := repo.RecordLoginFailure(ctx, clientIPKey, later, later.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("locks keys", func(t *testing.T) {
+		lockedUntil := theTime.Add(15 * time.Minute)
+		require.NoError(t, repo.LockLoginThrottle(ctx, accountKey, lockedUntil))
+
+		_, err := repo.RecordLoginFailure(ctx, loginCodeKey, theTime, theTime.Add(-time.Hour))
+		require.NoError(t, err)
+		require.NoError(t, repo.LockLoginThrottle(ctx, loginCodeKey, lockedUntil))
+
+		throttle, err := repo.GetLoginThrottle(ctx, accountKey)
+		require.NoError(t, err)
+
+		got, ok := throttle.LockedUntil.Get()
+		require.True(t, ok)
+		assert.True(t, lockedUntil.Equal(got))
+	})
+
+	t.Run("keeps counting while a key is locked", func(t *testing.T) {
+		count, err := repo.RecordLoginFailure(ctx, loginCodeKey, theTime.Add(5*time.Minute), theTime.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		throttle, err := repo.GetLoginThrottle(ctx, loginCodeKey)
+		require.NoError(t, err)
+		assert.True(t, throttle.LockedUntil.IsSet())
+	})
+
+	t.Run("starts counting again once the lockout has expired", func(t *testing.T) {
+		later := theTime.Add(20 * time.Minute)
+
+		count, err := repo.RecordLoginFailure(ctx, accountKey, later, later.Add(-time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		throttle, err := repo.GetLoginThrottle(ctx, accountKey)
+		require.NoError(t, err)
+		assert.False(t, throttle.LockedUntil.IsSet())
+	})
+
+	t.Run("unlocking a user clears their account and login code throttles", func(t *testing.T) {
+		require.NoError(t, repo.UnlockUserLogin(ctx, theUserID))
+
+		_, err := repo.GetLoginThrottle(ctx, accountKey)
+		require.ErrorIs(t, err, apperror.ErrLoginThrottleNotFound)
+
+		_, err = repo.GetLoginThrottle(ctx, loginCodeKey)
+		require.ErrorIs(t, err, apperror.ErrLoginThrottleNotFound)
+
+		_, err = repo.GetLoginThrottle(ctx, clientIPKey)
+		require.NoError(t, err)
+
+		require.ErrorIs(t, repo.UnlockUserLogin(ctx, uuid.New()), apperror.ErrUserNotFound)
+	})
+
+	t.Run("deletes stale throttles", func(t *testing.T) {
+		n, err := repo.DeleteStaleLoginThrottles(ctx, theTime.Add(3*time.Hour))
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		_, err = repo.GetLoginThrottle(ctx, clientIPKey)
+		require.ErrorIs(t, err, apperror.ErrLoginThrottleNotFound)
+	})
+}
@@ -0,0 +1,133 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/notification"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SQLNotificationRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLNotificationRepository(db *pgxpool.Pool) *SQLNotificationRepository {
+	return &SQLNotificationRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLNotificationRepository) GetStoreNotificationSettings(
+	ctx context.Context,
+	storeID uuid.UUID,
+	event notification.Event,
+) (notification.StoreSettings, error) {
+	row, err := r.queries.GetStoreNotificationSettings(ctx, gensql.GetStoreNotificationSettingsParams{
+		StoreID: typemapper.UUIDToPgtypeUUID(storeID),
+		Event:   string(event),
+	})
+	if err != nil {
+		return notification.StoreSettings{}, fmt.Errorf("failed to get store notification settings: %w", err)
+	}
+
+	language, err := notification.NewLanguage(row.NotificationLanguage)
+	if err != nil {
+		return notification.StoreSettings{}, fmt.Errorf("failed to parse notification language: %w", err)
+	}
+
+	return notification.StoreSettings{
+		StoreName: row.StoreName,
+		Language:  language,
+		Template:  typemapper.PgtypeTextToOptionalString(row.Template),
+	}, nil
+}
+
+func (r *SQLNotificationRepository) CreateNotification(ctx context.Context, n notification.Notification) error {
+	if err := r.queries.CreateNotification(ctx, gensql.CreateNotificationParams{
+		NotificationID:  typemapper.UUIDToPgtypeUUID(n.ID),
+		StoreID:         typemapper.UUIDToPgtypeUUID(n.StoreID),
+		RepairOrderID:   typemapper.UUIDToPgtypeUUID(n.RepairOrderID),
+		Event:           string(n.Event),
+		Recipient:       n.Recipient,
+		Message:         n.Message,
+		CreationTime:    typemapper.TimeToPgtypeTimestamptz(n.CreationTime),
+		NextAttemptTime: typemapper.TimeToPgtypeTimestamptz(n.CreationTime),
+		EventID:         typemapper.UUIDToPgtypeUUID(n.EventID),
+	}); err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLNotificationRepository) ClaimPendingNotifications(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	maxAttempts int,
+	limit int,
+) ([]notification.Notification, error) {
+	rows, err := r.queries.ClaimPendingNotifications(ctx, gensql.ClaimPendingNotificationsParams{
+		LeaseUntil:  typemapper.TimeToPgtypeTimestamptz(leaseUntil),
+		MaxAttempts: int32(maxAttempts),
+		Now:         typemapper.TimeToPgtypeTimestamptz(now),
+		BatchSize:   int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending notifications: %w", err)
+	}
+
+	notifications := make([]notification.Notification, 0, len(rows))
+	for _, row := range rows {
+		notifications = append(notifications, notification.Notification{
+			ID:            typemapper.MustPgtypeUUIDToUUID(row.NotificationID),
+			EventID:       typemapper.MustPgtypeUUIDToUUID(row.EventID),
+			StoreID:       typemapper.MustPgtypeUUIDToUUID(row.StoreID),
+			RepairOrderID: typemapper.MustPgtypeUUIDToUUID(row.RepairOrderID),
+			Event:         notification.Event(row.Event),
+			Recipient:     row.Recipient,
+			Message:       row.Message,
+			CreationTime:  row.CreationTime.Time,
+			Attempts:      int(row.Attempts),
+		})
+	}
+
+	return notifications, nil
+}
+
+func (r *SQLNotificationRepository) MarkNotificationSent(
+	ctx context.Context,
+	notificationID uuid.UUID,
+	sentTime time.Time,
+) error {
+	if err := r.queries.MarkNotificationSent(ctx, gensql.MarkNotificationSentParams{
+		NotificationID: typemapper.UUIDToPgtypeUUID(notificationID),
+		SentTime:       typemapper.TimeToPgtypeTimestamptz(sentTime),
+	}); err != nil {
+		return fmt.Errorf("failed to mark notification as sent: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLNotificationRepository) MarkNotificationFailed(
+	ctx context.Context,
+	notificationID uuid.UUID,
+	nextAttemptTime time.Time,
+	lastError string,
+) error {
+	if err := r.queries.MarkNotificationFailed(ctx, gensql.MarkNotificationFailedParams{
+		NotificationID:  typemapper.UUIDToPgtypeUUID(notificationID),
+		NextAttemptTime: typemapper.TimeToPgtypeTimestamptz(nextAttemptTime),
+		LastError:       typemapper.StringToPgtypeText(lastError),
+	}); err != nil {
+		return fmt.Errorf("failed to mark notification as failed: %w", err)
+	}
+
+	return nil
+}
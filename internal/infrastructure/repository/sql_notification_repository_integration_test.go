@@ -0,0 +1,253 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/modules/notification"
+	"github.com/JosephJoshua/remana-backend/internal/modules/outbox"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime = time.Unix(1713917762, 0)
+
+		theStoreID       = uuid.New()
+		theSalesPersonID = uuid.New()
+		theTechnicianID  = uuid.New()
+
+		theDamage         = damage{id: uuid.New(), name: "Broken Screen"}
+		thePhoneCondition = phoneCondition{id: uuid.New(), name: "Screen scratched"}
+		theEquipment      = phoneEquipment{id: uuid.New(), name: "Battery"}
+	)
+
+	queries := gensql.New(db)
+
+	seedCreateRepairOrder(
+		context.Background(),
+		t,
+		queries,
+		theStoreID,
+		uuid.New(),
+		theSalesPersonID,
+		uuid.New(),
+		theTechnicianID,
+		uuid.New(),
+		uuid.New(),
+		uuid.New(),
+		theDamage,
+		damage{id: uuid.New(), name: theDamage.name},
+		thePhoneCondition,
+		phoneCondition{id: uuid.New(), name: thePhoneCondition.name},
+		theEquipment,
+		phoneEquipment{id: uuid.New(), name: theEquipment.name},
+	)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+		}),
+	)
+
+	repo := repository.NewSQLNotificationRepository(db)
+	repairOrderRepo := repository.NewSQLRepairOrderRepository(db)
+
+	dispatcher := outbox.NewDispatcher(
+		repository.NewSQLOrderEventRepository(db),
+		testutil.NewTimeProviderStub(theTime),
+		notification.NewPublisher(repo, repairOrderRepo, testutil.NewTimeProviderStub(theTime)),
+	)
+
+	// createOrder creates an order through the repair order service and
+	// dispatches its created event, which queues the notification.
+	createOrder := func(t *testing.T, slug string) uuid.UUID {
+		locationProvider := &testutil.ResourceLocationProviderStub{}
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			locationProvider,
+			repairOrderRepo,
+			permissionProviderStub{},
+			testutil.NewRepairOrderSlugProviderStub(slug, nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.CreateRepairOrder(requestCtx, &genapi.CreateRepairOrderRequest{
+			CustomerName:       "John Doe",
+			ContactPhoneNumber: "08123456789",
+			PhoneType:          "iPhone 12",
+			Color:              "Black",
+			SalesPersonID:      theSalesPersonID,
+			TechnicianID:       theTechnicianID,
+			InitialCost:        100,
+			DamageTypes:        []uuid.UUID{theDamage.id},
+			PhoneConditions:    []uuid.UUID{thePhoneCondition.id},
+			PhoneEquipments:    []uuid.UUID{theEquipment.id},
+			Photos:             []uuid.UUID{seedPhoto(t, queries, theStoreID)},
+		})
+		require.NoError(t, err)
+		require.True(t, locationProvider.RepairOrderID.IsSet(), "location provider not called with repair order id")
+
+		require.NoError(t, dispatcher.DispatchPending(requestCtx))
+
+		return locationProvider.RepairOrderID.MustGet()
+	}
+
+	getNotifications := func(t *testing.T, orderID uuid.UUID) []gensql.Notification {
+		notifications, err := queries.GetNotificationsForTesting(
+			context.Background(),
+			typemapper.UUIDToPgtypeUUID(orderID),
+		)
+		require.NoError(t, err)
+
+		return notifications
+	}
+
+	t.Run("queues notification in indonesian by default", func(t *testing.T) {
+		orderID := createOrder(t, "notify-default")
+
+		notifications := getNotifications(t, orderID)
+		require.Len(t, notifications, 1)
+
+		assert.Equal(t, string(notification.EventOrderCreated), notifications[0].Event)
+		assert.Equal(t, "+628123456789", notifications[0].Recipient)
+		assert.True(t, strings.HasPrefix(notifications[0].Message, "Halo John Doe"))
+		assert.Equal(t, theTime, notifications[0].NextAttemptTime.Time)
+	})
+
+	t.Run("queues notification with the store's language and template", func(t *testing.T) {
+		err := queries.SetStoreNotificationLanguage(context.Background(), gensql.SetStoreNotificationLanguageParams{
+			StoreID:              typemapper.UUIDToPgtypeUUID(theStoreID),
+			NotificationLanguage: string(notification.LanguageEnglish),
+		})
+		require.NoError(t, err)
+
+		err = queries.SeedNotificationTemplate(context.Background(), gensql.SeedNotificationTemplateParams{
+			NotificationTemplateID: typemapper.UUIDToPgtypeUUID(uuid.New()),
+			StoreID:                typemapper.UUIDToPgtypeUUID(theStoreID),
+			Event:                  string(notification.EventOrderCreated),
+			Language:               string(notification.LanguageEnglish),
+			Template:               "Order {{ .Slug }} received",
+		})
+		require.NoError(t, err)
+
+		orderID := createOrder(t, "notify-template")
+
+		notifications := getNotifications(t, orderID)
+		require.Len(t, notifications, 1)
+		assert.Equal(t, "Order notify-template received", notifications[0].Message)
+	})
+
+	t.Run("queues notification once when event is delivered again", func(t *testing.T) {
+		orderID := createOrder(t, "notify-once")
+
+		events, err := queries.GetRepairOrderEventsForTesting(
+			context.Background(),
+			typemapper.UUIDToPgtypeUUID(orderID),
+		)
+		require.NoError(t, err)
+		require.Len(t, events, 1)
+
+		publisher := notification.NewPublisher(repo, repairOrderRepo, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, publisher.HandleOrderEvent(requestCtx, domain.OrderCreated{
+			OrderEventMetadata: domain.OrderEventMetadata{
+				EventID: typemapper.MustPgtypeUUIDToUUID(events[0].EventID),
+				OrderID: orderID,
+				StoreID: theStoreID,
+			},
+		}))
+
+		assert.Len(t, getNotifications(t, orderID), 1)
+	})
+
+	t.Run("claims due notifications and records the outcome", func(t *testing.T) {
+		sentOrderID := createOrder(t, "notify-sent")
+		failedOrderID := createOrder(t, "notify-failed")
+
+		now := theTime.Add(time.Minute)
+		leaseUntil := now.Add(5 * time.Minute)
+
+		claimed, err := repo.ClaimPendingNotifications(context.Background(), now, leaseUntil, 10, 100)
+		require.NoError(t, err)
+
+		ids := make(map[uuid.UUID]uuid.UUID)
+		for _, n := range claimed {
+			ids[n.RepairOrderID] = n.ID
+		}
+
+		require.Contains(t, ids, sentOrderID)
+		require.Contains(t, ids, failedOrderID)
+
+		claimedAgain, err := repo.ClaimPendingNotifications(context.Background(), now, leaseUntil, 10, 100)
+		require.NoError(t, err)
+		assert.Empty(t, claimedAgain, "claimed notifications should be leased")
+
+		require.NoError(t, repo.MarkNotificationSent(context.Background(), ids[sentOrderID], now))
+
+		nextAttemptTime := now.Add(30 * time.Second)
+		require.NoError(t, repo.MarkNotificationFailed(
+			context.Background(),
+			ids[failedOrderID],
+			nextAttemptTime,
+			"gateway is down",
+		))
+
+		sent := getNotifications(t, sentOrderID)
+		require.Len(t, sent, 1)
+		assert.Equal(t, now, sent[0].SentTime.Time)
+		assert.Equal(t, int32(1), sent[0].Attempts)
+
+		failed := getNotifications(t, failedOrderID)
+		require.Len(t, failed, 1)
+		assert.False(t, failed[0].SentTime.Valid)
+		assert.Equal(t, nextAttemptTime, failed[0].NextAttemptTime.Time)
+		assert.Equal(t, "gateway is down", failed[0].LastError.String)
+		assert.Equal(t, int32(1), failed[0].Attempts)
+
+		retried, err := repo.ClaimPendingNotifications(context.Background(), nextAttemptTime, nextAttemptTime.Add(time.Minute), 10, 100)
+		require.NoError(t, err)
+		require.Len(t, retried, 1)
+		assert.Equal(t, failedOrderID, retried[0].RepairOrderID)
+	})
+}
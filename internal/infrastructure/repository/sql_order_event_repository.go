@@ -0,0 +1,227 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/outbox"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SQLOrderEventRepository reads the outbox of order events, which
+// SQLRepairOrderRepository writes to when it saves an order.
+type SQLOrderEventRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLOrderEventRepository(db *pgxpool.Pool) *SQLOrderEventRepository {
+	return &SQLOrderEventRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLOrderEventRepository) ClaimPendingOrderEvents(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) ([]outbox.PendingEvent, error) {
+	rows, err := r.queries.ClaimPendingRepairOrderEvents(ctx, gensql.ClaimPendingRepairOrderEventsParams{
+		LeaseUntil: typemapper.TimeToPgtypeTimestamptz(leaseUntil),
+		Now:        typemapper.TimeToPgtypeTimestamptz(now),
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending repair order events: %w", err)
+	}
+
+	pending := make([]outbox.PendingEvent, 0, len(rows))
+	for _, row := range rows {
+		event, unmarshalErr := unmarshalOrderEvent(row)
+		if unmarshalErr != nil {
+			return nil, fmt.Errorf("failed to unmarshal repair order event: %w", unmarshalErr)
+		}
+
+		pending = append(pending, outbox.PendingEvent{
+			Event:    event,
+			Attempts: int(row.Attempts),
+		})
+	}
+
+	return pending, nil
+}
+
+func (r *SQLOrderEventRepository) MarkOrderEventDispatched(
+	ctx context.Context,
+	eventID uuid.UUID,
+	dispatchedTime time.Time,
+) error {
+	if err := r.queries.MarkRepairOrderEventDispatched(ctx, gensql.MarkRepairOrderEventDispatchedParams{
+		EventID:        typemapper.UUIDToPgtypeUUID(eventID),
+		DispatchedTime: typemapper.TimeToPgtypeTimestamptz(dispatchedTime),
+	}); err != nil {
+		return fmt.Errorf("failed to mark repair order event as dispatched: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLOrderEventRepository) MarkOrderEventFailed(
+	ctx context.Context,
+	eventID uuid.UUID,
+	nextAttemptTime time.Time,
+	lastError string,
+) error {
+	if err := r.queries.MarkRepairOrderEventFailed(ctx, gensql.MarkRepairOrderEventFailedParams{
+		EventID:         typemapper.UUIDToPgtypeUUID(eventID),
+		NextAttemptTime: typemapper.TimeToPgtypeTimestamptz(nextAttemptTime),
+		LastError:       typemapper.StringToPgtypeText(lastError),
+	}); err != nil {
+		return fmt.Errorf("failed to mark repair order event as failed: %w", err)
+	}
+
+	return nil
+}
+
+// The payloads hold what each event carries besides its metadata, which is
+// stored in columns of its own.
+type (
+	orderCostMutatedPayload struct {
+		Amount int    `json:"amount"`
+		Reason string `json:"reason"`
+	}
+
+	orderTechnicianChangedPayload struct {
+		PreviousTechnicianID uuid.UUID `json:"previous_technician_id"`
+		TechnicianID         uuid.UUID `json:"technician_id"`
+		Reason               string    `json:"reason"`
+	}
+
+	orderConfirmedPayload struct {
+		Contents string `json:"contents"`
+	}
+
+	orderCancelledPayload struct {
+		Reason string `json:"reason"`
+	}
+)
+
+func saveRepairOrderEvents(ctx context.Context, qtx *gensql.Queries, order domain.Order) error {
+	for _, event := range order.Events() {
+		payload, err := marshalOrderEventPayload(event)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s event: %w", event.Type(), err)
+		}
+
+		metadata := event.Metadata()
+
+		if err = qtx.CreateRepairOrderEvent(ctx, gensql.CreateRepairOrderEventParams{
+			EventID:         typemapper.UUIDToPgtypeUUID(metadata.EventID),
+			StoreID:         typemapper.UUIDToPgtypeUUID(metadata.StoreID),
+			RepairOrderID:   typemapper.UUIDToPgtypeUUID(metadata.OrderID),
+			EventType:       string(event.Type()),
+			Payload:         payload,
+			OccurredTime:    typemapper.TimeToPgtypeTimestamptz(metadata.OccurredTime),
+			NextAttemptTime: typemapper.TimeToPgtypeTimestamptz(metadata.OccurredTime),
+		}); err != nil {
+			return fmt.Errorf("failed to create %s event: %w", event.Type(), err)
+		}
+	}
+
+	return nil
+}
+
+func marshalOrderEventPayload(event domain.OrderEvent) ([]byte, error) {
+	var payload any
+
+	switch e := event.(type) {
+	case domain.OrderCostMutated:
+		payload = orderCostMutatedPayload{Amount: e.Amount, Reason: e.Reason}
+	case domain.OrderTechnicianChanged:
+		payload = orderTechnicianChangedPayload{
+			PreviousTechnicianID: e.PreviousTechnicianID,
+			TechnicianID:         e.TechnicianID,
+			Reason:               e.Reason,
+		}
+	case domain.OrderConfirmed:
+		payload = orderConfirmedPayload{Contents: e.Contents}
+	case domain.OrderCancelled:
+		payload = orderCancelledPayload{Reason: e.Reason}
+	case domain.OrderCreated, domain.OrderCompleted, domain.OrderPickedUp:
+		payload = struct{}{}
+	default:
+		return nil, fmt.Errorf("unknown event type %T", event)
+	}
+
+	return json.Marshal(payload)
+}
+
+func unmarshalOrderEvent(row gensql.RepairOrderEvent) (domain.OrderEvent, error) {
+	metadata := domain.OrderEventMetadata{
+		EventID:      typemapper.MustPgtypeUUIDToUUID(row.EventID),
+		OrderID:      typemapper.MustPgtypeUUIDToUUID(row.RepairOrderID),
+		StoreID:      typemapper.MustPgtypeUUIDToUUID(row.StoreID),
+		OccurredTime: row.OccurredTime.Time,
+	}
+
+	switch domain.OrderEventType(row.EventType) {
+	case domain.OrderEventTypeCreated:
+		return domain.OrderCreated{OrderEventMetadata: metadata}, nil
+
+	case domain.OrderEventTypeCostMutated:
+		var payload orderCostMutatedPayload
+		if err := json.Unmarshal(row.Payload, &payload); err != nil {
+			return nil, err
+		}
+
+		return domain.OrderCostMutated{
+			OrderEventMetadata: metadata,
+			Amount:             payload.Amount,
+			Reason:             payload.Reason,
+		}, nil
+
+	case domain.OrderEventTypeTechnicianChanged:
+		var payload orderTechnicianChangedPayload
+		if err := json.Unmarshal(row.Payload, &payload); err != nil {
+			return nil, err
+		}
+
+		return domain.OrderTechnicianChanged{
+			OrderEventMetadata:   metadata,
+			PreviousTechnicianID: payload.PreviousTechnicianID,
+			TechnicianID:         payload.TechnicianID,
+			Reason:               payload.Reason,
+		}, nil
+
+	case domain.OrderEventTypeConfirmed:
+		var payload orderConfirmedPayload
+		if err := json.Unmarshal(row.Payload, &payload); err != nil {
+			return nil, err
+		}
+
+		return domain.OrderConfirmed{OrderEventMetadata: metadata, Contents: payload.Contents}, nil
+
+	case domain.OrderEventTypeCompleted:
+		return domain.OrderCompleted{OrderEventMetadata: metadata}, nil
+
+	case domain.OrderEventTypePickedUp:
+		return domain.OrderPickedUp{OrderEventMetadata: metadata}, nil
+
+	case domain.OrderEventTypeCancelled:
+		var payload orderCancelledPayload
+		if err := json.Unmarshal(row.Payload, &payload); err != nil {
+			return nil, err
+		}
+
+		return domain.OrderCancelled{OrderEventMetadata: metadata, Reason: payload.Reason}, nil
+
+	default:
+		return nil, fmt.Errorf("unknown event type %q", row.EventType)
+	}
+}
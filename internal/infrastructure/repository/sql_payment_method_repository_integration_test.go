@@ -74,6 +74,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePaymentMethodRequest{
@@ -121,6 +122,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePaymentMethodRequest{
@@ -151,6 +153,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePaymentMethodRequest{
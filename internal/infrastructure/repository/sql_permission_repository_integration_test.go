@@ -75,6 +75,7 @@ func TestCreateRole(t *testing.T) {
 			locationProvider,
 			repo,
 			permissionProviderStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateRoleRequest{
@@ -127,6 +128,7 @@ func TestCreateRole(t *testing.T) {
 			locationProvider,
 			repo,
 			permissionProviderStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateRoleRequest{
@@ -159,6 +161,7 @@ func TestCreateRole(t *testing.T) {
 			locationProvider,
 			repo,
 			permissionProviderStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateRoleRequest{
@@ -233,6 +236,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			&testutil.ResourceLocationProviderStub{},
 			repository.NewSQLPermissionRepository(db),
 			permissionProviderStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -252,6 +256,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			&testutil.ResourceLocationProviderStub{},
 			repository.NewSQLPermissionRepository(db),
 			permissionProviderStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -274,6 +279,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			&testutil.ResourceLocationProviderStub{},
 			repository.NewSQLPermissionRepository(db),
 			permissionProviderStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -74,6 +74,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneConditionRequest{
@@ -121,6 +122,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneConditionRequest{
@@ -151,6 +153,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneConditionRequest{
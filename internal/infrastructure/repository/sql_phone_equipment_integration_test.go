@@ -74,6 +74,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneEquipmentRequest{
@@ -121,6 +122,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneEquipmentRequest{
@@ -151,6 +153,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneEquipmentRequest{
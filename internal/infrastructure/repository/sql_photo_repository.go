@@ -0,0 +1,76 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/photo"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SQLPhotoRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLPhotoRepository(db *pgxpool.Pool) *SQLPhotoRepository {
+	return &SQLPhotoRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLPhotoRepository) CreatePhoto(ctx context.Context, p photo.Photo) error {
+	if err := r.queries.CreatePhoto(ctx, gensql.CreatePhotoParams{
+		PhotoID:          typemapper.UUIDToPgtypeUUID(p.ID),
+		StoreID:          typemapper.UUIDToPgtypeUUID(p.StoreID),
+		ContentType:      p.ContentType,
+		BlobKey:          typemapper.OptionalStringToPgtypeText(p.BlobKey),
+		ThumbnailBlobKey: typemapper.OptionalStringToPgtypeText(p.ThumbnailBlobKey),
+		UploadedBy:       typemapper.OptionalUUIDToPgtypeUUID(p.UploaderID),
+		CreationTime:     typemapper.TimeToPgtypeTimestamptz(p.CreationTime),
+	}); err != nil {
+		return fmt.Errorf("failed to create photo: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLPhotoRepository) GetPhotoByID(
+	ctx context.Context,
+	storeID uuid.UUID,
+	photoID uuid.UUID,
+) (photo.Photo, error) {
+	row, err := r.queries.GetPhotoByID(ctx, gensql.GetPhotoByIDParams{
+		StoreID: typemapper.UUIDToPgtypeUUID(storeID),
+		PhotoID: typemapper.UUIDToPgtypeUUID(photoID),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return photo.Photo{}, apperror.ErrPhotoNotFound
+	}
+
+	if err != nil {
+		return photo.Photo{}, fmt.Errorf("failed to get photo by ID: %w", err)
+	}
+
+	p := photo.Photo{
+		ID:               typemapper.MustPgtypeUUIDToUUID(row.PhotoID),
+		StoreID:          typemapper.MustPgtypeUUIDToUUID(row.StoreID),
+		ContentType:      row.ContentType,
+		BlobKey:          typemapper.PgtypeTextToOptionalString(row.BlobKey),
+		ThumbnailBlobKey: typemapper.PgtypeTextToOptionalString(row.ThumbnailBlobKey),
+		SourceURL:        typemapper.PgtypeTextToOptionalString(row.SourceUrl),
+		CreationTime:     row.CreationTime.Time,
+	}
+
+	if row.UploadedBy.Valid {
+		p.UploaderID = optional.Some(typemapper.MustPgtypeUUIDToUUID(row.UploadedBy))
+	}
+
+	return p, nil
+}
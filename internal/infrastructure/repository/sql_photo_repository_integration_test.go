@@ -0,0 +1,117 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/photo"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPhotoRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime         = time.Unix(1713917762, 0)
+		theStoreID      = uuid.New()
+		theOtherStoreID = uuid.New()
+	)
+
+	queries := gensql.New(db)
+
+	for i, storeID := range []uuid.UUID{theStoreID, theOtherStoreID} {
+		_, initErr = queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+			StoreID:      typemapper.UUIDToPgtypeUUID(storeID),
+			StoreName:    "Not important",
+			StoreCode:    []string{"store-a", "store-b"}[i],
+			StoreAddress: "Not important",
+			PhoneNumber:  "+6281234567890",
+		})
+		require.NoError(t, initErr)
+	}
+
+	repo := repository.NewSQLPhotoRepository(db)
+
+	createPhoto := func(t *testing.T, storeID uuid.UUID) photo.Photo {
+		id := uuid.New()
+		p := photo.Photo{
+			ID:               id,
+			StoreID:          storeID,
+			ContentType:      photo.ContentTypeJPEG,
+			BlobKey:          optional.Some("photos/" + id.String()),
+			ThumbnailBlobKey: optional.Some("photos/" + id.String() + ".thumbnail"),
+			CreationTime:     theTime,
+		}
+
+		require.NoError(t, repo.CreatePhoto(context.Background(), p))
+		return p
+	}
+
+	t.Run("persists the photo", func(t *testing.T) {
+		want := createPhoto(t, theStoreID)
+
+		got, err := repo.GetPhotoByID(context.Background(), theStoreID, want.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, want.ID, got.ID)
+		assert.Equal(t, want.StoreID, got.StoreID)
+		assert.Equal(t, want.ContentType, got.ContentType)
+		assert.Equal(t, want.BlobKey, got.BlobKey)
+		assert.Equal(t, want.ThumbnailBlobKey, got.ThumbnailBlobKey)
+		assert.False(t, got.SourceURL.IsSet())
+		assert.False(t, got.UploaderID.IsSet())
+		assert.True(t, want.CreationTime.Equal(got.CreationTime))
+	})
+
+	t.Run("returns photo not found when photo belongs to another store", func(t *testing.T) {
+		p := createPhoto(t, theOtherStoreID)
+
+		_, err := repo.GetPhotoByID(context.Background(), theStoreID, p.ID)
+		assert.ErrorIs(t, err, apperror.ErrPhotoNotFound)
+	})
+
+	t.Run("counts only the photos of the store", func(t *testing.T) {
+		ours := createPhoto(t, theStoreID)
+		theirs := createPhoto(t, theOtherStoreID)
+
+		got, err := repository.NewSQLRepairOrderRepository(db).CountPhotosByIDs(
+			context.Background(),
+			theStoreID,
+			[]uuid.UUID{ours.ID, theirs.ID, uuid.New()},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, 1, got)
+	})
+}
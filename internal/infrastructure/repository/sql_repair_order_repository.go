@@ -5,14 +5,18 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"strings"
 
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/gensql"
 	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+	shareddomain "github.com/JosephJoshua/remana-backend/internal/modules/shared/domain"
 	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/typemapper"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -75,13 +79,381 @@ func (r *SQLRepairOrderRepository) CreateRepairOrder(ctx context.Context, order
 		return fmt.Errorf("failed to attach repair order costs: %w", err)
 	}
 
+	if err = r.saveRepairOrderPayments(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order payments: %w", err)
+	}
+
+	if err = saveRepairOrderEvents(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order events: %w", err)
+	}
+
 	if err = tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
+	order.ClearEvents()
+
 	return nil
 }
 
+func (r *SQLRepairOrderRepository) UpdateRepairOrder(ctx context.Context, order domain.Order) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			if errors.Is(rollbackErr, pgx.ErrTxClosed) {
+				return
+			}
+
+			err = fmt.Errorf("failed to rollback transaction: %w", rollbackErr)
+		}
+	}()
+
+	qtx := r.queries.WithTx(tx)
+
+	params, err := r.buildUpdateRepairOrderProgressParams(order)
+	if err != nil {
+		return fmt.Errorf("failed to build update repair order progress params: %w", err)
+	}
+
+	affected, err := qtx.UpdateRepairOrderProgress(ctx, params)
+	if err != nil {
+		return fmt.Errorf("failed to update repair order progress: %w", err)
+	}
+
+	// Another update has been saved since the order was loaded, so the
+	// change may have been made against an outdated order.
+	if affected == 0 {
+		return apperror.ErrRepairOrderConcurrentUpdate
+	}
+
+	if err = r.saveRepairOrderCosts(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order costs: %w", err)
+	}
+
+	if err = r.saveRepairOrderPayments(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order payments: %w", err)
+	}
+
+	if err = r.saveRepairOrderTechnicianAssignments(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order technician assignments: %w", err)
+	}
+
+	if err = r.saveRepairOrderDamages(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order damages: %w", err)
+	}
+
+	if err = r.saveRepairOrderPhoneConditions(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order phone conditions: %w", err)
+	}
+
+	if err = r.saveRepairOrderPhoneEquipments(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order phone equipments: %w", err)
+	}
+
+	if err = r.saveRepairOrderPhotos(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order photos: %w", err)
+	}
+
+	if err = saveRepairOrderEvents(ctx, qtx, order); err != nil {
+		return fmt.Errorf("failed to save repair order events: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	order.ClearEvents()
+
+	return nil
+}
+
+func (r *SQLRepairOrderRepository) GetRepairOrderByID(
+	ctx context.Context,
+	storeID uuid.UUID,
+	repairOrderID uuid.UUID,
+) (domain.Order, error) {
+	row, err := r.queries.GetRepairOrderByID(
+		ctx,
+		gensql.GetRepairOrderByIDParams{
+			StoreID:       typemapper.UUIDToPgtypeUUID(storeID),
+			RepairOrderID: typemapper.UUIDToPgtypeUUID(repairOrderID),
+		},
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperror.ErrRepairOrderNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order by ID: %w", err)
+	}
+
+	order, err := r.restoreRepairOrder(ctx, r.queries, row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore repair order: %w", err)
+	}
+
+	return order, nil
+}
+
+func (r *SQLRepairOrderRepository) GetRepairOrderBySlug(
+	ctx context.Context,
+	storeID uuid.UUID,
+	slug string,
+) (domain.Order, error) {
+	row, err := r.queries.GetRepairOrderBySlug(
+		ctx,
+		gensql.GetRepairOrderBySlugParams{
+			StoreID: typemapper.UUIDToPgtypeUUID(storeID),
+			Slug:    slug,
+		},
+	)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperror.ErrRepairOrderNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order by slug: %w", err)
+	}
+
+	order, err := r.restoreRepairOrder(ctx, r.queries, row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore repair order: %w", err)
+	}
+
+	return order, nil
+}
+
+// GetRepairOrderBySlugInAnyStore looks up a repair order without scoping it to
+// a store, which is only possible because slugs are unique across stores.
+func (r *SQLRepairOrderRepository) GetRepairOrderBySlugInAnyStore(
+	ctx context.Context,
+	slug string,
+) (domain.Order, error) {
+	row, err := r.queries.GetRepairOrderBySlugInAnyStore(ctx, slug)
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperror.ErrRepairOrderNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order by slug: %w", err)
+	}
+
+	order, err := r.restoreRepairOrder(ctx, r.queries, row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore repair order: %w", err)
+	}
+
+	return order, nil
+}
+
+func (r *SQLRepairOrderRepository) ListRepairOrders(
+	ctx context.Context,
+	storeID uuid.UUID,
+	filter readmodel.RepairOrderListFilter,
+	cursor optional.Optional[readmodel.RepairOrderListCursor],
+	limit int,
+) ([]readmodel.RepairOrderSummary, error) {
+	if limit > math.MaxInt32 {
+		return nil, errors.New("limit is greater than MaxInt32")
+	}
+
+	params := gensql.ListRepairOrdersParams{
+		StoreID:       typemapper.UUIDToPgtypeUUID(storeID),
+		Status:        listFilterStatusToPgtypeText(filter.Status),
+		TechnicianID:  typemapper.OptionalUUIDToPgtypeUUID(filter.TechnicianID),
+		SalesPersonID: typemapper.OptionalUUIDToPgtypeUUID(filter.SalesPersonID),
+		CreatedFrom:   typemapper.OptionalTimeToPgtypeTimestamptz(filter.CreatedFrom),
+		CreatedTo:     typemapper.OptionalTimeToPgtypeTimestamptz(filter.CreatedTo),
+		Search:        listFilterSearchToPgtypeText(filter.Search),
+		PageSize:      int32(limit),
+	}
+
+	if c, ok := cursor.Get(); ok {
+		params.CursorCreationTime = typemapper.TimeToPgtypeTimestamptz(c.CreationTime)
+		params.CursorRepairOrderID = typemapper.UUIDToPgtypeUUID(c.RepairOrderID)
+	}
+
+	rows, err := r.queries.ListRepairOrders(ctx, params)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list repair orders: %w", err)
+	}
+
+	summaries := make([]readmodel.RepairOrderSummary, 0, len(rows))
+	for _, row := range rows {
+		summaries = append(summaries, toRepairOrderSummary(row))
+	}
+
+	return summaries, nil
+}
+
+func (r *SQLRepairOrderRepository) CountRepairOrders(
+	ctx context.Context,
+	storeID uuid.UUID,
+	filter readmodel.RepairOrderListFilter,
+) (int, error) {
+	count, err := r.queries.CountRepairOrders(ctx, gensql.CountRepairOrdersParams{
+		StoreID:       typemapper.UUIDToPgtypeUUID(storeID),
+		Status:        listFilterStatusToPgtypeText(filter.Status),
+		TechnicianID:  typemapper.OptionalUUIDToPgtypeUUID(filter.TechnicianID),
+		SalesPersonID: typemapper.OptionalUUIDToPgtypeUUID(filter.SalesPersonID),
+		CreatedFrom:   typemapper.OptionalTimeToPgtypeTimestamptz(filter.CreatedFrom),
+		CreatedTo:     typemapper.OptionalTimeToPgtypeTimestamptz(filter.CreatedTo),
+		Search:        listFilterSearchToPgtypeText(filter.Search),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count repair orders: %w", err)
+	}
+
+	return int(count), nil
+}
+
+func (r *SQLRepairOrderRepository) GetTechnicianQueue(
+	ctx context.Context,
+	storeID uuid.UUID,
+	technicianID uuid.UUID,
+) ([]readmodel.RepairOrderSummary, error) {
+	rows, err := r.queries.GetTechnicianQueue(ctx, gensql.GetTechnicianQueueParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(storeID),
+		TechnicianID: typemapper.UUIDToPgtypeUUID(technicianID),
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get technician queue: %w", err)
+	}
+
+	summaries := make([]readmodel.RepairOrderSummary, 0, len(rows))
+	for _, row := range rows {
+		summaries = append(summaries, toRepairOrderSummary(gensql.ListRepairOrdersRow(row)))
+	}
+
+	return summaries, nil
+}
+
+func (r *SQLRepairOrderRepository) GetTechnicianWorkload(
+	ctx context.Context,
+	storeID uuid.UUID,
+) ([]readmodel.TechnicianWorkload, error) {
+	rows, err := r.queries.GetTechnicianWorkload(ctx, typemapper.UUIDToPgtypeUUID(storeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get technician workload: %w", err)
+	}
+
+	workloads := make([]readmodel.TechnicianWorkload, 0, len(rows))
+	for _, row := range rows {
+		workloads = append(workloads, readmodel.TechnicianWorkload{
+			TechnicianID:            typemapper.MustPgtypeUUIDToUUID(row.TechnicianID),
+			TechnicianName:          row.TechnicianName,
+			OpenCount:               int(row.OpenCount),
+			ConfirmedCount:          int(row.ConfirmedCount),
+			OldestOrderCreationTime: typemapper.PgtypeTimestamptzToOptionalTime(row.OldestOrderCreationTime),
+		})
+	}
+
+	return workloads, nil
+}
+
+func (r *SQLRepairOrderRepository) CreateRepairOrderNote(ctx context.Context, note domain.OrderNote) error {
+	if err := r.queries.CreateRepairOrderNote(ctx, gensql.CreateRepairOrderNoteParams{
+		RepairOrderNoteID: typemapper.UUIDToPgtypeUUID(note.ID()),
+		RepairOrderID:     typemapper.UUIDToPgtypeUUID(note.OrderID()),
+		AuthorUserID:      typemapper.UUIDToPgtypeUUID(note.AuthorID()),
+		Body:              note.Body(),
+		Visibility:        string(note.Visibility()),
+		CreationTime:      typemapper.TimeToPgtypeTimestamptz(note.CreationTime()),
+	}); err != nil {
+		return fmt.Errorf("failed to create repair order note: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLRepairOrderRepository) UpdateRepairOrderNote(ctx context.Context, note domain.OrderNote) error {
+	if err := r.queries.UpdateRepairOrderNote(ctx, gensql.UpdateRepairOrderNoteParams{
+		RepairOrderID:     typemapper.UUIDToPgtypeUUID(note.OrderID()),
+		RepairOrderNoteID: typemapper.UUIDToPgtypeUUID(note.ID()),
+		Body:              note.Body(),
+		Visibility:        string(note.Visibility()),
+		EditTime:          typemapper.OptionalTimeToPgtypeTimestamptz(note.EditTime()),
+	}); err != nil {
+		return fmt.Errorf("failed to update repair order note: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLRepairOrderRepository) GetRepairOrderNoteByID(
+	ctx context.Context,
+	repairOrderID uuid.UUID,
+	noteID uuid.UUID,
+) (domain.OrderNote, error) {
+	row, err := r.queries.GetRepairOrderNoteByID(ctx, gensql.GetRepairOrderNoteByIDParams{
+		RepairOrderID:     typemapper.UUIDToPgtypeUUID(repairOrderID),
+		RepairOrderNoteID: typemapper.UUIDToPgtypeUUID(noteID),
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, apperror.ErrRepairOrderNoteNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order note by ID: %w", err)
+	}
+
+	return restoreRepairOrderNote(row)
+}
+
+func (r *SQLRepairOrderRepository) GetRepairOrderNotes(
+	ctx context.Context,
+	repairOrderID uuid.UUID,
+	visibility optional.Optional[domain.OrderNoteVisibility],
+) ([]readmodel.RepairOrderNote, error) {
+	visibilityText := typemapper.OptionalStringToPgtypeText(optional.None[string]())
+	if value, ok := visibility.Get(); ok {
+		visibilityText = typemapper.StringToPgtypeText(string(value))
+	}
+
+	rows, err := r.queries.GetRepairOrderNotes(ctx, gensql.GetRepairOrderNotesParams{
+		RepairOrderID: typemapper.UUIDToPgtypeUUID(repairOrderID),
+		Visibility:    visibilityText,
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order notes: %w", err)
+	}
+
+	notes := make([]readmodel.RepairOrderNote, 0, len(rows))
+	for _, row := range rows {
+		note, restoreErr := restoreRepairOrderNote(gensql.RepairOrderNote{
+			RepairOrderNoteID: row.RepairOrderNoteID,
+			RepairOrderID:     row.RepairOrderID,
+			AuthorUserID:      row.AuthorUserID,
+			Body:              row.Body,
+			Visibility:        row.Visibility,
+			CreationTime:      row.CreationTime,
+			EditTime:          row.EditTime,
+		})
+
+		if restoreErr != nil {
+			return nil, restoreErr
+		}
+
+		notes = append(notes, readmodel.RepairOrderNote{
+			Note:           note,
+			AuthorUsername: row.AuthorUsername,
+		})
+	}
+
+	return notes, nil
+}
+
 func (r *SQLRepairOrderRepository) GetDamageNamesByIDs(
 	ctx context.Context,
 	storeID uuid.UUID,
@@ -154,6 +526,23 @@ func (r *SQLRepairOrderRepository) GetPhoneEquipmentNamesByIDs(
 	return phoneEquipmentNames, nil
 }
 
+func (r *SQLRepairOrderRepository) CountPhotosByIDs(
+	ctx context.Context,
+	storeID uuid.UUID,
+	ids []uuid.UUID,
+) (int, error) {
+	count, err := r.queries.CountPhotosByIDs(ctx, gensql.CountPhotosByIDsParams{
+		StoreID: typemapper.UUIDToPgtypeUUID(storeID),
+		Ids:     typemapper.UUIDsToPgtypeUUIDs(ids),
+	})
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count photos by IDs: %w", err)
+	}
+
+	return int(count), nil
+}
+
 func (r *SQLRepairOrderRepository) DoesSalesPersonExist(
 	ctx context.Context,
 	storeID uuid.UUID,
@@ -226,6 +615,38 @@ func (r *SQLRepairOrderRepository) DoesPaymentMethodExist(
 	return true, nil
 }
 
+func (r *SQLRepairOrderRepository) DoesStoreRequireConfirmationBeforeCompletion(
+	ctx context.Context,
+	storeID uuid.UUID,
+) (bool, error) {
+	required, err := r.queries.DoesStoreRequireConfirmationBeforeCompletion(ctx, typemapper.UUIDToPgtypeUUID(storeID))
+	if err != nil {
+		return false, fmt.Errorf("failed to check if store requires confirmation before completion: %w", err)
+	}
+
+	return required, nil
+}
+
+func (r *SQLRepairOrderRepository) GetStoreReceiptDetails(
+	ctx context.Context,
+	storeID uuid.UUID,
+) (readmodel.StoreReceiptDetails, error) {
+	details, err := r.queries.GetStoreReceiptDetails(ctx, typemapper.UUIDToPgtypeUUID(storeID))
+	if err != nil {
+		return readmodel.StoreReceiptDetails{}, fmt.Errorf("failed to get store receipt details: %w", err)
+	}
+
+	return readmodel.StoreReceiptDetails{
+		Name:          details.StoreName,
+		Address:       details.StoreAddress,
+		PhoneNumber:   details.PhoneNumber,
+		WarrantyTerms: typemapper.PgtypeTextToOptionalString(details.WarrantyTerms),
+		HTMLTemplate:  typemapper.PgtypeTextToOptionalString(details.ReceiptHtmlTemplate),
+		PDFTemplate:   typemapper.PgtypeTextToOptionalString(details.ReceiptPdfTemplate),
+		PaperWidth:    int(details.ReceiptPaperWidth),
+	}, nil
+}
+
 func (r *SQLRepairOrderRepository) buildCreateRepairOrderParams(
 	order domain.Order,
 ) (gensql.CreateRepairOrderParams, error) {
@@ -239,37 +660,72 @@ func (r *SQLRepairOrderRepository) buildCreateRepairOrderParams(
 		isPatternLocked = typemapper.BoolToPgtypeBool(securityDetails.MustGet().Type() == domain.PhoneSecurityTypePattern)
 	}
 
-	downPaymentAmount := typemapper.OptionalInt32ToPgtypeInt4(optional.None[int32]())
-	downPaymentMethodID := typemapper.OptionalUUIDToPgtypeUUID(optional.None[uuid.UUID]())
+	return gensql.CreateRepairOrderParams{
+		RepairOrderID:           typemapper.UUIDToPgtypeUUID(order.ID()),
+		CreationTime:            typemapper.TimeToPgtypeTimestamptz(order.CreationTime()),
+		Slug:                    order.Slug(),
+		StoreID:                 typemapper.UUIDToPgtypeUUID(order.StoreID()),
+		CustomerName:            order.CustomerName(),
+		ContactNumber:           order.ContactNumber().Value(),
+		PhoneType:               order.PhoneType(),
+		Color:                   order.Color(),
+		SalesPersonID:           typemapper.UUIDToPgtypeUUID(order.SalesPersonID()),
+		TechnicianID:            typemapper.UUIDToPgtypeUUID(order.TechnicianID()),
+		Imei:                    typemapper.OptionalStringToPgtypeText(order.IMEI()),
+		PartsNotCheckedYet:      typemapper.OptionalStringToPgtypeText(order.PartsNotCheckedYet()),
+		PasscodeOrPattern:       passcodeOrPattern,
+		IsPatternLocked:         isPatternLocked,
+		EstimatedCompletionTime: typemapper.OptionalTimeToPgtypeTimestamptz(order.EstimatedCompletionTime()),
+	}, nil
+}
+
+func (r *SQLRepairOrderRepository) buildUpdateRepairOrderProgressParams(
+	order domain.Order,
+) (gensql.UpdateRepairOrderProgressParams, error) {
+	writeOffAmount := typemapper.OptionalInt32ToPgtypeInt4(optional.None[int32]())
+	writeOffReason := typemapper.OptionalStringToPgtypeText(optional.None[string]())
+
+	writeOff := order.WriteOff()
+
+	if writeOff.IsSet() {
+		if writeOff.MustGet().Amount() > math.MaxInt32 || writeOff.MustGet().Amount() < math.MinInt32 {
+			return gensql.UpdateRepairOrderProgressParams{}, errors.New("write-off amount does not fit in int32")
+		}
+
+		writeOffAmount = typemapper.Int32ToPgtypeInt4(int32(writeOff.MustGet().Amount()))
+		writeOffReason = typemapper.StringToPgtypeText(writeOff.MustGet().Reason())
+	}
+
+	cancellationFee := typemapper.OptionalInt32ToPgtypeInt4(optional.None[int32]())
 
-	downPayment := order.DownPayment()
+	fee := order.CancellationFee()
 
-	if downPayment.IsSet() {
-		if downPayment.MustGet().Amount() > math.MaxInt {
-			return gensql.CreateRepairOrderParams{}, errors.New("down payment amount is greater than MaxInt")
+	if fee.IsSet() {
+		if fee.MustGet() > math.MaxInt32 {
+			return gensql.UpdateRepairOrderProgressParams{}, errors.New("cancellation fee is greater than MaxInt32")
 		}
 
-		downPaymentAmount = typemapper.Int32ToPgtypeInt4(int32(downPayment.MustGet().Amount()))
-		downPaymentMethodID = typemapper.UUIDToPgtypeUUID(downPayment.MustGet().PaymentMethodID())
+		cancellationFee = typemapper.Int32ToPgtypeInt4(int32(fee.MustGet()))
 	}
 
-	return gensql.CreateRepairOrderParams{
-		RepairOrderID:       typemapper.UUIDToPgtypeUUID(order.ID()),
-		CreationTime:        typemapper.TimeToPgtypeTimestamptz(order.CreationTime()),
-		Slug:                order.Slug(),
-		StoreID:             typemapper.UUIDToPgtypeUUID(order.StoreID()),
-		CustomerName:        order.CustomerName(),
-		ContactNumber:       order.ContactNumber().Value(),
-		PhoneType:           order.PhoneType(),
-		Color:               order.Color(),
-		SalesPersonID:       typemapper.UUIDToPgtypeUUID(order.SalesPersonID()),
+	if order.Version() > math.MaxInt32 {
+		return gensql.UpdateRepairOrderProgressParams{}, errors.New("version is greater than MaxInt32")
+	}
+
+	return gensql.UpdateRepairOrderProgressParams{
 		TechnicianID:        typemapper.UUIDToPgtypeUUID(order.TechnicianID()),
-		Imei:                typemapper.OptionalStringToPgtypeText(order.IMEI()),
-		PartsNotCheckedYet:  typemapper.OptionalStringToPgtypeText(order.PartsNotCheckedYet()),
-		PasscodeOrPattern:   passcodeOrPattern,
-		IsPatternLocked:     isPatternLocked,
-		DownPaymentAmount:   downPaymentAmount,
-		DownPaymentMethodID: downPaymentMethodID,
+		ConfirmationTime:    typemapper.OptionalTimeToPgtypeTimestamptz(order.ConfirmationTime()),
+		ConfirmationContent: typemapper.OptionalStringToPgtypeText(order.ConfirmationContents()),
+		CompletionTime:      typemapper.OptionalTimeToPgtypeTimestamptz(order.CompletionTime()),
+		PickUpTime:          typemapper.OptionalTimeToPgtypeTimestamptz(order.PickUpTime()),
+		CancellationTime:    typemapper.OptionalTimeToPgtypeTimestamptz(order.CancellationTime()),
+		CancellationReason:  typemapper.OptionalStringToPgtypeText(order.CancellationReason()),
+		CancellationFee:     cancellationFee,
+		WriteOffAmount:      writeOffAmount,
+		WriteOffReason:      writeOffReason,
+		StoreID:             typemapper.UUIDToPgtypeUUID(order.StoreID()),
+		RepairOrderID:       typemapper.UUIDToPgtypeUUID(order.ID()),
+		Version:             int32(order.Version()),
 	}, nil
 }
 
@@ -379,6 +835,87 @@ func (r *SQLRepairOrderRepository) attachRepairOrderCosts(
 	return nil
 }
 
+// saveRepairOrderCosts inserts the costs that are not stored yet. Costs are
+// never changed once recorded, so existing ones are left as is.
+func (r *SQLRepairOrderRepository) saveRepairOrderCosts(
+	ctx context.Context,
+	qtx *gensql.Queries,
+	order domain.Order,
+) error {
+	for _, cost := range order.Costs() {
+		if cost.Amount() > math.MaxInt32 || cost.Amount() < math.MinInt32 {
+			return errors.New("cost amount does not fit in int32")
+		}
+
+		err := qtx.SaveRepairOrderCost(ctx, gensql.SaveRepairOrderCostParams{
+			RepairOrderCostID: typemapper.UUIDToPgtypeUUID(cost.ID()),
+			RepairOrderID:     typemapper.UUIDToPgtypeUUID(order.ID()),
+			Amount:            int32(cost.Amount()),
+			Reason:            typemapper.OptionalStringToPgtypeText(cost.Reason()),
+			CreationTime:      typemapper.TimeToPgtypeTimestamptz(cost.CreationTime()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to save repair order cost: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// saveRepairOrderPayments inserts the payments that are not stored yet. The
+// ledger is append-only, so existing entries are left as is.
+func (r *SQLRepairOrderRepository) saveRepairOrderPayments(
+	ctx context.Context,
+	qtx *gensql.Queries,
+	order domain.Order,
+) error {
+	for _, payment := range order.Payments() {
+		if payment.Amount() > math.MaxInt32 {
+			return errors.New("payment amount is greater than MaxInt32")
+		}
+
+		err := qtx.SaveRepairOrderPayment(ctx, gensql.SaveRepairOrderPaymentParams{
+			RepairOrderPaymentID: typemapper.UUIDToPgtypeUUID(payment.ID()),
+			RepairOrderID:        typemapper.UUIDToPgtypeUUID(order.ID()),
+			PaymentType:          string(payment.Type()),
+			Amount:               int32(payment.Amount()),
+			PaymentMethodID:      typemapper.UUIDToPgtypeUUID(payment.PaymentMethodID()),
+			Reason:               typemapper.OptionalStringToPgtypeText(payment.Reason()),
+			CreationTime:         typemapper.TimeToPgtypeTimestamptz(payment.CreationTime()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to save repair order payment: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *SQLRepairOrderRepository) saveRepairOrderTechnicianAssignments(
+	ctx context.Context,
+	qtx *gensql.Queries,
+	order domain.Order,
+) error {
+	for _, assignment := range order.TechnicianAssignments() {
+		err := qtx.SaveRepairOrderTechnicianAssignment(ctx, gensql.SaveRepairOrderTechnicianAssignmentParams{
+			RepairOrderTechnicianAssignmentID: typemapper.UUIDToPgtypeUUID(assignment.ID()),
+			RepairOrderID:                     typemapper.UUIDToPgtypeUUID(order.ID()),
+			PreviousTechnicianID:              typemapper.UUIDToPgtypeUUID(assignment.PreviousTechnicianID()),
+			TechnicianID:                      typemapper.UUIDToPgtypeUUID(assignment.TechnicianID()),
+			Reason:                            assignment.Reason(),
+			CreationTime:                      typemapper.TimeToPgtypeTimestamptz(assignment.CreationTime()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to save repair order technician assignment: %w", err)
+		}
+	}
+
+	return nil
+}
+
 func (r *SQLRepairOrderRepository) attachRepairOrderPhotos(
 	ctx context.Context,
 	qtx *gensql.Queries,
@@ -386,12 +923,13 @@ func (r *SQLRepairOrderRepository) attachRepairOrderPhotos(
 ) error {
 	params := make([]gensql.AddPhotosToRepairOrderParams, 0, len(order.Photos()))
 	for _, photo := range order.Photos() {
-		url := photo.URL()
-
 		params = append(params, gensql.AddPhotosToRepairOrderParams{
 			RepairOrderPhotoID: typemapper.UUIDToPgtypeUUID(photo.ID()),
 			RepairOrderID:      typemapper.UUIDToPgtypeUUID(order.ID()),
-			PhotoUrl:           url.String(),
+			PhotoID:            typemapper.UUIDToPgtypeUUID(photo.PhotoID()),
+			Stage:              string(photo.Stage()),
+			Caption:            typemapper.OptionalStringToPgtypeText(photo.Caption()),
+			CreationTime:       typemapper.TimeToPgtypeTimestamptz(photo.CreationTime()),
 		})
 	}
 
@@ -406,3 +944,400 @@ func (r *SQLRepairOrderRepository) attachRepairOrderPhotos(
 
 	return nil
 }
+
+func (r *SQLRepairOrderRepository) saveRepairOrderDamages(
+	ctx context.Context,
+	qtx *gensql.Queries,
+	order domain.Order,
+) error {
+	keepIDs := make([]pgtype.UUID, 0, len(order.Damages()))
+
+	for _, damage := range order.Damages() {
+		err := qtx.SaveRepairOrderDamage(ctx, gensql.SaveRepairOrderDamageParams{
+			RepairOrderDamageID: typemapper.UUIDToPgtypeUUID(damage.ID()),
+			RepairOrderID:       typemapper.UUIDToPgtypeUUID(order.ID()),
+			DamageName:          damage.Name(),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to save repair order damage: %w", err)
+		}
+
+		keepIDs = append(keepIDs, typemapper.UUIDToPgtypeUUID(damage.ID()))
+	}
+
+	err := qtx.DeleteRepairOrderDamagesExcept(ctx, gensql.DeleteRepairOrderDamagesExceptParams{
+		RepairOrderID: typemapper.UUIDToPgtypeUUID(order.ID()),
+		KeepIds:       keepIDs,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete removed repair order damages: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLRepairOrderRepository) saveRepairOrderPhoneConditions(
+	ctx context.Context,
+	qtx *gensql.Queries,
+	order domain.Order,
+) error {
+	keepIDs := make([]pgtype.UUID, 0, len(order.PhoneConditions()))
+
+	for _, condition := range order.PhoneConditions() {
+		err := qtx.SaveRepairOrderPhoneCondition(ctx, gensql.SaveRepairOrderPhoneConditionParams{
+			RepairOrderPhoneConditionID: typemapper.UUIDToPgtypeUUID(condition.ID()),
+			RepairOrderID:               typemapper.UUIDToPgtypeUUID(order.ID()),
+			PhoneConditionName:          condition.Name(),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to save repair order phone condition: %w", err)
+		}
+
+		keepIDs = append(keepIDs, typemapper.UUIDToPgtypeUUID(condition.ID()))
+	}
+
+	err := qtx.DeleteRepairOrderPhoneConditionsExcept(ctx, gensql.DeleteRepairOrderPhoneConditionsExceptParams{
+		RepairOrderID: typemapper.UUIDToPgtypeUUID(order.ID()),
+		KeepIds:       keepIDs,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete removed repair order phone conditions: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLRepairOrderRepository) saveRepairOrderPhoneEquipments(
+	ctx context.Context,
+	qtx *gensql.Queries,
+	order domain.Order,
+) error {
+	keepIDs := make([]pgtype.UUID, 0, len(order.PhoneEquipments()))
+
+	for _, equipment := range order.PhoneEquipments() {
+		err := qtx.SaveRepairOrderPhoneEquipment(ctx, gensql.SaveRepairOrderPhoneEquipmentParams{
+			RepairOrderPhoneEquipmentID: typemapper.UUIDToPgtypeUUID(equipment.ID()),
+			RepairOrderID:               typemapper.UUIDToPgtypeUUID(order.ID()),
+			PhoneEquipmentName:          equipment.Name(),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to save repair order phone equipment: %w", err)
+		}
+
+		keepIDs = append(keepIDs, typemapper.UUIDToPgtypeUUID(equipment.ID()))
+	}
+
+	err := qtx.DeleteRepairOrderPhoneEquipmentsExcept(ctx, gensql.DeleteRepairOrderPhoneEquipmentsExceptParams{
+		RepairOrderID: typemapper.UUIDToPgtypeUUID(order.ID()),
+		KeepIds:       keepIDs,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete removed repair order phone equipments: %w", err)
+	}
+
+	return nil
+}
+
+// saveRepairOrderPhotos inserts the photos that are not stored yet and
+// deletes the ones that have been removed from the order. Damages, phone
+// conditions and phone equipments are saved the same way.
+func (r *SQLRepairOrderRepository) saveRepairOrderPhotos(
+	ctx context.Context,
+	qtx *gensql.Queries,
+	order domain.Order,
+) error {
+	keepIDs := make([]pgtype.UUID, 0, len(order.Photos()))
+
+	for _, photo := range order.Photos() {
+		err := qtx.SaveRepairOrderPhoto(ctx, gensql.SaveRepairOrderPhotoParams{
+			RepairOrderPhotoID: typemapper.UUIDToPgtypeUUID(photo.ID()),
+			RepairOrderID:      typemapper.UUIDToPgtypeUUID(order.ID()),
+			PhotoID:            typemapper.UUIDToPgtypeUUID(photo.PhotoID()),
+			Stage:              string(photo.Stage()),
+			Caption:            typemapper.OptionalStringToPgtypeText(photo.Caption()),
+			CreationTime:       typemapper.TimeToPgtypeTimestamptz(photo.CreationTime()),
+		})
+
+		if err != nil {
+			return fmt.Errorf("failed to save repair order photo: %w", err)
+		}
+
+		keepIDs = append(keepIDs, typemapper.UUIDToPgtypeUUID(photo.ID()))
+	}
+
+	err := qtx.DeleteRepairOrderPhotosExcept(ctx, gensql.DeleteRepairOrderPhotosExceptParams{
+		RepairOrderID: typemapper.UUIDToPgtypeUUID(order.ID()),
+		KeepIds:       keepIDs,
+	})
+
+	if err != nil {
+		return fmt.Errorf("failed to delete removed repair order photos: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLRepairOrderRepository) restoreRepairOrder(
+	ctx context.Context,
+	queries *gensql.Queries,
+	row gensql.RepairOrder,
+) (domain.Order, error) {
+	damages, err := queries.GetRepairOrderDamages(ctx, row.RepairOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order damages: %w", err)
+	}
+
+	phoneConditions, err := queries.GetRepairOrderPhoneConditions(ctx, row.RepairOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order phone conditions: %w", err)
+	}
+
+	phoneEquipments, err := queries.GetRepairOrderPhoneEquipments(ctx, row.RepairOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order phone equipments: %w", err)
+	}
+
+	costs, err := queries.GetRepairOrderCosts(ctx, row.RepairOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order costs: %w", err)
+	}
+
+	photos, err := queries.GetRepairOrderPhotos(ctx, row.RepairOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order photos: %w", err)
+	}
+
+	payments, err := queries.GetRepairOrderPayments(ctx, row.RepairOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order payments: %w", err)
+	}
+
+	assignments, err := queries.GetRepairOrderTechnicianAssignments(ctx, row.RepairOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get repair order technician assignments: %w", err)
+	}
+
+	params, err := r.buildRestoreRepairOrderParams(row)
+	if err != nil {
+		return nil, fmt.Errorf("failed to build restore repair order params: %w", err)
+	}
+
+	for _, damage := range damages {
+		params.Damages = append(params.Damages, domain.RestoreOrderItemParams{
+			ID:   typemapper.MustPgtypeUUIDToUUID(damage.RepairOrderDamageID),
+			Name: damage.DamageName,
+		})
+	}
+
+	for _, phoneCondition := range phoneConditions {
+		params.PhoneConditions = append(params.PhoneConditions, domain.RestoreOrderItemParams{
+			ID:   typemapper.MustPgtypeUUIDToUUID(phoneCondition.RepairOrderPhoneConditionID),
+			Name: phoneCondition.PhoneConditionName,
+		})
+	}
+
+	for _, phoneEquipment := range phoneEquipments {
+		params.PhoneEquipments = append(params.PhoneEquipments, domain.RestoreOrderItemParams{
+			ID:   typemapper.MustPgtypeUUIDToUUID(phoneEquipment.RepairOrderPhoneEquipmentID),
+			Name: phoneEquipment.PhoneEquipmentName,
+		})
+	}
+
+	for _, cost := range costs {
+		params.Costs = append(params.Costs, domain.RestoreOrderCostParams{
+			ID:           typemapper.MustPgtypeUUIDToUUID(cost.RepairOrderCostID),
+			Amount:       int(cost.Amount),
+			Reason:       typemapper.PgtypeTextToOptionalString(cost.Reason),
+			CreationTime: cost.CreationTime.Time,
+		})
+	}
+
+	for _, assignment := range assignments {
+		params.TechnicianAssignments = append(params.TechnicianAssignments, domain.RestoreOrderTechnicianAssignmentParams{
+			ID:                   typemapper.MustPgtypeUUIDToUUID(assignment.RepairOrderTechnicianAssignmentID),
+			PreviousTechnicianID: typemapper.MustPgtypeUUIDToUUID(assignment.PreviousTechnicianID),
+			TechnicianID:         typemapper.MustPgtypeUUIDToUUID(assignment.TechnicianID),
+			Reason:               assignment.Reason,
+			CreationTime:         assignment.CreationTime.Time,
+		})
+	}
+
+	for _, photo := range photos {
+		stage, stageErr := domain.NewOrderPhotoStage(photo.Stage)
+		if stageErr != nil {
+			return nil, fmt.Errorf("failed to parse photo stage: %w", stageErr)
+		}
+
+		params.Photos = append(params.Photos, domain.RestoreOrderPhotoParams{
+			ID:           typemapper.MustPgtypeUUIDToUUID(photo.RepairOrderPhotoID),
+			PhotoID:      typemapper.MustPgtypeUUIDToUUID(photo.PhotoID),
+			Stage:        stage,
+			Caption:      typemapper.PgtypeTextToOptionalString(photo.Caption),
+			CreationTime: photo.CreationTime.Time,
+		})
+	}
+
+	for _, payment := range payments {
+		paymentType, typeErr := domain.NewOrderPaymentType(payment.PaymentType)
+		if typeErr != nil {
+			return nil, fmt.Errorf("failed to parse payment type: %w", typeErr)
+		}
+
+		if payment.Amount < 0 {
+			return nil, errors.New("payment amount is negative")
+		}
+
+		params.Payments = append(params.Payments, domain.RestoreOrderPaymentParams{
+			ID:              typemapper.MustPgtypeUUIDToUUID(payment.RepairOrderPaymentID),
+			Type:            paymentType,
+			Amount:          uint(payment.Amount),
+			PaymentMethodID: typemapper.MustPgtypeUUIDToUUID(payment.PaymentMethodID),
+			Reason:          typemapper.PgtypeTextToOptionalString(payment.Reason),
+			CreationTime:    payment.CreationTime.Time,
+		})
+	}
+
+	return domain.RestoreOrder(params)
+}
+
+func (r *SQLRepairOrderRepository) buildRestoreRepairOrderParams(
+	row gensql.RepairOrder,
+) (domain.RestoreOrderParams, error) {
+	contactNumber, err := shareddomain.NewPhoneNumber(row.ContactNumber)
+	if err != nil {
+		return domain.RestoreOrderParams{}, fmt.Errorf("failed to parse contact number: %w", err)
+	}
+
+	securityDetails := optional.None[domain.PhoneSecurityDetails]()
+	if row.PasscodeOrPattern.Valid {
+		if row.IsPatternLocked.Valid && row.IsPatternLocked.Bool {
+			pattern, patternErr := domain.NewPatternSecurity(row.PasscodeOrPattern.String)
+			if patternErr != nil {
+				return domain.RestoreOrderParams{}, fmt.Errorf("failed to restore pattern security: %w", patternErr)
+			}
+
+			securityDetails = optional.Some(pattern)
+		} else {
+			securityDetails = optional.Some(domain.NewPasscodeSecurity(row.PasscodeOrPattern.String))
+		}
+	}
+
+	writeOff, err := restoreOrderWriteOff(row.WriteOffAmount, row.WriteOffReason)
+	if err != nil {
+		return domain.RestoreOrderParams{}, fmt.Errorf("failed to restore write-off: %w", err)
+	}
+
+	cancellationFee := optional.None[uint]()
+	if row.CancellationFee.Valid {
+		if row.CancellationFee.Int32 < 0 {
+			return domain.RestoreOrderParams{}, errors.New("cancellation fee is negative")
+		}
+
+		cancellationFee = optional.Some(uint(row.CancellationFee.Int32))
+	}
+
+	return domain.RestoreOrderParams{
+		ID:                      typemapper.MustPgtypeUUIDToUUID(row.RepairOrderID),
+		CreationTime:            row.CreationTime.Time,
+		Slug:                    row.Slug,
+		StoreID:                 typemapper.MustPgtypeUUIDToUUID(row.StoreID),
+		CustomerName:            row.CustomerName,
+		ContactNumber:           contactNumber,
+		PhoneType:               row.PhoneType,
+		Color:                   row.Color,
+		SalesPersonID:           typemapper.MustPgtypeUUIDToUUID(row.SalesPersonID),
+		TechnicianID:            typemapper.MustPgtypeUUIDToUUID(row.TechnicianID),
+		Imei:                    typemapper.PgtypeTextToOptionalString(row.Imei),
+		PartsNotCheckedYet:      typemapper.PgtypeTextToOptionalString(row.PartsNotCheckedYet),
+		PhoneSecurityDetails:    securityDetails,
+		ConfirmationTime:        typemapper.PgtypeTimestamptzToOptionalTime(row.ConfirmationTime),
+		ConfirmationContents:    typemapper.PgtypeTextToOptionalString(row.ConfirmationContent),
+		PickUpTime:              typemapper.PgtypeTimestamptzToOptionalTime(row.PickUpTime),
+		CompletionTime:          typemapper.PgtypeTimestamptzToOptionalTime(row.CompletionTime),
+		CancellationTime:        typemapper.PgtypeTimestamptzToOptionalTime(row.CancellationTime),
+		CancellationReason:      typemapper.PgtypeTextToOptionalString(row.CancellationReason),
+		CancellationFee:         cancellationFee,
+		WriteOff:                writeOff,
+		EstimatedCompletionTime: typemapper.PgtypeTimestamptzToOptionalTime(row.EstimatedCompletionTime),
+		Version:                 int(row.Version),
+	}, nil
+}
+
+func restoreOrderWriteOff(amount pgtype.Int4, reason pgtype.Text) (optional.Optional[domain.OrderWriteOff], error) {
+	if !amount.Valid || !reason.Valid {
+		return optional.None[domain.OrderWriteOff](), nil
+	}
+
+	writeOff, err := domain.NewOrderWriteOff(int(amount.Int32), reason.String)
+	if err != nil {
+		return optional.None[domain.OrderWriteOff](), err
+	}
+
+	return optional.Some(writeOff), nil
+}
+
+func restoreRepairOrderNote(row gensql.RepairOrderNote) (domain.OrderNote, error) {
+	visibility, err := domain.NewOrderNoteVisibility(row.Visibility)
+	if err != nil {
+		return nil, fmt.Errorf("failed to restore repair order note visibility: %w", err)
+	}
+
+	return domain.RestoreOrderNote(domain.RestoreOrderNoteParams{
+		ID:           typemapper.MustPgtypeUUIDToUUID(row.RepairOrderNoteID),
+		OrderID:      typemapper.MustPgtypeUUIDToUUID(row.RepairOrderID),
+		AuthorID:     typemapper.MustPgtypeUUIDToUUID(row.AuthorUserID),
+		Body:         row.Body,
+		Visibility:   visibility,
+		CreationTime: row.CreationTime.Time,
+		EditTime:     typemapper.PgtypeTimestamptzToOptionalTime(row.EditTime),
+	}), nil
+}
+
+func toRepairOrderSummary(row gensql.ListRepairOrdersRow) readmodel.RepairOrderSummary {
+	return readmodel.RepairOrderSummary{
+		ID:           typemapper.MustPgtypeUUIDToUUID(row.RepairOrderID),
+		CreationTime: row.CreationTime.Time,
+		Slug:         row.Slug,
+		Status: domain.DeriveOrderStatus(
+			row.ConfirmationTime.Valid,
+			row.CompletionTime.Valid,
+			row.PickUpTime.Valid,
+			row.CancellationTime.Valid,
+		),
+		CustomerName:  row.CustomerName,
+		ContactNumber: row.ContactNumber,
+		PhoneType:     row.PhoneType,
+		Color:         row.Color,
+		IMEI:          typemapper.PgtypeTextToOptionalString(row.Imei),
+		TechnicianID:  typemapper.MustPgtypeUUIDToUUID(row.TechnicianID),
+		SalesPersonID: typemapper.MustPgtypeUUIDToUUID(row.SalesPersonID),
+	}
+}
+
+func listFilterStatusToPgtypeText(status optional.Optional[domain.OrderStatus]) pgtype.Text {
+	value, ok := status.Get()
+	if !ok {
+		return typemapper.OptionalStringToPgtypeText(optional.None[string]())
+	}
+
+	return typemapper.StringToPgtypeText(string(value))
+}
+
+// listFilterSearchToPgtypeText escapes the LIKE wildcards in the search term
+// so they are matched literally.
+func listFilterSearchToPgtypeText(search optional.Optional[string]) pgtype.Text {
+	value, ok := search.Get()
+	if !ok {
+		return typemapper.OptionalStringToPgtypeText(optional.None[string]())
+	}
+
+	return typemapper.StringToPgtypeText(likeEscaper.Replace(value))
+}
+
+var likeEscaper = strings.NewReplacer(`\`, `\\`, "%", `\%`, "_", `\_`)
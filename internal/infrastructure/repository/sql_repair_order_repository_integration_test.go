@@ -7,22 +7,28 @@ import (
 	"context"
 	"errors"
 	"net/http"
-	"net/url"
+	"sync"
 	"testing"
 	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/appconstant"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/gensql"
 	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	repairorderreadmodel "github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+	shareddomain "github.com/JosephJoshua/remana-backend/internal/modules/shared/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/testutil"
 	"github.com/JosephJoshua/remana-backend/internal/typemapper"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/ory/dockertest/v3"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -157,7 +163,7 @@ func TestCreateRepairOrder(t *testing.T) {
 			DamageTypes:        []uuid.UUID{theDamage.id},
 			PhoneConditions:    []uuid.UUID{thePhoneCondition.id},
 			PhoneEquipments:    []uuid.UUID{theEquipment.id},
-			Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+			Photos:             []uuid.UUID{seedPhoto(t, queries, theStoreID)},
 			Imei:               genapi.NewOptString("123456789012345"),
 			PartsNotCheckedYet: genapi.NewOptString("Battery"),
 			Passcode: genapi.NewOptCreateRepairOrderRequestPasscode(genapi.CreateRepairOrderRequestPasscode{
@@ -177,7 +183,7 @@ func TestCreateRepairOrder(t *testing.T) {
 		slugProvider := testutil.NewRepairOrderSlugProviderStub("some-slug", nil)
 
 		repo := repository.NewSQLRepairOrderRepository(db)
-		s := repairorder.NewService(timeProvider, locationProvider, repo, permissionProviderStub{}, slugProvider)
+		s := repairorder.NewService(timeProvider, locationProvider, repo, permissionProviderStub{}, slugProvider, testutil.NewReceiptRendererStub(), testutil.NewLabelRendererStub(), testutil.NewAuditLogStub())
 
 		req := validRequest()
 
@@ -215,10 +221,6 @@ func TestCreateRepairOrder(t *testing.T) {
 		assert.Equal(t, req.PartsNotCheckedYet.Value, order.PartsNotCheckedYet.String)
 		assert.Equal(t, req.Passcode.Value.IsPatternLocked, order.IsPatternLocked.Bool)
 		assert.Equal(t, req.Passcode.Value.Value, order.PasscodeOrPattern.String)
-		assert.Equal(t, int32(req.DownPayment.Value.Amount), order.DownPaymentAmount.Int32)
-
-		require.True(t, order.DownPaymentMethodID.Valid)
-		assert.Equal(t, req.DownPayment.Value.Method, typemapper.MustPgtypeUUIDToUUID(order.DownPaymentMethodID))
 
 		assert.False(t, order.PickUpTime.Valid)
 		assert.False(t, order.CompletionTime.Valid)
@@ -227,8 +229,6 @@ func TestCreateRepairOrder(t *testing.T) {
 		assert.False(t, order.WarrantyDays.Valid)
 		assert.False(t, order.ConfirmationTime.Valid)
 		assert.False(t, order.ConfirmationContent.Valid)
-		assert.False(t, order.RepaymentAmount.Valid)
-		assert.False(t, order.RepaymentMethodID.Valid)
 
 		damages, err := queries.GetRepairOrderDamagesForTesting(
 			context.Background(),
@@ -271,6 +271,18 @@ func TestCreateRepairOrder(t *testing.T) {
 		assert.Equal(t, int32(req.InitialCost), costs[0].Amount)
 		assert.Empty(t, costs[0].Reason.String)
 
+		payments, err := queries.GetRepairOrderPaymentsForTesting(
+			context.Background(),
+			typemapper.UUIDToPgtypeUUID(orderID),
+		)
+
+		require.NoError(t, err)
+
+		require.Equal(t, 1, len(payments))
+		assert.Equal(t, string(domain.OrderPaymentTypeDownPayment), payments[0].PaymentType)
+		assert.Equal(t, int32(req.DownPayment.Value.Amount), payments[0].Amount)
+		assert.Equal(t, req.DownPayment.Value.Method, typemapper.MustPgtypeUUIDToUUID(payments[0].PaymentMethodID))
+
 		photos, err := queries.GetRepairOrderPhotosForTesting(
 			context.Background(),
 			typemapper.UUIDToPgtypeUUID(orderID),
@@ -279,12 +291,15 @@ func TestCreateRepairOrder(t *testing.T) {
 		require.NoError(t, err)
 		assert.Equal(t, 1, len(photos))
 
-		_, err = url.Parse(photos[0].PhotoUrl)
-		require.NoError(t, err, "invalid photo url")
+		assert.Equal(t, req.Photos[0], typemapper.MustPgtypeUUIDToUUID(photos[0].PhotoID))
+		assert.Equal(t, string(domain.OrderPhotoStageIntake), photos[0].Stage)
 	})
 
 	t.Run("returns bad request", func(t *testing.T) {
-		var someRandomID = uuid.New()
+		var (
+			someRandomID      = uuid.New()
+			otherStorePhotoID = seedPhoto(t, queries, otherStoreID)
+		)
 
 		testCases := []struct {
 			name  string
@@ -368,6 +383,18 @@ func TestCreateRepairOrder(t *testing.T) {
 					req.SalesPersonID = otherStoreSalesPersonID
 				},
 			},
+			{
+				name: "when photo does not exist",
+				setup: func(req *genapi.CreateRepairOrderRequest) {
+					req.Photos = []uuid.UUID{someRandomID}
+				},
+			},
+			{
+				name: "when photo is from different store",
+				setup: func(req *genapi.CreateRepairOrderRequest) {
+					req.Photos = []uuid.UUID{otherStorePhotoID}
+				},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -377,7 +404,7 @@ func TestCreateRepairOrder(t *testing.T) {
 				slugProvider := testutil.NewRepairOrderSlugProviderStub("some-slug", nil)
 				repo := repository.NewSQLRepairOrderRepository(db)
 
-				s := repairorder.NewService(timeProvider, locationProvider, repo, permissionProviderStub{}, slugProvider)
+				s := repairorder.NewService(timeProvider, locationProvider, repo, permissionProviderStub{}, slugProvider, testutil.NewReceiptRendererStub(), testutil.NewLabelRendererStub(), testutil.NewAuditLogStub())
 
 				req := validRequest()
 				tc.setup(&req)
@@ -517,3 +544,1221 @@ func seedCreateRepairOrder(
 	})
 	require.NoError(t, err)
 }
+
+func seedPhoto(t *testing.T, queries *gensql.Queries, storeID uuid.UUID) uuid.UUID {
+	t.Helper()
+
+	photoID := uuid.New()
+
+	_, err := queries.SeedPhoto(context.Background(), gensql.SeedPhotoParams{
+		PhotoID: typemapper.UUIDToPgtypeUUID(photoID),
+		StoreID: typemapper.UUIDToPgtypeUUID(storeID),
+		BlobKey: pgtype.Text{String: "photos/" + photoID.String(), Valid: true},
+	})
+	require.NoError(t, err)
+
+	return photoID
+}
+
+func TestGetRepairOrder(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", initErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theCreationTime = time.Unix(1713917762, 0)
+
+		theStoreID         = uuid.New()
+		theSalesPersonID   = uuid.New()
+		theTechnicianID    = uuid.New()
+		thePaymentMethodID = uuid.New()
+
+		theDamage         = damage{id: uuid.New(), name: "Broken Screen"}
+		thePhoneCondition = phoneCondition{id: uuid.New(), name: "Screen scratched"}
+		theEquipment      = phoneEquipment{id: uuid.New(), name: "Battery"}
+
+		otherStoreID = uuid.New()
+	)
+
+	newRequestCtx := func(storeID uuid.UUID) context.Context {
+		return appcontext.NewContextWithUser(
+			testutil.RequestContextWithLogger(context.Background()),
+			testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+				details.Store.ID = storeID
+			}),
+		)
+	}
+
+	queries := gensql.New(db)
+
+	seedCreateRepairOrder(
+		context.Background(),
+		t,
+		queries,
+		theStoreID,
+		otherStoreID,
+		theSalesPersonID,
+		uuid.New(),
+		theTechnicianID,
+		uuid.New(),
+		thePaymentMethodID,
+		uuid.New(),
+		theDamage,
+		damage{id: uuid.New(), name: theDamage.name},
+		thePhoneCondition,
+		phoneCondition{id: uuid.New(), name: thePhoneCondition.name},
+		theEquipment,
+		phoneEquipment{id: uuid.New(), name: theEquipment.name},
+	)
+
+	locationProvider := &testutil.ResourceLocationProviderStub{}
+	repo := repository.NewSQLRepairOrderRepository(db)
+
+	s := repairorder.NewService(
+		testutil.NewTimeProviderStub(theCreationTime),
+		locationProvider,
+		repo,
+		permissionProviderStub{},
+		testutil.NewRepairOrderSlugProviderStub("some-slug", nil),
+		testutil.NewReceiptRendererStub(),
+		testutil.NewLabelRendererStub(),
+		testutil.NewAuditLogStub(),
+	)
+
+	req := genapi.CreateRepairOrderRequest{
+		CustomerName:       "John Doe",
+		ContactPhoneNumber: "08123456789",
+		PhoneType:          "iPhone 12",
+		Color:              "Black",
+		SalesPersonID:      theSalesPersonID,
+		TechnicianID:       theTechnicianID,
+		InitialCost:        100,
+		DamageTypes:        []uuid.UUID{theDamage.id},
+		PhoneConditions:    []uuid.UUID{thePhoneCondition.id},
+		PhoneEquipments:    []uuid.UUID{theEquipment.id},
+		Photos:             []uuid.UUID{seedPhoto(t, queries, theStoreID)},
+		Imei:               genapi.NewOptString("123456789012345"),
+		Passcode: genapi.NewOptCreateRepairOrderRequestPasscode(genapi.CreateRepairOrderRequestPasscode{
+			Value:           "1234",
+			IsPatternLocked: true,
+		}),
+		DownPayment: genapi.NewOptCreateRepairOrderRequestDownPayment(genapi.CreateRepairOrderRequestDownPayment{
+			Amount: 50,
+			Method: thePaymentMethodID,
+		}),
+		EstimatedCompletionTime: genapi.NewOptDateTime(theCreationTime.Add(48 * time.Hour)),
+	}
+
+	_, initErr = s.CreateRepairOrder(newRequestCtx(theStoreID), &req)
+	require.NoError(t, initErr)
+	require.True(t, locationProvider.RepairOrderID.IsSet(), "location provider not called with repair order id")
+
+	theOrderID := locationProvider.RepairOrderID.MustGet()
+
+	t.Run("returns the whole repair order", func(t *testing.T) {
+		got, err := s.GetRepairOrder(newRequestCtx(theStoreID), genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		assert.Equal(t, theOrderID, got.ID)
+		assert.Equal(t, "some-slug", got.Slug)
+		assert.True(t, theCreationTime.Equal(got.CreationTime))
+		assert.Equal(t, req.CustomerName, got.CustomerName)
+		assert.Equal(t, req.PhoneType, got.PhoneType)
+		assert.Equal(t, req.Color, got.Color)
+		assert.Equal(t, req.SalesPersonID, got.SalesPersonID)
+		assert.Equal(t, req.TechnicianID, got.TechnicianID)
+		assert.Equal(t, req.Imei, got.Imei)
+		assert.False(t, got.PartsNotCheckedYet.IsSet())
+
+		require.True(t, got.Passcode.IsSet())
+		assert.Equal(t, req.Passcode.Value.Value, got.Passcode.Value.Value)
+		assert.True(t, got.Passcode.Value.IsPatternLocked)
+
+		require.Len(t, got.Payments, 1)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeDownPayment, got.Payments[0].Type)
+		assert.Equal(t, req.DownPayment.Value.Amount, got.Payments[0].Amount)
+		assert.Equal(t, req.DownPayment.Value.Method, got.Payments[0].Method)
+
+		require.Len(t, got.Costs, 1)
+		assert.Equal(t, req.InitialCost, got.Costs[0].Amount)
+		assert.True(t, got.Costs[0].IsInitial)
+
+		require.Len(t, got.Damages, 1)
+		assert.Equal(t, theDamage.name, got.Damages[0].Name)
+
+		require.Len(t, got.PhoneConditions, 1)
+		assert.Equal(t, thePhoneCondition.name, got.PhoneConditions[0].Name)
+
+		require.Len(t, got.PhoneEquipments, 1)
+		assert.Equal(t, theEquipment.name, got.PhoneEquipments[0].Name)
+
+		require.Len(t, got.Photos, 1)
+		assert.Equal(t, req.Photos[0], got.Photos[0].PhotoID)
+
+		require.True(t, got.EstimatedCompletionTime.IsSet())
+		assert.True(t, req.EstimatedCompletionTime.Value.Equal(got.EstimatedCompletionTime.Value))
+
+		assert.False(t, got.Confirmation.IsSet())
+		assert.False(t, got.CompletionTime.IsSet())
+		assert.False(t, got.PickUpTime.IsSet())
+		assert.False(t, got.Cancellation.IsSet())
+	})
+
+	t.Run("returns the repair order by slug", func(t *testing.T) {
+		got, err := s.GetRepairOrderBySlug(newRequestCtx(theStoreID), genapi.GetRepairOrderBySlugParams{Slug: "some-slug"})
+		require.NoError(t, err)
+
+		assert.Equal(t, theOrderID, got.ID)
+	})
+
+	t.Run("returns the repair order by slug in any store", func(t *testing.T) {
+		got, err := repo.GetRepairOrderBySlugInAnyStore(context.Background(), "some-slug")
+		require.NoError(t, err)
+
+		assert.Equal(t, theOrderID, got.ID())
+
+		_, err = repo.GetRepairOrderBySlugInAnyStore(context.Background(), "other-slug")
+		require.ErrorIs(t, err, apperror.ErrRepairOrderNotFound)
+	})
+
+	t.Run("returns the store receipt details", func(t *testing.T) {
+		got, err := repo.GetStoreReceiptDetails(context.Background(), otherStoreID)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Not important", got.Name)
+		assert.Equal(t, 58, got.PaperWidth)
+		assert.False(t, got.WarrantyTerms.IsSet())
+		assert.False(t, got.HTMLTemplate.IsSet())
+		assert.False(t, got.PDFTemplate.IsSet())
+
+		err = queries.SetStoreReceiptSettings(context.Background(), gensql.SetStoreReceiptSettingsParams{
+			StoreID:             typemapper.UUIDToPgtypeUUID(theStoreID),
+			WarrantyTerms:       typemapper.StringToPgtypeText("30 days warranty"),
+			ReceiptHtmlTemplate: typemapper.StringToPgtypeText("<p>{{ .Slug }}</p>"),
+		})
+		require.NoError(t, err)
+
+		err = queries.SetStoreReceiptPaperWidth(context.Background(), gensql.SetStoreReceiptPaperWidthParams{
+			StoreID:           typemapper.UUIDToPgtypeUUID(theStoreID),
+			ReceiptPaperWidth: 80,
+		})
+		require.NoError(t, err)
+
+		got, err = repo.GetStoreReceiptDetails(context.Background(), theStoreID)
+		require.NoError(t, err)
+
+		assert.Equal(t, 80, got.PaperWidth)
+
+		assert.Equal(t, "30 days warranty", got.WarrantyTerms.MustGet())
+		assert.Equal(t, "<p>{{ .Slug }}</p>", got.HTMLTemplate.MustGet())
+		assert.False(t, got.PDFTemplate.IsSet())
+	})
+
+	t.Run("returns not found when repair order is from different store", func(t *testing.T) {
+		_, err := s.GetRepairOrder(newRequestCtx(otherStoreID), genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+
+		_, err = s.GetRepairOrderBySlug(newRequestCtx(otherStoreID), genapi.GetRepairOrderBySlugParams{Slug: "some-slug"})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+}
+
+func TestListRepairOrders(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", initErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		baseTime = time.Unix(1713917762, 0)
+
+		theStoreID             = uuid.New()
+		theSalesPersonID       = uuid.New()
+		theTechnicianID        = uuid.New()
+		otherStoreID           = uuid.New()
+		otherStoreTechnicianID = uuid.New()
+		otherStoreSalesPerson  = uuid.New()
+	)
+
+	queries := gensql.New(db)
+
+	seedCreateRepairOrder(
+		context.Background(),
+		t,
+		queries,
+		theStoreID,
+		otherStoreID,
+		theSalesPersonID,
+		otherStoreSalesPerson,
+		theTechnicianID,
+		otherStoreTechnicianID,
+		uuid.New(),
+		uuid.New(),
+		damage{id: uuid.New(), name: "Broken Screen"},
+		damage{id: uuid.New(), name: "Broken Screen"},
+		phoneCondition{id: uuid.New(), name: "Screen scratched"},
+		phoneCondition{id: uuid.New(), name: "Screen scratched"},
+		phoneEquipment{id: uuid.New(), name: "Battery"},
+		phoneEquipment{id: uuid.New(), name: "Battery"},
+	)
+
+	repo := repository.NewSQLRepairOrderRepository(db)
+
+	createOrder := func(storeID uuid.UUID, technicianID uuid.UUID, salesPersonID uuid.UUID, customerName string, creationTime time.Time) domain.Order {
+		contactNumber, err := shareddomain.NewPhoneNumber("08123456789")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:  creationTime,
+			Slug:          uuid.NewString(),
+			StoreID:       storeID,
+			CustomerName:  customerName,
+			ContactNumber: contactNumber,
+			PhoneType:     "iPhone 12",
+			Color:         "Black",
+			InitialCost:   100,
+			Damages:       []string{"Broken Screen"},
+			Photos:        []uuid.UUID{seedPhoto(t, queries, storeID)},
+			SalesPersonID: salesPersonID,
+			TechnicianID:  technicianID,
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, repo.CreateRepairOrder(context.Background(), order))
+
+		return order
+	}
+
+	theOrders := []domain.Order{
+		createOrder(theStoreID, theTechnicianID, theSalesPersonID, "John Doe", baseTime),
+		createOrder(theStoreID, theTechnicianID, theSalesPersonID, "Jane 100% Doe", baseTime.Add(-1*time.Hour)),
+		createOrder(theStoreID, theTechnicianID, theSalesPersonID, "Budi", baseTime.Add(-2*time.Hour)),
+	}
+
+	createOrder(otherStoreID, otherStoreTechnicianID, otherStoreSalesPerson, "John Doe", baseTime)
+
+	noFilter := repairorderreadmodel.RepairOrderListFilter{}
+	noCursor := optional.None[repairorderreadmodel.RepairOrderListCursor]()
+
+	t.Run("lists repair orders of the store newest first", func(t *testing.T) {
+		got, err := repo.ListRepairOrders(context.Background(), theStoreID, noFilter, noCursor, 10)
+		require.NoError(t, err)
+
+		require.Len(t, got, len(theOrders))
+		for i, order := range theOrders {
+			assert.Equal(t, order.ID(), got[i].ID)
+			assert.Equal(t, domain.OrderStatusOpen, got[i].Status)
+		}
+
+		count, err := repo.CountRepairOrders(context.Background(), theStoreID, noFilter)
+		require.NoError(t, err)
+		assert.Equal(t, len(theOrders), count)
+	})
+
+	t.Run("continues after cursor", func(t *testing.T) {
+		cursor := optional.Some(repairorderreadmodel.RepairOrderListCursor{
+			CreationTime:  theOrders[0].CreationTime(),
+			RepairOrderID: theOrders[0].ID(),
+		})
+
+		got, err := repo.ListRepairOrders(context.Background(), theStoreID, noFilter, cursor, 1)
+		require.NoError(t, err)
+
+		require.Len(t, got, 1)
+		assert.Equal(t, theOrders[1].ID(), got[0].ID)
+	})
+
+	t.Run("filters repair orders", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			filter repairorderreadmodel.RepairOrderListFilter
+			want   []domain.Order
+		}{
+			{
+				name:   "by search term case insensitively",
+				filter: repairorderreadmodel.RepairOrderListFilter{Search: optional.Some("doe")},
+				want:   theOrders[:2],
+			},
+			{
+				name:   "by search term containing wildcards literally",
+				filter: repairorderreadmodel.RepairOrderListFilter{Search: optional.Some("100%")},
+				want:   theOrders[1:2],
+			},
+			{
+				name:   "by slug",
+				filter: repairorderreadmodel.RepairOrderListFilter{Search: optional.Some(theOrders[2].Slug())},
+				want:   theOrders[2:],
+			},
+			{
+				name:   "by status",
+				filter: repairorderreadmodel.RepairOrderListFilter{Status: optional.Some(domain.OrderStatusCancelled)},
+				want:   []domain.Order{},
+			},
+			{
+				name:   "by technician",
+				filter: repairorderreadmodel.RepairOrderListFilter{TechnicianID: optional.Some(otherStoreTechnicianID)},
+				want:   []domain.Order{},
+			},
+			{
+				name: "by creation time range",
+				filter: repairorderreadmodel.RepairOrderListFilter{
+					CreatedFrom: optional.Some(baseTime.Add(-90 * time.Minute)),
+					CreatedTo:   optional.Some(baseTime),
+				},
+				want: theOrders[1:2],
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				got, err := repo.ListRepairOrders(context.Background(), theStoreID, tc.filter, noCursor, 10)
+				require.NoError(t, err)
+
+				require.Len(t, got, len(tc.want))
+				for i, order := range tc.want {
+					assert.Equal(t, order.ID(), got[i].ID)
+				}
+
+				count, err := repo.CountRepairOrders(context.Background(), theStoreID, tc.filter)
+				require.NoError(t, err)
+				assert.Equal(t, len(tc.want), count)
+			})
+		}
+	})
+
+	t.Run("returns the technician queue and workload", func(t *testing.T) {
+		idleTechnicianID := uuid.New()
+		_, err := queries.SeedTechnician(context.Background(), gensql.SeedTechnicianParams{
+			TechnicianID:   typemapper.UUIDToPgtypeUUID(idleTechnicianID),
+			TechnicianName: "Zaki",
+			StoreID:        typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, theOrders[0].ConfirmToCustomer(baseTime, "Replace the screen"))
+		require.NoError(t, repo.UpdateRepairOrder(context.Background(), theOrders[0]))
+
+		require.NoError(t, theOrders[1].Cancel(
+			baseTime,
+			"customer changed their mind",
+			optional.None[uint](),
+			optional.None[domain.NewOrderRefundParams](),
+		))
+		require.NoError(t, repo.UpdateRepairOrder(context.Background(), theOrders[1]))
+
+		queue, err := repo.GetTechnicianQueue(context.Background(), theStoreID, theTechnicianID)
+		require.NoError(t, err)
+
+		require.Len(t, queue, 2)
+		assert.Equal(t, theOrders[2].ID(), queue[0].ID, "queue should be oldest first")
+		assert.Equal(t, theOrders[0].ID(), queue[1].ID)
+		assert.Equal(t, domain.OrderStatusConfirmed, queue[1].Status)
+
+		queue, err = repo.GetTechnicianQueue(context.Background(), otherStoreID, theTechnicianID)
+		require.NoError(t, err)
+		assert.Empty(t, queue)
+
+		workloads, err := repo.GetTechnicianWorkload(context.Background(), theStoreID)
+		require.NoError(t, err)
+
+		require.Len(t, workloads, 2)
+
+		for _, workload := range workloads {
+			switch workload.TechnicianID {
+			case theTechnicianID:
+				assert.Equal(t, 1, workload.OpenCount)
+				assert.Equal(t, 1, workload.ConfirmedCount)
+				assert.Equal(t, baseTime.Add(-2*time.Hour), workload.OldestOrderCreationTime.GetOrElse(time.Time{}))
+			case idleTechnicianID:
+				assert.Equal(t, "Zaki", workload.TechnicianName)
+				assert.Zero(t, workload.OpenCount)
+				assert.Zero(t, workload.ConfirmedCount)
+				assert.False(t, workload.OldestOrderCreationTime.IsSet())
+			default:
+				t.Errorf("unexpected technician %s", workload.TechnicianID)
+			}
+		}
+	})
+}
+
+func TestUpdateRepairOrder(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", initErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime = time.Unix(1713917762, 0)
+
+		theStoreID         = uuid.New()
+		theSalesPersonID   = uuid.New()
+		theTechnicianID    = uuid.New()
+		thePaymentMethodID = uuid.New()
+
+		theDamage         = damage{id: uuid.New(), name: "Broken Screen"}
+		thePhoneCondition = phoneCondition{id: uuid.New(), name: "Screen scratched"}
+		theEquipment      = phoneEquipment{id: uuid.New(), name: "Battery"}
+	)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+		}),
+	)
+
+	queries := gensql.New(db)
+
+	seedCreateRepairOrder(
+		context.Background(),
+		t,
+		queries,
+		theStoreID,
+		uuid.New(),
+		theSalesPersonID,
+		uuid.New(),
+		theTechnicianID,
+		uuid.New(),
+		thePaymentMethodID,
+		uuid.New(),
+		theDamage,
+		damage{id: uuid.New(), name: theDamage.name},
+		thePhoneCondition,
+		phoneCondition{id: uuid.New(), name: thePhoneCondition.name},
+		theEquipment,
+		phoneEquipment{id: uuid.New(), name: theEquipment.name},
+	)
+
+	initErr = queries.SetStoreRequiresConfirmationBeforeCompletion(
+		context.Background(),
+		gensql.SetStoreRequiresConfirmationBeforeCompletionParams{
+			StoreID:                              typemapper.UUIDToPgtypeUUID(theStoreID),
+			RequiresConfirmationBeforeCompletion: true,
+		},
+	)
+	require.NoError(t, initErr)
+
+	repo := repository.NewSQLRepairOrderRepository(db)
+
+	createOrder := func(t *testing.T, slug string) uuid.UUID {
+		locationProvider := &testutil.ResourceLocationProviderStub{}
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			locationProvider,
+			repo,
+			permissionProviderStub{},
+			testutil.NewRepairOrderSlugProviderStub(slug, nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.CreateRepairOrder(requestCtx, &genapi.CreateRepairOrderRequest{
+			CustomerName:       "John Doe",
+			ContactPhoneNumber: "08123456789",
+			PhoneType:          "iPhone 12",
+			Color:              "Black",
+			SalesPersonID:      theSalesPersonID,
+			TechnicianID:       theTechnicianID,
+			InitialCost:        100,
+			DamageTypes:        []uuid.UUID{theDamage.id},
+			PhoneConditions:    []uuid.UUID{thePhoneCondition.id},
+			PhoneEquipments:    []uuid.UUID{theEquipment.id},
+			Photos:             []uuid.UUID{seedPhoto(t, queries, theStoreID)},
+		})
+		require.NoError(t, err)
+		require.True(t, locationProvider.RepairOrderID.IsSet(), "location provider not called with repair order id")
+
+		return locationProvider.RepairOrderID.MustGet()
+	}
+
+	s := repairorder.NewService(
+		testutil.NewTimeProviderStub(theTime),
+		&testutil.ResourceLocationProviderStub{},
+		repo,
+		permissionProviderStub{},
+		testutil.NewRepairOrderSlugProviderStub("not-used", nil),
+		testutil.NewReceiptRendererStub(),
+		testutil.NewLabelRendererStub(),
+		testutil.NewAuditLogStub(),
+	)
+
+	t.Run("persists confirmation, completion and pick up", func(t *testing.T) {
+		theOrderID := createOrder(t, "to-be-picked-up")
+
+		_, err := s.CompleteRepairOrder(requestCtx, genapi.CompleteRepairOrderParams{RepairOrderId: theOrderID})
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+
+		_, err = s.ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.CompleteRepairOrder(requestCtx, genapi.CompleteRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		_, err = s.PickUpRepairOrder(
+			requestCtx,
+			genapi.NewOptPickUpRepairOrderRequest(genapi.PickUpRepairOrderRequest{
+				Repayment: genapi.NewOptPickUpRepairOrderRequestRepayment(genapi.PickUpRepairOrderRequestRepayment{
+					Amount: 100,
+					Method: thePaymentMethodID,
+				}),
+			}),
+			genapi.PickUpRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		require.True(t, got.Confirmation.IsSet())
+		assert.True(t, theTime.Equal(got.Confirmation.Value.Time))
+		assert.Equal(t, "Replace the screen", got.Confirmation.Value.Contents)
+
+		require.True(t, got.CompletionTime.IsSet())
+		assert.True(t, theTime.Equal(got.CompletionTime.Value))
+
+		require.True(t, got.PickUpTime.IsSet())
+		assert.True(t, theTime.Equal(got.PickUpTime.Value))
+
+		require.Len(t, got.Payments, 1)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeFinal, got.Payments[0].Type)
+		assert.Equal(t, 100, got.Payments[0].Amount)
+		assert.Equal(t, thePaymentMethodID, got.Payments[0].Method)
+
+		assert.False(t, got.Cancellation.IsSet())
+		assert.False(t, got.WriteOff.IsSet())
+	})
+
+	t.Run("persists write-off", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-write-off")
+
+		_, err := s.ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.CompleteRepairOrder(requestCtx, genapi.CompleteRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		_, err = s.PickUpRepairOrder(
+			requestCtx,
+			genapi.NewOptPickUpRepairOrderRequest(genapi.PickUpRepairOrderRequest{
+				Repayment: genapi.NewOptPickUpRepairOrderRequestRepayment(genapi.PickUpRepairOrderRequestRepayment{
+					Amount: 90,
+					Method: thePaymentMethodID,
+				}),
+				WriteOffReason: genapi.NewOptString("Loyal customer"),
+			}),
+			genapi.PickUpRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		require.True(t, got.WriteOff.IsSet())
+		assert.Equal(t, 10, got.WriteOff.Value.Amount)
+		assert.Equal(t, "Loyal customer", got.WriteOff.Value.Reason)
+		assert.Equal(t, 0, got.OutstandingAmount)
+	})
+
+	t.Run("persists cancellation", func(t *testing.T) {
+		theOrderID := createOrder(t, "to-be-cancelled")
+
+		_, err := s.CancelRepairOrder(
+			requestCtx,
+			&genapi.CancelRepairOrderRequest{Reason: "Customer declined"},
+			genapi.CancelRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		require.True(t, got.Cancellation.IsSet())
+		assert.True(t, theTime.Equal(got.Cancellation.Value.Time))
+		assert.Equal(t, "Customer declined", got.Cancellation.Value.Reason)
+
+		_, err = s.PickUpRepairOrder(
+			requestCtx,
+			genapi.OptPickUpRepairOrderRequest{},
+			genapi.PickUpRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("rejects transitions on an outdated repair order", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-outdated-update")
+
+		first, err := repo.GetRepairOrderByID(context.Background(), theStoreID, theOrderID)
+		require.NoError(t, err)
+
+		second, err := repo.GetRepairOrderByID(context.Background(), theStoreID, theOrderID)
+		require.NoError(t, err)
+
+		require.NoError(t, first.Cancel(
+			theTime,
+			"Customer declined",
+			optional.None[uint](),
+			optional.None[domain.NewOrderRefundParams](),
+		))
+		require.NoError(t, repo.UpdateRepairOrder(context.Background(), first))
+
+		require.NoError(t, second.ConfirmToCustomer(theTime, "Replace the screen"))
+		require.ErrorIs(t, repo.UpdateRepairOrder(context.Background(), second), apperror.ErrRepairOrderConcurrentUpdate)
+
+		reloaded, err := repo.GetRepairOrderByID(context.Background(), theStoreID, theOrderID)
+		require.NoError(t, err)
+
+		assert.True(t, reloaded.CancellationTime().PointerValue().IsSet())
+		assert.False(t, reloaded.ConfirmationTime().PointerValue().IsSet())
+		assert.Equal(t, 1, reloaded.Version())
+	})
+
+	t.Run("persists cancellation fee and refund", func(t *testing.T) {
+		theOrderID := createOrder(t, "to-be-refunded")
+
+		_, err := s.RecordRepairOrderPayment(
+			requestCtx,
+			&genapi.RecordRepairOrderPaymentRequest{
+				Type:   genapi.RecordRepairOrderPaymentRequestTypeDownPayment,
+				Amount: 30,
+				Method: thePaymentMethodID,
+			},
+			genapi.RecordRepairOrderPaymentParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.CancelRepairOrder(
+			requestCtx,
+			&genapi.CancelRepairOrderRequest{
+				Reason: "Customer declined",
+				Fee:    genapi.NewOptInt(10),
+				Refund: genapi.NewOptCancelRepairOrderRequestRefund(genapi.CancelRepairOrderRequestRefund{
+					Amount: 20,
+					Method: thePaymentMethodID,
+					Reason: "Repair cancelled",
+				}),
+			},
+			genapi.CancelRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		require.True(t, got.Cancellation.IsSet())
+		assert.Equal(t, 10, got.Cancellation.Value.Fee.Value)
+
+		require.Len(t, got.Payments, 2)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeRefund, got.Payments[1].Type)
+		assert.Equal(t, 20, got.Payments[1].Amount)
+		assert.Equal(t, "Repair cancelled", got.Payments[1].Reason.Value)
+
+		assert.Equal(t, 10, got.PaidAmount)
+		assert.Equal(t, 0, got.OutstandingAmount)
+	})
+
+	t.Run("writes order events to the outbox", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-events")
+
+		_, err := s.AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 25, Reason: "Replaced battery"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.CancelRepairOrder(
+			requestCtx,
+			&genapi.CancelRepairOrderRequest{Reason: "Customer declined"},
+			genapi.CancelRepairOrderParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		rows, err := queries.GetRepairOrderEventsForTesting(
+			context.Background(),
+			typemapper.UUIDToPgtypeUUID(theOrderID),
+		)
+		require.NoError(t, err)
+
+		types := make([]string, 0, len(rows))
+		for _, row := range rows {
+			types = append(types, row.EventType)
+			assert.Equal(t, theStoreID, typemapper.MustPgtypeUUIDToUUID(row.StoreID))
+			assert.False(t, row.DispatchedTime.Valid)
+		}
+
+		assert.ElementsMatch(t, []string{
+			string(domain.OrderEventTypeCreated),
+			string(domain.OrderEventTypeCostMutated),
+			string(domain.OrderEventTypeCancelled),
+		}, types)
+
+		pending, err := repository.NewSQLOrderEventRepository(db).ClaimPendingOrderEvents(
+			context.Background(),
+			theTime,
+			theTime.Add(time.Minute),
+			100,
+		)
+		require.NoError(t, err)
+
+		var got []domain.OrderEvent
+		for _, p := range pending {
+			if p.Event.Metadata().OrderID == theOrderID {
+				got = append(got, p.Event)
+			}
+		}
+
+		require.Len(t, got, 3)
+		assert.Contains(t, got, domain.OrderCostMutated{
+			OrderEventMetadata: got[indexOfEventType(got, domain.OrderEventTypeCostMutated)].Metadata(),
+			Amount:             25,
+			Reason:             "Replaced battery",
+		})
+		assert.Contains(t, got, domain.OrderCancelled{
+			OrderEventMetadata: got[indexOfEventType(got, domain.OrderEventTypeCancelled)].Metadata(),
+			Reason:             "Customer declined",
+		})
+	})
+
+	t.Run("persists cost adjustments", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-cost-adjustments")
+
+		_, err := s.AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 25, Reason: "Replaced battery"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: -10, Reason: "Discount"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		require.Len(t, got.Costs, 3)
+		assert.True(t, got.Costs[0].IsInitial)
+		assert.Equal(t, 25, got.Costs[1].Amount)
+		assert.Equal(t, "Replaced battery", got.Costs[1].Reason.Value)
+		assert.Equal(t, -10, got.Costs[2].Amount)
+
+		assert.Equal(t, 115, got.TotalCost)
+		assert.Equal(t, 115, got.OutstandingAmount)
+	})
+
+	t.Run("persists technician reassignment", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-reassignment")
+
+		otherTechnicianID := uuid.New()
+		_, err := queries.SeedTechnician(context.Background(), gensql.SeedTechnicianParams{
+			TechnicianID:   typemapper.UUIDToPgtypeUUID(otherTechnicianID),
+			TechnicianName: "Not important",
+			StoreID:        typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, err)
+
+		_, err = s.ChangeRepairOrderTechnician(
+			requestCtx,
+			&genapi.ChangeRepairOrderTechnicianRequest{TechnicianID: otherTechnicianID, Reason: "On leave"},
+			genapi.ChangeRepairOrderTechnicianParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		assert.Equal(t, otherTechnicianID, got.TechnicianID)
+
+		require.Len(t, got.TechnicianAssignments, 1)
+		assert.Equal(t, theTechnicianID, got.TechnicianAssignments[0].PreviousTechnicianID)
+		assert.Equal(t, otherTechnicianID, got.TechnicianAssignments[0].TechnicianID)
+		assert.Equal(t, "On leave", got.TechnicianAssignments[0].Reason)
+		assert.Equal(t, theTime, got.TechnicianAssignments[0].CreationTime)
+	})
+
+	t.Run("persists damage, phone condition and phone equipment edits", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-intake-edits")
+
+		otherDamageID, otherConditionID, otherEquipmentID := uuid.New(), uuid.New(), uuid.New()
+
+		_, err := queries.SeedDamageType(context.Background(), gensql.SeedDamageTypeParams{
+			DamageTypeID:   typemapper.UUIDToPgtypeUUID(otherDamageID),
+			DamageTypeName: "Water damage",
+			StoreID:        typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, err)
+
+		_, err = queries.SeedPhoneCondition(context.Background(), gensql.SeedPhoneConditionParams{
+			PhoneConditionID:   typemapper.UUIDToPgtypeUUID(otherConditionID),
+			PhoneConditionName: "Back glass cracked",
+			StoreID:            typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, err)
+
+		_, err = queries.SeedPhoneEquipment(context.Background(), gensql.SeedPhoneEquipmentParams{
+			PhoneEquipmentID:   typemapper.UUIDToPgtypeUUID(otherEquipmentID),
+			PhoneEquipmentName: "SIM tray",
+			StoreID:            typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, err)
+
+		got, err := s.AddRepairOrderDamages(
+			requestCtx,
+			&genapi.AddRepairOrderDamagesRequest{DamageTypes: []uuid.UUID{otherDamageID}},
+			genapi.AddRepairOrderDamagesParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.RemoveRepairOrderDamage(requestCtx, genapi.RemoveRepairOrderDamageParams{
+			RepairOrderId:       theOrderID,
+			RepairOrderDamageId: got.Damages[0].ID,
+		})
+		require.NoError(t, err)
+
+		_, err = s.AddRepairOrderPhoneConditions(
+			requestCtx,
+			&genapi.AddRepairOrderPhoneConditionsRequest{PhoneConditions: []uuid.UUID{otherConditionID}},
+			genapi.AddRepairOrderPhoneConditionsParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.RemoveRepairOrderPhoneEquipment(requestCtx, genapi.RemoveRepairOrderPhoneEquipmentParams{
+			RepairOrderId:               theOrderID,
+			RepairOrderPhoneEquipmentId: got.PhoneEquipments[0].ID,
+		})
+		require.NoError(t, err)
+
+		_, err = s.AddRepairOrderPhoneEquipments(
+			requestCtx,
+			&genapi.AddRepairOrderPhoneEquipmentsRequest{PhoneEquipments: []uuid.UUID{otherEquipmentID}},
+			genapi.AddRepairOrderPhoneEquipmentsParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		damages, err := queries.GetRepairOrderDamagesForTesting(context.Background(), typemapper.UUIDToPgtypeUUID(theOrderID))
+		require.NoError(t, err)
+		require.Len(t, damages, 1)
+		assert.Equal(t, "Water damage", damages[0].DamageName)
+
+		conditions, err := queries.GetRepairOrderPhoneConditionsForTesting(
+			context.Background(),
+			typemapper.UUIDToPgtypeUUID(theOrderID),
+		)
+		require.NoError(t, err)
+		assert.Len(t, conditions, 2)
+
+		equipments, err := queries.GetRepairOrderPhoneEquipmentsForTesting(
+			context.Background(),
+			typemapper.UUIDToPgtypeUUID(theOrderID),
+		)
+		require.NoError(t, err)
+		require.Len(t, equipments, 1)
+		assert.Equal(t, "SIM tray", equipments[0].PhoneEquipmentName)
+
+		reloaded, err := repo.GetRepairOrderByID(context.Background(), theStoreID, theOrderID)
+		require.NoError(t, err)
+
+		require.Len(t, reloaded.Damages(), 1)
+		assert.Equal(t, "Water damage", reloaded.Damages()[0].Name())
+
+		conditionNames := make([]string, 0, len(reloaded.PhoneConditions()))
+		for _, condition := range reloaded.PhoneConditions() {
+			conditionNames = append(conditionNames, condition.Name())
+		}
+		assert.ElementsMatch(t, []string{thePhoneCondition.name, "Back glass cracked"}, conditionNames)
+
+		require.Len(t, reloaded.PhoneEquipments(), 1)
+		assert.Equal(t, "SIM tray", reloaded.PhoneEquipments()[0].Name())
+	})
+
+	t.Run("persists added and removed photos", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-photos")
+		diagnosisPhotoID := seedPhoto(t, queries, theStoreID)
+		repairPhotoID := seedPhoto(t, queries, theStoreID)
+
+		for _, req := range []*genapi.AddRepairOrderPhotoRequest{
+			{
+				PhotoID: diagnosisPhotoID,
+				Stage:   genapi.RepairOrderPhotoStageDiagnosis,
+				Caption: genapi.NewOptString("Water damage under the shield"),
+			},
+			{PhotoID: repairPhotoID, Stage: genapi.RepairOrderPhotoStageRepair},
+		} {
+			_, err := s.AddRepairOrderPhoto(requestCtx, req, genapi.AddRepairOrderPhotoParams{RepairOrderId: theOrderID})
+			require.NoError(t, err)
+		}
+
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+		require.Len(t, got.Photos, 3)
+
+		var repairPhoto genapi.RepairOrderPhotosItem
+		for _, photo := range got.Photos {
+			switch photo.PhotoID {
+			case diagnosisPhotoID:
+				assert.Equal(t, genapi.RepairOrderPhotoStageDiagnosis, photo.Stage)
+				assert.Equal(t, genapi.NewOptString("Water damage under the shield"), photo.Caption)
+				assert.Equal(t, theTime, photo.CreationTime)
+			case repairPhotoID:
+				repairPhoto = photo
+			default:
+				assert.Equal(t, genapi.RepairOrderPhotoStageIntake, photo.Stage)
+			}
+		}
+
+		_, err = s.RemoveRepairOrderPhoto(requestCtx, genapi.RemoveRepairOrderPhotoParams{
+			RepairOrderId:      theOrderID,
+			RepairOrderPhotoId: repairPhoto.ID,
+		})
+		require.NoError(t, err)
+
+		photos, err := queries.GetRepairOrderPhotosForTesting(context.Background(), typemapper.UUIDToPgtypeUUID(theOrderID))
+		require.NoError(t, err)
+
+		require.Len(t, photos, 2)
+		for _, photo := range photos {
+			assert.NotEqual(t, repairPhotoID, typemapper.MustPgtypeUUIDToUUID(photo.PhotoID))
+		}
+
+		reloaded, err := repo.GetRepairOrderByID(context.Background(), theStoreID, theOrderID)
+		require.NoError(t, err)
+
+		stages := make(map[uuid.UUID]domain.OrderPhotoStage, len(reloaded.Photos()))
+		for _, photo := range reloaded.Photos() {
+			stages[photo.PhotoID()] = photo.Stage()
+		}
+
+		assert.Len(t, stages, 2)
+		assert.Equal(t, domain.OrderPhotoStageDiagnosis, stages[diagnosisPhotoID])
+		assert.NotContains(t, stages, repairPhotoID)
+	})
+
+	t.Run("persists notes", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-notes")
+
+		theRoleID, theUserID := uuid.New(), uuid.New()
+
+		_, err := queries.SeedRole(context.Background(), gensql.SeedRoleParams{
+			RoleID:   typemapper.UUIDToPgtypeUUID(theRoleID),
+			RoleName: "Not important",
+			StoreID:  typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, err)
+
+		_, err = queries.SeedUser(context.Background(), gensql.SeedUserParams{
+			UserID:       typemapper.UUIDToPgtypeUUID(theUserID),
+			Username:     "frontdesk",
+			UserPassword: "not important",
+			RoleID:       typemapper.UUIDToPgtypeUUID(theRoleID),
+			StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, err)
+
+		authorCtx := appcontext.NewContextWithUser(
+			testutil.RequestContextWithLogger(context.Background()),
+			testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+				details.ID = theUserID
+				details.Username = "frontdesk"
+				details.Store.ID = theStoreID
+			}),
+		)
+
+		internalNote, err := s.AddRepairOrderNote(
+			authorCtx,
+			&genapi.AddRepairOrderNoteRequest{Body: "Customer OK'd screen replacement by phone", Visibility: genapi.RepairOrderNoteVisibilityInternal},
+			genapi.AddRepairOrderNoteParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		customerNote, err := s.AddRepairOrderNote(
+			authorCtx,
+			&genapi.AddRepairOrderNoteRequest{Body: "Waiting for parts", Visibility: genapi.RepairOrderNoteVisibilityInternal},
+			genapi.AddRepairOrderNoteParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		_, err = s.EditRepairOrderNote(
+			authorCtx,
+			&genapi.EditRepairOrderNoteRequest{Body: "Replacement screen has been ordered", Visibility: genapi.RepairOrderNoteVisibilityCustomer},
+			genapi.EditRepairOrderNoteParams{RepairOrderId: theOrderID, NoteId: customerNote.ID},
+		)
+		require.NoError(t, err)
+
+		got, err := s.ListRepairOrderNotes(authorCtx, genapi.ListRepairOrderNotesParams{RepairOrderId: theOrderID})
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 2)
+
+		notes := make(map[uuid.UUID]genapi.RepairOrderNote, len(got.Items))
+		for _, item := range got.Items {
+			notes[item.ID] = item
+		}
+
+		assert.Equal(t, "Customer OK'd screen replacement by phone", notes[internalNote.ID].Body)
+		assert.Equal(t, "frontdesk", notes[internalNote.ID].AuthorUsername)
+		assert.False(t, notes[internalNote.ID].EditTime.IsSet())
+
+		assert.Equal(t, "Replacement screen has been ordered", notes[customerNote.ID].Body)
+		assert.Equal(t, genapi.RepairOrderNoteVisibilityCustomer, notes[customerNote.ID].Visibility)
+		assert.Equal(t, theTime, notes[customerNote.ID].EditTime.Value)
+
+		customerNotes, err := repo.GetRepairOrderNotes(
+			context.Background(),
+			theOrderID,
+			optional.Some(domain.OrderNoteVisibilityCustomer),
+		)
+		require.NoError(t, err)
+
+		require.Len(t, customerNotes, 1)
+		assert.Equal(t, customerNote.ID, customerNotes[0].Note.ID())
+	})
+
+	t.Run("persists payments", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-payments")
+
+		for _, req := range []genapi.RecordRepairOrderPaymentRequest{
+			{Type: genapi.RecordRepairOrderPaymentRequestTypeDownPayment, Amount: 30, Method: thePaymentMethodID},
+			{Type: genapi.RecordRepairOrderPaymentRequestTypePartial, Amount: 20, Method: thePaymentMethodID},
+		} {
+			_, err := s.RecordRepairOrderPayment(
+				requestCtx,
+				&req,
+				genapi.RecordRepairOrderPaymentParams{RepairOrderId: theOrderID},
+			)
+			require.NoError(t, err)
+		}
+
+		got, err := s.ListRepairOrderPayments(
+			requestCtx,
+			genapi.ListRepairOrderPaymentsParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeDownPayment, got.Items[0].Type)
+		assert.Equal(t, 30, got.Items[0].Amount)
+		assert.Equal(t, genapi.RepairOrderPaymentTypePartial, got.Items[1].Type)
+		assert.Equal(t, 20, got.Items[1].Amount)
+
+		assert.Equal(t, 50, got.PaidAmount)
+		assert.Equal(t, 50, got.OutstandingAmount)
+	})
+
+	t.Run("does not overpay with concurrent payments", func(t *testing.T) {
+		theOrderID := createOrder(t, "with-concurrent-payments")
+
+		const attempts = 5
+
+		var wg sync.WaitGroup
+		errs := make(chan error, attempts)
+
+		for range attempts {
+			wg.Add(1)
+
+			go func() {
+				defer wg.Done()
+
+				_, err := s.RecordRepairOrderPayment(
+					requestCtx,
+					&genapi.RecordRepairOrderPaymentRequest{
+						Type:   genapi.RecordRepairOrderPaymentRequestTypePartial,
+						Amount: 100,
+						Method: thePaymentMethodID,
+					},
+					genapi.RecordRepairOrderPaymentParams{RepairOrderId: theOrderID},
+				)
+
+				errs <- err
+			}()
+		}
+
+		wg.Wait()
+		close(errs)
+
+		succeeded := 0
+		for err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+
+			// Payments that loaded the order before the first one was saved
+			// conflict, and the rest see that nothing is outstanding anymore.
+			var apiErr *genapi.ErrorStatusCode
+			require.ErrorAs(t, err, &apiErr)
+			assert.Contains(t, []int{http.StatusConflict, http.StatusBadRequest}, apiErr.StatusCode)
+		}
+
+		assert.Equal(t, 1, succeeded)
+
+		got, err := s.ListRepairOrderPayments(
+			requestCtx,
+			genapi.ListRepairOrderPaymentsParams{RepairOrderId: theOrderID},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 1)
+		assert.Equal(t, 100, got.PaidAmount)
+		assert.Equal(t, 0, got.OutstandingAmount)
+	})
+}
+
+func indexOfEventType(events []domain.OrderEvent, eventType domain.OrderEventType) int {
+	for i, event := range events {
+		if event.Type() == eventType {
+			return i
+		}
+	}
+
+	return -1
+}
@@ -74,6 +74,7 @@ func TestCreateSalesPerson(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateSalesPersonRequest{
@@ -121,6 +122,7 @@ func TestCreateSalesPerson(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateSalesPersonRequest{
@@ -151,6 +153,7 @@ func TestCreateSalesPerson(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateSalesPersonRequest{
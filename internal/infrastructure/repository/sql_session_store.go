@@ -0,0 +1,83 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SQLSessionStore is an scs.Store (and scs.CtxStore) backed by the sessions table,
+// so sessions are shared between replicas and survive restarts.
+type SQLSessionStore struct {
+	queries *gensql.Queries
+}
+
+func NewSQLSessionStore(db *pgxpool.Pool) *SQLSessionStore {
+	return &SQLSessionStore{
+		queries: gensql.New(db),
+	}
+}
+
+func (s *SQLSessionStore) Find(token string) ([]byte, bool, error) {
+	return s.FindCtx(context.Background(), token)
+}
+
+func (s *SQLSessionStore) Commit(token string, b []byte, expiry time.Time) error {
+	return s.CommitCtx(context.Background(), token, b, expiry)
+}
+
+func (s *SQLSessionStore) Delete(token string) error {
+	return s.DeleteCtx(context.Background(), token)
+}
+
+func (s *SQLSessionStore) FindCtx(ctx context.Context, token string) ([]byte, bool, error) {
+	data, err := s.queries.FindSession(ctx, gensql.FindSessionParams{
+		Token: token,
+		Now:   typemapper.TimeToPgtypeTimestamptz(time.Now()),
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, false, nil
+	}
+
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to find session: %w", err)
+	}
+
+	return data, true, nil
+}
+
+func (s *SQLSessionStore) CommitCtx(ctx context.Context, token string, b []byte, expiry time.Time) error {
+	if err := s.queries.CommitSession(ctx, gensql.CommitSessionParams{
+		Token:  token,
+		Data:   b,
+		Expiry: typemapper.TimeToPgtypeTimestamptz(expiry),
+	}); err != nil {
+		return fmt.Errorf("failed to commit session: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SQLSessionStore) DeleteCtx(ctx context.Context, token string) error {
+	if err := s.queries.DeleteSession(ctx, token); err != nil {
+		return fmt.Errorf("failed to delete session: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SQLSessionStore) DeleteExpiredSessions(ctx context.Context, now time.Time) (int64, error) {
+	n, err := s.queries.DeleteExpiredSessions(ctx, typemapper.TimeToPgtypeTimestamptz(now))
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete expired sessions: %w", err)
+	}
+
+	return n, nil
+}
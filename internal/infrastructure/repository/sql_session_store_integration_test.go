@@ -0,0 +1,182 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/core"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSessionStore(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	t.Run("session survives a server restart", func(t *testing.T) {
+		const (
+			theUsername  = "admin"
+			thePassword  = "Password123"
+			theStoreCode = "restart-store"
+		)
+
+		seedSessionStoreUser(t, db, theUsername, thePassword, theStoreCode)
+
+		blobStore, err := core.NewLocalBlobStore(t.TempDir())
+		require.NoError(t, err)
+
+		newServer := func() http.Handler {
+			srv, middlewares, srvErr := core.NewAPIServer(db, blobStore, nil)
+			require.NoError(t, srvErr)
+
+			handler := http.Handler(srv)
+			for i := len(middlewares) - 1; i >= 0; i-- {
+				handler = middlewares[i](handler)
+			}
+
+			return handler
+		}
+
+		// Both servers are built before logging in so nothing but the
+		// database is shared between them.
+		first := newServer()
+		second := newServer()
+
+		body, err := json.Marshal(map[string]string{
+			"username":   theUsername,
+			"password":   thePassword,
+			"store_code": theStoreCode,
+		})
+		require.NoError(t, err)
+
+		req := httptest.NewRequest(http.MethodPost, "/auth/login", bytes.NewReader(body))
+		req.Header.Set("Content-Type", "application/json")
+
+		rec := httptest.NewRecorder()
+		first.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var cookie *http.Cookie
+		for _, c := range rec.Result().Cookies() {
+			if c.Name == "session_id" {
+				cookie = c
+			}
+		}
+		require.NotNil(t, cookie, "expected session cookie to be set")
+
+		req = httptest.NewRequest(http.MethodGet, "/users/me", nil)
+		req.AddCookie(cookie)
+
+		rec = httptest.NewRecorder()
+		second.ServeHTTP(rec, req)
+		require.Equal(t, http.StatusOK, rec.Code, rec.Body.String())
+
+		var me struct {
+			Username string `json:"username"`
+		}
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &me))
+		assert.Equal(t, theUsername, me.Username)
+	})
+
+	t.Run("expired sessions are not found and are cleaned up", func(t *testing.T) {
+		store := repository.NewSQLSessionStore(db)
+		ctx := context.Background()
+		now := time.Now()
+
+		require.NoError(t, store.CommitCtx(ctx, "expired-token", []byte("data"), now.Add(-time.Minute)))
+		require.NoError(t, store.CommitCtx(ctx, "live-token", []byte("data"), now.Add(time.Hour)))
+
+		_, found, err := store.FindCtx(ctx, "expired-token")
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		n, err := store.DeleteExpiredSessions(ctx, now)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), n)
+
+		data, found, err := store.FindCtx(ctx, "live-token")
+		require.NoError(t, err)
+		assert.True(t, found)
+		assert.Equal(t, []byte("data"), data)
+	})
+
+	t.Run("deleted sessions are not found", func(t *testing.T) {
+		store := repository.NewSQLSessionStore(db)
+		ctx := context.Background()
+
+		require.NoError(t, store.CommitCtx(ctx, "deleted-token", []byte("data"), time.Now().Add(time.Hour)))
+		require.NoError(t, store.DeleteCtx(ctx, "deleted-token"))
+
+		_, found, err := store.FindCtx(ctx, "deleted-token")
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
+
+func seedSessionStoreUser(t *testing.T, db *pgxpool.Pool, username string, password string, storeCode string) {
+	t.Helper()
+
+	ctx := context.Background()
+	queries := gensql.New(db)
+
+	storeID, err := queries.SeedStore(ctx, gensql.SeedStoreParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(uuid.New()),
+		StoreName:    "Not important",
+		StoreCode:    storeCode,
+		StoreAddress: "Not important",
+		PhoneNumber:  "081234567890",
+	})
+	require.NoError(t, err)
+
+	roleID, err := queries.SeedRole(ctx, gensql.SeedRoleParams{
+		RoleID:       typemapper.UUIDToPgtypeUUID(uuid.New()),
+		RoleName:     "Not important",
+		StoreID:      storeID,
+		IsStoreAdmin: true,
+	})
+	require.NoError(t, err)
+
+	hashedPassword, err := (&core.PasswordHasher{}).Hash(password)
+	require.NoError(t, err)
+
+	_, err = queries.SeedUser(ctx, gensql.SeedUserParams{
+		UserID:       typemapper.UUIDToPgtypeUUID(uuid.New()),
+		Username:     username,
+		UserPassword: hashedPassword,
+		RoleID:       roleID,
+		StoreID:      storeID,
+	})
+	require.NoError(t, err)
+}
@@ -74,6 +74,7 @@ func TestCreateTechnician(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateTechnicianRequest{
@@ -121,6 +122,7 @@ func TestCreateTechnician(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateTechnicianRequest{
@@ -151,6 +153,7 @@ func TestCreateTechnician(t *testing.T) {
 			locationProvider,
 			permissionProviderStub{},
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateTechnicianRequest{
@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SQLTOTPRepository struct {
+	queries *gensql.Queries
+	db      *pgxpool.Pool
+}
+
+func NewSQLTOTPRepository(db *pgxpool.Pool) *SQLTOTPRepository {
+	return &SQLTOTPRepository{
+		queries: gensql.New(db),
+		db:      db,
+	}
+}
+
+func (r *SQLTOTPRepository) GetUserTOTP(ctx context.Context, userID uuid.UUID) (user.TOTP, error) {
+	row, err := r.queries.GetUserTOTP(ctx, typemapper.UUIDToPgtypeUUID(userID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return user.TOTP{}, apperror.ErrTOTPNotFound
+	} else if err != nil {
+		return user.TOTP{}, fmt.Errorf("failed to get user TOTP: %w", err)
+	}
+
+	return user.TOTP{
+		Secret:           row.TotpSecret,
+		CreationTime:     row.CreationTime.Time,
+		ConfirmationTime: typemapper.PgtypeTimestamptzToOptionalTime(row.ConfirmationTime),
+	}, nil
+}
+
+// CreatePendingTOTP replaces any enrollment the user hasn't confirmed yet.
+func (r *SQLTOTPRepository) CreatePendingTOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	secret string,
+	now time.Time,
+) error {
+	affected, err := r.queries.UpsertPendingUserTOTP(ctx, gensql.UpsertPendingUserTOTPParams{
+		UserID:       typemapper.UUIDToPgtypeUUID(userID),
+		TotpSecret:   secret,
+		CreationTime: typemapper.TimeToPgtypeTimestamptz(now),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upsert pending user TOTP: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrTOTPAlreadyEnabled
+	}
+
+	return nil
+}
+
+// ConfirmTOTP enables the user's pending TOTP and replaces their recovery
+// codes. usedStep is the time step of the code used to confirm, which can't
+// be used again to log in.
+func (r *SQLTOTPRepository) ConfirmTOTP(
+	ctx context.Context,
+	userID uuid.UUID,
+	usedStep int64,
+	now time.Time,
+	recoveryCodes []string,
+) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			if errors.Is(rollbackErr, pgx.ErrTxClosed) {
+				return
+			}
+
+			err = fmt.Errorf("failed to rollback transaction: %w", rollbackErr)
+		}
+	}()
+
+	qtx := r.queries.WithTx(tx)
+
+	affected, err := qtx.ConfirmUserTOTP(ctx, gensql.ConfirmUserTOTPParams{
+		ConfirmationTime: typemapper.TimeToPgtypeTimestamptz(now),
+		UsedStep:         usedStep,
+		UserID:           typemapper.UUIDToPgtypeUUID(userID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to confirm user TOTP: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrTOTPNotFound
+	}
+
+	if err = qtx.DeleteTOTPRecoveryCodes(ctx, typemapper.UUIDToPgtypeUUID(userID)); err != nil {
+		return fmt.Errorf("failed to delete TOTP recovery codes: %w", err)
+	}
+
+	for _, code := range recoveryCodes {
+		if err = qtx.CreateTOTPRecoveryCode(ctx, gensql.CreateTOTPRecoveryCodeParams{
+			TotpRecoveryCodeID: typemapper.UUIDToPgtypeUUID(uuid.New()),
+			UserID:             typemapper.UUIDToPgtypeUUID(userID),
+			CodeHash:           hashRecoveryCode(code),
+			CreationTime:       typemapper.TimeToPgtypeTimestamptz(now),
+		}); err != nil {
+			return fmt.Errorf("failed to create TOTP recovery code: %w", err)
+		}
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLTOTPRepository) DeleteTOTP(ctx context.Context, userID uuid.UUID) (err error) {
+	tx, err := r.db.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	defer func() {
+		if rollbackErr := tx.Rollback(ctx); rollbackErr != nil {
+			if errors.Is(rollbackErr, pgx.ErrTxClosed) {
+				return
+			}
+
+			err = fmt.Errorf("failed to rollback transaction: %w", rollbackErr)
+		}
+	}()
+
+	qtx := r.queries.WithTx(tx)
+
+	affected, err := qtx.DeleteUserTOTP(ctx, typemapper.UUIDToPgtypeUUID(userID))
+	if err != nil {
+		return fmt.Errorf("failed to delete user TOTP: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrTOTPNotFound
+	}
+
+	if err = qtx.DeleteTOTPRecoveryCodes(ctx, typemapper.UUIDToPgtypeUUID(userID)); err != nil {
+		return fmt.Errorf("failed to delete TOTP recovery codes: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// UseRecoveryCode returns apperror.ErrLoginCodeMismatch if the code doesn't
+// match one of the user's unused recovery codes.
+func (r *SQLTOTPRepository) UseRecoveryCode(
+	ctx context.Context,
+	userID uuid.UUID,
+	recoveryCode string,
+	now time.Time,
+) error {
+	affected, err := r.queries.UseTOTPRecoveryCode(ctx, gensql.UseTOTPRecoveryCodeParams{
+		UserID:   typemapper.UUIDToPgtypeUUID(userID),
+		CodeHash: hashRecoveryCode(recoveryCode),
+		UseTime:  typemapper.TimeToPgtypeTimestamptz(now),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to use TOTP recovery code: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrLoginCodeMismatch
+	}
+
+	return nil
+}
+
+func (r *SQLTOTPRepository) CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error) {
+	count, err := r.queries.CountUnusedTOTPRecoveryCodes(ctx, typemapper.UUIDToPgtypeUUID(userID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to count unused TOTP recovery codes: %w", err)
+	}
+
+	return count, nil
+}
+
+// hashRecoveryCode ignores case and dashes since recovery codes are typed in
+// by hand. Unlike passwords they are random enough that a fast hash is fine.
+func hashRecoveryCode(code string) string {
+	normalized := strings.ToUpper(strings.ReplaceAll(code, "-", ""))
+	sum := sha256.Sum256([]byte(normalized))
+
+	return hex.EncodeToString(sum[:])
+}
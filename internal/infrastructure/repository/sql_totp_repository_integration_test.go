@@ -0,0 +1,170 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime      = time.Now().Truncate(time.Microsecond)
+		theStoreID   = uuid.New()
+		theUserID    = uuid.New()
+		theUsername  = "user-a"
+		theStoreCode = "store-a"
+	)
+
+	queries := gensql.New(db)
+
+	_, initErr = queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		StoreName:    "Not important",
+		StoreCode:    theStoreCode,
+		StoreAddress: "Not important",
+		PhoneNumber:  "+6281234567890",
+	})
+	require.NoError(t, initErr)
+
+	roleID, initErr := queries.SeedRole(context.Background(), gensql.SeedRoleParams{
+		RoleID:       typemapper.UUIDToPgtypeUUID(uuid.New()),
+		RoleName:     "Not important",
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		IsStoreAdmin: true,
+	})
+	require.NoError(t, initErr)
+
+	_, initErr = queries.SeedUser(context.Background(), gensql.SeedUserParams{
+		UserID:       typemapper.UUIDToPgtypeUUID(theUserID),
+		Username:     theUsername,
+		UserPassword: "notimportant",
+		RoleID:       roleID,
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+	})
+	require.NoError(t, initErr)
+
+	repo := repository.NewSQLTOTPRepository(db)
+	authRepo := repository.NewSQLAuthRepository(db)
+	ctx := context.Background()
+
+	hasTOTP := func(t *testing.T) bool {
+		t.Helper()
+
+		user, err := authRepo.GetUserByUsernameAndStoreCode(ctx, theUsername, theStoreCode)
+		require.NoError(t, err)
+
+		return user.HasTOTP
+	}
+
+	// ORDER MATTERS!
+
+	t.Run("pending enrollments are not used to log in", func(t *testing.T) {
+		require.NoError(t, repo.CreatePendingTOTP(ctx, theUserID, "OLDSECRET", theTime))
+		require.NoError(t, repo.CreatePendingTOTP(ctx, theUserID, "NEWSECRET", theTime))
+
+		totp, err := repo.GetUserTOTP(ctx, theUserID)
+		require.NoError(t, err)
+		assert.Equal(t, "NEWSECRET", totp.Secret)
+		assert.False(t, totp.ConfirmationTime.IsSet())
+
+		_, err = authRepo.GetConfirmedTOTPSecret(ctx, theUserID)
+		require.ErrorIs(t, err, apperror.ErrTOTPNotFound)
+		assert.False(t, hasTOTP(t))
+	})
+
+	t.Run("confirming enables TOTP and stores recovery codes", func(t *testing.T) {
+		err := repo.ConfirmTOTP(ctx, theUserID, 100, theTime, []string{"AAAAA-BBBBB", "CCCCC-DDDDD"})
+		require.NoError(t, err)
+
+		secret, err := authRepo.GetConfirmedTOTPSecret(ctx, theUserID)
+		require.NoError(t, err)
+		assert.Equal(t, "NEWSECRET", secret)
+		assert.True(t, hasTOTP(t))
+
+		count, err := repo.CountUnusedRecoveryCodes(ctx, theUserID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), count)
+
+		err = repo.ConfirmTOTP(ctx, theUserID, 101, theTime, nil)
+		require.ErrorIs(t, err, apperror.ErrTOTPNotFound)
+
+		err = repo.CreatePendingTOTP(ctx, theUserID, "ANOTHERSECRET", theTime)
+		require.ErrorIs(t, err, apperror.ErrTOTPAlreadyEnabled)
+	})
+
+	t.Run("TOTP steps can only be used once and in order", func(t *testing.T) {
+		require.ErrorIs(t, authRepo.UseTOTPStep(ctx, theUserID, 100), apperror.ErrLoginCodeMismatch)
+		require.NoError(t, authRepo.UseTOTPStep(ctx, theUserID, 102))
+		require.ErrorIs(t, authRepo.UseTOTPStep(ctx, theUserID, 102), apperror.ErrLoginCodeMismatch)
+		require.ErrorIs(t, authRepo.UseTOTPStep(ctx, theUserID, 101), apperror.ErrLoginCodeMismatch)
+	})
+
+	t.Run("recovery codes can only be used once", func(t *testing.T) {
+		require.NoError(t, authRepo.UseTOTPRecoveryCode(ctx, theUserID, "aaaaabbbbb", theTime))
+
+		err := authRepo.UseTOTPRecoveryCode(ctx, theUserID, "AAAAA-BBBBB", theTime)
+		require.ErrorIs(t, err, apperror.ErrLoginCodeMismatch)
+
+		err = authRepo.UseTOTPRecoveryCode(ctx, theUserID, "EEEEE-FFFFF", theTime)
+		require.ErrorIs(t, err, apperror.ErrLoginCodeMismatch)
+
+		count, err := repo.CountUnusedRecoveryCodes(ctx, theUserID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+	})
+
+	t.Run("recovery codes used to disable TOTP can only be used once", func(t *testing.T) {
+		require.NoError(t, repo.UseRecoveryCode(ctx, theUserID, "CCCCC-DDDDD", theTime))
+		require.ErrorIs(t, repo.UseRecoveryCode(ctx, theUserID, "CCCCC-DDDDD", theTime), apperror.ErrLoginCodeMismatch)
+
+		count, err := repo.CountUnusedRecoveryCodes(ctx, theUserID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+
+	t.Run("disabling deletes the TOTP and recovery codes", func(t *testing.T) {
+		require.NoError(t, repo.DeleteTOTP(ctx, theUserID))
+		require.ErrorIs(t, repo.DeleteTOTP(ctx, theUserID), apperror.ErrTOTPNotFound)
+
+		_, err := repo.GetUserTOTP(ctx, theUserID)
+		require.ErrorIs(t, err, apperror.ErrTOTPNotFound)
+		assert.False(t, hasTOTP(t))
+
+		count, err := repo.CountUnusedRecoveryCodes(ctx, theUserID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+	})
+}
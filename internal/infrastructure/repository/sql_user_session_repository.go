@@ -0,0 +1,134 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// SQLUserSessionRepository tracks which user a session store token belongs
+// to. Revoking a session deletes its token from the session store, which
+// cascades to the tracked session.
+type SQLUserSessionRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLUserSessionRepository(db *pgxpool.Pool) *SQLUserSessionRepository {
+	return &SQLUserSessionRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLUserSessionRepository) CreateUserSession(ctx context.Context, token string, session user.Session) error {
+	if err := r.queries.CreateUserSession(ctx, gensql.CreateUserSessionParams{
+		UserSessionID: typemapper.UUIDToPgtypeUUID(session.ID),
+		Token:         token,
+		UserID:        typemapper.UUIDToPgtypeUUID(session.UserID),
+		UserAgent:     typemapper.OptionalStringToPgtypeText(session.UserAgent),
+		IpAddress:     typemapper.OptionalStringToPgtypeText(session.IPAddress),
+		CreationTime:  typemapper.TimeToPgtypeTimestamptz(session.CreationTime),
+	}); err != nil {
+		return fmt.Errorf("failed to create user session: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLUserSessionRepository) GetActiveUserSessions(
+	ctx context.Context,
+	userID uuid.UUID,
+	now time.Time,
+) ([]user.Session, error) {
+	rows, err := r.queries.GetActiveUserSessions(ctx, gensql.GetActiveUserSessionsParams{
+		UserID: typemapper.UUIDToPgtypeUUID(userID),
+		Now:    typemapper.TimeToPgtypeTimestamptz(now),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active user sessions: %w", err)
+	}
+
+	sessions := make([]user.Session, 0, len(rows))
+	for _, row := range rows {
+		sessions = append(sessions, user.Session{
+			ID:           typemapper.MustPgtypeUUIDToUUID(row.UserSessionID),
+			UserID:       typemapper.MustPgtypeUUIDToUUID(row.UserID),
+			UserAgent:    typemapper.PgtypeTextToOptionalString(row.UserAgent),
+			IPAddress:    typemapper.PgtypeTextToOptionalString(row.IpAddress),
+			CreationTime: row.CreationTime.Time,
+			LastSeenTime: row.LastSeenTime.Time,
+		})
+	}
+
+	return sessions, nil
+}
+
+func (r *SQLUserSessionRepository) RevokeUserSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	affected, err := r.queries.RevokeUserSession(ctx, gensql.RevokeUserSessionParams{
+		UserSessionID: typemapper.UUIDToPgtypeUUID(sessionID),
+		UserID:        typemapper.UUIDToPgtypeUUID(userID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to revoke user session: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrUserSessionNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLUserSessionRepository) RevokeOtherUserSessions(
+	ctx context.Context,
+	userID uuid.UUID,
+	keepSessionID uuid.UUID,
+) (int64, error) {
+	affected, err := r.queries.RevokeOtherUserSessions(ctx, gensql.RevokeOtherUserSessionsParams{
+		UserID:            typemapper.UUIDToPgtypeUUID(userID),
+		KeepUserSessionID: typemapper.UUIDToPgtypeUUID(keepSessionID),
+	})
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke other user sessions: %w", err)
+	}
+
+	return affected, nil
+}
+
+func (r *SQLUserSessionRepository) RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) (int64, error) {
+	affected, err := r.queries.RevokeAllUserSessions(ctx, typemapper.UUIDToPgtypeUUID(userID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to revoke all user sessions: %w", err)
+	}
+
+	return affected, nil
+}
+
+func (r *SQLUserSessionRepository) IsUserInStore(
+	ctx context.Context,
+	storeID uuid.UUID,
+	userID uuid.UUID,
+) (bool, error) {
+	_, err := r.queries.IsUserInStore(ctx, gensql.IsUserInStoreParams{
+		UserID:  typemapper.UUIDToPgtypeUUID(userID),
+		StoreID: typemapper.UUIDToPgtypeUUID(storeID),
+	})
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("failed to check if user is in store: %w", err)
+	}
+
+	return true, nil
+}
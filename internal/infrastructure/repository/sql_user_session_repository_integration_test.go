@@ -0,0 +1,190 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUserSessionRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime        = time.Now().Truncate(time.Microsecond)
+		theStoreID     = uuid.New()
+		theUserID      = uuid.New()
+		theOtherUserID = uuid.New()
+		sessionCount   = 0
+	)
+
+	queries := gensql.New(db)
+
+	_, initErr = queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		StoreName:    "Not important",
+		StoreCode:    "store-a",
+		StoreAddress: "Not important",
+		PhoneNumber:  "+6281234567890",
+	})
+	require.NoError(t, initErr)
+
+	roleID, initErr := queries.SeedRole(context.Background(), gensql.SeedRoleParams{
+		RoleID:       typemapper.UUIDToPgtypeUUID(uuid.New()),
+		RoleName:     "Not important",
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		IsStoreAdmin: false,
+	})
+	require.NoError(t, initErr)
+
+	for i, userID := range []uuid.UUID{theUserID, theOtherUserID} {
+		_, initErr = queries.SeedUser(context.Background(), gensql.SeedUserParams{
+			UserID:       typemapper.UUIDToPgtypeUUID(userID),
+			Username:     []string{"user-a", "user-b"}[i],
+			UserPassword: "notimportant",
+			RoleID:       roleID,
+			StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		})
+		require.NoError(t, initErr)
+	}
+
+	store := repository.NewSQLSessionStore(db)
+	repo := repository.NewSQLUserSessionRepository(db)
+
+	createSession := func(t *testing.T, userID uuid.UUID, expiry time.Time) user.Session {
+		t.Helper()
+
+		sessionCount++
+		token := uuid.NewString()
+
+		session := user.Session{
+			ID:           uuid.New(),
+			UserID:       userID,
+			UserAgent:    optional.Some("Firefox"),
+			IPAddress:    optional.Some("203.0.113.7"),
+			CreationTime: theTime.Add(time.Duration(sessionCount) * time.Minute),
+			LastSeenTime: theTime.Add(time.Duration(sessionCount) * time.Minute),
+		}
+
+		require.NoError(t, store.CommitCtx(context.Background(), token, []byte("data"), expiry))
+		require.NoError(t, repo.CreateUserSession(context.Background(), token, session))
+
+		return session
+	}
+
+	getSessionIDs := func(t *testing.T, userID uuid.UUID) []uuid.UUID {
+		t.Helper()
+
+		sessions, err := repo.GetActiveUserSessions(context.Background(), userID, time.Now())
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, 0, len(sessions))
+		for _, s := range sessions {
+			ids = append(ids, s.ID)
+		}
+
+		return ids
+	}
+
+	t.Run("lists active sessions of the user, most recently seen first", func(t *testing.T) {
+		userID := theUserID
+
+		first := createSession(t, userID, time.Now().Add(time.Hour))
+		second := createSession(t, userID, time.Now().Add(time.Hour))
+		_ = createSession(t, userID, time.Now().Add(-time.Minute))
+		_ = createSession(t, theOtherUserID, time.Now().Add(time.Hour))
+
+		sessions, err := repo.GetActiveUserSessions(context.Background(), userID, time.Now())
+		require.NoError(t, err)
+		require.Len(t, sessions, 2)
+
+		assert.Equal(t, second.ID, sessions[0].ID)
+		assert.Equal(t, first.ID, sessions[1].ID)
+		assert.Equal(t, optional.Some("Firefox"), sessions[0].UserAgent)
+		assert.Equal(t, optional.Some("203.0.113.7"), sessions[0].IPAddress)
+		assert.True(t, second.CreationTime.Equal(sessions[0].CreationTime))
+
+		require.NoError(t, repo.RevokeUserSession(context.Background(), userID, first.ID))
+		assert.Equal(t, []uuid.UUID{second.ID}, getSessionIDs(t, userID))
+
+		_, err = repo.RevokeAllUserSessions(context.Background(), userID)
+		require.NoError(t, err)
+	})
+
+	t.Run("does not revoke sessions of other users", func(t *testing.T) {
+		session := createSession(t, theOtherUserID, time.Now().Add(time.Hour))
+
+		err := repo.RevokeUserSession(context.Background(), theUserID, session.ID)
+		require.ErrorIs(t, err, apperror.ErrUserSessionNotFound)
+
+		assert.Contains(t, getSessionIDs(t, theOtherUserID), session.ID)
+	})
+
+	t.Run("revokes all other sessions", func(t *testing.T) {
+		current := createSession(t, theUserID, time.Now().Add(time.Hour))
+		_ = createSession(t, theUserID, time.Now().Add(time.Hour))
+		_ = createSession(t, theUserID, time.Now().Add(time.Hour))
+
+		revoked, err := repo.RevokeOtherUserSessions(context.Background(), theUserID, current.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), revoked)
+		assert.Equal(t, []uuid.UUID{current.ID}, getSessionIDs(t, theUserID))
+
+		_, err = repo.RevokeAllUserSessions(context.Background(), theUserID)
+		require.NoError(t, err)
+	})
+
+	t.Run("revokes all sessions of a user", func(t *testing.T) {
+		_ = createSession(t, theUserID, time.Now().Add(time.Hour))
+		_ = createSession(t, theUserID, time.Now().Add(time.Hour))
+
+		revoked, err := repo.RevokeAllUserSessions(context.Background(), theUserID)
+		require.NoError(t, err)
+
+		assert.Equal(t, int64(2), revoked)
+		assert.Empty(t, getSessionIDs(t, theUserID))
+	})
+
+	t.Run("checks whether a user is in the store", func(t *testing.T) {
+		ok, err := repo.IsUserInStore(context.Background(), theStoreID, theUserID)
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = repo.IsUserInStore(context.Background(), uuid.New(), theUserID)
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/webhook"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+type SQLWebhookRepository struct {
+	queries *gensql.Queries
+}
+
+func NewSQLWebhookRepository(db *pgxpool.Pool) *SQLWebhookRepository {
+	return &SQLWebhookRepository{
+		queries: gensql.New(db),
+	}
+}
+
+func (r *SQLWebhookRepository) CreateWebhook(ctx context.Context, w webhook.Webhook) error {
+	if err := r.queries.CreateWebhook(ctx, gensql.CreateWebhookParams{
+		WebhookID:    typemapper.UUIDToPgtypeUUID(w.ID),
+		StoreID:      typemapper.UUIDToPgtypeUUID(w.StoreID),
+		Url:          w.URL,
+		Secret:       w.Secret,
+		EventTypes:   eventTypesToStrings(w.EventTypes),
+		CreationTime: typemapper.TimeToPgtypeTimestamptz(w.CreationTime),
+	}); err != nil {
+		return fmt.Errorf("failed to create webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLWebhookRepository) GetWebhooksByStoreID(ctx context.Context, storeID uuid.UUID) ([]webhook.Webhook, error) {
+	rows, err := r.queries.GetWebhooksByStoreID(ctx, typemapper.UUIDToPgtypeUUID(storeID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhooks by store ID: %w", err)
+	}
+
+	return toWebhooks(rows), nil
+}
+
+func (r *SQLWebhookRepository) GetWebhookByID(
+	ctx context.Context,
+	storeID uuid.UUID,
+	webhookID uuid.UUID,
+) (webhook.Webhook, error) {
+	row, err := r.queries.GetWebhookByID(ctx, gensql.GetWebhookByIDParams{
+		StoreID:   typemapper.UUIDToPgtypeUUID(storeID),
+		WebhookID: typemapper.UUIDToPgtypeUUID(webhookID),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return webhook.Webhook{}, apperror.ErrWebhookNotFound
+	}
+
+	if err != nil {
+		return webhook.Webhook{}, fmt.Errorf("failed to get webhook by ID: %w", err)
+	}
+
+	return toWebhook(row), nil
+}
+
+func (r *SQLWebhookRepository) UpdateWebhook(ctx context.Context, w webhook.Webhook) error {
+	affected, err := r.queries.UpdateWebhook(ctx, gensql.UpdateWebhookParams{
+		Url:                 w.URL,
+		Secret:              w.Secret,
+		EventTypes:          eventTypesToStrings(w.EventTypes),
+		IsEnabled:           w.Enabled,
+		ConsecutiveFailures: int32(w.ConsecutiveFailures),
+		DisabledTime:        typemapper.OptionalTimeToPgtypeTimestamptz(w.DisabledTime),
+		StoreID:             typemapper.UUIDToPgtypeUUID(w.StoreID),
+		WebhookID:           typemapper.UUIDToPgtypeUUID(w.ID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update webhook: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLWebhookRepository) DeleteWebhook(ctx context.Context, storeID uuid.UUID, webhookID uuid.UUID) error {
+	affected, err := r.queries.DeleteWebhook(ctx, gensql.DeleteWebhookParams{
+		StoreID:   typemapper.UUIDToPgtypeUUID(storeID),
+		WebhookID: typemapper.UUIDToPgtypeUUID(webhookID),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete webhook: %w", err)
+	}
+
+	if affected == 0 {
+		return apperror.ErrWebhookNotFound
+	}
+
+	return nil
+}
+
+func (r *SQLWebhookRepository) GetEnabledWebhooksForEvent(
+	ctx context.Context,
+	storeID uuid.UUID,
+	eventType domain.OrderEventType,
+) ([]webhook.Webhook, error) {
+	rows, err := r.queries.GetEnabledWebhooksForEvent(ctx, gensql.GetEnabledWebhooksForEventParams{
+		StoreID:   typemapper.UUIDToPgtypeUUID(storeID),
+		EventType: string(eventType),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get enabled webhooks for event: %w", err)
+	}
+
+	return toWebhooks(rows), nil
+}
+
+func (r *SQLWebhookRepository) IncrementConsecutiveFailures(ctx context.Context, webhookID uuid.UUID) (int, error) {
+	failures, err := r.queries.IncrementWebhookConsecutiveFailures(ctx, typemapper.UUIDToPgtypeUUID(webhookID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to increment webhook consecutive failures: %w", err)
+	}
+
+	return int(failures), nil
+}
+
+func (r *SQLWebhookRepository) ResetConsecutiveFailures(ctx context.Context, webhookID uuid.UUID) error {
+	if err := r.queries.ResetWebhookConsecutiveFailures(ctx, typemapper.UUIDToPgtypeUUID(webhookID)); err != nil {
+		return fmt.Errorf("failed to reset webhook consecutive failures: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLWebhookRepository) DisableWebhook(ctx context.Context, webhookID uuid.UUID, disabledTime time.Time) error {
+	if err := r.queries.DisableWebhook(ctx, gensql.DisableWebhookParams{
+		DisabledTime: typemapper.TimeToPgtypeTimestamptz(disabledTime),
+		WebhookID:    typemapper.UUIDToPgtypeUUID(webhookID),
+	}); err != nil {
+		return fmt.Errorf("failed to disable webhook: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLWebhookRepository) CreateDelivery(ctx context.Context, d webhook.Delivery) error {
+	if err := r.queries.CreateWebhookDelivery(ctx, gensql.CreateWebhookDeliveryParams{
+		WebhookDeliveryID: typemapper.UUIDToPgtypeUUID(d.ID),
+		WebhookID:         typemapper.UUIDToPgtypeUUID(d.WebhookID),
+		EventID:           typemapper.UUIDToPgtypeUUID(d.EventID),
+		EventType:         string(d.EventType),
+		Payload:           d.Payload,
+		ReplayOf:          typemapper.OptionalUUIDToPgtypeUUID(d.ReplayOf),
+		CreationTime:      typemapper.TimeToPgtypeTimestamptz(d.CreationTime),
+		NextAttemptTime:   typemapper.TimeToPgtypeTimestamptz(d.CreationTime),
+	}); err != nil {
+		return fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLWebhookRepository) GetDeliveriesByWebhookID(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	limit int,
+) ([]webhook.Delivery, error) {
+	rows, err := r.queries.GetWebhookDeliveriesByWebhookID(ctx, gensql.GetWebhookDeliveriesByWebhookIDParams{
+		WebhookID: typemapper.UUIDToPgtypeUUID(webhookID),
+		MaxCount:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook deliveries by webhook ID: %w", err)
+	}
+
+	deliveries := make([]webhook.Delivery, 0, len(rows))
+	for _, row := range rows {
+		deliveries = append(deliveries, toWebhookDelivery(row))
+	}
+
+	return deliveries, nil
+}
+
+func (r *SQLWebhookRepository) GetDeliveryByID(
+	ctx context.Context,
+	webhookID uuid.UUID,
+	deliveryID uuid.UUID,
+) (webhook.Delivery, error) {
+	row, err := r.queries.GetWebhookDeliveryByID(ctx, gensql.GetWebhookDeliveryByIDParams{
+		WebhookID:         typemapper.UUIDToPgtypeUUID(webhookID),
+		WebhookDeliveryID: typemapper.UUIDToPgtypeUUID(deliveryID),
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return webhook.Delivery{}, apperror.ErrWebhookDeliveryNotFound
+	}
+
+	if err != nil {
+		return webhook.Delivery{}, fmt.Errorf("failed to get webhook delivery by ID: %w", err)
+	}
+
+	return toWebhookDelivery(row), nil
+}
+
+func (r *SQLWebhookRepository) ClaimPendingDeliveries(
+	ctx context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	limit int,
+) ([]webhook.PendingDelivery, error) {
+	rows, err := r.queries.ClaimPendingWebhookDeliveries(ctx, gensql.ClaimPendingWebhookDeliveriesParams{
+		LeaseUntil: typemapper.TimeToPgtypeTimestamptz(leaseUntil),
+		Now:        typemapper.TimeToPgtypeTimestamptz(now),
+		BatchSize:  int32(limit),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim pending webhook deliveries: %w", err)
+	}
+
+	pending := make([]webhook.PendingDelivery, 0, len(rows))
+	for _, row := range rows {
+		pending = append(pending, webhook.PendingDelivery{
+			ID:        typemapper.MustPgtypeUUIDToUUID(row.WebhookDeliveryID),
+			WebhookID: typemapper.MustPgtypeUUIDToUUID(row.WebhookID),
+			EventID:   typemapper.MustPgtypeUUIDToUUID(row.EventID),
+			EventType: domain.OrderEventType(row.EventType),
+			Payload:   row.Payload,
+			Attempts:  int(row.Attempts),
+			URL:       row.Url,
+			Secret:    row.Secret,
+		})
+	}
+
+	return pending, nil
+}
+
+func (r *SQLWebhookRepository) MarkDeliverySucceeded(
+	ctx context.Context,
+	deliveryID uuid.UUID,
+	statusCode int,
+	deliveredTime time.Time,
+) error {
+	if err := r.queries.MarkWebhookDeliverySucceeded(ctx, gensql.MarkWebhookDeliverySucceededParams{
+		StatusCode:        typemapper.Int32ToPgtypeInt4(int32(statusCode)),
+		DeliveredTime:     typemapper.TimeToPgtypeTimestamptz(deliveredTime),
+		WebhookDeliveryID: typemapper.UUIDToPgtypeUUID(deliveryID),
+	}); err != nil {
+		return fmt.Errorf("failed to mark webhook delivery as succeeded: %w", err)
+	}
+
+	return nil
+}
+
+func (r *SQLWebhookRepository) MarkDeliveryFailed(
+	ctx context.Context,
+	deliveryID uuid.UUID,
+	failure webhook.DeliveryFailure,
+) error {
+	statusCode := pgtype.Int4{}
+	if code, ok := failure.StatusCode.Get(); ok {
+		statusCode = typemapper.Int32ToPgtypeInt4(int32(code))
+	}
+
+	if err := r.queries.MarkWebhookDeliveryFailed(ctx, gensql.MarkWebhookDeliveryFailedParams{
+		NextAttemptTime:   typemapper.TimeToPgtypeTimestamptz(failure.NextAttemptTime),
+		StatusCode:        statusCode,
+		LastError:         typemapper.StringToPgtypeText(failure.Error),
+		FailedTime:        typemapper.OptionalTimeToPgtypeTimestamptz(failure.FailedTime),
+		WebhookDeliveryID: typemapper.UUIDToPgtypeUUID(deliveryID),
+	}); err != nil {
+		return fmt.Errorf("failed to mark webhook delivery as failed: %w", err)
+	}
+
+	return nil
+}
+
+func toWebhooks(rows []gensql.Webhook) []webhook.Webhook {
+	webhooks := make([]webhook.Webhook, 0, len(rows))
+	for _, row := range rows {
+		webhooks = append(webhooks, toWebhook(row))
+	}
+
+	return webhooks
+}
+
+func toWebhook(row gensql.Webhook) webhook.Webhook {
+	eventTypes := make([]domain.OrderEventType, 0, len(row.EventTypes))
+	for _, t := range row.EventTypes {
+		eventTypes = append(eventTypes, domain.OrderEventType(t))
+	}
+
+	return webhook.Webhook{
+		ID:                  typemapper.MustPgtypeUUIDToUUID(row.WebhookID),
+		StoreID:             typemapper.MustPgtypeUUIDToUUID(row.StoreID),
+		URL:                 row.Url,
+		Secret:              row.Secret,
+		EventTypes:          eventTypes,
+		Enabled:             row.IsEnabled,
+		ConsecutiveFailures: int(row.ConsecutiveFailures),
+		DisabledTime:        typemapper.PgtypeTimestamptzToOptionalTime(row.DisabledTime),
+		CreationTime:        row.CreationTime.Time,
+	}
+}
+
+func toWebhookDelivery(row gensql.WebhookDelivery) webhook.Delivery {
+	d := webhook.Delivery{
+		ID:            typemapper.MustPgtypeUUIDToUUID(row.WebhookDeliveryID),
+		WebhookID:     typemapper.MustPgtypeUUIDToUUID(row.WebhookID),
+		EventID:       typemapper.MustPgtypeUUIDToUUID(row.EventID),
+		EventType:     domain.OrderEventType(row.EventType),
+		Payload:       row.Payload,
+		CreationTime:  row.CreationTime.Time,
+		Attempts:      int(row.Attempts),
+		LastError:     typemapper.PgtypeTextToOptionalString(row.LastError),
+		DeliveredTime: typemapper.PgtypeTimestamptzToOptionalTime(row.DeliveredTime),
+		FailedTime:    typemapper.PgtypeTimestamptzToOptionalTime(row.FailedTime),
+	}
+
+	if row.ReplayOf.Valid {
+		d.ReplayOf = optional.Some(typemapper.MustPgtypeUUIDToUUID(row.ReplayOf))
+	}
+
+	if row.LastStatusCode.Valid {
+		d.LastStatusCode = optional.Some(int(row.LastStatusCode.Int32))
+	}
+
+	return d
+}
+
+func eventTypesToStrings(eventTypes []domain.OrderEventType) []string {
+	res := make([]string, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		res = append(res, string(t))
+	}
+
+	return res
+}
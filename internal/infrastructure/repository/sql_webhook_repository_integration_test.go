@@ -0,0 +1,267 @@
+//go:build integration
+// +build integration
+
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/gensql"
+	"github.com/JosephJoshua/remana-backend/internal/infrastructure/repository"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/webhook"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/JosephJoshua/remana-backend/internal/typemapper"
+	"github.com/google/uuid"
+	"github.com/ory/dockertest/v3"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookRepository(t *testing.T) {
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	pool, initErr := testutil.StartDockerPool()
+	require.NoError(t, initErr, "error starting docker pool")
+
+	postgresResource, db, initErr := testutil.StartPostgresContainer(pool)
+	require.NoError(t, initErr, "error starting postgres container")
+
+	t.Cleanup(func() {
+		if purgeErr := testutil.PurgeDockerResources(pool, []*dockertest.Resource{postgresResource}); purgeErr != nil {
+			t.Fatalf("failed to purge docker resources: %v", purgeErr)
+		}
+	})
+
+	initErr = testutil.MigratePostgres(context.Background(), db)
+	require.NoError(t, initErr, "error migrating database")
+
+	var (
+		theTime    = time.Unix(1713917762, 0)
+		theStoreID = uuid.New()
+	)
+
+	queries := gensql.New(db)
+
+	_, initErr = queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+		StoreID:      typemapper.UUIDToPgtypeUUID(theStoreID),
+		StoreName:    "Not important",
+		StoreCode:    "not-important",
+		StoreAddress: "Not important",
+		PhoneNumber:  "+6281234567890",
+	})
+	require.NoError(t, initErr)
+
+	repo := repository.NewSQLWebhookRepository(db)
+
+	createWebhook := func(t *testing.T, storeID uuid.UUID, eventTypes ...domain.OrderEventType) webhook.Webhook {
+		w := webhook.Webhook{
+			ID:           uuid.New(),
+			StoreID:      storeID,
+			URL:          "https://example.com/hook",
+			Secret:       "a-very-secret-key",
+			EventTypes:   eventTypes,
+			Enabled:      true,
+			CreationTime: theTime,
+		}
+
+		require.NoError(t, repo.CreateWebhook(context.Background(), w))
+		return w
+	}
+
+	createDelivery := func(t *testing.T, webhookID uuid.UUID, eventID uuid.UUID) webhook.Delivery {
+		d := webhook.Delivery{
+			ID:           uuid.New(),
+			WebhookID:    webhookID,
+			EventID:      eventID,
+			EventType:    domain.OrderEventTypeCreated,
+			Payload:      []byte(`{"type":"order_created"}`),
+			CreationTime: theTime,
+		}
+
+		require.NoError(t, repo.CreateDelivery(context.Background(), d))
+		return d
+	}
+
+	t.Run("creates, updates and deletes webhooks", func(t *testing.T) {
+		w := createWebhook(t, theStoreID, domain.OrderEventTypeConfirmed)
+
+		got, err := repo.GetWebhookByID(context.Background(), theStoreID, w.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, w.URL, got.URL)
+		assert.Equal(t, w.Secret, got.Secret)
+		assert.Equal(t, w.EventTypes, got.EventTypes)
+		assert.True(t, got.Enabled)
+		assert.Equal(t, theTime, got.CreationTime)
+
+		_, err = repo.GetWebhookByID(context.Background(), uuid.New(), w.ID)
+		require.ErrorIs(t, err, apperror.ErrWebhookNotFound)
+
+		got.Enabled = false
+		got.DisabledTime = optional.Some(theTime)
+		got.EventTypes = []domain.OrderEventType{}
+		require.NoError(t, repo.UpdateWebhook(context.Background(), got))
+
+		updated, err := repo.GetWebhookByID(context.Background(), theStoreID, w.ID)
+		require.NoError(t, err)
+
+		assert.False(t, updated.Enabled)
+		assert.Equal(t, theTime, updated.DisabledTime.GetOrElse(time.Time{}))
+		assert.Empty(t, updated.EventTypes)
+
+		require.ErrorIs(t, repo.DeleteWebhook(context.Background(), uuid.New(), w.ID), apperror.ErrWebhookNotFound)
+		require.NoError(t, repo.DeleteWebhook(context.Background(), theStoreID, w.ID))
+
+		_, err = repo.GetWebhookByID(context.Background(), theStoreID, w.ID)
+		require.ErrorIs(t, err, apperror.ErrWebhookNotFound)
+	})
+
+	t.Run("returns enabled webhooks subscribed to the event", func(t *testing.T) {
+		storeID := uuid.New()
+		_, err := queries.SeedStore(context.Background(), gensql.SeedStoreParams{
+			StoreID:      typemapper.UUIDToPgtypeUUID(storeID),
+			StoreName:    "Not important",
+			StoreCode:    "not-important-2",
+			StoreAddress: "Not important",
+			PhoneNumber:  "+6281234567890",
+		})
+		require.NoError(t, err)
+
+		everything := createWebhook(t, storeID)
+		confirmed := createWebhook(t, storeID, domain.OrderEventTypeConfirmed)
+		createWebhook(t, storeID, domain.OrderEventTypeCancelled)
+
+		disabled := createWebhook(t, storeID, domain.OrderEventTypeConfirmed)
+		require.NoError(t, repo.DisableWebhook(context.Background(), disabled.ID, theTime))
+
+		got, err := repo.GetEnabledWebhooksForEvent(context.Background(), storeID, domain.OrderEventTypeConfirmed)
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, 0, len(got))
+		for _, w := range got {
+			ids = append(ids, w.ID)
+		}
+
+		assert.ElementsMatch(t, []uuid.UUID{everything.ID, confirmed.ID}, ids)
+	})
+
+	t.Run("creates one delivery per event but allows replays", func(t *testing.T) {
+		w := createWebhook(t, theStoreID)
+		eventID := uuid.New()
+
+		original := createDelivery(t, w.ID, eventID)
+		createDelivery(t, w.ID, eventID)
+
+		replay := webhook.Delivery{
+			ID:           uuid.New(),
+			WebhookID:    w.ID,
+			EventID:      eventID,
+			EventType:    original.EventType,
+			Payload:      original.Payload,
+			ReplayOf:     optional.Some(original.ID),
+			CreationTime: theTime.Add(time.Minute),
+		}
+		require.NoError(t, repo.CreateDelivery(context.Background(), replay))
+
+		got, err := repo.GetDeliveriesByWebhookID(context.Background(), w.ID, 50)
+		require.NoError(t, err)
+		require.Len(t, got, 2)
+
+		assert.Equal(t, replay.ID, got[0].ID, "deliveries should be newest first")
+		assert.Equal(t, original.ID, got[0].ReplayOf.GetOrElse(uuid.Nil))
+		assert.Equal(t, original.ID, got[1].ID)
+		assert.Equal(t, original.Payload, got[1].Payload)
+
+		_, err = repo.GetDeliveryByID(context.Background(), uuid.New(), original.ID)
+		require.ErrorIs(t, err, apperror.ErrWebhookDeliveryNotFound)
+	})
+
+	t.Run("claims due deliveries of enabled webhooks and records the outcome", func(t *testing.T) {
+		enabled := createWebhook(t, theStoreID)
+		disabled := createWebhook(t, theStoreID)
+
+		succeeded := createDelivery(t, enabled.ID, uuid.New())
+		failed := createDelivery(t, enabled.ID, uuid.New())
+		paused := createDelivery(t, disabled.ID, uuid.New())
+
+		require.NoError(t, repo.DisableWebhook(context.Background(), disabled.ID, theTime))
+
+		now := theTime.Add(time.Minute)
+		leaseUntil := now.Add(5 * time.Minute)
+
+		claimed, err := repo.ClaimPendingDeliveries(context.Background(), now, leaseUntil, 100)
+		require.NoError(t, err)
+
+		ids := make([]uuid.UUID, 0, len(claimed))
+		for _, d := range claimed {
+			ids = append(ids, d.ID)
+
+			if d.ID == succeeded.ID {
+				assert.Equal(t, enabled.URL, d.URL)
+				assert.Equal(t, enabled.Secret, d.Secret)
+			}
+		}
+
+		assert.Subset(t, ids, []uuid.UUID{succeeded.ID, failed.ID})
+		assert.NotContains(t, ids, paused.ID, "deliveries of disabled webhooks shouldn't be claimed")
+
+		claimedAgain, err := repo.ClaimPendingDeliveries(context.Background(), now, leaseUntil, 100)
+		require.NoError(t, err)
+		assert.Empty(t, claimedAgain, "claimed deliveries should be leased")
+
+		require.NoError(t, repo.MarkDeliverySucceeded(context.Background(), succeeded.ID, 204, now))
+		require.NoError(t, repo.MarkDeliveryFailed(context.Background(), failed.ID, webhook.DeliveryFailure{
+			StatusCode:      optional.Some(500),
+			Error:           "unexpected status code 500",
+			NextAttemptTime: now.Add(30 * time.Second),
+			FailedTime:      optional.Some(now),
+		}))
+
+		gotSucceeded, err := repo.GetDeliveryByID(context.Background(), enabled.ID, succeeded.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, webhook.DeliveryStatusDelivered, gotSucceeded.Status())
+		assert.Equal(t, 204, gotSucceeded.LastStatusCode.GetOrElse(0))
+		assert.Equal(t, 1, gotSucceeded.Attempts)
+
+		gotFailed, err := repo.GetDeliveryByID(context.Background(), enabled.ID, failed.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, webhook.DeliveryStatusFailed, gotFailed.Status())
+		assert.Equal(t, 500, gotFailed.LastStatusCode.GetOrElse(0))
+		assert.Equal(t, "unexpected status code 500", gotFailed.LastError.GetOrElse(""))
+
+		retried, err := repo.ClaimPendingDeliveries(context.Background(), now.Add(time.Hour), now.Add(2*time.Hour), 100)
+		require.NoError(t, err)
+
+		for _, d := range retried {
+			assert.NotContains(t, []uuid.UUID{succeeded.ID, failed.ID}, d.ID, "delivered and failed deliveries shouldn't be claimed")
+		}
+	})
+
+	t.Run("counts consecutive failures", func(t *testing.T) {
+		w := createWebhook(t, theStoreID)
+
+		failures, err := repo.IncrementConsecutiveFailures(context.Background(), w.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 1, failures)
+
+		failures, err = repo.IncrementConsecutiveFailures(context.Background(), w.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, failures)
+
+		require.NoError(t, repo.ResetConsecutiveFailures(context.Background(), w.ID))
+
+		got, err := repo.GetWebhookByID(context.Background(), theStoreID, w.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 0, got.ConsecutiveFailures)
+	})
+}
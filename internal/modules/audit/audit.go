@@ -0,0 +1,115 @@
+package audit
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+type Action string
+
+const (
+	ActionRepairOrderCreated               = Action("repair_order_created")
+	ActionRepairOrderCostAdded             = Action("repair_order_cost_added")
+	ActionRepairOrderPaymentRecorded       = Action("repair_order_payment_recorded")
+	ActionRepairOrderTechnicianChanged     = Action("repair_order_technician_changed")
+	ActionRepairOrderDamagesAdded          = Action("repair_order_damages_added")
+	ActionRepairOrderDamageRemoved         = Action("repair_order_damage_removed")
+	ActionRepairOrderPhoneConditionsAdded  = Action("repair_order_phone_conditions_added")
+	ActionRepairOrderPhoneConditionRemoved = Action("repair_order_phone_condition_removed")
+	ActionRepairOrderPhoneEquipmentsAdded  = Action("repair_order_phone_equipments_added")
+	ActionRepairOrderPhoneEquipmentRemoved = Action("repair_order_phone_equipment_removed")
+	ActionRepairOrderPhotoAdded            = Action("repair_order_photo_added")
+	ActionRepairOrderPhotoRemoved          = Action("repair_order_photo_removed")
+	ActionRepairOrderConfirmed             = Action("repair_order_confirmed")
+	ActionRepairOrderCompleted             = Action("repair_order_completed")
+	ActionRepairOrderPickedUp              = Action("repair_order_picked_up")
+	ActionRepairOrderCancelled             = Action("repair_order_cancelled")
+	ActionRepairOrderNoteAdded             = Action("repair_order_note_added")
+	ActionRepairOrderNoteEdited            = Action("repair_order_note_edited")
+	ActionRoleCreated                      = Action("role_created")
+	ActionRolePermissionsAssigned          = Action("role_permissions_assigned")
+	ActionTechnicianCreated                = Action("technician_created")
+	ActionSalesPersonCreated               = Action("sales_person_created")
+	ActionDamageTypeCreated                = Action("damage_type_created")
+	ActionPhoneConditionCreated            = Action("phone_condition_created")
+	ActionPhoneEquipmentCreated            = Action("phone_equipment_created")
+	ActionPaymentMethodCreated             = Action("payment_method_created")
+	ActionWebhookCreated                   = Action("webhook_created")
+	ActionWebhookUpdated                   = Action("webhook_updated")
+	ActionWebhookDeleted                   = Action("webhook_deleted")
+	ActionPhotoUploaded                    = Action("photo_uploaded")
+	ActionUserSessionsRevoked              = Action("user_sessions_revoked")
+	ActionLoginCodeIssued                  = Action("login_code_issued")
+	ActionLoginCodeRevoked                 = Action("login_code_revoked")
+	ActionTOTPEnabled                      = Action("totp_enabled")
+	ActionTOTPDisabled                     = Action("totp_disabled")
+	ActionUserLoginUnlocked                = Action("user_login_unlocked")
+)
+
+type EntityType string
+
+const (
+	EntityTypeRepairOrder    = EntityType("repair_order")
+	EntityTypeRole           = EntityType("role")
+	EntityTypeTechnician     = EntityType("technician")
+	EntityTypeSalesPerson    = EntityType("sales_person")
+	EntityTypeDamageType     = EntityType("damage_type")
+	EntityTypePhoneCondition = EntityType("phone_condition")
+	EntityTypePhoneEquipment = EntityType("phone_equipment")
+	EntityTypePaymentMethod  = EntityType("payment_method")
+	EntityTypePhoto          = EntityType("photo")
+	EntityTypeWebhook        = EntityType("webhook")
+	EntityTypeUser           = EntityType("user")
+)
+
+// Change is a mutation made by the user of the current request. Before and
+// After are stored as JSON; Before is nil when the change created the entity.
+type Change struct {
+	Action     Action
+	EntityType EntityType
+	EntityID   uuid.UUID
+	Before     any
+	After      any
+}
+
+// Entry is a recorded change. Entries are never updated or deleted.
+type Entry struct {
+	ID            uuid.UUID
+	StoreID       uuid.UUID
+	UserID        uuid.UUID
+	Username      string
+	CorrelationID optional.Optional[string]
+	Action        Action
+	EntityType    EntityType
+	EntityID      uuid.UUID
+	Before        []byte
+	After         []byte
+	CreationTime  time.Time
+}
+
+type TimeProvider interface {
+	Now() time.Time
+}
+
+func ToAPIEntry(entry Entry) genapi.AuditLogEntry {
+	res := genapi.AuditLogEntry{
+		ID:           entry.ID,
+		Action:       genapi.AuditLogAction(entry.Action),
+		EntityType:   genapi.AuditLogEntityType(entry.EntityType),
+		EntityID:     entry.EntityID,
+		UserID:       entry.UserID,
+		Username:     entry.Username,
+		Before:       entry.Before,
+		After:        entry.After,
+		CreationTime: entry.CreationTime,
+	}
+
+	if correlationID, ok := entry.CorrelationID.Get(); ok {
+		res.CorrelationID = genapi.NewOptString(correlationID)
+	}
+
+	return res
+}
@@ -0,0 +1,83 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+type LogRepository interface {
+	CreateEntry(ctx context.Context, entry Entry) error
+	GetEntriesByEntity(ctx context.Context, storeID uuid.UUID, entityType EntityType, entityID uuid.UUID) ([]Entry, error)
+}
+
+// Log records changes on behalf of the user of the current request, tagged
+// with its correlation ID so they can be matched with the request logs.
+type Log struct {
+	repo         LogRepository
+	timeProvider TimeProvider
+}
+
+func NewLog(repo LogRepository, timeProvider TimeProvider) *Log {
+	return &Log{
+		repo:         repo,
+		timeProvider: timeProvider,
+	}
+}
+
+func (l *Log) Record(ctx context.Context, change Change) error {
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		return errors.New("user is missing from context")
+	}
+
+	entry := Entry{
+		ID:           uuid.New(),
+		StoreID:      user.Store.ID,
+		UserID:       user.ID,
+		Username:     user.Username,
+		Action:       change.Action,
+		EntityType:   change.EntityType,
+		EntityID:     change.EntityID,
+		CreationTime: l.timeProvider.Now(),
+	}
+
+	if correlationID, hasCorrelationID := appcontext.GetCorrelationIDFromContext(ctx); hasCorrelationID {
+		entry.CorrelationID = optional.Some(correlationID)
+	}
+
+	var err error
+
+	if change.Before != nil {
+		if entry.Before, err = json.Marshal(change.Before); err != nil {
+			return fmt.Errorf("failed to marshal state before the change: %w", err)
+		}
+	}
+
+	if change.After != nil {
+		if entry.After, err = json.Marshal(change.After); err != nil {
+			return fmt.Errorf("failed to marshal state after the change: %w", err)
+		}
+	}
+
+	if err = l.repo.CreateEntry(ctx, entry); err != nil {
+		return fmt.Errorf("failed to create audit log entry: %w", err)
+	}
+
+	return nil
+}
+
+// History returns the changes made to an entity, oldest first.
+func (l *Log) History(ctx context.Context, storeID uuid.UUID, entityType EntityType, entityID uuid.UUID) ([]Entry, error) {
+	entries, err := l.repo.GetEntriesByEntity(ctx, storeID, entityType, entityID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get audit log entries: %w", err)
+	}
+
+	return entries, nil
+}
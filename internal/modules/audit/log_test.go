@@ -0,0 +1,189 @@
+//go:build unit
+// +build unit
+
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecord(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theTime          = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+		theCorrelationID = "cojb2s8r0l2b9r7kmvd0"
+		theUser          = testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Username = "cashier"
+		})
+	)
+
+	requestCtx := appcontext.NewContextWithCorrelationID(
+		appcontext.NewContextWithUser(context.Background(), theUser),
+		theCorrelationID,
+	)
+
+	theChange := audit.Change{
+		Action:     audit.ActionRepairOrderCostAdded,
+		EntityType: audit.EntityTypeRepairOrder,
+		EntityID:   uuid.New(),
+		Before:     map[string]int{"total_cost": 200},
+		After:      map[string]int{"total_cost": 350},
+	}
+
+	t.Run("records the change on behalf of the user", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &logRepositoryStub{}
+		require.NoError(t, audit.NewLog(repo, testutil.NewTimeProviderStub(theTime)).Record(requestCtx, theChange))
+
+		require.Len(t, repo.created, 1)
+		got := repo.created[0]
+
+		assert.NotEqual(t, uuid.Nil, got.ID)
+		assert.Equal(t, theUser.Store.ID, got.StoreID)
+		assert.Equal(t, theUser.ID, got.UserID)
+		assert.Equal(t, "cashier", got.Username)
+		assert.Equal(t, theCorrelationID, got.CorrelationID.GetOrElse(""))
+		assert.Equal(t, theChange.Action, got.Action)
+		assert.Equal(t, theChange.EntityType, got.EntityType)
+		assert.Equal(t, theChange.EntityID, got.EntityID)
+		assert.JSONEq(t, `{"total_cost":200}`, string(got.Before))
+		assert.JSONEq(t, `{"total_cost":350}`, string(got.After))
+		assert.Equal(t, theTime, got.CreationTime)
+	})
+
+	t.Run("leaves out what is missing", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &logRepositoryStub{}
+		change := theChange
+		change.Before = nil
+
+		ctx := appcontext.NewContextWithUser(context.Background(), theUser)
+		require.NoError(t, audit.NewLog(repo, testutil.NewTimeProviderStub(theTime)).Record(ctx, change))
+
+		require.Len(t, repo.created, 1)
+		assert.Nil(t, repo.created[0].Before)
+		assert.False(t, repo.created[0].CorrelationID.IsSet())
+	})
+
+	t.Run("returns error when user is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &logRepositoryStub{}
+		err := audit.NewLog(repo, testutil.NewTimeProviderStub(theTime)).Record(context.Background(), theChange)
+
+		require.Error(t, err)
+		assert.Empty(t, repo.created)
+	})
+
+	t.Run("returns error when state can't be marshalled", func(t *testing.T) {
+		t.Parallel()
+
+		change := theChange
+		change.After = func() {}
+
+		err := audit.NewLog(&logRepositoryStub{}, testutil.NewTimeProviderStub(theTime)).Record(requestCtx, change)
+		require.Error(t, err)
+	})
+
+	t.Run("returns error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &logRepositoryStub{err: errors.New("oh no!")}
+		err := audit.NewLog(repo, testutil.NewTimeProviderStub(theTime)).Record(requestCtx, theChange)
+
+		require.Error(t, err)
+	})
+}
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID  = uuid.New()
+		theEntityID = uuid.New()
+	)
+
+	t.Run("returns the entries of the entity", func(t *testing.T) {
+		t.Parallel()
+
+		entries := []audit.Entry{{ID: uuid.New()}, {ID: uuid.New()}}
+		repo := &logRepositoryStub{entries: entries}
+
+		got, err := audit.NewLog(repo, testutil.NewTimeProviderStub(time.Now())).History(
+			context.Background(),
+			theStoreID,
+			audit.EntityTypeRepairOrder,
+			theEntityID,
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, entries, got)
+		assert.Equal(t, theStoreID, repo.storeID)
+		assert.Equal(t, audit.EntityTypeRepairOrder, repo.entityType)
+		assert.Equal(t, theEntityID, repo.entityID)
+	})
+
+	t.Run("returns error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &logRepositoryStub{err: errors.New("oh no!")}
+
+		_, err := audit.NewLog(repo, testutil.NewTimeProviderStub(time.Now())).History(
+			context.Background(),
+			theStoreID,
+			audit.EntityTypeRepairOrder,
+			theEntityID,
+		)
+		require.Error(t, err)
+	})
+}
+
+type logRepositoryStub struct {
+	entries []audit.Entry
+	err     error
+
+	created    []audit.Entry
+	storeID    uuid.UUID
+	entityType audit.EntityType
+	entityID   uuid.UUID
+}
+
+func (l *logRepositoryStub) CreateEntry(_ context.Context, entry audit.Entry) error {
+	if l.err != nil {
+		return l.err
+	}
+
+	l.created = append(l.created, entry)
+	return nil
+}
+
+func (l *logRepositoryStub) GetEntriesByEntity(
+	_ context.Context,
+	storeID uuid.UUID,
+	entityType audit.EntityType,
+	entityID uuid.UUID,
+) ([]audit.Entry, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	l.storeID = storeID
+	l.entityType = entityType
+	l.entityID = entityID
+
+	return l.entries, nil
+}
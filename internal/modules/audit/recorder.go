@@ -0,0 +1,32 @@
+package audit
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/JosephJoshua/remana-backend/internal/apierror"
+	"github.com/rs/zerolog"
+)
+
+// Recorder records a change made by the user of the current request.
+type Recorder interface {
+	Record(ctx context.Context, change Change) error
+}
+
+// RecordChange records change and returns an internal server error for the
+// caller to return when that fails. The change itself has been saved by then,
+// but the request must not report success for a change that is missing from
+// the audit log.
+func RecordChange(ctx context.Context, recorder Recorder, change Change) error {
+	if err := recorder.Record(ctx, change); err != nil {
+		zerolog.Ctx(ctx).Error().
+			Err(err).
+			Str("action", string(change.Action)).
+			Str("entity_id", change.EntityID.String()).
+			Msg("failed to record change in audit log")
+
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to record change in audit log")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+//go:build unit
+// +build unit
+
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordChange(t *testing.T) {
+	t.Parallel()
+
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	theChange := audit.Change{
+		Action:     audit.ActionRoleCreated,
+		EntityType: audit.EntityTypeRole,
+		EntityID:   uuid.New(),
+		After:      map[string]string{"name": "cashier"},
+	}
+
+	t.Run("records the change", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := testutil.NewAuditLogStub()
+		require.NoError(t, audit.RecordChange(requestCtx, recorder, theChange))
+
+		require.Len(t, recorder.Changes, 1)
+		assert.Equal(t, theChange, recorder.Changes[0])
+	})
+
+	t.Run("returns internal server error when recording fails", func(t *testing.T) {
+		t.Parallel()
+
+		recorder := testutil.NewAuditLogStub()
+		recorder.SetError(errors.New("oh no"))
+
+		err := audit.RecordChange(requestCtx, recorder, theChange)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
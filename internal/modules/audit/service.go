@@ -0,0 +1,154 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apierror"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/pagination"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultSearchPageSize = 50
+	maxSearchPageSize     = 100
+)
+
+type SearchFilter struct {
+	EntityType  optional.Optional[EntityType]
+	EntityID    optional.Optional[uuid.UUID]
+	UserID      optional.Optional[uuid.UUID]
+	Action      optional.Optional[Action]
+	CreatedFrom optional.Optional[time.Time]
+	CreatedTo   optional.Optional[time.Time]
+}
+
+// SearchCursor points at the last entry of a page. Entries are searched
+// newest first, so the next page starts right after it.
+type SearchCursor struct {
+	CreationTime time.Time
+	EntryID      uuid.UUID
+}
+
+type Repository interface {
+	SearchEntries(
+		ctx context.Context,
+		storeID uuid.UUID,
+		filter SearchFilter,
+		cursor optional.Optional[SearchCursor],
+		limit int,
+	) ([]Entry, error)
+}
+
+type Service struct {
+	repo Repository
+}
+
+func NewService(repo Repository) *Service {
+	return &Service{
+		repo: repo,
+	}
+}
+
+// SearchAuditLog is only available to store admins as the audit log covers
+// every module, including ones the user may not otherwise have access to.
+func (s *Service) SearchAuditLog(
+	ctx context.Context,
+	params genapi.SearchAuditLogParams,
+) (*genapi.AuditLogEntryList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if !user.Role.IsStoreAdmin {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	filter, err := buildSearchFilter(params)
+	if err != nil {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+	}
+
+	cursor := optional.None[SearchCursor]()
+	if params.Cursor.IsSet() {
+		creationTime, entryID, decodeErr := pagination.DecodeCursor(params.Cursor.Value)
+		if decodeErr != nil {
+			return nil, apierror.ToAPIError(http.StatusBadRequest, "invalid cursor")
+		}
+
+		cursor = optional.Some(SearchCursor{CreationTime: creationTime, EntryID: entryID})
+	}
+
+	limit := params.Limit.Or(defaultSearchPageSize)
+	if limit < 1 || limit > maxSearchPageSize {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "limit must be between 1 and 100")
+	}
+
+	// One more entry than requested is fetched to know whether there is a next page.
+	entries, err := s.repo.SearchEntries(ctx, user.Store.ID, filter, cursor, limit+1)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to search audit log")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to search audit log")
+	}
+
+	res := &genapi.AuditLogEntryList{
+		Items: make([]genapi.AuditLogEntry, 0, min(len(entries), limit)),
+	}
+
+	if len(entries) > limit {
+		entries = entries[:limit]
+
+		last := entries[len(entries)-1]
+		res.NextCursor = genapi.NewOptString(pagination.EncodeCursor(last.CreationTime, last.ID))
+	}
+
+	for _, entry := range entries {
+		res.Items = append(res.Items, ToAPIEntry(entry))
+	}
+
+	return res, nil
+}
+
+func buildSearchFilter(params genapi.SearchAuditLogParams) (SearchFilter, error) {
+	filter := SearchFilter{}
+
+	if params.EntityType.IsSet() {
+		filter.EntityType = optional.Some(EntityType(params.EntityType.Value))
+	}
+
+	if params.EntityID.IsSet() {
+		filter.EntityID = optional.Some(params.EntityID.Value)
+	}
+
+	if params.UserID.IsSet() {
+		filter.UserID = optional.Some(params.UserID.Value)
+	}
+
+	if params.Action.IsSet() {
+		filter.Action = optional.Some(Action(params.Action.Value))
+	}
+
+	if params.From.IsSet() {
+		filter.CreatedFrom = optional.Some(params.From.Value)
+	}
+
+	if params.To.IsSet() {
+		filter.CreatedTo = optional.Some(params.To.Value)
+	}
+
+	if params.From.IsSet() && params.To.IsSet() && !params.From.Value.Before(params.To.Value) {
+		return filter, errors.New("from must be before to")
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,225 @@
+//go:build unit
+// +build unit
+
+package audit_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSearchAuditLog(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	adminCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.IsStoreAdmin = true
+		}),
+	)
+
+	newEntries := func(n int) []audit.Entry {
+		entries := make([]audit.Entry, 0, n)
+		for i := range n {
+			entries = append(entries, audit.Entry{
+				ID:           uuid.New(),
+				StoreID:      theStoreID,
+				UserID:       uuid.New(),
+				Username:     "cashier",
+				Action:       audit.ActionRepairOrderPaymentRecorded,
+				EntityType:   audit.EntityTypeRepairOrder,
+				EntityID:     uuid.New(),
+				After:        []byte(`{"paid_amount":100}`),
+				CreationTime: theTime.Add(-time.Duration(i) * time.Minute),
+			})
+		}
+
+		return entries
+	}
+
+	t.Run("returns matching entries of the store", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			theEntityID = uuid.New()
+			theUserID   = uuid.New()
+			from        = theTime.Add(-time.Hour)
+			to          = theTime
+		)
+
+		repo := &serviceRepositoryStub{entries: newEntries(2)}
+
+		got, err := audit.NewService(repo).SearchAuditLog(adminCtx, genapi.SearchAuditLogParams{
+			EntityType: genapi.NewOptAuditLogEntityType(genapi.AuditLogEntityTypeRepairOrder),
+			EntityID:   genapi.NewOptUUID(theEntityID),
+			UserID:     genapi.NewOptUUID(theUserID),
+			Action:     genapi.NewOptAuditLogAction(genapi.AuditLogActionRepairOrderPaymentRecorded),
+			From:       genapi.NewOptDateTime(from),
+			To:         genapi.NewOptDateTime(to),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, repo.entries[0].ID, got.Items[0].ID)
+		assert.Equal(t, genapi.AuditLogActionRepairOrderPaymentRecorded, got.Items[0].Action)
+		assert.JSONEq(t, `{"paid_amount":100}`, string(got.Items[0].After))
+		assert.False(t, got.NextCursor.IsSet())
+
+		assert.Equal(t, theStoreID, repo.storeID)
+		assert.Equal(t, audit.SearchFilter{
+			EntityType:  optional.Some(audit.EntityTypeRepairOrder),
+			EntityID:    optional.Some(theEntityID),
+			UserID:      optional.Some(theUserID),
+			Action:      optional.Some(audit.ActionRepairOrderPaymentRecorded),
+			CreatedFrom: optional.Some(from),
+			CreatedTo:   optional.Some(to),
+		}, repo.filter)
+	})
+
+	t.Run("paginates with the cursor", func(t *testing.T) {
+		t.Parallel()
+
+		entries := newEntries(3)
+		repo := &serviceRepositoryStub{entries: entries}
+		s := audit.NewService(repo)
+
+		first, err := s.SearchAuditLog(adminCtx, genapi.SearchAuditLogParams{Limit: genapi.NewOptInt(2)})
+		require.NoError(t, err)
+
+		require.Len(t, first.Items, 2)
+		require.True(t, first.NextCursor.IsSet())
+		assert.Equal(t, 3, repo.limit, "one more entry should be fetched to know if there is a next page")
+		assert.False(t, repo.cursor.IsSet())
+
+		_, err = s.SearchAuditLog(adminCtx, genapi.SearchAuditLogParams{
+			Cursor: first.NextCursor,
+			Limit:  genapi.NewOptInt(2),
+		})
+		require.NoError(t, err)
+
+		cursor, ok := repo.cursor.Get()
+		require.True(t, ok)
+		assert.Equal(t, entries[1].ID, cursor.EntryID)
+		assert.True(t, entries[1].CreationTime.Equal(cursor.CreationTime))
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			params genapi.SearchAuditLogParams
+		}{
+			{
+				name:   "when cursor is invalid",
+				params: genapi.SearchAuditLogParams{Cursor: genapi.NewOptString("not a cursor")},
+			},
+			{
+				name:   "when limit is out of range",
+				params: genapi.SearchAuditLogParams{Limit: genapi.NewOptInt(101)},
+			},
+			{
+				name: "when from is not before to",
+				params: genapi.SearchAuditLogParams{
+					From: genapi.NewOptDateTime(theTime),
+					To:   genapi.NewOptDateTime(theTime),
+				},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				_, err := audit.NewService(&serviceRepositoryStub{}).SearchAuditLog(adminCtx, tc.params)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+			})
+		}
+	})
+
+	t.Run("returns internal server error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &serviceRepositoryStub{err: errors.New("oh no!")}
+
+		_, err := audit.NewService(repo).SearchAuditLog(adminCtx, genapi.SearchAuditLogParams{})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns forbidden when user is not a store admin", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := appcontext.NewContextWithUser(
+			testutil.RequestContextWithLogger(context.Background()),
+			testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+				details.Store.ID = theStoreID
+			}),
+		)
+
+		_, err := audit.NewService(&serviceRepositoryStub{}).SearchAuditLog(ctx, genapi.SearchAuditLogParams{})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := audit.NewService(&serviceRepositoryStub{}).SearchAuditLog(
+			testutil.RequestContextWithLogger(context.Background()),
+			genapi.SearchAuditLogParams{},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+type serviceRepositoryStub struct {
+	entries []audit.Entry
+	err     error
+
+	storeID uuid.UUID
+	filter  audit.SearchFilter
+	cursor  optional.Optional[audit.SearchCursor]
+	limit   int
+}
+
+func (s *serviceRepositoryStub) SearchEntries(
+	_ context.Context,
+	storeID uuid.UUID,
+	filter audit.SearchFilter,
+	cursor optional.Optional[audit.SearchCursor],
+	limit int,
+) ([]audit.Entry, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+
+	s.storeID = storeID
+	s.filter = filter
+	s.cursor = cursor
+	s.limit = limit
+
+	return s.entries[:min(len(s.entries), limit)], nil
+}
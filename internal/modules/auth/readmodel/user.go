@@ -6,4 +6,5 @@ type User struct {
 	ID           uuid.UUID
 	Password     string
 	IsStoreAdmin bool
+	HasTOTP      bool
 }
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/apierror"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
@@ -16,19 +17,31 @@ import (
 
 type SecurityHandlerSessionManager interface {
 	GetUserID(ctx context.Context) (uuid.UUID, error)
+	GetSessionID(ctx context.Context) (uuid.UUID, error)
 }
 
 type SecurityHandlerRepository interface {
 	GetUserDetailsByID(ctx context.Context, userID uuid.UUID) (readmodel.UserDetails, error)
+	TouchUserSession(ctx context.Context, sessionID uuid.UUID, userID uuid.UUID, now time.Time) error
+}
+
+type TimeProvider interface {
+	Now() time.Time
 }
 
 type SecurityHandler struct {
+	timeProvider   TimeProvider
 	sessionManager SecurityHandlerSessionManager
 	repo           SecurityHandlerRepository
 }
 
-func NewSecurityHandler(sessionManager SecurityHandlerSessionManager, repo SecurityHandlerRepository) *SecurityHandler {
+func NewSecurityHandler(
+	timeProvider TimeProvider,
+	sessionManager SecurityHandlerSessionManager,
+	repo SecurityHandlerRepository,
+) *SecurityHandler {
 	return &SecurityHandler{
+		timeProvider:   timeProvider,
 		sessionManager: sessionManager,
 		repo:           repo,
 	}
@@ -55,6 +68,27 @@ func (s *SecurityHandler) HandleSessionCookie(
 		)
 	}
 
+	sessionID, err := s.sessionManager.GetSessionID(ctx)
+	if err != nil {
+		if !errors.Is(err, apperror.ErrMissingSession) {
+			l.Error().Err(err).Msg("failed to get session ID from session")
+		}
+
+		return ctx, apierror.ToAPIError(
+			http.StatusUnauthorized,
+			"invalid session. please try logging out and logging back in",
+		)
+	}
+
+	// Revoked sessions are deleted, so this also rejects them.
+	err = s.repo.TouchUserSession(ctx, sessionID, userID, s.timeProvider.Now())
+	if errors.Is(err, apperror.ErrUserSessionNotFound) {
+		return ctx, apierror.ToAPIError(http.StatusUnauthorized, "session has been revoked. please log in again")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to touch user session")
+		return ctx, apierror.ToAPIError(http.StatusInternalServerError, "failed to check session")
+	}
+
 	user, err := s.repo.GetUserDetailsByID(ctx, userID)
 	if err != nil {
 		if errors.Is(err, apperror.ErrUserNotFound) {
@@ -73,5 +107,6 @@ func (s *SecurityHandler) HandleSessionCookie(
 	})
 
 	ctx = appcontext.NewContextWithUser(ctx, &user)
+	ctx = appcontext.NewContextWithSessionID(ctx, sessionID)
 	return ctx, nil
 }
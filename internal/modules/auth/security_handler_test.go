@@ -8,6 +8,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
@@ -21,8 +22,10 @@ import (
 )
 
 type securityHandlerSessionManagerStub struct {
-	userID *uuid.UUID
-	err    error
+	userID     *uuid.UUID
+	sessionID  *uuid.UUID
+	err        error
+	sessionErr error
 }
 
 func (s *securityHandlerSessionManagerStub) GetUserID(_ context.Context) (uuid.UUID, error) {
@@ -37,9 +40,22 @@ func (s *securityHandlerSessionManagerStub) GetUserID(_ context.Context) (uuid.U
 	return *s.userID, nil
 }
 
+func (s *securityHandlerSessionManagerStub) GetSessionID(_ context.Context) (uuid.UUID, error) {
+	if s.sessionErr != nil {
+		return uuid.UUID{}, s.sessionErr
+	}
+
+	if s.sessionID == nil {
+		return uuid.New(), nil
+	}
+
+	return *s.sessionID, nil
+}
+
 type securityHandlerRepositoryStub struct {
 	userDetails *readmodel.UserDetails
 	err         error
+	touchErr    error
 }
 
 func (s *securityHandlerRepositoryStub) GetUserDetailsByID(
@@ -59,6 +75,15 @@ func (s *securityHandlerRepositoryStub) GetUserDetailsByID(
 	return *s.userDetails, nil
 }
 
+func (s *securityHandlerRepositoryStub) TouchUserSession(
+	_ context.Context,
+	_ uuid.UUID,
+	_ uuid.UUID,
+	_ time.Time,
+) error {
+	return s.touchErr
+}
+
 func TestHandleSessionCookie(t *testing.T) {
 	t.Parallel()
 
@@ -68,6 +93,7 @@ func TestHandleSessionCookie(t *testing.T) {
 		userID := uuid.New()
 
 		sh := auth.NewSecurityHandler(
+			testutil.NewTimeProviderStub(time.Now()),
 			&securityHandlerSessionManagerStub{userID: &userID, err: errors.New("oh no error")},
 			&securityHandlerRepositoryStub{userDetails: nil, err: nil},
 		)
@@ -88,6 +114,7 @@ func TestHandleSessionCookie(t *testing.T) {
 		t.Parallel()
 
 		sh := auth.NewSecurityHandler(
+			testutil.NewTimeProviderStub(time.Now()),
 			&securityHandlerSessionManagerStub{userID: nil, err: nil},
 			&securityHandlerRepositoryStub{userDetails: nil, err: nil},
 		)
@@ -110,6 +137,7 @@ func TestHandleSessionCookie(t *testing.T) {
 		userID := uuid.New()
 
 		sh := auth.NewSecurityHandler(
+			testutil.NewTimeProviderStub(time.Now()),
 			&securityHandlerSessionManagerStub{userID: &userID, err: nil},
 			&securityHandlerRepositoryStub{userDetails: nil, err: nil},
 		)
@@ -134,6 +162,7 @@ func TestHandleSessionCookie(t *testing.T) {
 		var userDetails readmodel.UserDetails
 
 		sh := auth.NewSecurityHandler(
+			testutil.NewTimeProviderStub(time.Now()),
 			&securityHandlerSessionManagerStub{userID: &userID, err: nil},
 			&securityHandlerRepositoryStub{userDetails: &userDetails, err: errors.New("oh no error")},
 		)
@@ -147,6 +176,66 @@ func TestHandleSessionCookie(t *testing.T) {
 		assert.False(t, ok)
 	})
 
+	t.Run("returns unauthorized when session has no session ID", func(t *testing.T) {
+		t.Parallel()
+
+		userID := uuid.New()
+
+		sh := auth.NewSecurityHandler(
+			testutil.NewTimeProviderStub(time.Now()),
+			&securityHandlerSessionManagerStub{userID: &userID, sessionErr: apperror.ErrMissingSession},
+			&securityHandlerRepositoryStub{userDetails: &readmodel.UserDetails{}, err: nil},
+		)
+
+		ctx, err := sh.HandleSessionCookie(context.Background(), "", genapi.SessionCookie{APIKey: ""})
+
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+
+		_, ok := appcontext.GetUserFromContext(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("returns unauthorized when session has been revoked", func(t *testing.T) {
+		t.Parallel()
+
+		userID := uuid.New()
+
+		sh := auth.NewSecurityHandler(
+			testutil.NewTimeProviderStub(time.Now()),
+			&securityHandlerSessionManagerStub{userID: &userID},
+			&securityHandlerRepositoryStub{
+				userDetails: &readmodel.UserDetails{},
+				touchErr:    apperror.ErrUserSessionNotFound,
+			},
+		)
+
+		ctx, err := sh.HandleSessionCookie(context.Background(), "", genapi.SessionCookie{APIKey: ""})
+
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+
+		_, ok := appcontext.GetUserFromContext(ctx)
+		assert.False(t, ok)
+	})
+
+	t.Run("returns internal server error when touching session fails", func(t *testing.T) {
+		t.Parallel()
+
+		userID := uuid.New()
+
+		sh := auth.NewSecurityHandler(
+			testutil.NewTimeProviderStub(time.Now()),
+			&securityHandlerSessionManagerStub{userID: &userID},
+			&securityHandlerRepositoryStub{
+				userDetails: &readmodel.UserDetails{},
+				touchErr:    errors.New("oh no error"),
+			},
+		)
+
+		_, err := sh.HandleSessionCookie(context.Background(), "", genapi.SessionCookie{APIKey: ""})
+
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
 	t.Run("adds user details to context", func(t *testing.T) {
 		t.Parallel()
 
@@ -167,8 +256,11 @@ func TestHandleSessionCookie(t *testing.T) {
 			},
 		}
 
+		sessionID := uuid.New()
+
 		sh := auth.NewSecurityHandler(
-			&securityHandlerSessionManagerStub{userID: &userID, err: nil},
+			testutil.NewTimeProviderStub(time.Now()),
+			&securityHandlerSessionManagerStub{userID: &userID, sessionID: &sessionID, err: nil},
 			&securityHandlerRepositoryStub{userDetails: &userDetails, err: nil},
 		)
 
@@ -181,5 +273,10 @@ func TestHandleSessionCookie(t *testing.T) {
 
 		require.True(t, ok)
 		assert.EqualExportedValues(t, userDetails, *got)
+
+		gotSessionID, ok := appcontext.GetSessionIDFromContext(ctx)
+
+		require.True(t, ok)
+		assert.Equal(t, sessionID, gotSessionID)
 	})
 }
@@ -3,9 +3,12 @@ package auth
 import (
 	"context"
 	"errors"
+	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/apierror"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
@@ -26,7 +29,21 @@ type LoginCodePromptManager interface {
 
 type ServiceRepository interface {
 	GetUserByUsernameAndStoreCode(ctx context.Context, username string, storeCode string) (readmodel.User, error)
-	CheckAndDeleteUserLoginCode(ctx context.Context, userID uuid.UUID, loginCode string) error
+	CheckAndDeleteUserLoginCode(ctx context.Context, userID uuid.UUID, loginCode string, now time.Time) error
+	GetConfirmedTOTPSecret(ctx context.Context, userID uuid.UUID) (string, error)
+	UseTOTPStep(ctx context.Context, userID uuid.UUID, step int64) error
+	UseTOTPRecoveryCode(ctx context.Context, userID uuid.UUID, recoveryCode string, now time.Time) error
+}
+
+type TOTPValidator interface {
+	Validate(secret string, code string, now time.Time) (step int64, ok bool)
+}
+
+type ThrottleRepository interface {
+	GetLoginThrottle(ctx context.Context, key ThrottleKey) (LoginThrottle, error)
+	RecordLoginFailure(ctx context.Context, key ThrottleKey, now time.Time, forgetBefore time.Time) (int, error)
+	LockLoginThrottle(ctx context.Context, key ThrottleKey, lockedUntil time.Time) error
+	ResetLoginThrottle(ctx context.Context, key ThrottleKey) error
 }
 
 type PasswordHasher interface {
@@ -35,23 +52,32 @@ type PasswordHasher interface {
 }
 
 type Service struct {
+	timeProvider           TimeProvider
 	sessionManager         ServiceSessionManager
 	loginCodePromptManager LoginCodePromptManager
 	repo                   ServiceRepository
 	hasher                 PasswordHasher
+	totpValidator          TOTPValidator
+	throttleRepo           ThrottleRepository
 }
 
 func NewService(
+	timeProvider TimeProvider,
 	sessionManager ServiceSessionManager,
 	loginCodePromptManager LoginCodePromptManager,
 	repo ServiceRepository,
 	hasher PasswordHasher,
+	totpValidator TOTPValidator,
+	throttleRepo ThrottleRepository,
 ) *Service {
 	return &Service{
+		timeProvider:           timeProvider,
 		sessionManager:         sessionManager,
 		loginCodePromptManager: loginCodePromptManager,
 		repo:                   repo,
 		hasher:                 hasher,
+		totpValidator:          totpValidator,
+		throttleRepo:           throttleRepo,
 	}
 }
 
@@ -60,11 +86,23 @@ func (s *Service) Login(ctx context.Context, req *genapi.LoginCredentials) (*gen
 
 	const randomHash = "$2a$14$7IotmYZSWWVoGd.D5xaMLOi2W0bBbHZfNZ0NxX.BpphGmNd9IbC/u"
 
+	now := s.timeProvider.Now()
+	accountKey := AccountThrottleKey(req.GetStoreCode(), req.GetUsername())
+	throttles := s.withClientIPThrottle(ctx, throttledKey{key: accountKey, policy: accountThrottlePolicy})
+
+	tl := l.With().Str("username", req.GetUsername()).Str("store_code", req.GetStoreCode()).Logger()
+
+	if err := s.checkThrottles(ctx, &tl, now, throttles); err != nil {
+		return nil, err
+	}
+
 	user, err := s.repo.GetUserByUsernameAndStoreCode(ctx, req.Username, req.StoreCode)
 	if errors.Is(err, apperror.ErrUserNotFound) {
 		// Security measure to prevent timing attacks.
 		_ = s.hasher.Check(randomHash, req.Password)
 
+		s.recordLoginFailures(ctx, &tl, now, throttles)
+
 		l.
 			Info().
 			Str("username", req.GetUsername()).
@@ -84,6 +122,10 @@ func (s *Service) Login(ctx context.Context, req *genapi.LoginCredentials) (*gen
 	if err != nil {
 		if errors.Is(err, apperror.ErrPasswordMismatch) {
 			l.Info().Str("user_id", user.ID.String()).Msg("wrong password")
+
+			tl = tl.With().Str("user_id", user.ID.String()).Logger()
+			s.recordLoginFailures(ctx, &tl, now, throttles)
+
 			return nil, apierror.ToAPIError(http.StatusUnauthorized, "invalid credentials")
 		}
 
@@ -91,7 +133,13 @@ func (s *Service) Login(ctx context.Context, req *genapi.LoginCredentials) (*gen
 		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check password")
 	}
 
-	if user.IsStoreAdmin {
+	// The client IP key isn't reset so that one valid account can't be used to
+	// keep guessing others from the same IP.
+	if err = s.throttleRepo.ResetLoginThrottle(ctx, accountKey); err != nil {
+		l.Error().Err(err).Msg("ThrottleRepository.ResetLoginThrottle(); failed to reset login throttle")
+	}
+
+	if user.IsStoreAdmin && !user.HasTOTP {
 		l.Info().Str("user_id", user.ID.String()).Msg("store admin logged in")
 
 		if err = s.sessionManager.NewSession(ctx, user.ID); err != nil {
@@ -104,13 +152,19 @@ func (s *Service) Login(ctx context.Context, req *genapi.LoginCredentials) (*gen
 		}, nil
 	}
 
-	l.Info().Str("user_id", user.ID.String()).Msg("store employee login code prompt initiated")
+	l.Info().Str("user_id", user.ID.String()).Msg("login code prompt initiated")
 
 	if err = s.loginCodePromptManager.NewPrompt(ctx, user.ID); err != nil {
 		l.Error().Err(err).Msg("LoginCodePromptManager.NewPrompt(); failed to create login code prompt")
 		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create login code prompt")
 	}
 
+	if user.IsStoreAdmin {
+		return &genapi.LoginResponse{
+			Type: genapi.LoginResponseTypeAdminTotp,
+		}, nil
+	}
+
 	return &genapi.LoginResponse{
 		Type: genapi.LoginResponseTypeEmployee,
 	}, nil
@@ -129,16 +183,32 @@ func (s *Service) LoginCodePrompt(ctx context.Context, req *genapi.LoginCodeProm
 		return apierror.ToAPIError(http.StatusInternalServerError, "failed to get user ID")
 	}
 
-	err = s.repo.CheckAndDeleteUserLoginCode(ctx, userID, req.GetLoginCode())
+	now := s.timeProvider.Now()
+	loginCodeKey := LoginCodeThrottleKey(userID)
+	throttles := s.withClientIPThrottle(ctx, throttledKey{key: loginCodeKey, policy: loginCodeThrottlePolicy})
+
+	tl := l.With().Str("user_id", userID.String()).Logger()
+
+	if err = s.checkThrottles(ctx, &tl, now, throttles); err != nil {
+		return err
+	}
+
+	method, err := s.checkSecondFactor(ctx, userID, req.GetLoginCode(), now)
 	if errors.Is(err, apperror.ErrLoginCodeMismatch) {
 		l.Info().Str("user_id", userID.String()).Msg("wrong login code")
+
+		s.recordLoginFailures(ctx, &tl, now, throttles)
 		return apierror.ToAPIError(http.StatusBadRequest, "wrong login code")
 	} else if err != nil {
-		l.Error().Err(err).Msg("Repository.CheckUserLoginCode(); failed to check and delete login code")
+		l.Error().Err(err).Msg("failed to check login code")
 		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check and delete login code")
 	}
 
-	l.Info().Str("user_id", userID.String()).Msg("store employee logged in")
+	if err = s.throttleRepo.ResetLoginThrottle(ctx, loginCodeKey); err != nil {
+		l.Error().Err(err).Msg("ThrottleRepository.ResetLoginThrottle(); failed to reset login throttle")
+	}
+
+	l.Info().Str("user_id", userID.String()).Str("method", method).Msg("user logged in with second factor")
 
 	if err = s.sessionManager.NewSession(ctx, userID); err != nil {
 		l.Error().Err(err).Msg("SessionManager.NewSession(); failed to create session")
@@ -153,6 +223,118 @@ func (s *Service) LoginCodePrompt(ctx context.Context, req *genapi.LoginCodeProm
 	return nil
 }
 
+// checkSecondFactor accepts a TOTP or recovery code if the user has enrolled
+// an authenticator, and a login code issued by an admin otherwise. It returns
+// which one was used.
+func (s *Service) checkSecondFactor(
+	ctx context.Context,
+	userID uuid.UUID,
+	code string,
+	now time.Time,
+) (string, error) {
+	secret, err := s.repo.GetConfirmedTOTPSecret(ctx, userID)
+	if err != nil && !errors.Is(err, apperror.ErrTOTPNotFound) {
+		return "", fmt.Errorf("failed to get TOTP secret: %w", err)
+	}
+
+	if err == nil {
+		if step, ok := s.totpValidator.Validate(secret, code, now); ok {
+			return "totp", s.repo.UseTOTPStep(ctx, userID, step)
+		}
+
+		err = s.repo.UseTOTPRecoveryCode(ctx, userID, code, now)
+		if !errors.Is(err, apperror.ErrLoginCodeMismatch) {
+			return "recovery_code", err
+		}
+	}
+
+	return "login_code", s.repo.CheckAndDeleteUserLoginCode(ctx, userID, code, now)
+}
+
+type throttledKey struct {
+	key    ThrottleKey
+	policy ThrottlePolicy
+}
+
+func (s *Service) withClientIPThrottle(ctx context.Context, throttles ...throttledKey) []throttledKey {
+	if ip, ok := appcontext.GetClientIPFromContext(ctx); ok {
+		throttles = append(throttles, throttledKey{key: ClientIPThrottleKey(ip), policy: clientIPThrottlePolicy})
+	}
+
+	return throttles
+}
+
+// checkThrottles fails closed: if the throttles can't be read, the attempt
+// is rejected.
+func (s *Service) checkThrottles(
+	ctx context.Context,
+	l *zerolog.Logger,
+	now time.Time,
+	throttles []throttledKey,
+) error {
+	for _, t := range throttles {
+		throttle, err := s.throttleRepo.GetLoginThrottle(ctx, t.key)
+		if errors.Is(err, apperror.ErrLoginThrottleNotFound) {
+			continue
+		} else if err != nil {
+			l.Error().Err(err).Msg("ThrottleRepository.GetLoginThrottle(); failed to get login throttle")
+			return apierror.ToAPIError(http.StatusInternalServerError, "failed to check login attempts")
+		}
+
+		wait := t.policy.RetryAfter(throttle, now)
+		if wait <= 0 {
+			continue
+		}
+
+		l.Info().Str("throttle_scope", string(t.key.Scope)).Dur("retry_after", wait).Msg("login attempt throttled")
+
+		seconds := int(wait.Round(time.Second) / time.Second)
+		return apierror.ToAPIError(
+			http.StatusTooManyRequests,
+			fmt.Sprintf("too many failed login attempts. please try again in %d seconds", max(seconds, 1)),
+		)
+	}
+
+	return nil
+}
+
+// recordLoginFailures only logs errors since the attempt has already failed.
+func (s *Service) recordLoginFailures(
+	ctx context.Context,
+	l *zerolog.Logger,
+	now time.Time,
+	throttles []throttledKey,
+) {
+	for _, t := range throttles {
+		count, err := s.throttleRepo.RecordLoginFailure(ctx, t.key, now, now.Add(-t.policy.ForgetAfter))
+		if err != nil {
+			l.Error().Err(err).Msg("ThrottleRepository.RecordLoginFailure(); failed to record login failure")
+			continue
+		}
+
+		lockedUntil, ok := t.policy.LockUntil(count, now)
+		if !ok {
+			continue
+		}
+
+		if err = s.throttleRepo.LockLoginThrottle(ctx, t.key, lockedUntil); err != nil {
+			l.Error().Err(err).Msg("ThrottleRepository.LockLoginThrottle(); failed to lock login throttle")
+			continue
+		}
+
+		e := l.Warn()
+		if t.key.Scope == ThrottleScopeClientIP {
+			e = e.Str("client_ip", t.key.Value)
+		}
+
+		e.
+			Str("throttle_scope", string(t.key.Scope)).
+			Int("failure_count", count).
+			Time("locked_until", lockedUntil).
+			Msg("login locked out after too many failed attempts")
+	}
+}
+
 func (s *Service) Logout(ctx context.Context) error {
 	l := zerolog.Ctx(ctx)
 
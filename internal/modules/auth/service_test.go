@@ -8,13 +8,16 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/testutil"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -66,6 +69,10 @@ type serviceRepositoryStub struct {
 	loginCodeDeleted  bool
 	getUserErr        error
 	checkLoginCodeErr error
+	totpSecret        string
+	lastUsedTOTPStep  int64
+	recoveryCode      string
+	recoveryCodeUsed  bool
 }
 
 func (a *serviceRepositoryStub) GetUserByUsernameAndStoreCode(
@@ -90,6 +97,7 @@ func (a *serviceRepositoryStub) CheckAndDeleteUserLoginCode(
 	_ context.Context,
 	userID uuid.UUID,
 	loginCode string,
+	_ time.Time,
 ) error {
 	if a.checkLoginCodeErr != nil {
 		return a.checkLoginCodeErr
@@ -103,6 +111,109 @@ func (a *serviceRepositoryStub) CheckAndDeleteUserLoginCode(
 	return nil
 }
 
+func (a *serviceRepositoryStub) GetConfirmedTOTPSecret(_ context.Context, _ uuid.UUID) (string, error) {
+	if a.totpSecret == "" {
+		return "", apperror.ErrTOTPNotFound
+	}
+
+	return a.totpSecret, nil
+}
+
+func (a *serviceRepositoryStub) UseTOTPStep(_ context.Context, _ uuid.UUID, step int64) error {
+	if step <= a.lastUsedTOTPStep {
+		return apperror.ErrLoginCodeMismatch
+	}
+
+	a.lastUsedTOTPStep = step
+	return nil
+}
+
+func (a *serviceRepositoryStub) UseTOTPRecoveryCode(
+	_ context.Context,
+	_ uuid.UUID,
+	recoveryCode string,
+	_ time.Time,
+) error {
+	if a.recoveryCode == "" || a.recoveryCodeUsed || a.recoveryCode != recoveryCode {
+		return apperror.ErrLoginCodeMismatch
+	}
+
+	a.recoveryCodeUsed = true
+	return nil
+}
+
+type totpValidatorStub struct {
+	code string
+	step int64
+}
+
+func (v totpValidatorStub) Validate(_ string, code string, _ time.Time) (int64, bool) {
+	if v.code == "" || v.code != code {
+		return 0, false
+	}
+
+	return v.step, true
+}
+
+type throttleRepositoryStub struct {
+	throttles map[auth.ThrottleKey]auth.LoginThrottle
+	getErr    error
+}
+
+func newThrottleRepositoryStub() *throttleRepositoryStub {
+	return &throttleRepositoryStub{
+		throttles: make(map[auth.ThrottleKey]auth.LoginThrottle),
+	}
+}
+
+func (r *throttleRepositoryStub) GetLoginThrottle(_ context.Context, key auth.ThrottleKey) (auth.LoginThrottle, error) {
+	if r.getErr != nil {
+		return auth.LoginThrottle{}, r.getErr
+	}
+
+	throttle, ok := r.throttles[key]
+	if !ok {
+		return auth.LoginThrottle{}, apperror.ErrLoginThrottleNotFound
+	}
+
+	return throttle, nil
+}
+
+func (r *throttleRepositoryStub) RecordLoginFailure(
+	_ context.Context,
+	key auth.ThrottleKey,
+	now time.Time,
+	forgetBefore time.Time,
+) (int, error) {
+	throttle := r.throttles[key]
+	if throttle.LastFailureTime.Before(forgetBefore) {
+		throttle.FailureCount = 0
+	}
+
+	throttle.FailureCount++
+	throttle.LastFailureTime = now
+	r.throttles[key] = throttle
+
+	return throttle.FailureCount, nil
+}
+
+func (r *throttleRepositoryStub) LockLoginThrottle(
+	_ context.Context,
+	key auth.ThrottleKey,
+	lockedUntil time.Time,
+) error {
+	throttle := r.throttles[key]
+	throttle.LockedUntil = optional.Some(lockedUntil)
+	r.throttles[key] = throttle
+
+	return nil
+}
+
+func (r *throttleRepositoryStub) ResetLoginThrottle(_ context.Context, key auth.ThrottleKey) error {
+	delete(r.throttles, key)
+	return nil
+}
+
 func TestLogin(t *testing.T) {
 	t.Parallel()
 
@@ -181,7 +292,15 @@ func TestLogin(t *testing.T) {
 					loginCodeDeleted: false,
 				}
 
-				s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+				s := auth.NewService(
+					testutil.NewTimeProviderStub(time.Now()),
+					sessionManager,
+					loginCodePromptManager,
+					repo,
+					testutil.PasswordHasherStub{},
+					totpValidatorStub{},
+					newThrottleRepositoryStub(),
+				)
 				_, err := s.Login(requestCtx, tc.req)
 
 				testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
@@ -205,7 +324,15 @@ func TestLogin(t *testing.T) {
 			loginCodeDeleted: false,
 		}
 
-		s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
 
 		got, err := s.Login(requestCtx, &genapi.LoginCredentials{
 			Username:  correctUsername,
@@ -233,7 +360,15 @@ func TestLogin(t *testing.T) {
 			loginCodeDeleted: false,
 		}
 
-		s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
 
 		got, err := s.Login(requestCtx, &genapi.LoginCredentials{
 			Username:  correctUsername,
@@ -248,6 +383,43 @@ func TestLogin(t *testing.T) {
 		assert.Nil(t, sessionManager.userID)
 	})
 
+	t.Run("creates new login code prompt when store admin has enabled TOTP", func(t *testing.T) {
+		t.Parallel()
+
+		totpAdminUser := adminUser
+		totpAdminUser.HasTOTP = true
+
+		sessionManager := new(serviceSessionManagerStub)
+		loginCodePromptManager := new(loginCodePromptManagerStub)
+		repo := &serviceRepositoryStub{
+			user:      totpAdminUser,
+			username:  correctUsername,
+			storeCode: correctStoreCode,
+		}
+
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
+
+		got, err := s.Login(requestCtx, &genapi.LoginCredentials{
+			Username:  correctUsername,
+			Password:  correctPassword,
+			StoreCode: correctStoreCode,
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, genapi.LoginResponseTypeAdminTotp, got.Type)
+		require.NotNil(t, loginCodePromptManager.userID)
+		assert.Equal(t, adminUser.ID.String(), loginCodePromptManager.userID.String())
+		assert.Nil(t, sessionManager.userID)
+	})
+
 	t.Run("returns internal server error when repository.GetUserByUsernameAndStoreCode() errors", func(t *testing.T) {
 		t.Parallel()
 
@@ -263,7 +435,15 @@ func TestLogin(t *testing.T) {
 			getUserErr:       errors.New("oh no!"),
 		}
 
-		s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
 		_, err := s.Login(requestCtx, &genapi.LoginCredentials{
 			Username:  correctUsername,
 			Password:  correctPassword,
@@ -305,7 +485,15 @@ func TestLoginCodePrompt(t *testing.T) {
 			loginCodeDeleted: false,
 		}
 
-		s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
 
 		err := s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{
 			LoginCode: "12345678",
@@ -330,7 +518,15 @@ func TestLoginCodePrompt(t *testing.T) {
 			loginCodeDeleted: false,
 		}
 
-		s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
 
 		err := s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{
 			LoginCode: "12345678",
@@ -357,7 +553,15 @@ func TestLoginCodePrompt(t *testing.T) {
 			loginCodeDeleted: false,
 		}
 
-		s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
 
 		err := s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{
 			LoginCode: loginCode,
@@ -384,7 +588,15 @@ func TestLoginCodePrompt(t *testing.T) {
 			checkLoginCodeErr: errors.New("oh no!"),
 		}
 
-		s := auth.NewService(sessionManager, loginCodePromptManager, repo, testutil.PasswordHasherStub{})
+		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			sessionManager,
+			loginCodePromptManager,
+			repo,
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
+		)
 
 		err := s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{
 			LoginCode: "12345678",
@@ -397,6 +609,253 @@ func TestLoginCodePrompt(t *testing.T) {
 		assert.Equal(t, userID.String(), loginCodePromptManager.userID.String())
 		assert.False(t, repo.loginCodeDeleted)
 	})
+
+	t.Run("accepts TOTP codes, recovery codes and login codes when TOTP is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		const (
+			totpCode     = "123456"
+			totpStep     = int64(57130592)
+			recoveryCode = "K7QM2-XTP4A"
+		)
+
+		testCases := []struct {
+			name                 string
+			code                 string
+			lastUsedStep         int64
+			wantStatus           int
+			wantRecoveryCodeUsed bool
+			wantLoginCodeDeleted bool
+		}{
+			{
+				name: "accepts a TOTP code",
+				code: totpCode,
+			},
+			{
+				name:         "rejects a TOTP code that has already been used",
+				code:         totpCode,
+				lastUsedStep: totpStep,
+				wantStatus:   http.StatusBadRequest,
+			},
+			{
+				name:                 "accepts a recovery code",
+				code:                 recoveryCode,
+				wantRecoveryCodeUsed: true,
+			},
+			{
+				name:                 "accepts a login code",
+				code:                 loginCode,
+				wantLoginCodeDeleted: true,
+			},
+			{
+				name:       "rejects a wrong code",
+				code:       "654321",
+				wantStatus: http.StatusBadRequest,
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				sessionManager := new(serviceSessionManagerStub)
+				loginCodePromptManager := &loginCodePromptManagerStub{userID: &userID}
+				repo := &serviceRepositoryStub{
+					user:             user,
+					loginCode:        loginCode,
+					totpSecret:       "JBSWY3DPEHPK3PXP",
+					lastUsedTOTPStep: tc.lastUsedStep,
+					recoveryCode:     recoveryCode,
+				}
+
+				s := auth.NewService(
+					testutil.NewTimeProviderStub(time.Now()),
+					sessionManager,
+					loginCodePromptManager,
+					repo,
+					testutil.PasswordHasherStub{},
+					totpValidatorStub{code: totpCode, step: totpStep},
+					newThrottleRepositoryStub(),
+				)
+
+				err := s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{
+					LoginCode: tc.code,
+				})
+
+				assert.Equal(t, tc.wantRecoveryCodeUsed, repo.recoveryCodeUsed)
+				assert.Equal(t, tc.wantLoginCodeDeleted, repo.loginCodeDeleted)
+
+				if tc.wantStatus != 0 {
+					testutil.AssertAPIStatusCode(t, tc.wantStatus, err)
+					assert.Nil(t, sessionManager.userID)
+
+					return
+				}
+
+				require.NoError(t, err)
+				require.NotNil(t, sessionManager.userID)
+				assert.Equal(t, userID.String(), sessionManager.userID.String())
+			})
+		}
+	})
+}
+
+func TestLoginThrottling(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	const (
+		username  = "testuser"
+		password  = "testpassword"
+		storeCode = "teststore"
+		loginCode = "1234"
+		clientIP  = "192.0.2.1"
+	)
+
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	userID := uuid.New()
+	accountKey := auth.AccountThrottleKey(storeCode, username)
+	loginCodeKey := auth.LoginCodeThrottleKey(userID)
+
+	newService := func(
+		now time.Time,
+		promptManager *loginCodePromptManagerStub,
+		throttleRepo *throttleRepositoryStub,
+	) *auth.Service {
+		return auth.NewService(
+			testutil.NewTimeProviderStub(now),
+			new(serviceSessionManagerStub),
+			promptManager,
+			&serviceRepositoryStub{
+				user:      readmodel.User{ID: userID, Password: password},
+				username:  username,
+				storeCode: storeCode,
+				loginCode: loginCode,
+			},
+			testutil.PasswordHasherStub{},
+			totpValidatorStub{},
+			throttleRepo,
+		)
+	}
+
+	login := func(s *auth.Service, password string) error {
+		_, err := s.Login(requestCtx, &genapi.LoginCredentials{
+			Username:  username,
+			Password:  password,
+			StoreCode: storeCode,
+		})
+
+		return err
+	}
+
+	t.Run("delays login after a few failed attempts", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := newThrottleRepositoryStub()
+		s := newService(now, new(loginCodePromptManagerStub), throttleRepo)
+
+		for range 3 {
+			testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, login(s, "wrongpassword"))
+		}
+
+		testutil.AssertAPIStatusCode(t, http.StatusTooManyRequests, login(s, password))
+
+		later := newService(now.Add(time.Minute), new(loginCodePromptManagerStub), throttleRepo)
+		require.NoError(t, login(later, password))
+
+		_, ok := throttleRepo.throttles[accountKey]
+		assert.False(t, ok, "account throttle should be reset after logging in")
+	})
+
+	t.Run("counts failed attempts against the client IP", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := newThrottleRepositoryStub()
+		s := newService(now, new(loginCodePromptManagerStub), throttleRepo)
+
+		ctx := appcontext.NewContextWithClientIP(requestCtx, clientIP)
+		_, err := s.Login(ctx, &genapi.LoginCredentials{
+			Username:  "someoneelse",
+			Password:  password,
+			StoreCode: storeCode,
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+
+		assert.Equal(t, 1, throttleRepo.throttles[auth.ClientIPThrottleKey(clientIP)].FailureCount)
+		assert.Equal(t, 1, throttleRepo.throttles[auth.AccountThrottleKey(storeCode, "someoneelse")].FailureCount)
+	})
+
+	t.Run("locks account after too many failed attempts", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := newThrottleRepositoryStub()
+		attemptTime := now
+
+		for range 10 {
+			s := newService(attemptTime, new(loginCodePromptManagerStub), throttleRepo)
+			testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, login(s, "wrongpassword"))
+
+			attemptTime = attemptTime.Add(time.Minute)
+		}
+
+		throttle := throttleRepo.throttles[accountKey]
+		lockedUntil, ok := throttle.LockedUntil.Get()
+		require.True(t, ok)
+		assert.True(t, lockedUntil.After(attemptTime))
+
+		s := newService(attemptTime, new(loginCodePromptManagerStub), throttleRepo)
+		testutil.AssertAPIStatusCode(t, http.StatusTooManyRequests, login(s, password))
+	})
+
+	t.Run("returns internal server error when throttle can't be checked", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := newThrottleRepositoryStub()
+		throttleRepo.getErr = errors.New("oh no")
+
+		s := newService(now, new(loginCodePromptManagerStub), throttleRepo)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, login(s, password))
+	})
+
+	t.Run("locks login codes after a few wrong ones", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := newThrottleRepositoryStub()
+		attemptTime := now
+
+		for range 5 {
+			s := newService(attemptTime, &loginCodePromptManagerStub{userID: &userID}, throttleRepo)
+			err := s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{LoginCode: "0000"})
+			testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+
+			attemptTime = attemptTime.Add(time.Minute)
+		}
+
+		throttle := throttleRepo.throttles[loginCodeKey]
+		require.True(t, throttle.LockedUntil.IsSet())
+
+		s := newService(attemptTime, &loginCodePromptManagerStub{userID: &userID}, throttleRepo)
+		err := s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{LoginCode: loginCode})
+		testutil.AssertAPIStatusCode(t, http.StatusTooManyRequests, err)
+	})
+
+	t.Run("resets login code throttle after correct login code", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := newThrottleRepositoryStub()
+		throttleRepo.throttles[loginCodeKey] = auth.LoginThrottle{
+			FailureCount:    1,
+			LastFailureTime: now.Add(-time.Minute),
+		}
+
+		s := newService(now, &loginCodePromptManagerStub{userID: &userID}, throttleRepo)
+		require.NoError(t, s.LoginCodePrompt(requestCtx, &genapi.LoginCodePrompt{LoginCode: loginCode}))
+
+		_, ok := throttleRepo.throttles[loginCodeKey]
+		assert.False(t, ok)
+	})
 }
 
 func TestLogout(t *testing.T) {
@@ -413,10 +872,13 @@ func TestLogout(t *testing.T) {
 		sessionManager := &serviceSessionManagerStub{userID: &userID}
 
 		s := auth.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
 			sessionManager,
 			new(loginCodePromptManagerStub),
 			new(serviceRepositoryStub),
 			new(testutil.PasswordHasherStub),
+			totpValidatorStub{},
+			newThrottleRepositoryStub(),
 		)
 
 		err := s.Logout(requestCtx)
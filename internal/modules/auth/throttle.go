@@ -0,0 +1,133 @@
+package auth
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+type ThrottleScope string
+
+const (
+	ThrottleScopeAccount   = ThrottleScope("account")
+	ThrottleScopeClientIP  = ThrottleScope("client_ip")
+	ThrottleScopeLoginCode = ThrottleScope("login_code")
+)
+
+// ThrottleKey identifies what failed login attempts are counted against.
+type ThrottleKey struct {
+	Scope ThrottleScope
+	Value string
+}
+
+// AccountThrottleKey is counted per store code and username, whether or not
+// the user exists, so usernames can't be told apart by how they're throttled.
+func AccountThrottleKey(storeCode string, username string) ThrottleKey {
+	// Quoting keeps "a b" + "c" and "a" + "b c" apart.
+	return ThrottleKey{
+		Scope: ThrottleScopeAccount,
+		Value: strconv.Quote(storeCode) + " " + strconv.Quote(username),
+	}
+}
+
+func ClientIPThrottleKey(ip string) ThrottleKey {
+	return ThrottleKey{
+		Scope: ThrottleScopeClientIP,
+		Value: ip,
+	}
+}
+
+// LoginCodeThrottleKey is counted per user rather than per prompt, since
+// starting a new prompt would otherwise reset the count.
+func LoginCodeThrottleKey(userID uuid.UUID) ThrottleKey {
+	return ThrottleKey{
+		Scope: ThrottleScopeLoginCode,
+		Value: userID.String(),
+	}
+}
+
+// LoginThrottle is the failed attempts counted against a key.
+type LoginThrottle struct {
+	FailureCount    int
+	LastFailureTime time.Time
+	LockedUntil     optional.Optional[time.Time]
+}
+
+// ThrottlePolicy allows FreeAttempts failures before delaying each further
+// attempt, doubling the delay from BaseDelay up to MaxDelay. After
+// LockoutAfter failures the key is locked for LockoutDuration. Failures are
+// forgotten after ForgetAfter without another one.
+type ThrottlePolicy struct {
+	FreeAttempts    int
+	BaseDelay       time.Duration
+	MaxDelay        time.Duration
+	LockoutAfter    int
+	LockoutDuration time.Duration
+	ForgetAfter     time.Duration
+}
+
+var (
+	accountThrottlePolicy = ThrottlePolicy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutAfter:    10,
+		LockoutDuration: 15 * time.Minute,
+		ForgetAfter:     time.Hour,
+	}
+
+	// Higher limits since many employees of a store can share an IP.
+	clientIPThrottlePolicy = ThrottlePolicy{
+		FreeAttempts:    10,
+		BaseDelay:       time.Second,
+		MaxDelay:        time.Minute,
+		LockoutAfter:    50,
+		LockoutDuration: 15 * time.Minute,
+		ForgetAfter:     time.Hour,
+	}
+
+	// Stricter limits since login codes are much shorter than passwords.
+	loginCodeThrottlePolicy = ThrottlePolicy{
+		FreeAttempts:    3,
+		BaseDelay:       2 * time.Second,
+		MaxDelay:        time.Minute,
+		LockoutAfter:    5,
+		LockoutDuration: 15 * time.Minute,
+		ForgetAfter:     time.Hour,
+	}
+)
+
+// RetryAfter returns how long to wait before the next attempt is allowed, or
+// zero if it's allowed now.
+func (p ThrottlePolicy) RetryAfter(throttle LoginThrottle, now time.Time) time.Duration {
+	if lockedUntil, ok := throttle.LockedUntil.Get(); ok && now.Before(lockedUntil) {
+		return lockedUntil.Sub(now)
+	}
+
+	if throttle.FailureCount < p.FreeAttempts || !now.Before(throttle.LastFailureTime.Add(p.ForgetAfter)) {
+		return 0
+	}
+
+	delay := p.MaxDelay
+	if shift := throttle.FailureCount - p.FreeAttempts; shift < 32 {
+		delay = min(p.BaseDelay<<shift, p.MaxDelay)
+	}
+
+	if wait := throttle.LastFailureTime.Add(delay).Sub(now); wait > 0 {
+		return wait
+	}
+
+	return 0
+}
+
+// LockUntil returns when a key with failureCount failures should be
+// unlocked, if it should be locked at all.
+func (p ThrottlePolicy) LockUntil(failureCount int, now time.Time) (time.Time, bool) {
+	if failureCount < p.LockoutAfter {
+		return time.Time{}, false
+	}
+
+	return now.Add(p.LockoutDuration), true
+}
@@ -0,0 +1,72 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/rs/zerolog"
+)
+
+// throttleRetention is how long login throttles are kept after their last
+// failure or lock. It should outlast the ForgetAfter and LockoutDuration of
+// every throttle policy so no throttle in effect is deleted.
+const throttleRetention = 24 * time.Hour
+
+type ThrottleCleanerRepository interface {
+	DeleteStaleLoginThrottles(ctx context.Context, before time.Time) (int64, error)
+}
+
+// ThrottleCleaner periodically removes login throttles that are no longer in
+// effect, so the table doesn't grow with every client that ever failed a login.
+type ThrottleCleaner struct {
+	repo         ThrottleCleanerRepository
+	timeProvider TimeProvider
+}
+
+func NewThrottleCleaner(repo ThrottleCleanerRepository, timeProvider TimeProvider) *ThrottleCleaner {
+	return &ThrottleCleaner{
+		repo:         repo,
+		timeProvider: timeProvider,
+	}
+}
+
+// Run deletes stale login throttles every interval until ctx is done.
+func (c *ThrottleCleaner) Run(ctx context.Context, interval time.Duration) {
+	l := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := c.DeleteStale(ctx); err != nil {
+			l.Error().Err(err).Msg("failed to delete stale login throttles")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DeleteStale deletes the login throttles that have been idle for longer
+// than throttleRetention.
+func (c *ThrottleCleaner) DeleteStale(ctx context.Context) error {
+	before := c.timeProvider.Now().Add(-throttleRetention)
+
+	n, err := c.repo.DeleteStaleLoginThrottles(ctx, before)
+	if err != nil {
+		return fmt.Errorf("failed to delete stale login throttles: %w", err)
+	}
+
+	if n > 0 {
+		zerolog.Ctx(ctx).Debug().
+			Int64("deleted_throttles", n).
+			Time("idle_since", before).
+			Msg("deleted stale login throttles")
+	}
+
+	return nil
+}
@@ -0,0 +1,63 @@
+//go:build unit
+// +build unit
+
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestThrottleCleanerDeleteStale(t *testing.T) {
+	t.Parallel()
+
+	theTime := time.Date(2024, time.January, 2, 12, 0, 0, 0, time.UTC)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	ctx := testutil.RequestContextWithLogger(context.Background())
+
+	t.Run("deletes throttles idle for a day", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &throttleCleanerRepositoryStub{deleted: 3}
+		c := auth.NewThrottleCleaner(repo, testutil.NewTimeProviderStub(theTime))
+
+		require.NoError(t, c.DeleteStale(ctx))
+		assert.Equal(t, []time.Time{theTime.Add(-24 * time.Hour)}, repo.calledWithBefore)
+	})
+
+	t.Run("returns error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &throttleCleanerRepositoryStub{err: errors.New("oh no!")}
+		c := auth.NewThrottleCleaner(repo, testutil.NewTimeProviderStub(theTime))
+
+		require.Error(t, c.DeleteStale(ctx))
+	})
+}
+
+type throttleCleanerRepositoryStub struct {
+	deleted          int64
+	err              error
+	calledWithBefore []time.Time
+}
+
+func (r *throttleCleanerRepositoryStub) DeleteStaleLoginThrottles(_ context.Context, before time.Time) (int64, error) {
+	r.calledWithBefore = append(r.calledWithBefore, before)
+
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	return r.deleted, nil
+}
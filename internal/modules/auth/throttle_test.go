@@ -0,0 +1,105 @@
+//go:build unit
+// +build unit
+
+package auth_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestThrottlePolicyRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+
+	policy := auth.ThrottlePolicy{
+		FreeAttempts:    3,
+		BaseDelay:       time.Second,
+		MaxDelay:        10 * time.Second,
+		LockoutAfter:    10,
+		LockoutDuration: 15 * time.Minute,
+		ForgetAfter:     time.Hour,
+	}
+
+	testCases := []struct {
+		name     string
+		throttle auth.LoginThrottle
+		want     time.Duration
+	}{
+		{
+			name:     "allows free attempts",
+			throttle: auth.LoginThrottle{FailureCount: 2, LastFailureTime: now},
+			want:     0,
+		},
+		{
+			name:     "delays after free attempts",
+			throttle: auth.LoginThrottle{FailureCount: 3, LastFailureTime: now},
+			want:     time.Second,
+		},
+		{
+			name:     "doubles delay with each failure",
+			throttle: auth.LoginThrottle{FailureCount: 5, LastFailureTime: now},
+			want:     4 * time.Second,
+		},
+		{
+			name:     "caps delay",
+			throttle: auth.LoginThrottle{FailureCount: 9, LastFailureTime: now},
+			want:     10 * time.Second,
+		},
+		{
+			name:     "counts delay from last failure",
+			throttle: auth.LoginThrottle{FailureCount: 5, LastFailureTime: now.Add(-3 * time.Second)},
+			want:     time.Second,
+		},
+		{
+			name:     "forgets old failures",
+			throttle: auth.LoginThrottle{FailureCount: 9, LastFailureTime: now.Add(-time.Hour)},
+			want:     0,
+		},
+		{
+			name: "waits until lockout ends",
+			throttle: auth.LoginThrottle{
+				FailureCount:    10,
+				LastFailureTime: now.Add(-5 * time.Minute),
+				LockedUntil:     optional.Some(now.Add(10 * time.Minute)),
+			},
+			want: 10 * time.Minute,
+		},
+		{
+			name: "allows attempts after lockout ends",
+			throttle: auth.LoginThrottle{
+				FailureCount:    10,
+				LastFailureTime: now.Add(-20 * time.Minute),
+				LockedUntil:     optional.Some(now.Add(-5 * time.Minute)),
+			},
+			want: 0,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.want, policy.RetryAfter(tc.throttle, now))
+		})
+	}
+}
+
+func TestThrottlePolicyLockUntil(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.January, 1, 12, 0, 0, 0, time.UTC)
+	policy := auth.ThrottlePolicy{LockoutAfter: 5, LockoutDuration: 15 * time.Minute}
+
+	_, ok := policy.LockUntil(4, now)
+	assert.False(t, ok)
+
+	lockedUntil, ok := policy.LockUntil(5, now)
+	assert.True(t, ok)
+	assert.Equal(t, now.Add(15*time.Minute), lockedUntil)
+}
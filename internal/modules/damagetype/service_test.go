@@ -14,6 +14,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/damagetype"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
@@ -55,6 +56,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateDamageTypeRequest{
@@ -72,6 +74,55 @@ func TestCreateDamageType(t *testing.T) {
 		assert.Equal(t, theStoreID, repo.createCalledWith.storeID)
 	})
 
+	t.Run("records creation in audit log", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+
+		s := damagetype.NewService(
+			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		req := &genapi.CreateDamageTypeRequest{
+			Name: "damage type 1",
+		}
+
+		_, err := s.CreateDamageType(requestCtx, req)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.createCalledWith)
+		require.Len(t, auditLog.Changes, 1)
+
+		assert.Equal(t, audit.ActionDamageTypeCreated, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypeDamageType, auditLog.Changes[0].EntityType)
+		assert.Equal(t, repo.createCalledWith.id, auditLog.Changes[0].EntityID)
+		assert.Equal(t, req, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns internal server error when audit log fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.SetError(errors.New("oh no"))
+
+		s := damagetype.NewService(
+			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		_, err := s.CreateDamageType(requestCtx, &genapi.CreateDamageTypeRequest{
+			Name: "damage type 1",
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
 	t.Run("returns resource location when damage type is created", func(t *testing.T) {
 		t.Parallel()
 
@@ -86,7 +137,7 @@ func TestCreateDamageType(t *testing.T) {
 		resourceLocationProvider := testutil.NewResourceLocationProviderStubForDamageType(theLocation)
 		repo := &repositoryStub{storeID: theStoreID}
 
-		s := damagetype.NewService(resourceLocationProvider, qualifyingPermissionProvider, repo)
+		s := damagetype.NewService(resourceLocationProvider, qualifyingPermissionProvider, repo, testutil.NewAuditLogStub())
 
 		got, err := s.CreateDamageType(requestCtx, &genapi.CreateDamageTypeRequest{
 			Name: "damage type 1",
@@ -110,6 +161,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		emptyCtx := testutil.RequestContextWithLogger(context.Background())
@@ -127,6 +179,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateDamageType(requestCtx, &genapi.CreateDamageTypeRequest{
@@ -143,6 +196,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateDamageType(requestCtx, &genapi.CreateDamageTypeRequest{
@@ -164,6 +218,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateDamageTypeRequest{
@@ -181,6 +236,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{nameTakenErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateDamageType(requestCtx, &genapi.CreateDamageTypeRequest{
@@ -197,6 +253,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateDamageType(requestCtx, &genapi.CreateDamageTypeRequest{
@@ -213,6 +270,7 @@ func TestCreateDamageType(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForDamageType(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, errors.New("oh no!")),
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateDamageType(requestCtx, &genapi.CreateDamageTypeRequest{
@@ -0,0 +1,121 @@
+package notification
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	dispatchBatchSize   = 20
+	dispatchMaxAttempts = 10
+
+	// dispatchLease is how long a claimed notification is hidden from other
+	// dispatchers. It is retried after that if the dispatcher dies mid-send.
+	dispatchLease = 5 * time.Minute
+
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = 6 * time.Hour
+)
+
+type DispatcherRepository interface {
+	ClaimPendingNotifications(
+		ctx context.Context,
+		now time.Time,
+		leaseUntil time.Time,
+		maxAttempts int,
+		limit int,
+	) ([]Notification, error)
+	MarkNotificationSent(ctx context.Context, notificationID uuid.UUID, sentTime time.Time) error
+	MarkNotificationFailed(
+		ctx context.Context,
+		notificationID uuid.UUID,
+		nextAttemptTime time.Time,
+		lastError string,
+	) error
+}
+
+// Dispatcher sends the notifications in the outbox, retrying failed ones with
+// exponential backoff until they run out of attempts.
+type Dispatcher struct {
+	repo         DispatcherRepository
+	notifier     Notifier
+	timeProvider TimeProvider
+}
+
+func NewDispatcher(repo DispatcherRepository, notifier Notifier, timeProvider TimeProvider) *Dispatcher {
+	return &Dispatcher{
+		repo:         repo,
+		notifier:     notifier,
+		timeProvider: timeProvider,
+	}
+}
+
+// Run dispatches pending notifications every interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	l := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DispatchPending(ctx); err != nil {
+			l.Error().Err(err).Msg("failed to dispatch notifications")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending sends a batch of notifications that are due.
+func (d *Dispatcher) DispatchPending(ctx context.Context) error {
+	l := zerolog.Ctx(ctx)
+	now := d.timeProvider.Now()
+
+	notifications, err := d.repo.ClaimPendingNotifications(
+		ctx,
+		now,
+		now.Add(dispatchLease),
+		dispatchMaxAttempts,
+		dispatchBatchSize,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to claim pending notifications: %w", err)
+	}
+
+	for _, n := range notifications {
+		if sendErr := d.notifier.Notify(ctx, n.Recipient, n.Message); sendErr != nil {
+			l.Warn().Err(sendErr).Str("notification_id", n.ID.String()).Msg("failed to send notification")
+
+			nextAttemptTime := d.timeProvider.Now().Add(retryDelay(n.Attempts + 1))
+			if err = d.repo.MarkNotificationFailed(ctx, n.ID, nextAttemptTime, sendErr.Error()); err != nil {
+				return fmt.Errorf("failed to mark notification as failed: %w", err)
+			}
+
+			continue
+		}
+
+		if err = d.repo.MarkNotificationSent(ctx, n.ID, d.timeProvider.Now()); err != nil {
+			return fmt.Errorf("failed to mark notification as sent: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// retryDelay returns how long to wait after the given number of failed attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, retryMaxDelay)
+}
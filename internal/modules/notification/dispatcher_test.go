@@ -0,0 +1,217 @@
+//go:build unit
+// +build unit
+
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/notification"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchPending(t *testing.T) {
+	t.Parallel()
+
+	theTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	ctx := testutil.RequestContextWithLogger(context.Background())
+
+	newPending := func(attempts int) notification.Notification {
+		return notification.Notification{
+			ID:        uuid.New(),
+			Recipient: "+628123456789",
+			Message:   "Hello",
+			Attempts:  attempts,
+		}
+	}
+
+	t.Run("sends pending notifications and marks them as sent", func(t *testing.T) {
+		t.Parallel()
+
+		pending := newPending(0)
+		repo := &dispatcherRepositoryStub{pending: []notification.Notification{pending}}
+		notifier := &notifierStub{}
+
+		d := notification.NewDispatcher(repo, notifier, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, d.DispatchPending(ctx))
+
+		assert.Equal(t, []string{"+628123456789: Hello"}, notifier.sent)
+		assert.Equal(t, map[uuid.UUID]time.Time{pending.ID: theTime}, repo.sent)
+		assert.Empty(t, repo.failed)
+	})
+
+	t.Run("claims notifications that are due", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &dispatcherRepositoryStub{}
+
+		d := notification.NewDispatcher(repo, &notifierStub{}, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, d.DispatchPending(ctx))
+
+		assert.Equal(t, theTime, repo.claimedAt)
+		assert.True(t, repo.leaseUntil.After(theTime))
+	})
+
+	t.Run("retries failed notifications with exponential backoff", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			attempts int
+			want     time.Duration
+		}{
+			{attempts: 0, want: 30 * time.Second},
+			{attempts: 1, want: time.Minute},
+			{attempts: 4, want: 8 * time.Minute},
+			{attempts: 9, want: 256 * time.Minute},
+			{attempts: 20, want: 6 * time.Hour},
+		}
+
+		for _, tc := range tests {
+			pending := newPending(tc.attempts)
+			repo := &dispatcherRepositoryStub{pending: []notification.Notification{pending}}
+
+			d := notification.NewDispatcher(
+				repo,
+				&notifierStub{err: errors.New("gateway is down")},
+				testutil.NewTimeProviderStub(theTime),
+			)
+			require.NoError(t, d.DispatchPending(ctx))
+
+			assert.Empty(t, repo.sent)
+			assert.Equal(t, theTime.Add(tc.want), repo.failed[pending.ID], "after %d attempts", tc.attempts)
+			assert.Equal(t, "gateway is down", repo.lastError)
+		}
+	})
+
+	t.Run("returns error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			repo     *dispatcherRepositoryStub
+			notifier *notifierStub
+		}{
+			{
+				name:     "claiming notifications",
+				repo:     &dispatcherRepositoryStub{claimErr: errors.New("oh no!")},
+				notifier: &notifierStub{},
+			},
+			{
+				name: "marking notification as sent",
+				repo: &dispatcherRepositoryStub{
+					pending: []notification.Notification{newPending(0)},
+					markErr: errors.New("oh no!"),
+				},
+				notifier: &notifierStub{},
+			},
+			{
+				name: "marking notification as failed",
+				repo: &dispatcherRepositoryStub{
+					pending: []notification.Notification{newPending(0)},
+					markErr: errors.New("oh no!"),
+				},
+				notifier: &notifierStub{err: errors.New("gateway is down")},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				d := notification.NewDispatcher(tc.repo, tc.notifier, testutil.NewTimeProviderStub(theTime))
+				assert.Error(t, d.DispatchPending(ctx))
+			})
+		}
+	})
+}
+
+type dispatcherRepositoryStub struct {
+	pending  []notification.Notification
+	claimErr error
+	markErr  error
+
+	claimedAt  time.Time
+	leaseUntil time.Time
+	sent       map[uuid.UUID]time.Time
+	failed     map[uuid.UUID]time.Time
+	lastError  string
+}
+
+func (d *dispatcherRepositoryStub) ClaimPendingNotifications(
+	_ context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	_ int,
+	_ int,
+) ([]notification.Notification, error) {
+	if d.claimErr != nil {
+		return nil, d.claimErr
+	}
+
+	d.claimedAt = now
+	d.leaseUntil = leaseUntil
+
+	return d.pending, nil
+}
+
+func (d *dispatcherRepositoryStub) MarkNotificationSent(
+	_ context.Context,
+	notificationID uuid.UUID,
+	sentTime time.Time,
+) error {
+	if d.markErr != nil {
+		return d.markErr
+	}
+
+	if d.sent == nil {
+		d.sent = make(map[uuid.UUID]time.Time)
+	}
+
+	d.sent[notificationID] = sentTime
+	return nil
+}
+
+func (d *dispatcherRepositoryStub) MarkNotificationFailed(
+	_ context.Context,
+	notificationID uuid.UUID,
+	nextAttemptTime time.Time,
+	lastError string,
+) error {
+	if d.markErr != nil {
+		return d.markErr
+	}
+
+	if d.failed == nil {
+		d.failed = make(map[uuid.UUID]time.Time)
+	}
+
+	d.failed[notificationID] = nextAttemptTime
+	d.lastError = lastError
+
+	return nil
+}
+
+type notifierStub struct {
+	err  error
+	sent []string
+}
+
+func (n *notifierStub) Notify(_ context.Context, recipient string, message string) error {
+	if n.err != nil {
+		return n.err
+	}
+
+	n.sent = append(n.sent, recipient+": "+message)
+	return nil
+}
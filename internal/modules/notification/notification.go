@@ -0,0 +1,65 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+var ErrUnsupportedLanguage = errors.New("unsupported notification language")
+
+type Event string
+
+const (
+	EventOrderCreated   = Event("order_created")
+	EventOrderConfirmed = Event("order_confirmed")
+	EventOrderCompleted = Event("order_completed")
+	EventOrderCancelled = Event("order_cancelled")
+)
+
+type Language string
+
+const (
+	LanguageIndonesian = Language("id")
+	LanguageEnglish    = Language("en")
+)
+
+func NewLanguage(value string) (Language, error) {
+	switch lang := Language(value); lang {
+	case LanguageIndonesian, LanguageEnglish:
+		return lang, nil
+	default:
+		return "", ErrUnsupportedLanguage
+	}
+}
+
+// Notifier sends a message to a customer through a single channel.
+type Notifier interface {
+	Notify(ctx context.Context, recipient string, message string) error
+}
+
+// Notification is a message waiting in the outbox to be sent to a customer.
+type Notification struct {
+	ID            uuid.UUID
+	EventID       uuid.UUID
+	StoreID       uuid.UUID
+	RepairOrderID uuid.UUID
+	Event         Event
+	Recipient     string
+	Message       string
+	CreationTime  time.Time
+	Attempts      int
+}
+
+type StoreSettings struct {
+	StoreName string
+	Language  Language
+	Template  optional.Optional[string]
+}
+
+type TimeProvider interface {
+	Now() time.Time
+}
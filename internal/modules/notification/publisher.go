@@ -0,0 +1,91 @@
+package notification
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/google/uuid"
+)
+
+type PublisherRepository interface {
+	GetStoreNotificationSettings(ctx context.Context, storeID uuid.UUID, event Event) (StoreSettings, error)
+	CreateNotification(ctx context.Context, notification Notification) error
+}
+
+type OrderRepository interface {
+	GetRepairOrderByID(ctx context.Context, storeID uuid.UUID, repairOrderID uuid.UUID) (domain.Order, error)
+}
+
+// orderEvents maps the order events the customer is notified about to their
+// notification events. Customers aren't notified when they pick up their phone.
+var orderEvents = map[domain.OrderEventType]Event{
+	domain.OrderEventTypeCreated:   EventOrderCreated,
+	domain.OrderEventTypeConfirmed: EventOrderConfirmed,
+	domain.OrderEventTypeCompleted: EventOrderCompleted,
+	domain.OrderEventTypeCancelled: EventOrderCancelled,
+}
+
+// Publisher subscribes to order events. It renders the message of each event
+// and puts it in the outbox, where the Dispatcher picks it up.
+type Publisher struct {
+	repo         PublisherRepository
+	orderRepo    OrderRepository
+	timeProvider TimeProvider
+}
+
+func NewPublisher(repo PublisherRepository, orderRepo OrderRepository, timeProvider TimeProvider) *Publisher {
+	return &Publisher{
+		repo:         repo,
+		orderRepo:    orderRepo,
+		timeProvider: timeProvider,
+	}
+}
+
+// HandleOrderEvent queues the notification of the event. The notification is
+// keyed by the event, so handling the same event twice only queues it once.
+func (p *Publisher) HandleOrderEvent(ctx context.Context, orderEvent domain.OrderEvent) error {
+	event, ok := orderEvents[orderEvent.Type()]
+	if !ok {
+		return nil
+	}
+
+	metadata := orderEvent.Metadata()
+
+	order, err := p.orderRepo.GetRepairOrderByID(ctx, metadata.StoreID, metadata.OrderID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil
+		}
+
+		return fmt.Errorf("failed to get repair order: %w", err)
+	}
+
+	settings, err := p.repo.GetStoreNotificationSettings(ctx, order.StoreID(), event)
+	if err != nil {
+		return fmt.Errorf("failed to get store notification settings: %w", err)
+	}
+
+	message, err := renderMessage(settings, event, order)
+	if err != nil {
+		return fmt.Errorf("failed to render %s message: %w", event, err)
+	}
+
+	err = p.repo.CreateNotification(ctx, Notification{
+		ID:            uuid.New(),
+		EventID:       metadata.EventID,
+		StoreID:       order.StoreID(),
+		RepairOrderID: order.ID(),
+		Event:         event,
+		Recipient:     order.ContactNumber().Value(),
+		Message:       message,
+		CreationTime:  p.timeProvider.Now(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create notification: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,277 @@
+//go:build unit
+// +build unit
+
+package notification_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/modules/notification"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	shareddomain "github.com/JosephJoshua/remana-backend/internal/modules/shared/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleOrderEvent(t *testing.T) {
+	t.Parallel()
+
+	theTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	lastEvent := func(order domain.Order) domain.OrderEvent {
+		events := order.Events()
+		return events[len(events)-1]
+	}
+
+	t.Run("queues message in the store's language", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name     string
+			language notification.Language
+			want     string
+		}{
+			{
+				name:     "indonesian",
+				language: notification.LanguageIndonesian,
+				want: "Halo John Doe, iPhone 12 Anda sudah kami terima di Some Store dengan nomor servis R123-45678-9012. " +
+					"Perkiraan selesai 08 May 2024. Biaya sementara Rp1.250.000.",
+			},
+			{
+				name:     "english",
+				language: notification.LanguageEnglish,
+				want: "Hi John Doe, Some Store has received your iPhone 12 under repair order R123-45678-9012. " +
+					"It should be done by 08 May 2024. The cost so far is Rp1.250.000.",
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				order := newTestOrder(t, theTime)
+				repo := &publisherRepositoryStub{
+					settings: notification.StoreSettings{StoreName: "Some Store", Language: tc.language},
+				}
+
+				p := notification.NewPublisher(repo, &orderRepositoryStub{order: order}, testutil.NewTimeProviderStub(theTime))
+				require.NoError(t, p.HandleOrderEvent(context.Background(), lastEvent(order)))
+
+				require.Len(t, repo.created, 1)
+
+				got := repo.created[0]
+				assert.Equal(t, tc.want, got.Message)
+				assert.Equal(t, lastEvent(order).Metadata().EventID, got.EventID)
+				assert.Equal(t, "+628123456789", got.Recipient)
+				assert.Equal(t, order.ID(), got.RepairOrderID)
+				assert.Equal(t, order.StoreID(), got.StoreID)
+				assert.Equal(t, notification.EventOrderCreated, got.Event)
+				assert.Equal(t, theTime, got.CreationTime)
+			})
+		}
+	})
+
+	t.Run("uses the store template", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t, theTime)
+		require.NoError(t, order.Cancel(
+			theTime,
+			"Parts unavailable",
+			optional.None[uint](),
+			optional.None[domain.NewOrderRefundParams](),
+		))
+
+		repo := &publisherRepositoryStub{
+			settings: notification.StoreSettings{
+				StoreName: "Some Store",
+				Language:  notification.LanguageEnglish,
+				Template:  optional.Some("{{ .Slug }} cancelled: {{ .CancellationReason }}"),
+			},
+		}
+
+		p := notification.NewPublisher(repo, &orderRepositoryStub{order: order}, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, p.HandleOrderEvent(context.Background(), lastEvent(order)))
+
+		require.Len(t, repo.created, 1)
+		assert.Equal(t, "R123-45678-9012 cancelled: Parts unavailable", repo.created[0].Message)
+	})
+
+	t.Run("ignores events the customer isn't notified about", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t, theTime)
+		require.NoError(t, order.MutateCost(theTime, 50000, "Replacement battery"))
+
+		repo := &publisherRepositoryStub{
+			settings: notification.StoreSettings{Language: notification.LanguageEnglish},
+		}
+
+		p := notification.NewPublisher(repo, &orderRepositoryStub{order: order}, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, p.HandleOrderEvent(context.Background(), lastEvent(order)))
+
+		assert.Empty(t, repo.created)
+	})
+
+	t.Run("ignores events of orders that no longer exist", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t, theTime)
+		repo := &publisherRepositoryStub{
+			settings: notification.StoreSettings{Language: notification.LanguageEnglish},
+		}
+
+		p := notification.NewPublisher(repo, &orderRepositoryStub{}, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, p.HandleOrderEvent(context.Background(), lastEvent(order)))
+
+		assert.Empty(t, repo.created)
+	})
+
+	t.Run("returns error when the store template is invalid", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &publisherRepositoryStub{
+			settings: notification.StoreSettings{
+				Language: notification.LanguageEnglish,
+				Template: optional.Some("{{ .Slug"),
+			},
+		}
+
+		order := newTestOrder(t, theTime)
+
+		p := notification.NewPublisher(repo, &orderRepositoryStub{order: order}, testutil.NewTimeProviderStub(theTime))
+		err := p.HandleOrderEvent(context.Background(), lastEvent(order))
+
+		require.Error(t, err)
+		assert.Empty(t, repo.created)
+	})
+
+	t.Run("returns error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name      string
+			repo      *publisherRepositoryStub
+			orderRepo *orderRepositoryStub
+		}{
+			{
+				name: "getting order",
+				repo: &publisherRepositoryStub{
+					settings: notification.StoreSettings{Language: notification.LanguageEnglish},
+				},
+				orderRepo: &orderRepositoryStub{err: errors.New("oh no!")},
+			},
+			{
+				name:      "getting settings",
+				repo:      &publisherRepositoryStub{getErr: errors.New("oh no!")},
+				orderRepo: &orderRepositoryStub{},
+			},
+			{
+				name: "creating notification",
+				repo: &publisherRepositoryStub{
+					settings:  notification.StoreSettings{Language: notification.LanguageEnglish},
+					createErr: errors.New("oh no!"),
+				},
+				orderRepo: &orderRepositoryStub{},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				order := newTestOrder(t, theTime)
+				if tc.orderRepo.err == nil {
+					tc.orderRepo.order = order
+				}
+
+				p := notification.NewPublisher(tc.repo, tc.orderRepo, testutil.NewTimeProviderStub(theTime))
+				err := p.HandleOrderEvent(context.Background(), lastEvent(order))
+
+				assert.Error(t, err)
+			})
+		}
+	})
+}
+
+func newTestOrder(t *testing.T, creationTime time.Time) domain.Order {
+	t.Helper()
+
+	contactNumber, err := shareddomain.NewPhoneNumber("08123456789")
+	require.NoError(t, err)
+
+	order, err := domain.NewOrder(domain.NewOrderParams{
+		CreationTime:            creationTime,
+		Slug:                    "R123-45678-9012",
+		StoreID:                 uuid.New(),
+		CustomerName:            "John Doe",
+		ContactNumber:           contactNumber,
+		PhoneType:               "iPhone 12",
+		Color:                   "Black",
+		InitialCost:             1250000,
+		Damages:                 []string{"Screen"},
+		Photos:                  []uuid.UUID{uuid.New()},
+		SalesPersonID:           uuid.New(),
+		TechnicianID:            uuid.New(),
+		EstimatedCompletionTime: optional.Some(creationTime.Add(48 * time.Hour)),
+	})
+	require.NoError(t, err)
+
+	return order
+}
+
+type publisherRepositoryStub struct {
+	settings  notification.StoreSettings
+	getErr    error
+	createErr error
+	created   []notification.Notification
+}
+
+func (p *publisherRepositoryStub) GetStoreNotificationSettings(
+	_ context.Context,
+	_ uuid.UUID,
+	_ notification.Event,
+) (notification.StoreSettings, error) {
+	if p.getErr != nil {
+		return notification.StoreSettings{}, p.getErr
+	}
+
+	return p.settings, nil
+}
+
+func (p *publisherRepositoryStub) CreateNotification(_ context.Context, n notification.Notification) error {
+	if p.createErr != nil {
+		return p.createErr
+	}
+
+	p.created = append(p.created, n)
+	return nil
+}
+
+type orderRepositoryStub struct {
+	order domain.Order
+	err   error
+}
+
+func (o *orderRepositoryStub) GetRepairOrderByID(
+	_ context.Context,
+	_ uuid.UUID,
+	repairOrderID uuid.UUID,
+) (domain.Order, error) {
+	if o.err != nil {
+		return nil, o.err
+	}
+
+	if o.order == nil || o.order.ID() != repairOrderID {
+		return nil, apperror.ErrRepairOrderNotFound
+	}
+
+	return o.order, nil
+}
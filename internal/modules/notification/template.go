@@ -0,0 +1,125 @@
+package notification
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+)
+
+var defaultTemplates = map[Event]map[Language]string{
+	EventOrderCreated: {
+		LanguageIndonesian: "Halo {{ .CustomerName }}, {{ .PhoneType }} Anda sudah kami terima di {{ .StoreName }} " +
+			"dengan nomor servis {{ .Slug }}.{{ with .EstimatedCompletionTime }} Perkiraan selesai {{ date . }}.{{ end }} " +
+			"Biaya sementara Rp{{ amount .TotalCost }}.",
+		LanguageEnglish: "Hi {{ .CustomerName }}, {{ .StoreName }} has received your {{ .PhoneType }} " +
+			"under repair order {{ .Slug }}.{{ with .EstimatedCompletionTime }} It should be done by {{ date . }}.{{ end }} " +
+			"The cost so far is Rp{{ amount .TotalCost }}.",
+	},
+	EventOrderConfirmed: {
+		LanguageIndonesian: "Halo {{ .CustomerName }}, konfirmasi untuk servis {{ .Slug }}: {{ .ConfirmationContents }}",
+		LanguageEnglish:    "Hi {{ .CustomerName }}, an update on repair order {{ .Slug }}: {{ .ConfirmationContents }}",
+	},
+	EventOrderCompleted: {
+		LanguageIndonesian: "Halo {{ .CustomerName }}, servis {{ .PhoneType }} Anda ({{ .Slug }}) sudah selesai " +
+			"dan dapat diambil di {{ .StoreName }}.{{ if gt .OutstandingAmount 0 }} " +
+			"Sisa pembayaran Rp{{ amount .OutstandingAmount }}.{{ end }}",
+		LanguageEnglish: "Hi {{ .CustomerName }}, the repair of your {{ .PhoneType }} ({{ .Slug }}) is done " +
+			"and it is ready for pick-up at {{ .StoreName }}.{{ if gt .OutstandingAmount 0 }} " +
+			"The remaining balance is Rp{{ amount .OutstandingAmount }}.{{ end }}",
+	},
+	EventOrderCancelled: {
+		LanguageIndonesian: "Halo {{ .CustomerName }}, servis {{ .Slug }} di {{ .StoreName }} dibatalkan. " +
+			"Alasan: {{ .CancellationReason }}",
+		LanguageEnglish: "Hi {{ .CustomerName }}, repair order {{ .Slug }} at {{ .StoreName }} has been cancelled. " +
+			"Reason: {{ .CancellationReason }}",
+	},
+}
+
+var templateFuncs = template.FuncMap{
+	"amount": formatAmount,
+	"date":   func(t time.Time) string { return t.Format("02 Jan 2006") },
+}
+
+type messageData struct {
+	StoreName               string
+	CustomerName            string
+	Slug                    string
+	PhoneType               string
+	TotalCost               int
+	OutstandingAmount       int
+	EstimatedCompletionTime *time.Time
+	ConfirmationContents    string
+	CancellationReason      string
+}
+
+func newMessageData(storeName string, order domain.Order) messageData {
+	data := messageData{
+		StoreName:         storeName,
+		CustomerName:      order.CustomerName(),
+		Slug:              order.Slug(),
+		PhoneType:         order.PhoneType(),
+		TotalCost:         order.TotalCost(),
+		OutstandingAmount: order.OutstandingAmount(),
+	}
+
+	estimate := order.EstimatedCompletionTime()
+	if value, ok := estimate.Get(); ok {
+		data.EstimatedCompletionTime = &value
+	}
+
+	contents := order.ConfirmationContents()
+	data.ConfirmationContents = contents.GetOrElse("")
+
+	reason := order.CancellationReason()
+	data.CancellationReason = reason.GetOrElse("")
+
+	return data
+}
+
+// renderMessage renders the store's own template if it has one, falling back to
+// the default template of the event in the store's language.
+func renderMessage(settings StoreSettings, event Event, order domain.Order) (string, error) {
+	text, ok := settings.Template.Get()
+	if !ok {
+		text, ok = defaultTemplates[event][settings.Language]
+		if !ok {
+			return "", fmt.Errorf("no default template for %s in %s", event, settings.Language)
+		}
+	}
+
+	tmpl, err := template.New(string(event)).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse template: %w", err)
+	}
+
+	var b strings.Builder
+	if err = tmpl.Execute(&b, newMessageData(settings.StoreName, order)); err != nil {
+		return "", fmt.Errorf("failed to execute template: %w", err)
+	}
+
+	return b.String(), nil
+}
+
+func formatAmount(amount int) string {
+	digits := strconv.Itoa(amount)
+
+	sign := ""
+	if amount < 0 {
+		sign, digits = "-", digits[1:]
+	}
+
+	var b strings.Builder
+	for i, d := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			b.WriteRune('.')
+		}
+
+		b.WriteRune(d)
+	}
+
+	return sign + b.String()
+}
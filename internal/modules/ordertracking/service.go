@@ -0,0 +1,119 @@
+package ordertracking
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"net/http"
+
+	"github.com/JosephJoshua/remana-backend/internal/apierror"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type Repository interface {
+	GetRepairOrderBySlugInAnyStore(ctx context.Context, slug string) (domain.Order, error)
+	GetRepairOrderNotes(
+		ctx context.Context,
+		repairOrderID uuid.UUID,
+		visibility optional.Optional[domain.OrderNoteVisibility],
+	) ([]readmodel.RepairOrderNote, error)
+}
+
+type RateLimiter interface {
+	Allow(key string) bool
+}
+
+type Service struct {
+	repo            Repository
+	ipRateLimiter   RateLimiter
+	slugRateLimiter RateLimiter
+}
+
+func NewService(repo Repository, ipRateLimiter RateLimiter, slugRateLimiter RateLimiter) *Service {
+	return &Service{
+		repo:            repo,
+		ipRateLimiter:   ipRateLimiter,
+		slugRateLimiter: slugRateLimiter,
+	}
+}
+
+func (s *Service) GetPublicRepairOrder(
+	ctx context.Context,
+	params genapi.GetPublicRepairOrderParams,
+) (*genapi.PublicRepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	ip, ok := appcontext.GetClientIPFromContext(ctx)
+	if !ok {
+		l.Error().Msg("client IP is missing from context")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to identify client")
+	}
+
+	// Limiting per slug as well stops guessing the last four digits from many IPs.
+	if !s.ipRateLimiter.Allow(ip) || !s.slugRateLimiter.Allow(params.Slug) {
+		return nil, apierror.ToAPIError(http.StatusTooManyRequests, "too many requests. please try again later")
+	}
+
+	order, err := s.repo.GetRepairOrderBySlugInAnyStore(ctx, params.Slug)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by slug")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	lastFour := order.ContactNumber().LastFourDigits()
+	if subtle.ConstantTimeCompare([]byte(lastFour), []byte(params.PhoneLastFour)) != 1 {
+		// Same response as a missing order so that slugs can't be enumerated.
+		return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+	}
+
+	notes, err := s.repo.GetRepairOrderNotes(ctx, order.ID(), optional.Some(domain.OrderNoteVisibilityCustomer))
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get repair order notes")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	return toAPIPublicRepairOrder(order, notes), nil
+}
+
+func toAPIPublicRepairOrder(order domain.Order, notes []readmodel.RepairOrderNote) *genapi.PublicRepairOrder {
+	res := &genapi.PublicRepairOrder{
+		Slug:              order.Slug(),
+		Status:            genapi.PublicRepairOrderStatus(order.Status()),
+		CreationTime:      order.CreationTime(),
+		PhoneType:         order.PhoneType(),
+		OutstandingAmount: order.OutstandingAmount(),
+		Notes:             make([]genapi.PublicRepairOrderNotesItem, 0, len(notes)),
+	}
+
+	for _, note := range notes {
+		res.Notes = append(res.Notes, genapi.PublicRepairOrderNotesItem{
+			Body:         note.Note.Body(),
+			CreationTime: note.Note.CreationTime(),
+		})
+	}
+
+	if estimatedCompletionTime, ok := order.EstimatedCompletionTime().PointerValue().Get(); ok {
+		res.EstimatedCompletionTime = genapi.NewOptDateTime(estimatedCompletionTime)
+	}
+
+	if completionTime, ok := order.CompletionTime().PointerValue().Get(); ok {
+		res.CompletionTime = genapi.NewOptDateTime(completionTime)
+	}
+
+	if pickUpTime, ok := order.PickUpTime().PointerValue().Get(); ok {
+		res.PickUpTime = genapi.NewOptDateTime(pickUpTime)
+	}
+
+	return res
+}
@@ -0,0 +1,337 @@
+//go:build unit
+// +build unit
+
+package ordertracking_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/ordertracking"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+	shareddomain "github.com/JosephJoshua/remana-backend/internal/modules/shared/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetPublicRepairOrder(t *testing.T) {
+	t.Parallel()
+
+	const (
+		theIP = "203.0.113.7"
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := appcontext.NewContextWithClientIP(
+		testutil.RequestContextWithLogger(context.Background()),
+		theIP,
+	)
+
+	t.Run("returns the status of the repair order", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		s := ordertracking.NewService(
+			&repositoryStub{order: order},
+			&rateLimiterStub{},
+			&rateLimiterStub{},
+		)
+
+		got, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "6789",
+		})
+
+		require.NoError(t, err)
+		require.NotNil(t, got)
+
+		assert.Equal(t, order.Slug(), got.Slug)
+		assert.Equal(t, genapi.PublicRepairOrderStatusOpen, got.Status)
+		assert.Equal(t, order.PhoneType(), got.PhoneType)
+		assert.Equal(t, order.OutstandingAmount(), got.OutstandingAmount)
+
+		estimate := order.EstimatedCompletionTime()
+		assert.Equal(t, estimate.MustGet(), got.EstimatedCompletionTime.Value)
+		assert.False(t, got.CompletionTime.IsSet())
+		assert.False(t, got.PickUpTime.IsSet())
+		assert.Empty(t, got.Notes)
+	})
+
+	t.Run("only returns customer-visible notes", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		newNote := func(body string, visibility domain.OrderNoteVisibility) readmodel.RepairOrderNote {
+			return readmodel.RepairOrderNote{
+				Note: domain.RestoreOrderNote(domain.RestoreOrderNoteParams{
+					ID:           uuid.New(),
+					OrderID:      order.ID(),
+					AuthorID:     uuid.New(),
+					Body:         body,
+					Visibility:   visibility,
+					CreationTime: theTime,
+				}),
+				AuthorUsername: "technician",
+			}
+		}
+
+		s := ordertracking.NewService(
+			&repositoryStub{
+				order: order,
+				notes: []readmodel.RepairOrderNote{
+					newNote("Customer sounded annoyed", domain.OrderNoteVisibilityInternal),
+					newNote("Replacement screen has been ordered", domain.OrderNoteVisibilityCustomer),
+				},
+			},
+			&rateLimiterStub{},
+			&rateLimiterStub{},
+		)
+
+		got, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "6789",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, []genapi.PublicRepairOrderNotesItem{
+			{Body: "Replacement screen has been ordered", CreationTime: theTime},
+		}, got.Notes)
+	})
+
+	t.Run("checks the rate limits of the client IP and the slug", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		ipRateLimiter := &rateLimiterStub{}
+		slugRateLimiter := &rateLimiterStub{}
+
+		s := ordertracking.NewService(&repositoryStub{order: order}, ipRateLimiter, slugRateLimiter)
+
+		_, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "6789",
+		})
+
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{theIP}, ipRateLimiter.allowCalledWith)
+		assert.Equal(t, []string{order.Slug()}, slugRateLimiter.allowCalledWith)
+	})
+
+	t.Run("returns too many requests when the client IP is rate limited", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		s := ordertracking.NewService(
+			&repositoryStub{order: order},
+			&rateLimiterStub{limited: true},
+			&rateLimiterStub{},
+		)
+
+		_, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "6789",
+		})
+
+		testutil.AssertAPIStatusCode(t, http.StatusTooManyRequests, err)
+	})
+
+	t.Run("returns too many requests when the slug is rate limited", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		s := ordertracking.NewService(
+			&repositoryStub{order: order},
+			&rateLimiterStub{},
+			&rateLimiterStub{limited: true},
+		)
+
+		_, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "6789",
+		})
+
+		testutil.AssertAPIStatusCode(t, http.StatusTooManyRequests, err)
+	})
+
+	t.Run("returns not found when the last four digits don't match", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		s := ordertracking.NewService(
+			&repositoryStub{order: order},
+			&rateLimiterStub{},
+			&rateLimiterStub{},
+		)
+
+		_, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "1234",
+		})
+
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns not found when the repair order doesn't exist", func(t *testing.T) {
+		t.Parallel()
+
+		s := ordertracking.NewService(&repositoryStub{}, &rateLimiterStub{}, &rateLimiterStub{})
+
+		_, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          "R123-45678-9012",
+			PhoneLastFour: "6789",
+		})
+
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns internal server error when client IP is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		s := ordertracking.NewService(
+			&repositoryStub{order: order},
+			&rateLimiterStub{},
+			&rateLimiterStub{},
+		)
+
+		emptyCtx := testutil.RequestContextWithLogger(context.Background())
+		_, err := s.GetPublicRepairOrder(emptyCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "6789",
+		})
+
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns internal server error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		s := ordertracking.NewService(
+			&repositoryStub{err: errors.New("oh no!")},
+			&rateLimiterStub{},
+			&rateLimiterStub{},
+		)
+
+		_, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          "R123-45678-9012",
+			PhoneLastFour: "6789",
+		})
+
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns internal server error when getting the notes fails", func(t *testing.T) {
+		t.Parallel()
+
+		order := newTestOrder(t)
+		s := ordertracking.NewService(
+			&repositoryStub{order: order, notesErr: errors.New("oh no!")},
+			&rateLimiterStub{},
+			&rateLimiterStub{},
+		)
+
+		_, err := s.GetPublicRepairOrder(requestCtx, genapi.GetPublicRepairOrderParams{
+			Slug:          order.Slug(),
+			PhoneLastFour: "6789",
+		})
+
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func newTestOrder(t *testing.T) domain.Order {
+	t.Helper()
+
+	contactNumber, err := shareddomain.NewPhoneNumber("08123456789")
+	require.NoError(t, err)
+
+	creationTime := time.Now()
+
+	order, err := domain.NewOrder(domain.NewOrderParams{
+		CreationTime:            creationTime,
+		Slug:                    "slug-" + uuid.NewString(),
+		StoreID:                 uuid.New(),
+		CustomerName:            "John Doe",
+		ContactNumber:           contactNumber,
+		PhoneType:               "iPhone 12",
+		Color:                   "Black",
+		InitialCost:             100,
+		Damages:                 []string{"Screen"},
+		Photos:                  []uuid.UUID{uuid.New()},
+		SalesPersonID:           uuid.New(),
+		TechnicianID:            uuid.New(),
+		Imei:                    optional.Some("123456789012345"),
+		EstimatedCompletionTime: optional.Some(creationTime.Add(48 * time.Hour)),
+	})
+	require.NoError(t, err)
+
+	return order
+}
+
+type repositoryStub struct {
+	order    domain.Order
+	notes    []readmodel.RepairOrderNote
+	err      error
+	notesErr error
+}
+
+func (r *repositoryStub) GetRepairOrderBySlugInAnyStore(_ context.Context, slug string) (domain.Order, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	if r.order == nil || r.order.Slug() != slug {
+		return nil, apperror.ErrRepairOrderNotFound
+	}
+
+	return r.order, nil
+}
+
+func (r *repositoryStub) GetRepairOrderNotes(
+	_ context.Context,
+	repairOrderID uuid.UUID,
+	visibility optional.Optional[domain.OrderNoteVisibility],
+) ([]readmodel.RepairOrderNote, error) {
+	if r.notesErr != nil {
+		return nil, r.notesErr
+	}
+
+	var notes []readmodel.RepairOrderNote
+	for _, note := range r.notes {
+		if v, ok := visibility.Get(); ok && note.Note.Visibility() != v {
+			continue
+		}
+
+		if note.Note.OrderID() == repairOrderID {
+			notes = append(notes, note)
+		}
+	}
+
+	return notes, nil
+}
+
+type rateLimiterStub struct {
+	limited         bool
+	allowCalledWith []string
+}
+
+func (r *rateLimiterStub) Allow(key string) bool {
+	r.allowCalledWith = append(r.allowCalledWith, key)
+	return !r.limited
+}
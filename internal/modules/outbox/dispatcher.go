@@ -0,0 +1,141 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	dispatchBatchSize = 50
+
+	// dispatchLease is how long a claimed event is hidden from other
+	// dispatchers. It is delivered again after that if the dispatcher dies.
+	dispatchLease = 5 * time.Minute
+
+	retryBaseDelay = 5 * time.Second
+	retryMaxDelay  = time.Hour
+)
+
+// Subscriber handles order events. Events are delivered at least once and not
+// necessarily in order, so subscribers have to be idempotent.
+type Subscriber interface {
+	HandleOrderEvent(ctx context.Context, event domain.OrderEvent) error
+}
+
+// PendingEvent is an event in the outbox that hasn't been delivered yet.
+type PendingEvent struct {
+	Event    domain.OrderEvent
+	Attempts int
+}
+
+type Repository interface {
+	ClaimPendingOrderEvents(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]PendingEvent, error)
+	MarkOrderEventDispatched(ctx context.Context, eventID uuid.UUID, dispatchedTime time.Time) error
+	MarkOrderEventFailed(ctx context.Context, eventID uuid.UUID, nextAttemptTime time.Time, lastError string) error
+}
+
+type TimeProvider interface {
+	Now() time.Time
+}
+
+// Dispatcher delivers the events in the outbox to every subscriber. An event
+// is retried with exponential backoff until all subscribers have handled it.
+type Dispatcher struct {
+	repo         Repository
+	timeProvider TimeProvider
+	subscribers  []Subscriber
+}
+
+func NewDispatcher(repo Repository, timeProvider TimeProvider, subscribers ...Subscriber) *Dispatcher {
+	return &Dispatcher{
+		repo:         repo,
+		timeProvider: timeProvider,
+		subscribers:  subscribers,
+	}
+}
+
+// Run dispatches pending events every interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	l := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DispatchPending(ctx); err != nil {
+			l.Error().Err(err).Msg("failed to dispatch order events")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending delivers a batch of events that are due.
+func (d *Dispatcher) DispatchPending(ctx context.Context) error {
+	l := zerolog.Ctx(ctx)
+	now := d.timeProvider.Now()
+
+	pending, err := d.repo.ClaimPendingOrderEvents(ctx, now, now.Add(dispatchLease), dispatchBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to claim pending order events: %w", err)
+	}
+
+	for _, p := range pending {
+		eventID := p.Event.Metadata().EventID
+
+		if deliverErr := d.deliver(ctx, p.Event); deliverErr != nil {
+			l.Warn().
+				Err(deliverErr).
+				Str("event_id", eventID.String()).
+				Str("event_type", string(p.Event.Type())).
+				Msg("failed to deliver order event")
+
+			nextAttemptTime := d.timeProvider.Now().Add(retryDelay(p.Attempts + 1))
+			if err = d.repo.MarkOrderEventFailed(ctx, eventID, nextAttemptTime, deliverErr.Error()); err != nil {
+				return fmt.Errorf("failed to mark order event as failed: %w", err)
+			}
+
+			continue
+		}
+
+		if err = d.repo.MarkOrderEventDispatched(ctx, eventID, d.timeProvider.Now()); err != nil {
+			return fmt.Errorf("failed to mark order event as dispatched: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// deliver hands the event to every subscriber, even if an earlier one fails,
+// so one failing subscriber doesn't hold back the others.
+func (d *Dispatcher) deliver(ctx context.Context, event domain.OrderEvent) error {
+	var errs []error
+
+	for _, s := range d.subscribers {
+		if err := s.HandleOrderEvent(ctx, event); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// retryDelay returns how long to wait after the given number of failed attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, retryMaxDelay)
+}
@@ -0,0 +1,220 @@
+//go:build unit
+// +build unit
+
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/outbox"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchPending(t *testing.T) {
+	t.Parallel()
+
+	theTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	ctx := testutil.RequestContextWithLogger(context.Background())
+
+	newPending := func(attempts int) outbox.PendingEvent {
+		return outbox.PendingEvent{
+			Event: domain.OrderCreated{
+				OrderEventMetadata: domain.OrderEventMetadata{
+					EventID:      uuid.New(),
+					OrderID:      uuid.New(),
+					StoreID:      uuid.New(),
+					OccurredTime: theTime,
+				},
+			},
+			Attempts: attempts,
+		}
+	}
+
+	t.Run("delivers events to every subscriber and marks them as dispatched", func(t *testing.T) {
+		t.Parallel()
+
+		pending := newPending(0)
+		repo := &repositoryStub{pending: []outbox.PendingEvent{pending}}
+		first, second := &subscriberStub{}, &subscriberStub{}
+
+		d := outbox.NewDispatcher(repo, testutil.NewTimeProviderStub(theTime), first, second)
+		require.NoError(t, d.DispatchPending(ctx))
+
+		assert.Equal(t, []domain.OrderEvent{pending.Event}, first.handled)
+		assert.Equal(t, []domain.OrderEvent{pending.Event}, second.handled)
+
+		eventID := pending.Event.Metadata().EventID
+		assert.Equal(t, map[uuid.UUID]time.Time{eventID: theTime}, repo.dispatched)
+		assert.Empty(t, repo.failed)
+	})
+
+	t.Run("claims events that are due", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{}
+
+		d := outbox.NewDispatcher(repo, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, d.DispatchPending(ctx))
+
+		assert.Equal(t, theTime, repo.claimedAt)
+		assert.True(t, repo.leaseUntil.After(theTime))
+	})
+
+	t.Run("retries event with backoff when a subscriber fails", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			attempts int
+			want     time.Duration
+		}{
+			{attempts: 0, want: 5 * time.Second},
+			{attempts: 2, want: 20 * time.Second},
+			{attempts: 30, want: time.Hour},
+		}
+
+		for _, tc := range tests {
+			pending := newPending(tc.attempts)
+			repo := &repositoryStub{pending: []outbox.PendingEvent{pending}}
+			healthy := &subscriberStub{}
+
+			d := outbox.NewDispatcher(
+				repo,
+				testutil.NewTimeProviderStub(theTime),
+				&subscriberStub{err: errors.New("oh no!")},
+				healthy,
+			)
+			require.NoError(t, d.DispatchPending(ctx))
+
+			eventID := pending.Event.Metadata().EventID
+
+			assert.Len(t, healthy.handled, 1, "other subscribers should still get the event")
+			assert.Empty(t, repo.dispatched)
+			assert.Equal(t, theTime.Add(tc.want), repo.failed[eventID], "after %d attempts", tc.attempts)
+			assert.Equal(t, "oh no!", repo.lastError)
+		}
+	})
+
+	t.Run("returns error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name       string
+			repo       *repositoryStub
+			subscriber *subscriberStub
+		}{
+			{
+				name:       "claiming events",
+				repo:       &repositoryStub{claimErr: errors.New("oh no!")},
+				subscriber: &subscriberStub{},
+			},
+			{
+				name: "marking event as dispatched",
+				repo: &repositoryStub{
+					pending: []outbox.PendingEvent{newPending(0)},
+					markErr: errors.New("oh no!"),
+				},
+				subscriber: &subscriberStub{},
+			},
+			{
+				name: "marking event as failed",
+				repo: &repositoryStub{
+					pending: []outbox.PendingEvent{newPending(0)},
+					markErr: errors.New("oh no!"),
+				},
+				subscriber: &subscriberStub{err: errors.New("subscriber failed")},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				d := outbox.NewDispatcher(tc.repo, testutil.NewTimeProviderStub(theTime), tc.subscriber)
+				assert.Error(t, d.DispatchPending(ctx))
+			})
+		}
+	})
+}
+
+type repositoryStub struct {
+	pending  []outbox.PendingEvent
+	claimErr error
+	markErr  error
+
+	claimedAt  time.Time
+	leaseUntil time.Time
+	dispatched map[uuid.UUID]time.Time
+	failed     map[uuid.UUID]time.Time
+	lastError  string
+}
+
+func (r *repositoryStub) ClaimPendingOrderEvents(
+	_ context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	_ int,
+) ([]outbox.PendingEvent, error) {
+	if r.claimErr != nil {
+		return nil, r.claimErr
+	}
+
+	r.claimedAt = now
+	r.leaseUntil = leaseUntil
+
+	return r.pending, nil
+}
+
+func (r *repositoryStub) MarkOrderEventDispatched(_ context.Context, eventID uuid.UUID, dispatchedTime time.Time) error {
+	if r.markErr != nil {
+		return r.markErr
+	}
+
+	if r.dispatched == nil {
+		r.dispatched = make(map[uuid.UUID]time.Time)
+	}
+
+	r.dispatched[eventID] = dispatchedTime
+	return nil
+}
+
+func (r *repositoryStub) MarkOrderEventFailed(
+	_ context.Context,
+	eventID uuid.UUID,
+	nextAttemptTime time.Time,
+	lastError string,
+) error {
+	if r.markErr != nil {
+		return r.markErr
+	}
+
+	if r.failed == nil {
+		r.failed = make(map[uuid.UUID]time.Time)
+	}
+
+	r.failed[eventID] = nextAttemptTime
+	r.lastError = lastError
+
+	return nil
+}
+
+type subscriberStub struct {
+	err     error
+	handled []domain.OrderEvent
+}
+
+func (s *subscriberStub) HandleOrderEvent(_ context.Context, event domain.OrderEvent) error {
+	s.handled = append(s.handled, event)
+	return s.err
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/apierror"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -22,21 +23,28 @@ type ResourceLocationProvider interface {
 	PaymentMethod(paymentMethodID uuid.UUID) url.URL
 }
 
+type AuditRecorder interface {
+	Record(ctx context.Context, change audit.Change) error
+}
+
 type Service struct {
 	resourceLocationProvider ResourceLocationProvider
 	permissionProvider       permission.Provider
 	repo                     Repository
+	auditRecorder            AuditRecorder
 }
 
 func NewService(
 	resourceLocationProvider ResourceLocationProvider,
 	permissionProvider permission.Provider,
 	repo Repository,
+	auditRecorder AuditRecorder,
 ) *Service {
 	return &Service{
 		resourceLocationProvider: resourceLocationProvider,
 		permissionProvider:       permissionProvider,
 		repo:                     repo,
+		auditRecorder:            auditRecorder,
 	}
 }
 
@@ -77,6 +85,15 @@ func (s *Service) CreatePaymentMethod(
 		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create payment method")
 	}
 
+	if err := audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionPaymentMethodCreated,
+		EntityType: audit.EntityTypePaymentMethod,
+		EntityID:   id,
+		After:      req,
+	}); err != nil {
+		return nil, err
+	}
+
 	location := s.resourceLocationProvider.PaymentMethod(id)
 	return &genapi.CreatePaymentMethodCreated{
 		Location: location,
@@ -14,6 +14,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/paymentmethod"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
@@ -55,6 +56,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePaymentMethodRequest{
@@ -72,6 +74,55 @@ func TestCreatePaymentMethod(t *testing.T) {
 		assert.Equal(t, theStoreID, repo.createCalledWith.storeID)
 	})
 
+	t.Run("records creation in audit log", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+
+		s := paymentmethod.NewService(
+			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		req := &genapi.CreatePaymentMethodRequest{
+			Name: "payment method 1",
+		}
+
+		_, err := s.CreatePaymentMethod(requestCtx, req)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.createCalledWith)
+		require.Len(t, auditLog.Changes, 1)
+
+		assert.Equal(t, audit.ActionPaymentMethodCreated, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypePaymentMethod, auditLog.Changes[0].EntityType)
+		assert.Equal(t, repo.createCalledWith.id, auditLog.Changes[0].EntityID)
+		assert.Equal(t, req, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns internal server error when audit log fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.SetError(errors.New("oh no"))
+
+		s := paymentmethod.NewService(
+			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		_, err := s.CreatePaymentMethod(requestCtx, &genapi.CreatePaymentMethodRequest{
+			Name: "payment method 1",
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
 	t.Run("returns resource location when payment method is created", func(t *testing.T) {
 		t.Parallel()
 
@@ -86,7 +137,12 @@ func TestCreatePaymentMethod(t *testing.T) {
 		resourceLocationProvider := testutil.NewResourceLocationProviderStubForPaymentMethod(theLocation)
 		repo := &repositoryStub{storeID: theStoreID}
 
-		s := paymentmethod.NewService(resourceLocationProvider, qualifyingPermissionProvider, repo)
+		s := paymentmethod.NewService(
+			resourceLocationProvider,
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
 
 		got, err := s.CreatePaymentMethod(requestCtx, &genapi.CreatePaymentMethodRequest{
 			Name: "payment method 1",
@@ -110,6 +166,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		emptyCtx := testutil.RequestContextWithLogger(context.Background())
@@ -127,6 +184,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePaymentMethod(requestCtx, &genapi.CreatePaymentMethodRequest{
@@ -143,6 +201,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePaymentMethod(requestCtx, &genapi.CreatePaymentMethodRequest{
@@ -164,6 +223,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePaymentMethodRequest{
@@ -181,6 +241,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{nameTakenErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePaymentMethod(requestCtx, &genapi.CreatePaymentMethodRequest{
@@ -197,6 +258,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePaymentMethod(requestCtx, &genapi.CreatePaymentMethodRequest{
@@ -213,6 +275,7 @@ func TestCreatePaymentMethod(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPaymentMethod(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePaymentMethod(requestCtx, &genapi.CreatePaymentMethodRequest{
@@ -9,6 +9,9 @@ const (
 	groupNameSalesPerson    = "sales_person"
 	groupNamePaymentMethod  = "payment_method"
 	groupNameRole           = "role"
+	groupNameWebhook        = "webhook"
+	groupNamePhoto          = "photo"
+	groupNameUser           = "user"
 )
 
 type Permission interface {
@@ -36,6 +39,113 @@ func CreateRepairOrder() Permission {
 	}
 }
 
+func ViewRepairOrder() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "view",
+	}
+}
+
+func ViewRepairOrderHistory() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "view_history",
+	}
+}
+
+func AddRepairOrderCost() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "add_cost",
+	}
+}
+
+func RecordRepairOrderPayment() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "record_payment",
+	}
+}
+
+func ChangeRepairOrderTechnician() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "change_technician",
+	}
+}
+
+func AddRepairOrderNote() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "add_note",
+	}
+}
+
+func ViewRepairOrderPayments() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "view_payments",
+	}
+}
+
+func RefundRepairOrder() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "refund",
+	}
+}
+
+func ConfirmRepairOrder() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "confirm",
+	}
+}
+
+func CompleteRepairOrder() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "complete",
+	}
+}
+
+func PickUpRepairOrder() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "pick_up",
+	}
+}
+
+func CancelRepairOrder() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "cancel",
+	}
+}
+
+// EditRepairOrderIntake covers changing the damages, phone conditions and
+// phone equipments recorded when the phone was dropped off.
+func EditRepairOrderIntake() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "edit_intake",
+	}
+}
+
+func AddRepairOrderPhoto() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "add_photo",
+	}
+}
+
+func RemoveRepairOrderPhoto() Permission {
+	return permission{
+		groupName: groupNameRepairOrder,
+		name:      "remove_photo",
+	}
+}
+
 func CreateDamageType() Permission {
 	return permission{
 		groupName: groupNameDamageType,
@@ -64,6 +174,13 @@ func CreateTechnician() Permission {
 	}
 }
 
+func ViewTechnicianWorkload() Permission {
+	return permission{
+		groupName: groupNameTechnician,
+		name:      "view_workload",
+	}
+}
+
 func CreateSalesPerson() Permission {
 	return permission{
 		groupName: groupNameSalesPerson,
@@ -91,3 +208,66 @@ func AssignPermissionsToRole() Permission {
 		name:      "assign_permissions",
 	}
 }
+
+func CreateWebhook() Permission {
+	return permission{
+		groupName: groupNameWebhook,
+		name:      "create",
+	}
+}
+
+func ViewWebhooks() Permission {
+	return permission{
+		groupName: groupNameWebhook,
+		name:      "view",
+	}
+}
+
+func UpdateWebhook() Permission {
+	return permission{
+		groupName: groupNameWebhook,
+		name:      "update",
+	}
+}
+
+func DeleteWebhook() Permission {
+	return permission{
+		groupName: groupNameWebhook,
+		name:      "delete",
+	}
+}
+
+func ReplayWebhookDelivery() Permission {
+	return permission{
+		groupName: groupNameWebhook,
+		name:      "replay_delivery",
+	}
+}
+
+func UploadPhoto() Permission {
+	return permission{
+		groupName: groupNamePhoto,
+		name:      "upload",
+	}
+}
+
+func RevokeUserSessions() Permission {
+	return permission{
+		groupName: groupNameUser,
+		name:      "revoke_sessions",
+	}
+}
+
+func ManageLoginCodes() Permission {
+	return permission{
+		groupName: groupNameUser,
+		name:      "manage_login_codes",
+	}
+}
+
+func UnlockUserLogin() Permission {
+	return permission{
+		groupName: groupNameUser,
+		name:      "unlock_login",
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 )
@@ -31,21 +32,28 @@ type ResourceLocationProvider interface {
 	Role(roleID uuid.UUID) url.URL
 }
 
+type AuditRecorder interface {
+	Record(ctx context.Context, change audit.Change) error
+}
+
 type Service struct {
 	resourceLocationProvider ResourceLocationProvider
 	permissionProvider       Provider
 	repo                     ServiceRepository
+	auditRecorder            AuditRecorder
 }
 
 func NewService(
 	resourceLocationProvider ResourceLocationProvider,
 	repo ServiceRepository,
 	permissionProvider Provider,
+	auditRecorder AuditRecorder,
 ) *Service {
 	return &Service{
 		resourceLocationProvider: resourceLocationProvider,
 		permissionProvider:       permissionProvider,
 		repo:                     repo,
+		auditRecorder:            auditRecorder,
 	}
 }
 
@@ -86,6 +94,15 @@ func (s *Service) CreateRole(
 		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create role")
 	}
 
+	if err := audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionRoleCreated,
+		EntityType: audit.EntityTypeRole,
+		EntityID:   id,
+		After:      req,
+	}); err != nil {
+		return nil, err
+	}
+
 	location := s.resourceLocationProvider.Role(id)
 	return &genapi.CreateRoleCreated{
 		Location: location,
@@ -140,5 +157,15 @@ func (s *Service) AssignPermissionsToRole(
 		return apierror.ToAPIError(http.StatusInternalServerError, "failed to assign permissions to role")
 	}
 
+	// Permissions are only ever added to a role, so the assigned ones are the whole change.
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionRolePermissionsAssigned,
+		EntityType: audit.EntityTypeRole,
+		EntityID:   params.RoleId,
+		After:      req,
+	}); err != nil {
+		return err
+	}
+
 	return nil
 }
@@ -15,6 +15,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/testutil"
@@ -54,6 +55,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			repo,
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateRoleRequest{
@@ -73,6 +75,38 @@ func TestCreateRole(t *testing.T) {
 		assert.Equal(t, theStoreID, repo.createRoleCalledWith.storeID)
 	})
 
+	t.Run("records the created role in the audit log", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &serviceRepoStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+
+		s := permission.NewService(
+			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
+			repo,
+			qualifyingPermissionProvider,
+			auditLog,
+		)
+
+		req := &genapi.CreateRoleRequest{
+			Name:         "role 1",
+			IsStoreAdmin: true,
+		}
+
+		_, err := s.CreateRole(requestCtx, req)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.createRoleCalledWith)
+		require.Len(t, auditLog.Changes, 1)
+
+		got := auditLog.Changes[0]
+		assert.Equal(t, audit.ActionRoleCreated, got.Action)
+		assert.Equal(t, audit.EntityTypeRole, got.EntityType)
+		assert.Equal(t, repo.createRoleCalledWith.id, got.EntityID)
+		assert.Nil(t, got.Before)
+		assert.Equal(t, req, got.After)
+	})
+
 	t.Run("returns resource location when role is created", func(t *testing.T) {
 		t.Parallel()
 
@@ -87,7 +121,7 @@ func TestCreateRole(t *testing.T) {
 		resourceLocationProvider := testutil.NewResourceLocationProviderStubForRole(theLocation)
 		repo := &serviceRepoStub{storeID: theStoreID}
 
-		s := permission.NewService(resourceLocationProvider, repo, qualifyingPermissionProvider)
+		s := permission.NewService(resourceLocationProvider, repo, qualifyingPermissionProvider, testutil.NewAuditLogStub())
 
 		got, err := s.CreateRole(requestCtx, &genapi.CreateRoleRequest{
 			Name:         "role 1",
@@ -112,6 +146,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			&serviceRepoStub{},
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		emptyCtx := testutil.RequestContextWithLogger(context.Background())
@@ -130,6 +165,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			&serviceRepoStub{},
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateRole(requestCtx, &genapi.CreateRoleRequest{
@@ -147,6 +183,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			&serviceRepoStub{storeID: theStoreID},
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateRole(requestCtx, &genapi.CreateRoleRequest{
@@ -169,6 +206,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			repo,
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateRoleRequest{
@@ -187,6 +225,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			&serviceRepoStub{nameTakenErr: errors.New("oh no!"), storeID: theStoreID},
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateRole(requestCtx, &genapi.CreateRoleRequest{
@@ -204,6 +243,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			&serviceRepoStub{createRoleErr: errors.New("oh no!"), storeID: theStoreID},
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateRole(requestCtx, &genapi.CreateRoleRequest{
@@ -221,6 +261,7 @@ func TestCreateRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			&serviceRepoStub{createRoleErr: errors.New("oh no!"), storeID: theStoreID},
 			testutil.NewPermissionProviderStub(theRoleID, nil, errors.New("oh no!")),
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateRole(requestCtx, &genapi.CreateRoleRequest{
@@ -274,6 +315,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			repo,
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -295,6 +337,34 @@ func TestAssignPermissionsToRole(t *testing.T) {
 		assert.ElementsMatch(t, permissionIDs, repo.assignPermissionsCalledWith.permissionIDs)
 	})
 
+	t.Run("records the assigned permissions in the audit log", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog := testutil.NewAuditLogStub()
+
+		s := permission.NewService(
+			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
+			baseRepo(),
+			qualifyingPermissionProvider,
+			auditLog,
+		)
+
+		req := &genapi.AssignPermissionsToRoleRequest{
+			Permissions: thePermissions,
+		}
+
+		err := s.AssignPermissionsToRole(requestCtx, req, genapi.AssignPermissionsToRoleParams{RoleId: theRoleID})
+		require.NoError(t, err)
+
+		require.Len(t, auditLog.Changes, 1)
+
+		got := auditLog.Changes[0]
+		assert.Equal(t, audit.ActionRolePermissionsAssigned, got.Action)
+		assert.Equal(t, audit.EntityTypeRole, got.EntityType)
+		assert.Equal(t, theRoleID, got.EntityID)
+		assert.Equal(t, req, got.After)
+	})
+
 	t.Run("returns no error when request is valid", func(t *testing.T) {
 		t.Parallel()
 
@@ -302,6 +372,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			baseRepo(),
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -321,6 +392,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			baseRepo(),
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -341,6 +413,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			baseRepo(),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -360,6 +433,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			baseRepo(),
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -386,6 +460,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			baseRepo(),
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -408,6 +483,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			repo,
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -430,6 +506,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			repo,
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -452,6 +529,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			repo,
 			qualifyingPermissionProvider,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
@@ -471,6 +549,7 @@ func TestAssignPermissionsToRole(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForRole(url.URL{}),
 			baseRepo(),
 			testutil.NewPermissionProviderStub(theRoleID, nil, errors.New("oh no!")),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.AssignPermissionsToRoleRequest{
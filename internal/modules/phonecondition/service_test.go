@@ -14,6 +14,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/modules/phonecondition"
@@ -54,6 +55,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneConditionRequest{
@@ -71,6 +73,55 @@ func TestCreatePhoneCondition(t *testing.T) {
 		assert.Equal(t, theStoreID, repo.createCalledWith.storeID)
 	})
 
+	t.Run("records creation in audit log", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+
+		s := phonecondition.NewService(
+			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		req := &genapi.CreatePhoneConditionRequest{
+			Name: "phone condition 1",
+		}
+
+		_, err := s.CreatePhoneCondition(requestCtx, req)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.createCalledWith)
+		require.Len(t, auditLog.Changes, 1)
+
+		assert.Equal(t, audit.ActionPhoneConditionCreated, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypePhoneCondition, auditLog.Changes[0].EntityType)
+		assert.Equal(t, repo.createCalledWith.id, auditLog.Changes[0].EntityID)
+		assert.Equal(t, req, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns internal server error when audit log fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.SetError(errors.New("oh no"))
+
+		s := phonecondition.NewService(
+			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		_, err := s.CreatePhoneCondition(requestCtx, &genapi.CreatePhoneConditionRequest{
+			Name: "phone condition 1",
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
 	t.Run("returns resource location when phone condition is created", func(t *testing.T) {
 		t.Parallel()
 
@@ -85,7 +136,12 @@ func TestCreatePhoneCondition(t *testing.T) {
 		resourceLocationProvider := testutil.NewResourceLocationProviderStubForPhoneCondition(theLocation)
 		repo := &repositoryStub{storeID: theStoreID}
 
-		s := phonecondition.NewService(resourceLocationProvider, qualifyingPermissionProvider, repo)
+		s := phonecondition.NewService(
+			resourceLocationProvider,
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
 
 		got, err := s.CreatePhoneCondition(requestCtx, &genapi.CreatePhoneConditionRequest{
 			Name: "phone condition 1",
@@ -109,6 +165,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		emptyCtx := testutil.RequestContextWithLogger(context.Background())
@@ -126,6 +183,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneCondition(requestCtx, &genapi.CreatePhoneConditionRequest{
@@ -142,6 +200,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneCondition(requestCtx, &genapi.CreatePhoneConditionRequest{
@@ -163,6 +222,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneConditionRequest{
@@ -180,6 +240,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, errors.New("oh no!")),
 			&repositoryStub{nameTakenErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneCondition(requestCtx, &genapi.CreatePhoneConditionRequest{
@@ -196,6 +257,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{nameTakenErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneCondition(requestCtx, &genapi.CreatePhoneConditionRequest{
@@ -212,6 +274,7 @@ func TestCreatePhoneCondition(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneCondition(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneCondition(requestCtx, &genapi.CreatePhoneConditionRequest{
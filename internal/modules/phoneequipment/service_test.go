@@ -14,6 +14,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/modules/phoneequipment"
@@ -54,6 +55,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneEquipmentRequest{
@@ -71,6 +73,55 @@ func TestCreatePhoneEquipment(t *testing.T) {
 		assert.Equal(t, theStoreID, repo.createCalledWith.storeID)
 	})
 
+	t.Run("records creation in audit log", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+
+		s := phoneequipment.NewService(
+			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		req := &genapi.CreatePhoneEquipmentRequest{
+			Name: "phone equipment 1",
+		}
+
+		_, err := s.CreatePhoneEquipment(requestCtx, req)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.createCalledWith)
+		require.Len(t, auditLog.Changes, 1)
+
+		assert.Equal(t, audit.ActionPhoneEquipmentCreated, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypePhoneEquipment, auditLog.Changes[0].EntityType)
+		assert.Equal(t, repo.createCalledWith.id, auditLog.Changes[0].EntityID)
+		assert.Equal(t, req, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns internal server error when audit log fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.SetError(errors.New("oh no"))
+
+		s := phoneequipment.NewService(
+			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		_, err := s.CreatePhoneEquipment(requestCtx, &genapi.CreatePhoneEquipmentRequest{
+			Name: "phone equipment 1",
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
 	t.Run("returns resource location when phone equipment is created", func(t *testing.T) {
 		t.Parallel()
 
@@ -85,7 +136,12 @@ func TestCreatePhoneEquipment(t *testing.T) {
 		resourceLocationProvider := testutil.NewResourceLocationProviderStubForPhoneEquipment(theLocation)
 		repo := &repositoryStub{storeID: theStoreID}
 
-		s := phoneequipment.NewService(resourceLocationProvider, qualifyingPermissionProvider, repo)
+		s := phoneequipment.NewService(
+			resourceLocationProvider,
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
 
 		got, err := s.CreatePhoneEquipment(requestCtx, &genapi.CreatePhoneEquipmentRequest{
 			Name: "phone equipment 1",
@@ -109,6 +165,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		emptyCtx := testutil.RequestContextWithLogger(context.Background())
@@ -126,6 +183,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneEquipment(requestCtx, &genapi.CreatePhoneEquipmentRequest{
@@ -142,6 +200,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneEquipment(requestCtx, &genapi.CreatePhoneEquipmentRequest{
@@ -163,6 +222,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreatePhoneEquipmentRequest{
@@ -180,6 +240,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{nameTakenErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneEquipment(requestCtx, &genapi.CreatePhoneEquipmentRequest{
@@ -196,6 +257,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneEquipment(requestCtx, &genapi.CreatePhoneEquipmentRequest{
@@ -212,6 +274,7 @@ func TestCreatePhoneEquipment(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForPhoneEquipment(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, errors.New("oh no!")),
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreatePhoneEquipment(requestCtx, &genapi.CreatePhoneEquipmentRequest{
@@ -0,0 +1,65 @@
+package photo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+// MaxUploadSize is the largest photo, in bytes, that can be uploaded.
+const MaxUploadSize = 10 << 20
+
+const (
+	ContentTypeJPEG = "image/jpeg"
+	ContentTypePNG  = "image/png"
+)
+
+// Photo is an image that can be attached to the repair orders of its store.
+// Photos attached before uploads were supported only have a SourceURL.
+type Photo struct {
+	ID               uuid.UUID
+	StoreID          uuid.UUID
+	ContentType      string
+	BlobKey          optional.Optional[string]
+	ThumbnailBlobKey optional.Optional[string]
+	SourceURL        optional.Optional[string]
+	UploaderID       optional.Optional[uuid.UUID]
+	CreationTime     time.Time
+}
+
+// BlobStore keeps the contents of uploaded photos. Get and Delete return
+// apperror.ErrBlobNotFound when nothing is stored under the key.
+type BlobStore interface {
+	Put(ctx context.Context, key string, contentType string, data []byte) error
+	Get(ctx context.Context, key string) ([]byte, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// ProcessedImage is an uploaded image that is safe to store.
+type ProcessedImage struct {
+	ContentType string
+	Data        []byte
+	Thumbnail   []byte
+}
+
+// Processor validates an uploaded image, strips its location data and creates
+// its thumbnail. It returns apperror.ErrInvalidInput when the image isn't a
+// supported one.
+type Processor interface {
+	Process(data []byte) (ProcessedImage, error)
+}
+
+type TimeProvider interface {
+	Now() time.Time
+}
+
+func blobKey(storeID uuid.UUID, photoID uuid.UUID) string {
+	return fmt.Sprintf("photos/%s/%s", storeID, photoID)
+}
+
+func thumbnailBlobKey(storeID uuid.UUID, photoID uuid.UUID) string {
+	return fmt.Sprintf("photos/%s/%s.thumbnail", storeID, photoID)
+}
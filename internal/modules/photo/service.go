@@ -0,0 +1,262 @@
+package photo
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/JosephJoshua/remana-backend/internal/apierror"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+type Repository interface {
+	CreatePhoto(ctx context.Context, photo Photo) error
+	GetPhotoByID(ctx context.Context, storeID uuid.UUID, photoID uuid.UUID) (Photo, error)
+}
+
+type ResourceLocationProvider interface {
+	Photo(photoID uuid.UUID) url.URL
+}
+
+type AuditRecorder interface {
+	Record(ctx context.Context, change audit.Change) error
+}
+
+type Service struct {
+	timeProvider             TimeProvider
+	resourceLocationProvider ResourceLocationProvider
+	permissionProvider       permission.Provider
+	repo                     Repository
+	blobStore                BlobStore
+	processor                Processor
+	auditRecorder            AuditRecorder
+}
+
+func NewService(
+	timeProvider TimeProvider,
+	resourceLocationProvider ResourceLocationProvider,
+	permissionProvider permission.Provider,
+	repo Repository,
+	blobStore BlobStore,
+	processor Processor,
+	auditRecorder AuditRecorder,
+) *Service {
+	return &Service{
+		timeProvider:             timeProvider,
+		resourceLocationProvider: resourceLocationProvider,
+		permissionProvider:       permissionProvider,
+		repo:                     repo,
+		blobStore:                blobStore,
+		processor:                processor,
+		auditRecorder:            auditRecorder,
+	}
+}
+
+func (s *Service) UploadPhoto(ctx context.Context, req *genapi.UploadPhotoReq) (*genapi.PhotoHeaders, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.UploadPhoto()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if req.File.File == nil {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "file is required")
+	}
+
+	data, err := io.ReadAll(io.LimitReader(req.File.File, MaxUploadSize+1))
+	if err != nil {
+		l.Error().Err(err).Msg("failed to read uploaded file")
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "failed to read uploaded file")
+	}
+
+	if len(data) > MaxUploadSize {
+		return nil, apierror.ToAPIError(http.StatusRequestEntityTooLarge, "photo is too large")
+	}
+
+	image, err := s.processor.Process(data)
+	if errors.Is(err, apperror.ErrInvalidInput) {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to process photo")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to process photo")
+	}
+
+	photo := Photo{
+		ID:           uuid.New(),
+		StoreID:      user.Store.ID,
+		ContentType:  image.ContentType,
+		UploaderID:   optional.Some(user.ID),
+		CreationTime: s.timeProvider.Now(),
+	}
+
+	key := blobKey(photo.StoreID, photo.ID)
+	if err = s.blobStore.Put(ctx, key, image.ContentType, image.Data); err != nil {
+		l.Error().Err(err).Msg("failed to store photo")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to store photo")
+	}
+
+	photo.BlobKey = optional.Some(key)
+
+	thumbnailKey := thumbnailBlobKey(photo.StoreID, photo.ID)
+	if err = s.blobStore.Put(ctx, thumbnailKey, ContentTypeJPEG, image.Thumbnail); err != nil {
+		l.Error().Err(err).Msg("failed to store photo thumbnail")
+		s.deleteBlobs(ctx, l, key)
+
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to store photo")
+	}
+
+	photo.ThumbnailBlobKey = optional.Some(thumbnailKey)
+
+	if err = s.repo.CreatePhoto(ctx, photo); err != nil {
+		l.Error().Err(err).Msg("failed to create photo")
+		s.deleteBlobs(ctx, l, key, thumbnailKey)
+
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create photo")
+	}
+
+	res := toAPIPhoto(photo)
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionPhotoUploaded,
+		EntityType: audit.EntityTypePhoto,
+		EntityID:   photo.ID,
+		After:      res,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &genapi.PhotoHeaders{
+		Location: s.resourceLocationProvider.Photo(photo.ID),
+		Response: res,
+	}, nil
+}
+
+func (s *Service) GetPhoto(ctx context.Context, params genapi.GetPhotoParams) (genapi.GetPhotoRes, error) {
+	l := zerolog.Ctx(ctx)
+
+	photo, err := s.getViewablePhoto(ctx, l, params.PhotoId)
+	if err != nil {
+		return nil, err
+	}
+
+	if sourceURL, ok := photo.SourceURL.Get(); ok {
+		location, parseErr := url.Parse(sourceURL)
+		if parseErr != nil {
+			l.Error().Err(parseErr).Msg("failed to parse photo source URL")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get photo")
+		}
+
+		return &genapi.GetPhotoFound{Location: *location}, nil
+	}
+
+	data, err := s.getBlob(ctx, l, photo.BlobKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if photo.ContentType == ContentTypePNG {
+		return &genapi.GetPhotoOKImagePNG{Data: bytes.NewReader(data)}, nil
+	}
+
+	return &genapi.GetPhotoOKImageJpeg{Data: bytes.NewReader(data)}, nil
+}
+
+func (s *Service) GetPhotoThumbnail(
+	ctx context.Context,
+	params genapi.GetPhotoThumbnailParams,
+) (genapi.GetPhotoThumbnailOK, error) {
+	l := zerolog.Ctx(ctx)
+
+	photo, err := s.getViewablePhoto(ctx, l, params.PhotoId)
+	if err != nil {
+		return genapi.GetPhotoThumbnailOK{}, err
+	}
+
+	if !photo.ThumbnailBlobKey.IsSet() {
+		return genapi.GetPhotoThumbnailOK{}, apierror.ToAPIError(http.StatusNotFound, "photo has no thumbnail")
+	}
+
+	data, err := s.getBlob(ctx, l, photo.ThumbnailBlobKey)
+	if err != nil {
+		return genapi.GetPhotoThumbnailOK{}, err
+	}
+
+	return genapi.GetPhotoThumbnailOK{Data: bytes.NewReader(data)}, nil
+}
+
+func (s *Service) getViewablePhoto(ctx context.Context, l *zerolog.Logger, photoID uuid.UUID) (Photo, error) {
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return Photo{}, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return Photo{}, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return Photo{}, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	photo, err := s.repo.GetPhotoByID(ctx, user.Store.ID, photoID)
+	if errors.Is(err, apperror.ErrPhotoNotFound) {
+		return Photo{}, apierror.ToAPIError(http.StatusNotFound, "photo not found")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to get photo")
+		return Photo{}, apierror.ToAPIError(http.StatusInternalServerError, "failed to get photo")
+	}
+
+	return photo, nil
+}
+
+func (s *Service) getBlob(ctx context.Context, l *zerolog.Logger, key optional.Optional[string]) ([]byte, error) {
+	k, ok := key.Get()
+	if !ok {
+		l.Error().Msg("photo has neither a blob nor a source URL")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get photo")
+	}
+
+	data, err := s.blobStore.Get(ctx, k)
+	if err != nil {
+		l.Error().Err(err).Str("key", k).Msg("failed to get photo blob")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get photo")
+	}
+
+	return data, nil
+}
+
+func (s *Service) deleteBlobs(ctx context.Context, l *zerolog.Logger, keys ...string) {
+	for _, key := range keys {
+		if err := s.blobStore.Delete(ctx, key); err != nil {
+			l.Warn().Err(err).Str("key", key).Msg("failed to delete orphaned photo blob")
+		}
+	}
+}
+
+func toAPIPhoto(photo Photo) genapi.Photo {
+	return genapi.Photo{
+		ID:           photo.ID,
+		ContentType:  photo.ContentType,
+		HasThumbnail: photo.ThumbnailBlobKey.IsSet(),
+		CreationTime: photo.CreationTime,
+	}
+}
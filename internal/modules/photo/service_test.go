@@ -0,0 +1,569 @@
+//go:build unit
+// +build unit
+
+package photo_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
+	"github.com/JosephJoshua/remana-backend/internal/modules/photo"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	ht "github.com/ogen-go/ogen/http"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUploadPhoto(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.UploadPhoto()},
+		nil,
+	)
+
+	newRequest := func(data string) *genapi.UploadPhotoReq {
+		return &genapi.UploadPhotoReq{
+			File: ht.MultipartFile{Name: "photo.jpg", File: strings.NewReader(data)},
+		}
+	}
+
+	t.Run("stores the processed photo and its thumbnail", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		blobStore := newBlobStoreStub()
+		processor := &processorStub{image: photo.ProcessedImage{
+			ContentType: photo.ContentTypeJPEG,
+			Data:        []byte("stripped"),
+			Thumbnail:   []byte("thumbnail"),
+		}}
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			blobStore,
+			processor,
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.UploadPhoto(requestCtx, newRequest("original"))
+		require.NoError(t, err)
+
+		assert.Equal(t, []byte("original"), processor.calledWith)
+
+		require.Len(t, repo.photos, 1)
+		created := repo.photos[got.Response.ID]
+
+		assert.Equal(t, theStoreID, created.StoreID)
+		assert.Equal(t, photo.ContentTypeJPEG, created.ContentType)
+		assert.Equal(t, theTime, created.CreationTime)
+		assert.True(t, created.UploaderID.IsSet())
+
+		assert.Equal(t, []byte("stripped"), blobStore.blobs[created.BlobKey.MustGet()])
+		assert.Equal(t, []byte("thumbnail"), blobStore.blobs[created.ThumbnailBlobKey.MustGet()])
+
+		assert.Equal(t, photo.ContentTypeJPEG, got.Response.ContentType)
+		assert.True(t, got.Response.HasThumbnail)
+	})
+
+	t.Run("records upload in audit log", func(t *testing.T) {
+		t.Parallel()
+
+		auditLog := testutil.NewAuditLogStub()
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			newRepositoryStub(),
+			newBlobStoreStub(),
+			&processorStub{image: photo.ProcessedImage{ContentType: photo.ContentTypeJPEG}},
+			auditLog,
+		)
+
+		got, err := s.UploadPhoto(requestCtx, newRequest("original"))
+		require.NoError(t, err)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionPhotoUploaded, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypePhoto, auditLog.Changes[0].EntityType)
+		assert.Equal(t, got.Response.ID, auditLog.Changes[0].EntityID)
+		assert.Equal(t, got.Response, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns resource location when photo is uploaded", func(t *testing.T) {
+		t.Parallel()
+
+		theLocation := url.URL{Path: "/photos/ef21dc9e-c364-41cd-8c03-fa289d11e3a7"}
+		resourceLocationProvider := testutil.NewResourceLocationProviderStubForPhoto(theLocation)
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			resourceLocationProvider,
+			qualifyingPermissionProvider,
+			newRepositoryStub(),
+			newBlobStoreStub(),
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.UploadPhoto(requestCtx, newRequest("original"))
+		require.NoError(t, err)
+
+		assert.Equal(t, theLocation, got.Location)
+		assert.Equal(t, got.Response.ID, resourceLocationProvider.PhotoID.MustGet())
+	})
+
+	t.Run("returns bad request when processor rejects the photo", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		blobStore := newBlobStoreStub()
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			blobStore,
+			&processorStub{err: apperror.ErrInvalidInput},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.UploadPhoto(requestCtx, newRequest("not an image"))
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+
+		assert.Empty(t, repo.photos)
+		assert.Empty(t, blobStore.blobs)
+	})
+
+	t.Run("returns request entity too large when photo is too large", func(t *testing.T) {
+		t.Parallel()
+
+		processor := &processorStub{}
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			newRepositoryStub(),
+			newBlobStoreStub(),
+			processor,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.UploadPhoto(requestCtx, newRequest(strings.Repeat("a", photo.MaxUploadSize+1)))
+		testutil.AssertAPIStatusCode(t, http.StatusRequestEntityTooLarge, err)
+
+		assert.Nil(t, processor.calledWith)
+	})
+
+	t.Run("deletes the stored blobs when photo can't be created", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		repo.err = errors.New("oh no!")
+		blobStore := newBlobStoreStub()
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			blobStore,
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.UploadPhoto(requestCtx, newRequest("original"))
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+
+		assert.Empty(t, blobStore.blobs)
+	})
+
+	t.Run("returns forbidden when role doesn't have permission", func(t *testing.T) {
+		t.Parallel()
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			newRepositoryStub(),
+			newBlobStoreStub(),
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.UploadPhoto(requestCtx, newRequest("original"))
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			newRepositoryStub(),
+			newBlobStoreStub(),
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.UploadPhoto(testutil.RequestContextWithLogger(context.Background()), newRequest("original"))
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestGetPhoto(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.ViewRepairOrder()},
+		nil,
+	)
+
+	t.Run("returns the contents of an uploaded photo", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		blobStore := newBlobStoreStub()
+		existing := repo.addUploadedPhoto(blobStore, theStoreID, photo.ContentTypePNG)
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			blobStore,
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.GetPhoto(requestCtx, genapi.GetPhotoParams{PhotoId: existing.ID})
+		require.NoError(t, err)
+
+		png, ok := got.(*genapi.GetPhotoOKImagePNG)
+		require.True(t, ok)
+
+		data, err := io.ReadAll(png.Data)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("photo"), data)
+	})
+
+	t.Run("redirects to the source URL of a legacy photo", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := photo.Photo{
+			ID:          uuid.New(),
+			StoreID:     theStoreID,
+			ContentType: "application/octet-stream",
+			SourceURL:   optional.Some("https://example.com/photo.jpg"),
+		}
+		repo.photos[existing.ID] = existing
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			newBlobStoreStub(),
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.GetPhoto(requestCtx, genapi.GetPhotoParams{PhotoId: existing.ID})
+		require.NoError(t, err)
+
+		found, ok := got.(*genapi.GetPhotoFound)
+		require.True(t, ok)
+		assert.Equal(t, "https://example.com/photo.jpg", found.Location.String())
+	})
+
+	t.Run("returns not found when photo belongs to another store", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		blobStore := newBlobStoreStub()
+		existing := repo.addUploadedPhoto(blobStore, uuid.New(), photo.ContentTypeJPEG)
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			blobStore,
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.GetPhoto(requestCtx, genapi.GetPhotoParams{PhotoId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when role doesn't have permission", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		blobStore := newBlobStoreStub()
+		existing := repo.addUploadedPhoto(blobStore, theStoreID, photo.ContentTypeJPEG)
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			repo,
+			blobStore,
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.GetPhoto(requestCtx, genapi.GetPhotoParams{PhotoId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error when blob is missing", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addUploadedPhoto(newBlobStoreStub(), theStoreID, photo.ContentTypeJPEG)
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			newBlobStoreStub(),
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.GetPhoto(requestCtx, genapi.GetPhotoParams{PhotoId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestGetPhotoThumbnail(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.ViewRepairOrder()},
+		nil,
+	)
+
+	t.Run("returns the thumbnail of an uploaded photo", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		blobStore := newBlobStoreStub()
+		existing := repo.addUploadedPhoto(blobStore, theStoreID, photo.ContentTypeJPEG)
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			blobStore,
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.GetPhotoThumbnail(requestCtx, genapi.GetPhotoThumbnailParams{PhotoId: existing.ID})
+		require.NoError(t, err)
+
+		data, err := io.ReadAll(got.Data)
+		require.NoError(t, err)
+		assert.Equal(t, []byte("thumbnail"), data)
+	})
+
+	t.Run("returns not found when photo has no thumbnail", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := photo.Photo{
+			ID:          uuid.New(),
+			StoreID:     theStoreID,
+			ContentType: "application/octet-stream",
+			SourceURL:   optional.Some("https://example.com/photo.jpg"),
+		}
+		repo.photos[existing.ID] = existing
+
+		s := photo.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForPhoto(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			newBlobStoreStub(),
+			&processorStub{},
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.GetPhotoThumbnail(requestCtx, genapi.GetPhotoThumbnailParams{PhotoId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+}
+
+func newRequestContext(storeID uuid.UUID, roleID uuid.UUID) context.Context {
+	return appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = storeID
+			details.Role.ID = roleID
+		}),
+	)
+}
+
+type repositoryStub struct {
+	photos map[uuid.UUID]photo.Photo
+	err    error
+}
+
+func newRepositoryStub() *repositoryStub {
+	return &repositoryStub{
+		photos: make(map[uuid.UUID]photo.Photo),
+	}
+}
+
+func (r *repositoryStub) addUploadedPhoto(blobStore *blobStoreStub, storeID uuid.UUID, contentType string) photo.Photo {
+	p := photo.Photo{
+		ID:               uuid.New(),
+		StoreID:          storeID,
+		ContentType:      contentType,
+		BlobKey:          optional.Some("photo-key"),
+		ThumbnailBlobKey: optional.Some("thumbnail-key"),
+	}
+
+	blobStore.blobs["photo-key"] = []byte("photo")
+	blobStore.blobs["thumbnail-key"] = []byte("thumbnail")
+
+	r.photos[p.ID] = p
+	return p
+}
+
+func (r *repositoryStub) CreatePhoto(_ context.Context, p photo.Photo) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.photos[p.ID] = p
+	return nil
+}
+
+func (r *repositoryStub) GetPhotoByID(_ context.Context, storeID uuid.UUID, photoID uuid.UUID) (photo.Photo, error) {
+	if r.err != nil {
+		return photo.Photo{}, r.err
+	}
+
+	p, ok := r.photos[photoID]
+	if !ok || p.StoreID != storeID {
+		return photo.Photo{}, apperror.ErrPhotoNotFound
+	}
+
+	return p, nil
+}
+
+type blobStoreStub struct {
+	blobs map[string][]byte
+}
+
+func newBlobStoreStub() *blobStoreStub {
+	return &blobStoreStub{
+		blobs: make(map[string][]byte),
+	}
+}
+
+func (b *blobStoreStub) Put(_ context.Context, key string, _ string, data []byte) error {
+	b.blobs[key] = bytes.Clone(data)
+	return nil
+}
+
+func (b *blobStoreStub) Get(_ context.Context, key string) ([]byte, error) {
+	data, ok := b.blobs[key]
+	if !ok {
+		return nil, apperror.ErrBlobNotFound
+	}
+
+	return data, nil
+}
+
+func (b *blobStoreStub) Delete(_ context.Context, key string) error {
+	if _, ok := b.blobs[key]; !ok {
+		return apperror.ErrBlobNotFound
+	}
+
+	delete(b.blobs, key)
+	return nil
+}
+
+type processorStub struct {
+	image photo.ProcessedImage
+	err   error
+
+	calledWith []byte
+}
+
+func (p *processorStub) Process(data []byte) (photo.ProcessedImage, error) {
+	p.calledWith = data
+
+	if p.err != nil {
+		return photo.ProcessedImage{}, p.err
+	}
+
+	if p.image.ContentType == "" {
+		return photo.ProcessedImage{ContentType: photo.ContentTypeJPEG, Data: data, Thumbnail: data}, nil
+	}
+
+	return p.image, nil
+}
@@ -2,7 +2,8 @@ package domain
 
 import (
 	"fmt"
-	"net/url"
+	"math"
+	"slices"
 	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
@@ -13,20 +14,36 @@ import (
 
 type Order interface {
 	// ChangeContactPhoneNumber(newPhoneNumber shareddomain.PhoneNumber)
-	// AddDamage(damage string)
-	// RemoveDamage(damage string)
-	// AddPhoneCondition(condition string)
-	// RemovePhoneCondition(condition string)
-	// MutateCost(amount int, reason string)
-	// AddPhoto(photoID uuid.UUID)
-	// ChangeTechnician(newTechnicianID uuid.UUID)
-	// ConfirmToCustomer(time time.Time, contents string)
-	// PickUpByCustomer(repayment OrderPayment)
-	// CompleteRepair()
-	// Cancel()
+
+	AddDamage(name string) error
+	RemoveDamage(damageID uuid.UUID) error
+	AddPhoneCondition(name string) error
+	RemovePhoneCondition(phoneConditionID uuid.UUID) error
+	AddPhoneEquipment(name string) error
+	RemovePhoneEquipment(phoneEquipmentID uuid.UUID) error
+	AddPhoto(creationTime time.Time, params NewOrderPhotoParams) error
+	RemovePhoto(orderPhotoID uuid.UUID) error
+	MutateCost(creationTime time.Time, amount int, reason string) error
+	RecordPayment(creationTime time.Time, paymentType OrderPaymentType, params NewOrderPaymentParams) error
+	ChangeTechnician(changeTime time.Time, technicianID uuid.UUID, reason string) error
+
+	ConfirmToCustomer(confirmationTime time.Time, contents string) error
+	CompleteRepair(completionTime time.Time, requiresConfirmation bool) error
+	PickUpByCustomer(
+		pickUpTime time.Time,
+		finalPayment optional.Optional[NewOrderPaymentParams],
+		writeOffReason optional.Optional[string],
+	) error
+	Cancel(
+		cancellationTime time.Time,
+		reason string,
+		fee optional.Optional[uint],
+		refund optional.Optional[NewOrderRefundParams],
+	) error
 
 	ID() uuid.UUID
 	CreationTime() time.Time
+	Status() OrderStatus
 	Slug() string
 	StoreID() uuid.UUID
 	CustomerName() string
@@ -35,7 +52,11 @@ type Order interface {
 	Color() string
 	SalesPersonID() uuid.UUID
 	TechnicianID() uuid.UUID
+	TechnicianAssignments() []OrderTechnicianAssignment
 	Costs() []OrderCost
+	TotalCost() int
+	PaidAmount() int
+	OutstandingAmount() int
 	PhoneConditions() []PhoneCondition
 	PhoneEquipments() []PhoneEquipment
 	Damages() []Damage
@@ -43,14 +64,25 @@ type Order interface {
 	IMEI() optional.Optional[string]
 	PartsNotCheckedYet() optional.Optional[string]
 	PhoneSecurityDetails() optional.Optional[PhoneSecurityDetails]
+	EstimatedCompletionTime() optional.Optional[time.Time]
 	ConfirmationTime() optional.Optional[time.Time]
 	ConfirmationContents() optional.Optional[string]
 	PickUpTime() optional.Optional[time.Time]
 	CompletionTime() optional.Optional[time.Time]
 	CancellationTime() optional.Optional[time.Time]
 	CancellationReason() optional.Optional[string]
-	DownPayment() optional.Optional[OrderPayment]
-	Repayment() optional.Optional[OrderPayment]
+	CancellationFee() optional.Optional[uint]
+	Payments() []OrderPayment
+	WriteOff() optional.Optional[OrderWriteOff]
+
+	// Events returns the events recorded since the order was created or
+	// restored, or since ClearEvents was last called.
+	Events() []OrderEvent
+	ClearEvents()
+
+	// Version is the version the order was restored at, which the repository
+	// uses to detect concurrent updates. New orders start at 0.
+	Version() int
 }
 
 type order struct {
@@ -64,6 +96,7 @@ type order struct {
 	color                string
 	salesPersonID        uuid.UUID
 	technicianID         uuid.UUID
+	assignments          []OrderTechnicianAssignment
 	costs                []OrderCost
 	phoneConditions      []PhoneCondition
 	phoneEquipments      []PhoneEquipment
@@ -72,35 +105,40 @@ type order struct {
 	imei                 optional.Optional[string]
 	partsNotCheckedYet   optional.Optional[string]
 	phoneSecurityDetails optional.Optional[PhoneSecurityDetails]
+	estimatedCompletion  optional.Optional[time.Time]
 	confirmationTime     optional.Optional[time.Time]
 	confirmationContents optional.Optional[string]
 	pickUpTime           optional.Optional[time.Time]
 	completionTime       optional.Optional[time.Time]
 	cancellationTime     optional.Optional[time.Time]
 	cancellationReason   optional.Optional[string]
-	downPayment          optional.Optional[OrderPayment]
-	repayment            optional.Optional[OrderPayment]
+	cancellationFee      optional.Optional[uint]
+	payments             []OrderPayment
+	writeOff             optional.Optional[OrderWriteOff]
+	events               []OrderEvent
+	version              int
 }
 
 type NewOrderParams struct {
-	CreationTime         time.Time
-	Slug                 string
-	StoreID              uuid.UUID
-	CustomerName         string
-	ContactNumber        shareddomain.PhoneNumber
-	PhoneType            string
-	Color                string
-	InitialCost          uint
-	PhoneConditions      []string
-	PhoneEquipments      []string
-	Damages              []string
-	Photos               []url.URL
-	SalesPersonID        uuid.UUID
-	TechnicianID         uuid.UUID
-	Imei                 optional.Optional[string]
-	PartsNotCheckedYet   optional.Optional[string]
-	DownPayment          optional.Optional[OrderPayment]
-	PhoneSecurityDetails optional.Optional[PhoneSecurityDetails]
+	CreationTime            time.Time
+	Slug                    string
+	StoreID                 uuid.UUID
+	CustomerName            string
+	ContactNumber           shareddomain.PhoneNumber
+	PhoneType               string
+	Color                   string
+	InitialCost             uint
+	PhoneConditions         []string
+	PhoneEquipments         []string
+	Damages                 []string
+	Photos                  []uuid.UUID
+	SalesPersonID           uuid.UUID
+	TechnicianID            uuid.UUID
+	Imei                    optional.Optional[string]
+	PartsNotCheckedYet      optional.Optional[string]
+	DownPayment             optional.Optional[NewOrderPaymentParams]
+	PhoneSecurityDetails    optional.Optional[PhoneSecurityDetails]
+	EstimatedCompletionTime optional.Optional[time.Time]
 }
 
 func NewOrder(
@@ -146,11 +184,32 @@ func NewOrder(
 	}
 
 	photoVOs := make([]OrderPhoto, 0, len(params.Photos))
-	for _, photo := range params.Photos {
-		photoVO := newOrderPhoto(uuid.New(), photo)
+	for _, photoID := range params.Photos {
+		photoVO := newOrderPhoto(uuid.New(), photoID, OrderPhotoStageIntake, optional.None[string](), params.CreationTime)
 		photoVOs = append(photoVOs, photoVO)
 	}
 
+	paymentVOs := make([]OrderPayment, 0, 1)
+	if downPayment, ok := params.DownPayment.Get(); ok {
+		if downPayment.Amount > params.InitialCost {
+			return nil, fmt.Errorf("%w: down payment is greater than the initial cost", apperror.ErrInvalidInput)
+		}
+
+		payment, err := newOrderPayment(
+			uuid.New(),
+			OrderPaymentTypeDownPayment,
+			downPayment.Amount,
+			downPayment.PaymentMethodID,
+			optional.None[string](),
+			params.CreationTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create down payment: %w", err)
+		}
+
+		paymentVOs = append(paymentVOs, payment)
+	}
+
 	o := &order{
 		id:                   uuid.New(),
 		creationTime:         params.CreationTime,
@@ -170,19 +229,710 @@ func NewOrder(
 		imei:                 params.Imei,
 		partsNotCheckedYet:   params.PartsNotCheckedYet,
 		phoneSecurityDetails: params.PhoneSecurityDetails,
-		downPayment:          params.DownPayment,
+		estimatedCompletion:  params.EstimatedCompletionTime,
+		payments:             paymentVOs,
 		confirmationTime:     optional.None[time.Time](),
 		confirmationContents: optional.None[string](),
 		pickUpTime:           optional.None[time.Time](),
 		completionTime:       optional.None[time.Time](),
 		cancellationTime:     optional.None[time.Time](),
 		cancellationReason:   optional.None[string](),
-		repayment:            optional.None[OrderPayment](),
+		cancellationFee:      optional.None[uint](),
+		writeOff:             optional.None[OrderWriteOff](),
+	}
+
+	o.recordEvent(OrderCreated{OrderEventMetadata: o.newEventMetadata(params.CreationTime)})
+
+	return o, nil
+}
+
+type RestoreOrderParams struct {
+	ID                      uuid.UUID
+	CreationTime            time.Time
+	Slug                    string
+	StoreID                 uuid.UUID
+	CustomerName            string
+	ContactNumber           shareddomain.PhoneNumber
+	PhoneType               string
+	Color                   string
+	SalesPersonID           uuid.UUID
+	TechnicianID            uuid.UUID
+	TechnicianAssignments   []RestoreOrderTechnicianAssignmentParams
+	Costs                   []RestoreOrderCostParams
+	PhoneConditions         []RestoreOrderItemParams
+	PhoneEquipments         []RestoreOrderItemParams
+	Damages                 []RestoreOrderItemParams
+	Photos                  []RestoreOrderPhotoParams
+	Imei                    optional.Optional[string]
+	PartsNotCheckedYet      optional.Optional[string]
+	PhoneSecurityDetails    optional.Optional[PhoneSecurityDetails]
+	ConfirmationTime        optional.Optional[time.Time]
+	ConfirmationContents    optional.Optional[string]
+	PickUpTime              optional.Optional[time.Time]
+	CompletionTime          optional.Optional[time.Time]
+	CancellationTime        optional.Optional[time.Time]
+	CancellationReason      optional.Optional[string]
+	CancellationFee         optional.Optional[uint]
+	Payments                []RestoreOrderPaymentParams
+	WriteOff                optional.Optional[OrderWriteOff]
+	EstimatedCompletionTime optional.Optional[time.Time]
+	Version                 int
+}
+
+type RestoreOrderCostParams struct {
+	ID           uuid.UUID
+	Amount       int
+	Reason       optional.Optional[string]
+	CreationTime time.Time
+}
+
+type RestoreOrderTechnicianAssignmentParams struct {
+	ID                   uuid.UUID
+	PreviousTechnicianID uuid.UUID
+	TechnicianID         uuid.UUID
+	Reason               string
+	CreationTime         time.Time
+}
+
+type RestoreOrderPaymentParams struct {
+	ID              uuid.UUID
+	Type            OrderPaymentType
+	Amount          uint
+	PaymentMethodID uuid.UUID
+	Reason          optional.Optional[string]
+	CreationTime    time.Time
+}
+
+type RestoreOrderItemParams struct {
+	ID   uuid.UUID
+	Name string
+}
+
+type RestoreOrderPhotoParams struct {
+	ID           uuid.UUID
+	PhotoID      uuid.UUID
+	Stage        OrderPhotoStage
+	Caption      optional.Optional[string]
+	CreationTime time.Time
+}
+
+// RestoreOrder rebuilds an order that has already been persisted, so it
+// doesn't apply the validations that are only relevant when creating one.
+func RestoreOrder(params RestoreOrderParams) (Order, error) {
+	costVOs := make([]OrderCost, 0, len(params.Costs))
+	for _, cost := range params.Costs {
+		costVOs = append(costVOs, orderCost{
+			id:           cost.ID,
+			amount:       cost.Amount,
+			reason:       cost.Reason,
+			creationTime: cost.CreationTime,
+		})
+	}
+
+	assignmentVOs := make([]OrderTechnicianAssignment, 0, len(params.TechnicianAssignments))
+	for _, assignment := range params.TechnicianAssignments {
+		assignmentVOs = append(assignmentVOs, orderTechnicianAssignment{
+			id:                   assignment.ID,
+			previousTechnicianID: assignment.PreviousTechnicianID,
+			technicianID:         assignment.TechnicianID,
+			reason:               assignment.Reason,
+			creationTime:         assignment.CreationTime,
+		})
+	}
+
+	phoneConditionVOs := make([]PhoneCondition, 0, len(params.PhoneConditions))
+	for _, condition := range params.PhoneConditions {
+		phoneCondition, err := newPhoneCondition(condition.ID, condition.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore phone condition: %w", err)
+		}
+
+		phoneConditionVOs = append(phoneConditionVOs, phoneCondition)
+	}
+
+	phoneEquipmentVOs := make([]PhoneEquipment, 0, len(params.PhoneEquipments))
+	for _, equipment := range params.PhoneEquipments {
+		phoneEquipment, err := newPhoneEquipment(equipment.ID, equipment.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore phone equipment: %w", err)
+		}
+
+		phoneEquipmentVOs = append(phoneEquipmentVOs, phoneEquipment)
+	}
+
+	damageVOs := make([]Damage, 0, len(params.Damages))
+	for _, damage := range params.Damages {
+		damageVO, err := newDamage(damage.ID, damage.Name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore damage: %w", err)
+		}
+
+		damageVOs = append(damageVOs, damageVO)
+	}
+
+	photoVOs := make([]OrderPhoto, 0, len(params.Photos))
+	for _, photo := range params.Photos {
+		photoVOs = append(photoVOs, newOrderPhoto(photo.ID, photo.PhotoID, photo.Stage, photo.Caption, photo.CreationTime))
+	}
+
+	paymentVOs := make([]OrderPayment, 0, len(params.Payments))
+	for _, payment := range params.Payments {
+		paymentVO, err := newOrderPayment(
+			payment.ID,
+			payment.Type,
+			payment.Amount,
+			payment.PaymentMethodID,
+			payment.Reason,
+			payment.CreationTime,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to restore payment: %w", err)
+		}
+
+		paymentVOs = append(paymentVOs, paymentVO)
+	}
+
+	o := &order{
+		id:                   params.ID,
+		creationTime:         params.CreationTime,
+		slug:                 params.Slug,
+		storeID:              params.StoreID,
+		customerName:         params.CustomerName,
+		contactNumber:        params.ContactNumber,
+		phoneType:            params.PhoneType,
+		color:                params.Color,
+		costs:                costVOs,
+		phoneConditions:      phoneConditionVOs,
+		phoneEquipments:      phoneEquipmentVOs,
+		damages:              damageVOs,
+		photos:               photoVOs,
+		salesPersonID:        params.SalesPersonID,
+		technicianID:         params.TechnicianID,
+		assignments:          assignmentVOs,
+		imei:                 params.Imei,
+		partsNotCheckedYet:   params.PartsNotCheckedYet,
+		phoneSecurityDetails: params.PhoneSecurityDetails,
+		estimatedCompletion:  params.EstimatedCompletionTime,
+		confirmationTime:     params.ConfirmationTime,
+		confirmationContents: params.ConfirmationContents,
+		pickUpTime:           params.PickUpTime,
+		completionTime:       params.CompletionTime,
+		cancellationTime:     params.CancellationTime,
+		cancellationReason:   params.CancellationReason,
+		cancellationFee:      params.CancellationFee,
+		payments:             paymentVOs,
+		writeOff:             params.WriteOff,
+		version:              params.Version,
 	}
 
 	return o, nil
 }
 
+// AddDamage records damage found after intake. Damages are stored by name so
+// the order isn't affected when the store's damage types change later.
+func (o *order) AddDamage(name string) error {
+	if err := o.checkIntakeEditable("damages"); err != nil {
+		return err
+	}
+
+	for _, damage := range o.damages {
+		if damage.Name() == name {
+			return fmt.Errorf("%w: damage %q is already on the order", apperror.ErrInvalidInput, name)
+		}
+	}
+
+	damage, err := newDamage(uuid.New(), name)
+	if err != nil {
+		return err
+	}
+
+	o.damages = append(o.damages, damage)
+
+	return nil
+}
+
+// RemoveDamage removes a damage from the order. The order always keeps at
+// least one damage.
+func (o *order) RemoveDamage(damageID uuid.UUID) error {
+	if err := o.checkIntakeEditable("damages"); err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(o.damages, func(damage Damage) bool { return damage.ID() == damageID })
+	if idx == -1 {
+		return apperror.ErrRepairOrderDamageNotFound
+	}
+
+	if len(o.damages) == 1 {
+		return fmt.Errorf("%w: order must keep at least one damage", apperror.ErrInvalidInput)
+	}
+
+	o.damages = append(o.damages[:idx:idx], o.damages[idx+1:]...)
+
+	return nil
+}
+
+func (o *order) AddPhoneCondition(name string) error {
+	if err := o.checkIntakeEditable("phone conditions"); err != nil {
+		return err
+	}
+
+	for _, condition := range o.phoneConditions {
+		if condition.Name() == name {
+			return fmt.Errorf("%w: phone condition %q is already on the order", apperror.ErrInvalidInput, name)
+		}
+	}
+
+	condition, err := newPhoneCondition(uuid.New(), name)
+	if err != nil {
+		return err
+	}
+
+	o.phoneConditions = append(o.phoneConditions, condition)
+
+	return nil
+}
+
+func (o *order) RemovePhoneCondition(phoneConditionID uuid.UUID) error {
+	if err := o.checkIntakeEditable("phone conditions"); err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(o.phoneConditions, func(condition PhoneCondition) bool {
+		return condition.ID() == phoneConditionID
+	})
+
+	if idx == -1 {
+		return apperror.ErrRepairOrderPhoneConditionNotFound
+	}
+
+	o.phoneConditions = append(o.phoneConditions[:idx:idx], o.phoneConditions[idx+1:]...)
+
+	return nil
+}
+
+func (o *order) AddPhoneEquipment(name string) error {
+	if err := o.checkIntakeEditable("phone equipments"); err != nil {
+		return err
+	}
+
+	for _, equipment := range o.phoneEquipments {
+		if equipment.Name() == name {
+			return fmt.Errorf("%w: phone equipment %q is already on the order", apperror.ErrInvalidInput, name)
+		}
+	}
+
+	equipment, err := newPhoneEquipment(uuid.New(), name)
+	if err != nil {
+		return err
+	}
+
+	o.phoneEquipments = append(o.phoneEquipments, equipment)
+
+	return nil
+}
+
+func (o *order) RemovePhoneEquipment(phoneEquipmentID uuid.UUID) error {
+	if err := o.checkIntakeEditable("phone equipments"); err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(o.phoneEquipments, func(equipment PhoneEquipment) bool {
+		return equipment.ID() == phoneEquipmentID
+	})
+
+	if idx == -1 {
+		return apperror.ErrRepairOrderPhoneEquipmentNotFound
+	}
+
+	o.phoneEquipments = append(o.phoneEquipments[:idx:idx], o.phoneEquipments[idx+1:]...)
+
+	return nil
+}
+
+// AddPhoto attaches a photo taken after the order was created, e.g. of damage
+// found while diagnosing the phone. It doesn't check that the photo exists;
+// that is up to the caller.
+func (o *order) AddPhoto(creationTime time.Time, params NewOrderPhotoParams) error {
+	if status := o.Status(); status.IsFinal() {
+		return fmt.Errorf("%w: cannot add a photo to a %s order", apperror.ErrInvalidStateTransition, status)
+	}
+
+	if caption, ok := params.Caption.Get(); ok && caption == "" {
+		return fmt.Errorf("%w: caption is empty", apperror.ErrInvalidInput)
+	}
+
+	if _, err := NewOrderPhotoStage(string(params.Stage)); err != nil {
+		return err
+	}
+
+	for _, photo := range o.photos {
+		if photo.PhotoID() == params.PhotoID {
+			return fmt.Errorf("%w: photo is already attached to the order", apperror.ErrInvalidInput)
+		}
+	}
+
+	o.photos = append(o.photos, newOrderPhoto(uuid.New(), params.PhotoID, params.Stage, params.Caption, creationTime))
+
+	return nil
+}
+
+// RemovePhoto detaches a photo from the order. The order always keeps at
+// least one intake photo.
+func (o *order) RemovePhoto(orderPhotoID uuid.UUID) error {
+	if status := o.Status(); status.IsFinal() {
+		return fmt.Errorf("%w: cannot remove a photo from a %s order", apperror.ErrInvalidStateTransition, status)
+	}
+
+	idx := -1
+	intakeCount := 0
+
+	for i, photo := range o.photos {
+		if photo.ID() == orderPhotoID {
+			idx = i
+		}
+
+		if photo.Stage() == OrderPhotoStageIntake {
+			intakeCount++
+		}
+	}
+
+	if idx == -1 {
+		return apperror.ErrRepairOrderPhotoNotFound
+	}
+
+	if o.photos[idx].Stage() == OrderPhotoStageIntake && intakeCount == 1 {
+		return fmt.Errorf("%w: order must keep at least one intake photo", apperror.ErrInvalidInput)
+	}
+
+	o.photos = append(o.photos[:idx:idx], o.photos[idx+1:]...)
+
+	return nil
+}
+
+func (o *order) MutateCost(creationTime time.Time, amount int, reason string) error {
+	if status := o.Status(); status.IsFinal() {
+		return fmt.Errorf("%w: cannot change the cost of a %s order", apperror.ErrInvalidStateTransition, status)
+	}
+
+	cost, err := newAdditionalOrderCost(uuid.New(), amount, reason, creationTime)
+	if err != nil {
+		return err
+	}
+
+	if newTotal := o.TotalCost() + amount; newTotal < o.PaidAmount() {
+		return fmt.Errorf(
+			"%w: total cost of %d would be less than the paid amount of %d",
+			apperror.ErrInvalidInput,
+			newTotal,
+			o.PaidAmount(),
+		)
+	}
+
+	o.costs = append(o.costs, cost)
+
+	o.recordEvent(OrderCostMutated{
+		OrderEventMetadata: o.newEventMetadata(creationTime),
+		Amount:             amount,
+		Reason:             reason,
+	})
+
+	return nil
+}
+
+// RecordPayment adds a down payment or a partial payment to the ledger. Final
+// payments are recorded when the order is picked up.
+func (o *order) RecordPayment(
+	creationTime time.Time,
+	paymentType OrderPaymentType,
+	params NewOrderPaymentParams,
+) error {
+	if status := o.Status(); status.IsFinal() {
+		return fmt.Errorf("%w: cannot record a payment for a %s order", apperror.ErrInvalidStateTransition, status)
+	}
+
+	switch paymentType {
+	case OrderPaymentTypeDownPayment:
+		for _, payment := range o.payments {
+			if payment.Type() == OrderPaymentTypeDownPayment {
+				return fmt.Errorf("%w: order already has a down payment", apperror.ErrInvalidInput)
+			}
+		}
+	case OrderPaymentTypePartial:
+	case OrderPaymentTypeFinal, OrderPaymentTypeRefund:
+		return fmt.Errorf("%w: %s payments cannot be recorded directly", apperror.ErrInvalidInput, paymentType)
+	default:
+		return fmt.Errorf("%w: unknown payment type %q", apperror.ErrInvalidInput, paymentType)
+	}
+
+	payment, err := newOrderPayment(
+		uuid.New(),
+		paymentType,
+		params.Amount,
+		params.PaymentMethodID,
+		optional.None[string](),
+		creationTime,
+	)
+	if err != nil {
+		return err
+	}
+
+	if o.OutstandingAmount() < payment.SignedAmount() {
+		return fmt.Errorf(
+			"%w: payment of %d is greater than the outstanding amount of %d",
+			apperror.ErrInvalidInput,
+			payment.Amount(),
+			o.OutstandingAmount(),
+		)
+	}
+
+	o.payments = append(o.payments, payment)
+
+	return nil
+}
+
+// ChangeTechnician hands the order over to another technician. It doesn't
+// check that the technician exists; that is up to the caller.
+func (o *order) ChangeTechnician(changeTime time.Time, technicianID uuid.UUID, reason string) error {
+	if status := o.Status(); status.IsFinal() {
+		return fmt.Errorf("%w: cannot change the technician of a %s order", apperror.ErrInvalidStateTransition, status)
+	}
+
+	if technicianID == o.technicianID {
+		return fmt.Errorf("%w: order is already assigned to the technician", apperror.ErrInvalidInput)
+	}
+
+	assignment, err := newOrderTechnicianAssignment(uuid.New(), o.technicianID, technicianID, reason, changeTime)
+	if err != nil {
+		return err
+	}
+
+	o.assignments = append(o.assignments, assignment)
+	o.technicianID = technicianID
+
+	o.recordEvent(OrderTechnicianChanged{
+		OrderEventMetadata:   o.newEventMetadata(changeTime),
+		PreviousTechnicianID: assignment.PreviousTechnicianID(),
+		TechnicianID:         technicianID,
+		Reason:               reason,
+	})
+
+	return nil
+}
+
+func (o *order) ConfirmToCustomer(confirmationTime time.Time, contents string) error {
+	if err := o.checkTransitionTo(OrderStatusConfirmed); err != nil {
+		return err
+	}
+
+	if contents == "" {
+		return fmt.Errorf("%w: contents is empty", apperror.ErrInvalidInput)
+	}
+
+	o.confirmationTime = optional.Some(confirmationTime)
+	o.confirmationContents = optional.Some(contents)
+
+	o.recordEvent(OrderConfirmed{
+		OrderEventMetadata: o.newEventMetadata(confirmationTime),
+		Contents:           contents,
+	})
+
+	return nil
+}
+
+func (o *order) CompleteRepair(completionTime time.Time, requiresConfirmation bool) error {
+	if err := o.checkTransitionTo(OrderStatusCompleted); err != nil {
+		return err
+	}
+
+	if requiresConfirmation && !o.confirmationTime.IsSet() {
+		return fmt.Errorf("%w: order must be confirmed to the customer before it is completed", apperror.ErrInvalidStateTransition)
+	}
+
+	o.completionTime = optional.Some(completionTime)
+
+	o.recordEvent(OrderCompleted{OrderEventMetadata: o.newEventMetadata(completionTime)})
+
+	return nil
+}
+
+// PickUpByCustomer requires the payments, including the final one, to add up
+// to the total cost. Any difference has to be written off with a reason.
+func (o *order) PickUpByCustomer(
+	pickUpTime time.Time,
+	finalPayment optional.Optional[NewOrderPaymentParams],
+	writeOffReason optional.Optional[string],
+) error {
+	if err := o.checkTransitionTo(OrderStatusPickedUp); err != nil {
+		return err
+	}
+
+	payments := o.payments
+	paid := o.PaidAmount()
+
+	if params, ok := finalPayment.Get(); ok {
+		payment, err := newOrderPayment(
+			uuid.New(),
+			OrderPaymentTypeFinal,
+			params.Amount,
+			params.PaymentMethodID,
+			optional.None[string](),
+			pickUpTime,
+		)
+		if err != nil {
+			return err
+		}
+
+		payments = append(payments, payment)
+		paid += payment.SignedAmount()
+	}
+
+	writeOff := optional.None[OrderWriteOff]()
+
+	if difference := o.TotalCost() - paid; difference != 0 {
+		reason, ok := writeOffReason.Get()
+		if !ok {
+			return fmt.Errorf(
+				"%w: paid amount of %d does not match total cost of %d",
+				apperror.ErrInvalidInput,
+				paid,
+				o.TotalCost(),
+			)
+		}
+
+		tmp, err := NewOrderWriteOff(difference, reason)
+		if err != nil {
+			return err
+		}
+
+		writeOff = optional.Some(tmp)
+	}
+
+	o.pickUpTime = optional.Some(pickUpTime)
+	o.payments = payments
+	o.writeOff = writeOff
+
+	o.recordEvent(OrderPickedUp{OrderEventMetadata: o.newEventMetadata(pickUpTime)})
+
+	return nil
+}
+
+// Cancel settles the payments made so far. The store keeps the cancellation
+// fee, if any, and the rest of the paid amount has to be refunded.
+func (o *order) Cancel(
+	cancellationTime time.Time,
+	reason string,
+	fee optional.Optional[uint],
+	refund optional.Optional[NewOrderRefundParams],
+) error {
+	if err := o.checkTransitionTo(OrderStatusCancelled); err != nil {
+		return err
+	}
+
+	if reason == "" {
+		return fmt.Errorf("%w: reason is empty", apperror.ErrInvalidInput)
+	}
+
+	kept := 0
+	if value, ok := fee.Get(); ok {
+		if value == 0 {
+			return fmt.Errorf("%w: cancellation fee is zero", apperror.ErrInvalidInput)
+		}
+
+		if value > math.MaxInt32 {
+			return fmt.Errorf("%w: cancellation fee is greater than MaxInt32", apperror.ErrInvalidInput)
+		}
+
+		kept = int(value)
+	}
+
+	paid := o.PaidAmount()
+	if kept > paid {
+		return fmt.Errorf(
+			"%w: cancellation fee of %d is greater than the paid amount of %d",
+			apperror.ErrInvalidInput,
+			kept,
+			paid,
+		)
+	}
+
+	payments := o.payments
+	refundDue := paid - kept
+
+	params, ok := refund.Get()
+	if !ok && refundDue > 0 {
+		return fmt.Errorf("%w: %d has to be refunded", apperror.ErrInvalidInput, refundDue)
+	}
+
+	if ok {
+		if int(params.Amount) != refundDue {
+			return fmt.Errorf(
+				"%w: refund of %d does not match the refundable amount of %d",
+				apperror.ErrInvalidInput,
+				params.Amount,
+				refundDue,
+			)
+		}
+
+		payment, err := newOrderPayment(
+			uuid.New(),
+			OrderPaymentTypeRefund,
+			params.Amount,
+			params.PaymentMethodID,
+			optional.Some(params.Reason),
+			cancellationTime,
+		)
+		if err != nil {
+			return err
+		}
+
+		payments = append(payments, payment)
+	}
+
+	o.cancellationTime = optional.Some(cancellationTime)
+	o.cancellationReason = optional.Some(reason)
+	o.cancellationFee = fee
+	o.payments = payments
+
+	o.recordEvent(OrderCancelled{
+		OrderEventMetadata: o.newEventMetadata(cancellationTime),
+		Reason:             reason,
+	})
+
+	return nil
+}
+
+func (o *order) checkTransitionTo(next OrderStatus) error {
+	if current := o.Status(); !current.CanTransitionTo(next) {
+		return fmt.Errorf("%w: order cannot go from %s to %s", apperror.ErrInvalidStateTransition, current, next)
+	}
+
+	return nil
+}
+
+// checkIntakeEditable guards changes to what was recorded at intake, which
+// are only allowed while the phone is still at the store.
+func (o *order) checkIntakeEditable(what string) error {
+	if status := o.Status(); status.IsFinal() {
+		return fmt.Errorf("%w: cannot change the %s of a %s order", apperror.ErrInvalidStateTransition, what, status)
+	}
+
+	return nil
+}
+
+func (o *order) newEventMetadata(occurredTime time.Time) OrderEventMetadata {
+	return OrderEventMetadata{
+		EventID:      uuid.New(),
+		OrderID:      o.id,
+		StoreID:      o.storeID,
+		OccurredTime: occurredTime,
+	}
+}
+
+func (o *order) recordEvent(event OrderEvent) {
+	o.events = append(o.events, event)
+}
+
 func (o *order) ID() uuid.UUID {
 	return o.id
 }
@@ -191,6 +941,15 @@ func (o *order) CreationTime() time.Time {
 	return o.creationTime
 }
 
+func (o *order) Status() OrderStatus {
+	return DeriveOrderStatus(
+		o.confirmationTime.IsSet(),
+		o.completionTime.IsSet(),
+		o.pickUpTime.IsSet(),
+		o.cancellationTime.IsSet(),
+	)
+}
+
 func (o *order) Slug() string {
 	return o.slug
 }
@@ -223,10 +982,51 @@ func (o *order) TechnicianID() uuid.UUID {
 	return o.technicianID
 }
 
+func (o *order) TechnicianAssignments() []OrderTechnicianAssignment {
+	return o.assignments
+}
+
 func (o *order) Costs() []OrderCost {
 	return o.costs
 }
 
+func (o *order) TotalCost() int {
+	total := 0
+	for _, cost := range o.costs {
+		total += cost.Amount()
+	}
+
+	return total
+}
+
+// PaidAmount returns the sum of all payments minus refunds.
+func (o *order) PaidAmount() int {
+	paid := 0
+	for _, payment := range o.payments {
+		paid += payment.SignedAmount()
+	}
+
+	return paid
+}
+
+// OutstandingAmount returns what the customer still owes. It is zero once the
+// order is picked up, since any difference is written off, and once it is
+// cancelled, since only the cancellation fee is kept.
+func (o *order) OutstandingAmount() int {
+	if o.cancellationTime.IsSet() {
+		fee, _ := o.cancellationFee.Get()
+		return int(fee) - o.PaidAmount()
+	}
+
+	balance := o.TotalCost() - o.PaidAmount()
+
+	if writeOff, ok := o.writeOff.Get(); ok {
+		balance -= writeOff.Amount()
+	}
+
+	return balance
+}
+
 func (o *order) PhoneConditions() []PhoneCondition {
 	return o.phoneConditions
 }
@@ -255,6 +1055,10 @@ func (o *order) PhoneSecurityDetails() optional.Optional[PhoneSecurityDetails] {
 	return o.phoneSecurityDetails
 }
 
+func (o *order) EstimatedCompletionTime() optional.Optional[time.Time] {
+	return o.estimatedCompletion
+}
+
 func (o *order) ConfirmationTime() optional.Optional[time.Time] {
 	return o.confirmationTime
 }
@@ -279,12 +1083,28 @@ func (o *order) CancellationReason() optional.Optional[string] {
 	return o.cancellationReason
 }
 
-func (o *order) DownPayment() optional.Optional[OrderPayment] {
-	return o.downPayment
+func (o *order) CancellationFee() optional.Optional[uint] {
+	return o.cancellationFee
+}
+
+func (o *order) Payments() []OrderPayment {
+	return o.payments
 }
 
-func (o *order) Repayment() optional.Optional[OrderPayment] {
-	return o.repayment
+func (o *order) WriteOff() optional.Optional[OrderWriteOff] {
+	return o.writeOff
+}
+
+func (o *order) Events() []OrderEvent {
+	return o.events
+}
+
+func (o *order) ClearEvents() {
+	o.events = nil
+}
+
+func (o *order) Version() int {
+	return o.version
 }
 
 func validateNewOrderParams(params NewOrderParams) error {
@@ -312,10 +1132,6 @@ func validateNewOrderParams(params NewOrderParams) error {
 		return fmt.Errorf("%w: imei is empty", apperror.ErrInvalidInput)
 	}
 
-	if params.DownPayment.IsSet() && params.DownPayment.MustGet().Amount() > params.InitialCost {
-		return fmt.Errorf("%w: down payment amount is greater than initial cost", apperror.ErrInvalidInput)
-	}
-
 	if len(params.Damages) == 0 {
 		return fmt.Errorf("%w: damages is empty", apperror.ErrInvalidInput)
 	}
@@ -324,5 +1140,9 @@ func validateNewOrderParams(params NewOrderParams) error {
 		return fmt.Errorf("%w: photos is empty", apperror.ErrInvalidInput)
 	}
 
+	if estimate, ok := params.EstimatedCompletionTime.Get(); ok && estimate.Before(params.CreationTime) {
+		return fmt.Errorf("%w: estimatedCompletionTime is before creationTime", apperror.ErrInvalidInput)
+	}
+
 	return nil
 }
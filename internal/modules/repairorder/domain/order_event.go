@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type OrderEventType string
+
+const (
+	OrderEventTypeCreated           = OrderEventType("order_created")
+	OrderEventTypeCostMutated       = OrderEventType("order_cost_mutated")
+	OrderEventTypeTechnicianChanged = OrderEventType("order_technician_changed")
+	OrderEventTypeConfirmed         = OrderEventType("order_confirmed")
+	OrderEventTypeCompleted         = OrderEventType("order_completed")
+	OrderEventTypePickedUp          = OrderEventType("order_picked_up")
+	OrderEventTypeCancelled         = OrderEventType("order_cancelled")
+)
+
+// OrderEvent is a change to an order. Events are recorded on the order as it
+// changes and published once the order is saved.
+type OrderEvent interface {
+	Type() OrderEventType
+	Metadata() OrderEventMetadata
+}
+
+type OrderEventMetadata struct {
+	EventID      uuid.UUID
+	OrderID      uuid.UUID
+	StoreID      uuid.UUID
+	OccurredTime time.Time
+}
+
+func (m OrderEventMetadata) Metadata() OrderEventMetadata {
+	return m
+}
+
+type OrderCreated struct {
+	OrderEventMetadata
+}
+
+func (OrderCreated) Type() OrderEventType {
+	return OrderEventTypeCreated
+}
+
+type OrderCostMutated struct {
+	OrderEventMetadata
+	Amount int
+	Reason string
+}
+
+func (OrderCostMutated) Type() OrderEventType {
+	return OrderEventTypeCostMutated
+}
+
+type OrderTechnicianChanged struct {
+	OrderEventMetadata
+	PreviousTechnicianID uuid.UUID
+	TechnicianID         uuid.UUID
+	Reason               string
+}
+
+func (OrderTechnicianChanged) Type() OrderEventType {
+	return OrderEventTypeTechnicianChanged
+}
+
+type OrderConfirmed struct {
+	OrderEventMetadata
+	Contents string
+}
+
+func (OrderConfirmed) Type() OrderEventType {
+	return OrderEventTypeConfirmed
+}
+
+type OrderCompleted struct {
+	OrderEventMetadata
+}
+
+func (OrderCompleted) Type() OrderEventType {
+	return OrderEventTypeCompleted
+}
+
+type OrderPickedUp struct {
+	OrderEventMetadata
+}
+
+func (OrderPickedUp) Type() OrderEventType {
+	return OrderEventTypePickedUp
+}
+
+type OrderCancelled struct {
+	OrderEventMetadata
+	Reason string
+}
+
+func (OrderCancelled) Type() OrderEventType {
+	return OrderEventTypeCancelled
+}
@@ -0,0 +1,144 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+type OrderNoteVisibility string
+
+const (
+	OrderNoteVisibilityInternal = OrderNoteVisibility("internal")
+	OrderNoteVisibilityCustomer = OrderNoteVisibility("customer")
+)
+
+// OrderNoteEditWindow is how long after it was written a note can still be
+// edited.
+const OrderNoteEditWindow = 15 * time.Minute
+
+func NewOrderNoteVisibility(value string) (OrderNoteVisibility, error) {
+	switch visibility := OrderNoteVisibility(value); visibility {
+	case OrderNoteVisibilityInternal, OrderNoteVisibilityCustomer:
+		return visibility, nil
+	default:
+		return "", fmt.Errorf("%w: unknown note visibility %q", apperror.ErrInvalidInput, value)
+	}
+}
+
+// OrderNote is a timestamped remark left on an order by a staff member.
+// Only customer-visible notes are shown on the receipt and public tracking.
+type OrderNote interface {
+	Edit(editTime time.Time, body string, visibility OrderNoteVisibility) error
+
+	ID() uuid.UUID
+	OrderID() uuid.UUID
+	AuthorID() uuid.UUID
+	Body() string
+	Visibility() OrderNoteVisibility
+	CreationTime() time.Time
+	EditTime() optional.Optional[time.Time]
+}
+
+type orderNote struct {
+	id           uuid.UUID
+	orderID      uuid.UUID
+	authorID     uuid.UUID
+	body         string
+	visibility   OrderNoteVisibility
+	creationTime time.Time
+	editTime     optional.Optional[time.Time]
+}
+
+type NewOrderNoteParams struct {
+	OrderID      uuid.UUID
+	AuthorID     uuid.UUID
+	Body         string
+	Visibility   OrderNoteVisibility
+	CreationTime time.Time
+}
+
+func NewOrderNote(params NewOrderNoteParams) (OrderNote, error) {
+	if params.Body == "" {
+		return nil, fmt.Errorf("%w: body is empty", apperror.ErrInvalidInput)
+	}
+
+	return &orderNote{
+		id:           uuid.New(),
+		orderID:      params.OrderID,
+		authorID:     params.AuthorID,
+		body:         params.Body,
+		visibility:   params.Visibility,
+		creationTime: params.CreationTime,
+		editTime:     optional.None[time.Time](),
+	}, nil
+}
+
+type RestoreOrderNoteParams struct {
+	ID           uuid.UUID
+	OrderID      uuid.UUID
+	AuthorID     uuid.UUID
+	Body         string
+	Visibility   OrderNoteVisibility
+	CreationTime time.Time
+	EditTime     optional.Optional[time.Time]
+}
+
+func RestoreOrderNote(params RestoreOrderNoteParams) OrderNote {
+	return &orderNote{
+		id:           params.ID,
+		orderID:      params.OrderID,
+		authorID:     params.AuthorID,
+		body:         params.Body,
+		visibility:   params.Visibility,
+		creationTime: params.CreationTime,
+		editTime:     params.EditTime,
+	}
+}
+
+func (n *orderNote) Edit(editTime time.Time, body string, visibility OrderNoteVisibility) error {
+	if editTime.Sub(n.creationTime) > OrderNoteEditWindow {
+		return fmt.Errorf("%w: note can no longer be edited", apperror.ErrInvalidStateTransition)
+	}
+
+	if body == "" {
+		return fmt.Errorf("%w: body is empty", apperror.ErrInvalidInput)
+	}
+
+	n.body = body
+	n.visibility = visibility
+	n.editTime = optional.Some(editTime)
+
+	return nil
+}
+
+func (n *orderNote) ID() uuid.UUID {
+	return n.id
+}
+
+func (n *orderNote) OrderID() uuid.UUID {
+	return n.orderID
+}
+
+func (n *orderNote) AuthorID() uuid.UUID {
+	return n.authorID
+}
+
+func (n *orderNote) Body() string {
+	return n.body
+}
+
+func (n *orderNote) Visibility() OrderNoteVisibility {
+	return n.visibility
+}
+
+func (n *orderNote) CreationTime() time.Time {
+	return n.creationTime
+}
+
+func (n *orderNote) EditTime() optional.Optional[time.Time] {
+	return n.editTime
+}
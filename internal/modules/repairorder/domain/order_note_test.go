@@ -0,0 +1,113 @@
+//go:build unit
+// +build unit
+
+package domain_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrderNoteVisibility(t *testing.T) {
+	t.Run("returns visibility when value is known", func(t *testing.T) {
+		got, err := domain.NewOrderNoteVisibility("customer")
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.OrderNoteVisibilityCustomer, got)
+	})
+
+	t.Run("returns invalid input error when value is unknown", func(t *testing.T) {
+		_, err := domain.NewOrderNoteVisibility("public")
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+}
+
+func TestNewOrderNote(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	t.Run("creates the note", func(t *testing.T) {
+		orderID, authorID := uuid.New(), uuid.New()
+
+		got, err := domain.NewOrderNote(domain.NewOrderNoteParams{
+			OrderID:      orderID,
+			AuthorID:     authorID,
+			Body:         "Customer OK'd screen replacement by phone",
+			Visibility:   domain.OrderNoteVisibilityInternal,
+			CreationTime: theTime,
+		})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, uuid.Nil, got.ID())
+		assert.Equal(t, orderID, got.OrderID())
+		assert.Equal(t, authorID, got.AuthorID())
+		assert.Equal(t, "Customer OK'd screen replacement by phone", got.Body())
+		assert.Equal(t, domain.OrderNoteVisibilityInternal, got.Visibility())
+		assert.Equal(t, theTime, got.CreationTime())
+
+		editTime := got.EditTime()
+		assert.False(t, editTime.IsSet())
+	})
+
+	t.Run("returns invalid input error when body is empty", func(t *testing.T) {
+		_, err := domain.NewOrderNote(domain.NewOrderNoteParams{
+			OrderID:      uuid.New(),
+			AuthorID:     uuid.New(),
+			Visibility:   domain.OrderNoteVisibilityInternal,
+			CreationTime: theTime,
+		})
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+}
+
+func TestOrderNoteEdit(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newNote := func(t *testing.T) domain.OrderNote {
+		t.Helper()
+
+		note, err := domain.NewOrderNote(domain.NewOrderNoteParams{
+			OrderID:      uuid.New(),
+			AuthorID:     uuid.New(),
+			Body:         "Waiting for parts",
+			Visibility:   domain.OrderNoteVisibilityInternal,
+			CreationTime: theTime,
+		})
+		require.NoError(t, err)
+
+		return note
+	}
+
+	t.Run("edits the note within the edit window", func(t *testing.T) {
+		note := newNote(t)
+		editTime := theTime.Add(domain.OrderNoteEditWindow)
+
+		require.NoError(t, note.Edit(editTime, "Parts ordered", domain.OrderNoteVisibilityCustomer))
+
+		assert.Equal(t, "Parts ordered", note.Body())
+		assert.Equal(t, domain.OrderNoteVisibilityCustomer, note.Visibility())
+
+		got := note.EditTime()
+		assert.Equal(t, editTime, got.MustGet())
+	})
+
+	t.Run("returns invalid state transition error after the edit window", func(t *testing.T) {
+		note := newNote(t)
+
+		err := note.Edit(theTime.Add(domain.OrderNoteEditWindow+time.Second), "Parts ordered", domain.OrderNoteVisibilityInternal)
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+		assert.Equal(t, "Waiting for parts", note.Body())
+	})
+
+	t.Run("returns invalid input error when body is empty", func(t *testing.T) {
+		note := newNote(t)
+
+		err := note.Edit(theTime, "", domain.OrderNoteVisibilityInternal)
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+}
@@ -2,27 +2,110 @@ package domain
 
 import (
 	"fmt"
+	"math"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/google/uuid"
 )
 
+type OrderPaymentType string
+
+const (
+	OrderPaymentTypeDownPayment = OrderPaymentType("down_payment")
+	OrderPaymentTypePartial     = OrderPaymentType("partial")
+	OrderPaymentTypeFinal       = OrderPaymentType("final")
+	OrderPaymentTypeRefund      = OrderPaymentType("refund")
+)
+
+func NewOrderPaymentType(value string) (OrderPaymentType, error) {
+	switch paymentType := OrderPaymentType(value); paymentType {
+	case OrderPaymentTypeDownPayment, OrderPaymentTypePartial, OrderPaymentTypeFinal, OrderPaymentTypeRefund:
+		return paymentType, nil
+	default:
+		return "", fmt.Errorf("%w: unknown payment type %q", apperror.ErrInvalidInput, value)
+	}
+}
+
+// OrderPayment is an entry in the payment ledger of an order.
 type OrderPayment interface {
+	ID() uuid.UUID
+	Type() OrderPaymentType
 	Amount() uint
 	PaymentMethodID() uuid.UUID
+	Reason() optional.Optional[string]
+	CreationTime() time.Time
+
+	// SignedAmount returns how much the payment adds to the paid amount of
+	// the order, which is negative for refunds.
+	SignedAmount() int
+}
+
+type NewOrderPaymentParams struct {
+	Amount          uint
+	PaymentMethodID uuid.UUID
+}
+
+type NewOrderRefundParams struct {
+	Amount          uint
+	PaymentMethodID uuid.UUID
+	Reason          string
 }
 
 type orderPayment struct {
+	id              uuid.UUID
+	paymentType     OrderPaymentType
 	amount          uint
 	paymentMethodID uuid.UUID
+	reason          optional.Optional[string]
+	creationTime    time.Time
 }
 
-func NewOrderPayment(amount uint, paymentMethodID uuid.UUID) (OrderPayment, error) {
+func newOrderPayment(
+	id uuid.UUID,
+	paymentType OrderPaymentType,
+	amount uint,
+	paymentMethodID uuid.UUID,
+	reason optional.Optional[string],
+	creationTime time.Time,
+) (OrderPayment, error) {
+	if _, err := NewOrderPaymentType(string(paymentType)); err != nil {
+		return nil, err
+	}
+
 	if amount == 0 {
 		return nil, fmt.Errorf("%w: amount is zero", apperror.ErrInvalidInput)
 	}
 
-	return orderPayment{amount: amount, paymentMethodID: paymentMethodID}, nil
+	if amount > math.MaxInt32 {
+		return nil, fmt.Errorf("%w: amount is greater than MaxInt32", apperror.ErrInvalidInput)
+	}
+
+	if value, ok := reason.Get(); ok && value == "" {
+		return nil, fmt.Errorf("%w: reason is empty", apperror.ErrInvalidInput)
+	}
+
+	if paymentType == OrderPaymentTypeRefund && !reason.IsSet() {
+		return nil, fmt.Errorf("%w: refund has no reason", apperror.ErrInvalidInput)
+	}
+
+	return orderPayment{
+		id:              id,
+		paymentType:     paymentType,
+		amount:          amount,
+		paymentMethodID: paymentMethodID,
+		reason:          reason,
+		creationTime:    creationTime,
+	}, nil
+}
+
+func (o orderPayment) ID() uuid.UUID {
+	return o.id
+}
+
+func (o orderPayment) Type() OrderPaymentType {
+	return o.paymentType
 }
 
 func (o orderPayment) Amount() uint {
@@ -32,3 +115,19 @@ func (o orderPayment) Amount() uint {
 func (o orderPayment) PaymentMethodID() uuid.UUID {
 	return o.paymentMethodID
 }
+
+func (o orderPayment) Reason() optional.Optional[string] {
+	return o.reason
+}
+
+func (o orderPayment) CreationTime() time.Time {
+	return o.creationTime
+}
+
+func (o orderPayment) SignedAmount() int {
+	if o.paymentType == OrderPaymentTypeRefund {
+		return -int(o.amount)
+	}
+
+	return int(o.amount)
+}
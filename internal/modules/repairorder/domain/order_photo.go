@@ -1,25 +1,69 @@
 package domain
 
 import (
-	"net/url"
+	"fmt"
+	"time"
 
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/google/uuid"
 )
 
+// OrderPhotoStage is the point in the repair a photo was taken at.
+type OrderPhotoStage string
+
+const (
+	OrderPhotoStageIntake    = OrderPhotoStage("intake")
+	OrderPhotoStageDiagnosis = OrderPhotoStage("diagnosis")
+	OrderPhotoStageRepair    = OrderPhotoStage("repair")
+	OrderPhotoStageCompleted = OrderPhotoStage("completed")
+)
+
+func NewOrderPhotoStage(value string) (OrderPhotoStage, error) {
+	switch stage := OrderPhotoStage(value); stage {
+	case OrderPhotoStageIntake, OrderPhotoStageDiagnosis, OrderPhotoStageRepair, OrderPhotoStageCompleted:
+		return stage, nil
+	default:
+		return "", fmt.Errorf("%w: unknown photo stage %q", apperror.ErrInvalidInput, value)
+	}
+}
+
+// OrderPhoto attaches an uploaded photo to an order.
 type OrderPhoto interface {
 	ID() uuid.UUID
-	URL() url.URL
+	PhotoID() uuid.UUID
+	Stage() OrderPhotoStage
+	Caption() optional.Optional[string]
+	CreationTime() time.Time
 }
 
 type orderPhoto struct {
-	id  uuid.UUID
-	url url.URL
+	id           uuid.UUID
+	photoID      uuid.UUID
+	stage        OrderPhotoStage
+	caption      optional.Optional[string]
+	creationTime time.Time
+}
+
+type NewOrderPhotoParams struct {
+	PhotoID uuid.UUID
+	Stage   OrderPhotoStage
+	Caption optional.Optional[string]
 }
 
-func newOrderPhoto(id uuid.UUID, url url.URL) OrderPhoto {
+func newOrderPhoto(
+	id uuid.UUID,
+	photoID uuid.UUID,
+	stage OrderPhotoStage,
+	caption optional.Optional[string],
+	creationTime time.Time,
+) OrderPhoto {
 	return orderPhoto{
-		id:  id,
-		url: url,
+		id:           id,
+		photoID:      photoID,
+		stage:        stage,
+		caption:      caption,
+		creationTime: creationTime,
 	}
 }
 
@@ -27,6 +71,18 @@ func (o orderPhoto) ID() uuid.UUID {
 	return o.id
 }
 
-func (o orderPhoto) URL() url.URL {
-	return o.url
+func (o orderPhoto) PhotoID() uuid.UUID {
+	return o.photoID
+}
+
+func (o orderPhoto) Stage() OrderPhotoStage {
+	return o.stage
+}
+
+func (o orderPhoto) Caption() optional.Optional[string] {
+	return o.caption
+}
+
+func (o orderPhoto) CreationTime() time.Time {
+	return o.creationTime
 }
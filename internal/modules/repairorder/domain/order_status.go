@@ -0,0 +1,68 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+)
+
+type OrderStatus string
+
+const (
+	OrderStatusOpen      = OrderStatus("open")
+	OrderStatusConfirmed = OrderStatus("confirmed")
+	OrderStatusCompleted = OrderStatus("completed")
+	OrderStatusPickedUp  = OrderStatus("picked_up")
+	OrderStatusCancelled = OrderStatus("cancelled")
+)
+
+// orderStatusTransitions lists the statuses an order can move to from each
+// status. Picked up and cancelled orders are final.
+var orderStatusTransitions = map[OrderStatus][]OrderStatus{
+	OrderStatusOpen:      {OrderStatusConfirmed, OrderStatusCompleted, OrderStatusCancelled},
+	OrderStatusConfirmed: {OrderStatusCompleted, OrderStatusCancelled},
+	OrderStatusCompleted: {OrderStatusPickedUp, OrderStatusCancelled},
+	OrderStatusPickedUp:  {},
+	OrderStatusCancelled: {},
+}
+
+func NewOrderStatus(value string) (OrderStatus, error) {
+	switch status := OrderStatus(value); status {
+	case OrderStatusOpen, OrderStatusConfirmed, OrderStatusCompleted, OrderStatusPickedUp, OrderStatusCancelled:
+		return status, nil
+	default:
+		return "", fmt.Errorf("%w: unknown order status %q", apperror.ErrInvalidInput, value)
+	}
+}
+
+// DeriveOrderStatus returns the status of an order based on which of its
+// lifecycle timestamps are set. A later stage always takes precedence over an
+// earlier one, and cancellation takes precedence over everything.
+func DeriveOrderStatus(confirmed, completed, pickedUp, cancelled bool) OrderStatus {
+	switch {
+	case cancelled:
+		return OrderStatusCancelled
+	case pickedUp:
+		return OrderStatusPickedUp
+	case completed:
+		return OrderStatusCompleted
+	case confirmed:
+		return OrderStatusConfirmed
+	default:
+		return OrderStatusOpen
+	}
+}
+
+func (s OrderStatus) CanTransitionTo(next OrderStatus) bool {
+	for _, allowed := range orderStatusTransitions[s] {
+		if allowed == next {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s OrderStatus) IsFinal() bool {
+	return len(orderStatusTransitions[s]) == 0
+}
@@ -0,0 +1,75 @@
+//go:build unit
+// +build unit
+
+package domain_test
+
+import (
+	"testing"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewOrderStatus(t *testing.T) {
+	t.Run("returns status when value is known", func(t *testing.T) {
+		got, err := domain.NewOrderStatus("picked_up")
+		require.NoError(t, err)
+
+		assert.Equal(t, domain.OrderStatusPickedUp, got)
+	})
+
+	t.Run("returns invalid input error when value is unknown", func(t *testing.T) {
+		_, err := domain.NewOrderStatus("lost")
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+}
+
+func TestDeriveOrderStatus(t *testing.T) {
+	testCases := []struct {
+		name      string
+		confirmed bool
+		completed bool
+		pickedUp  bool
+		cancelled bool
+		want      domain.OrderStatus
+	}{
+		{name: "open when nothing happened yet", want: domain.OrderStatusOpen},
+		{name: "confirmed", confirmed: true, want: domain.OrderStatusConfirmed},
+		{name: "completed", confirmed: true, completed: true, want: domain.OrderStatusCompleted},
+		{name: "completed without confirmation", completed: true, want: domain.OrderStatusCompleted},
+		{name: "picked up", confirmed: true, completed: true, pickedUp: true, want: domain.OrderStatusPickedUp},
+		{name: "cancelled takes precedence", confirmed: true, completed: true, cancelled: true, want: domain.OrderStatusCancelled},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := domain.DeriveOrderStatus(tc.confirmed, tc.completed, tc.pickedUp, tc.cancelled)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestOrderStatusCanTransitionTo(t *testing.T) {
+	testCases := []struct {
+		from domain.OrderStatus
+		to   domain.OrderStatus
+		want bool
+	}{
+		{from: domain.OrderStatusOpen, to: domain.OrderStatusConfirmed, want: true},
+		{from: domain.OrderStatusOpen, to: domain.OrderStatusCompleted, want: true},
+		{from: domain.OrderStatusOpen, to: domain.OrderStatusPickedUp, want: false},
+		{from: domain.OrderStatusConfirmed, to: domain.OrderStatusConfirmed, want: false},
+		{from: domain.OrderStatusCompleted, to: domain.OrderStatusPickedUp, want: true},
+		{from: domain.OrderStatusCompleted, to: domain.OrderStatusCancelled, want: true},
+		{from: domain.OrderStatusPickedUp, to: domain.OrderStatusCancelled, want: false},
+		{from: domain.OrderStatusCancelled, to: domain.OrderStatusPickedUp, want: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.from)+" to "+string(tc.to), func(t *testing.T) {
+			assert.Equal(t, tc.want, tc.from.CanTransitionTo(tc.to))
+		})
+	}
+}
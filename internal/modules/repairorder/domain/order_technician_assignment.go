@@ -0,0 +1,67 @@
+package domain
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/google/uuid"
+)
+
+// OrderTechnicianAssignment records an order being handed over from one
+// technician to another.
+type OrderTechnicianAssignment interface {
+	ID() uuid.UUID
+	PreviousTechnicianID() uuid.UUID
+	TechnicianID() uuid.UUID
+	Reason() string
+	CreationTime() time.Time
+}
+
+type orderTechnicianAssignment struct {
+	id                   uuid.UUID
+	previousTechnicianID uuid.UUID
+	technicianID         uuid.UUID
+	reason               string
+	creationTime         time.Time
+}
+
+func newOrderTechnicianAssignment(
+	id uuid.UUID,
+	previousTechnicianID uuid.UUID,
+	technicianID uuid.UUID,
+	reason string,
+	creationTime time.Time,
+) (OrderTechnicianAssignment, error) {
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is empty", apperror.ErrInvalidInput)
+	}
+
+	return orderTechnicianAssignment{
+		id:                   id,
+		previousTechnicianID: previousTechnicianID,
+		technicianID:         technicianID,
+		reason:               reason,
+		creationTime:         creationTime,
+	}, nil
+}
+
+func (o orderTechnicianAssignment) ID() uuid.UUID {
+	return o.id
+}
+
+func (o orderTechnicianAssignment) PreviousTechnicianID() uuid.UUID {
+	return o.previousTechnicianID
+}
+
+func (o orderTechnicianAssignment) TechnicianID() uuid.UUID {
+	return o.technicianID
+}
+
+func (o orderTechnicianAssignment) Reason() string {
+	return o.reason
+}
+
+func (o orderTechnicianAssignment) CreationTime() time.Time {
+	return o.creationTime
+}
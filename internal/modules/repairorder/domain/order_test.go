@@ -4,7 +4,6 @@
 package domain_test
 
 import (
-	"net/url"
 	"testing"
 	"time"
 
@@ -34,7 +33,7 @@ func TestNewOrder(t *testing.T) {
 			PhoneConditions: []string{"condition 1"},
 			PhoneEquipments: []string{"equipment 1"},
 			Damages:         []string{"damage 1"},
-			Photos:          []url.URL{{Host: "example.com"}},
+			Photos:          []uuid.UUID{uuid.New()},
 			SalesPersonID:   uuid.New(),
 			TechnicianID:    uuid.New(),
 		}
@@ -65,11 +64,12 @@ func TestNewOrder(t *testing.T) {
 		assert.Equal(t, params.PhoneEquipments[0], got.PhoneEquipments()[0].Name())
 
 		require.NotEmpty(t, got.Photos())
-		assert.Equal(t, params.Photos[0], got.Photos()[0].URL())
+		assert.Equal(t, params.Photos[0], got.Photos()[0].PhotoID())
+		assert.Equal(t, domain.OrderPhotoStageIntake, got.Photos()[0].Stage())
+		assert.Equal(t, params.CreationTime, got.Photos()[0].CreationTime())
 	})
 
 	t.Run("returns invalid input error", func(t *testing.T) {
-		dummyURL := url.URL{Host: "example.com"}
 		dummyTime := time.Now()
 		dummyID := uuid.New()
 
@@ -113,11 +113,11 @@ func TestNewOrder(t *testing.T) {
 			{
 				name: "down payment greater than initial cost",
 				setup: func(params *domain.NewOrderParams) {
-					payment, err := domain.NewOrderPayment(500, dummyID)
-					require.NoError(t, err)
-
 					params.InitialCost = 100
-					params.DownPayment = optional.Some(payment)
+					params.DownPayment = optional.Some(domain.NewOrderPaymentParams{
+						Amount:          500,
+						PaymentMethodID: dummyID,
+					})
 				},
 			},
 			{
@@ -147,7 +147,7 @@ func TestNewOrder(t *testing.T) {
 			{
 				name: "empty photos",
 				setup: func(params *domain.NewOrderParams) {
-					params.Photos = []url.URL{}
+					params.Photos = []uuid.UUID{}
 				},
 			},
 			{
@@ -162,6 +162,12 @@ func TestNewOrder(t *testing.T) {
 					params.PartsNotCheckedYet = optional.Some("")
 				},
 			},
+			{
+				name: "estimated completion time before creation time",
+				setup: func(params *domain.NewOrderParams) {
+					params.EstimatedCompletionTime = optional.Some(dummyTime.Add(-time.Hour))
+				},
+			},
 		}
 
 		for _, tc := range testCases {
@@ -180,7 +186,7 @@ func TestNewOrder(t *testing.T) {
 					Color:         "White",
 					InitialCost:   100,
 					Damages:       []string{"damage 1"},
-					Photos:        []url.URL{dummyURL},
+					Photos:        []uuid.UUID{dummyID},
 					SalesPersonID: dummyID,
 					TechnicianID:  dummyID,
 				}
@@ -194,3 +200,1150 @@ func TestNewOrder(t *testing.T) {
 	})
 
 }
+
+func TestRestoreOrder(t *testing.T) {
+	t.Run("returns restored order", func(t *testing.T) {
+		theContactNumber, initErr := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, initErr)
+
+		params := domain.RestoreOrderParams{
+			ID:            uuid.New(),
+			CreationTime:  time.Now(),
+			Slug:          "slug",
+			StoreID:       uuid.New(),
+			CustomerName:  "John Doe",
+			ContactNumber: theContactNumber,
+			PhoneType:     "Advan G5",
+			Color:         "White",
+			SalesPersonID: uuid.New(),
+			TechnicianID:  uuid.New(),
+			TechnicianAssignments: []domain.RestoreOrderTechnicianAssignmentParams{
+				{ID: uuid.New(), PreviousTechnicianID: uuid.New(), TechnicianID: uuid.New(), Reason: "on leave", CreationTime: time.Now()},
+			},
+			Costs: []domain.RestoreOrderCostParams{
+				{ID: uuid.New(), Amount: 100, Reason: optional.None[string](), CreationTime: time.Now()},
+				{ID: uuid.New(), Amount: -20, Reason: optional.Some("discount"), CreationTime: time.Now()},
+			},
+			PhoneConditions: []domain.RestoreOrderItemParams{{ID: uuid.New(), Name: "condition 1"}},
+			PhoneEquipments: []domain.RestoreOrderItemParams{{ID: uuid.New(), Name: "equipment 1"}},
+			Damages:         []domain.RestoreOrderItemParams{{ID: uuid.New(), Name: "damage 1"}},
+			Photos: []domain.RestoreOrderPhotoParams{
+				{ID: uuid.New(), PhotoID: uuid.New(), Stage: domain.OrderPhotoStageIntake, CreationTime: time.Now()},
+				{
+					ID:           uuid.New(),
+					PhotoID:      uuid.New(),
+					Stage:        domain.OrderPhotoStageDiagnosis,
+					Caption:      optional.Some("water damage under the shield"),
+					CreationTime: time.Now(),
+				},
+			},
+			Payments: []domain.RestoreOrderPaymentParams{
+				{
+					ID:              uuid.New(),
+					Type:            domain.OrderPaymentTypeDownPayment,
+					Amount:          50,
+					PaymentMethodID: uuid.New(),
+					CreationTime:    time.Now(),
+				},
+				{
+					ID:              uuid.New(),
+					Type:            domain.OrderPaymentTypeRefund,
+					Amount:          10,
+					PaymentMethodID: uuid.New(),
+					Reason:          optional.Some("overcharged"),
+					CreationTime:    time.Now(),
+				},
+			},
+			CancellationTime:   optional.Some(time.Now()),
+			CancellationReason: optional.Some("customer changed their mind"),
+		}
+
+		got, err := domain.RestoreOrder(params)
+		require.NoError(t, err)
+
+		assert.Equal(t, params.ID, got.ID())
+		assert.Equal(t, params.Slug, got.Slug())
+		assert.Equal(t, params.StoreID, got.StoreID())
+
+		require.Len(t, got.Costs(), 2)
+		assert.Equal(t, params.Costs[0].ID, got.Costs()[0].ID())
+		assert.True(t, got.Costs()[0].IsInitial())
+		assert.Equal(t, -20, got.Costs()[1].Amount())
+		assert.False(t, got.Costs()[1].IsInitial())
+
+		require.Len(t, got.TechnicianAssignments(), 1)
+		assert.Equal(t, params.TechnicianAssignments[0].ID, got.TechnicianAssignments()[0].ID())
+		assert.Equal(t, "on leave", got.TechnicianAssignments()[0].Reason())
+
+		require.Len(t, got.Damages(), 1)
+		assert.Equal(t, params.Damages[0].ID, got.Damages()[0].ID())
+
+		require.Len(t, got.Photos(), 2)
+		assert.Equal(t, params.Photos[0].ID, got.Photos()[0].ID())
+		assert.Equal(t, domain.OrderPhotoStageDiagnosis, got.Photos()[1].Stage())
+		assert.Equal(t, params.Photos[1].Caption, got.Photos()[1].Caption())
+
+		require.Len(t, got.Payments(), 2)
+		assert.Equal(t, params.Payments[0].ID, got.Payments()[0].ID())
+		assert.Equal(t, domain.OrderPaymentTypeRefund, got.Payments()[1].Type())
+		assert.Equal(t, 40, got.PaidAmount())
+
+		cancellationReason := got.CancellationReason()
+		assert.Equal(t, "customer changed their mind", cancellationReason.MustGet())
+
+		assert.Empty(t, got.Events())
+	})
+
+	t.Run("returns invalid input error when damage name is empty", func(t *testing.T) {
+		_, err := domain.RestoreOrder(domain.RestoreOrderParams{
+			Damages: []domain.RestoreOrderItemParams{{ID: uuid.New(), Name: ""}},
+		})
+
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+}
+
+func TestOrderTransitions(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    time.Now(),
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{uuid.New()},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    uuid.New(),
+		})
+		require.NoError(t, err)
+
+		return order
+	}
+
+	t.Run("goes through the whole lifecycle", func(t *testing.T) {
+		order := newOrder(t)
+
+		repayment := domain.NewOrderPaymentParams{Amount: 100, PaymentMethodID: uuid.New()}
+
+		require.NoError(t, order.ConfirmToCustomer(theTime, "replace LCD"))
+		assert.Equal(t, domain.OrderStatusConfirmed, order.Status())
+
+		require.NoError(t, order.CompleteRepair(theTime, true))
+		assert.Equal(t, domain.OrderStatusCompleted, order.Status())
+
+		require.NoError(t, order.PickUpByCustomer(theTime, optional.Some(repayment), optional.None[string]()))
+		assert.Equal(t, domain.OrderStatusPickedUp, order.Status())
+
+		require.Len(t, order.Payments(), 1)
+		assert.Equal(t, domain.OrderPaymentTypeFinal, order.Payments()[0].Type())
+		assert.Equal(t, uint(100), order.Payments()[0].Amount())
+
+		gotContents := order.ConfirmationContents()
+		assert.Equal(t, "replace LCD", gotContents.MustGet())
+	})
+
+	t.Run("allows completion without confirmation when not required", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.CompleteRepair(theTime, false))
+		assert.Equal(t, domain.OrderStatusCompleted, order.Status())
+	})
+
+	t.Run("rejects completion without confirmation when required", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.CompleteRepair(theTime, true)
+		require.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+
+		completionTime := order.CompletionTime()
+		assert.False(t, completionTime.IsSet())
+	})
+
+	t.Run("rejects pick up before completion", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.PickUpByCustomer(theTime, optional.None[domain.NewOrderPaymentParams](), optional.None[string]())
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+	})
+
+	t.Run("rejects pick up of cancelled order", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.CompleteRepair(theTime, false))
+		require.NoError(t, order.Cancel(theTime, "customer changed their mind", optional.None[uint](), optional.None[domain.NewOrderRefundParams]()))
+
+		err := order.PickUpByCustomer(theTime, optional.None[domain.NewOrderPaymentParams](), optional.None[string]())
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+	})
+
+	t.Run("rejects cancellation without reason", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.Cancel(theTime, "", optional.None[uint](), optional.None[domain.NewOrderRefundParams]())
+		require.ErrorIs(t, err, apperror.ErrInvalidInput)
+		assert.Equal(t, domain.OrderStatusOpen, order.Status())
+	})
+
+	t.Run("rejects confirmation without contents", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.ConfirmToCustomer(theTime, "")
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+
+	t.Run("rejects cancellation of picked up order", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.CompleteRepair(theTime, false))
+		require.NoError(t, order.PickUpByCustomer(theTime, optional.None[domain.NewOrderPaymentParams](), optional.Some("waived")))
+
+		err := order.Cancel(theTime, "customer changed their mind", optional.None[uint](), optional.None[domain.NewOrderRefundParams]())
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+	})
+}
+
+func TestOrderMutateCost(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newOrder := func(t *testing.T, downPaymentAmount uint) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    time.Now(),
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{uuid.New()},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    uuid.New(),
+			DownPayment: optional.Some(domain.NewOrderPaymentParams{
+				Amount:          downPaymentAmount,
+				PaymentMethodID: uuid.New(),
+			}),
+		})
+		require.NoError(t, err)
+
+		return order
+	}
+
+	t.Run("records cost adjustments and updates the totals", func(t *testing.T) {
+		order := newOrder(t, 30)
+
+		require.NoError(t, order.MutateCost(theTime, 50, "replaced battery"))
+		require.NoError(t, order.MutateCost(theTime, -20, "discount"))
+
+		require.Len(t, order.Costs(), 3)
+		assert.False(t, order.Costs()[1].IsInitial())
+		assert.Equal(t, theTime, order.Costs()[2].CreationTime())
+
+		assert.Equal(t, 130, order.TotalCost())
+		assert.Equal(t, 30, order.PaidAmount())
+		assert.Equal(t, 100, order.OutstandingAmount())
+	})
+
+	t.Run("allows total to drop to the paid amount", func(t *testing.T) {
+		order := newOrder(t, 30)
+
+		require.NoError(t, order.MutateCost(theTime, -70, "discount"))
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+
+	t.Run("rejects adjustment that drives total below the paid amount", func(t *testing.T) {
+		order := newOrder(t, 30)
+
+		err := order.MutateCost(theTime, -71, "discount")
+		require.ErrorIs(t, err, apperror.ErrInvalidInput)
+		assert.Len(t, order.Costs(), 1)
+	})
+
+	t.Run("rejects adjustment without reason", func(t *testing.T) {
+		order := newOrder(t, 30)
+
+		err := order.MutateCost(theTime, 10, "")
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+
+	t.Run("rejects zero adjustment", func(t *testing.T) {
+		order := newOrder(t, 30)
+
+		err := order.MutateCost(theTime, 0, "nothing")
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+
+	t.Run("rejects adjustment of cancelled order", func(t *testing.T) {
+		order := newOrder(t, 30)
+		require.NoError(t, order.Cancel(theTime, "customer changed their mind", optional.Some[uint](30), optional.None[domain.NewOrderRefundParams]()))
+
+		err := order.MutateCost(theTime, 10, "replaced battery")
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+	})
+}
+
+func TestOrderChangeTechnician(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	theTechnicianID := uuid.New()
+
+	newOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    time.Now(),
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{uuid.New()},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    theTechnicianID,
+		})
+		require.NoError(t, err)
+
+		order.ClearEvents()
+		return order
+	}
+
+	t.Run("reassigns the order and keeps the history", func(t *testing.T) {
+		order := newOrder(t)
+
+		first, second := uuid.New(), uuid.New()
+		require.NoError(t, order.ChangeTechnician(theTime, first, "on leave"))
+		require.NoError(t, order.ChangeTechnician(theTime.Add(time.Hour), second, "needs a specialist"))
+
+		assert.Equal(t, second, order.TechnicianID())
+
+		require.Len(t, order.TechnicianAssignments(), 2)
+
+		assignment := order.TechnicianAssignments()[0]
+		assert.Equal(t, theTechnicianID, assignment.PreviousTechnicianID())
+		assert.Equal(t, first, assignment.TechnicianID())
+		assert.Equal(t, "on leave", assignment.Reason())
+		assert.Equal(t, theTime, assignment.CreationTime())
+
+		assignment = order.TechnicianAssignments()[1]
+		assert.Equal(t, first, assignment.PreviousTechnicianID())
+		assert.Equal(t, second, assignment.TechnicianID())
+
+		require.Len(t, order.Events(), 2)
+		assert.Equal(t, domain.OrderTechnicianChanged{
+			OrderEventMetadata: domain.OrderEventMetadata{
+				EventID:      order.Events()[0].Metadata().EventID,
+				OrderID:      order.ID(),
+				StoreID:      order.StoreID(),
+				OccurredTime: theTime,
+			},
+			PreviousTechnicianID: theTechnicianID,
+			TechnicianID:         first,
+			Reason:               "on leave",
+		}, order.Events()[0])
+	})
+
+	t.Run("rejects reassignment without reason", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.ChangeTechnician(theTime, uuid.New(), "")
+		require.ErrorIs(t, err, apperror.ErrInvalidInput)
+
+		assert.Equal(t, theTechnicianID, order.TechnicianID())
+		assert.Empty(t, order.TechnicianAssignments())
+		assert.Empty(t, order.Events())
+	})
+
+	t.Run("rejects reassignment to the same technician", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.ChangeTechnician(theTime, theTechnicianID, "no reason")
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+
+	t.Run("rejects reassignment of finished order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.Cancel(theTime, "customer changed their mind", optional.None[uint](), optional.None[domain.NewOrderRefundParams]()))
+
+		err := order.ChangeTechnician(theTime, uuid.New(), "on leave")
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+	})
+}
+
+func TestOrderIntakeItems(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    time.Now(),
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{uuid.New()},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    uuid.New(),
+		})
+		require.NoError(t, err)
+
+		return order
+	}
+
+	t.Run("adds and removes damages", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.AddDamage("damage 2"))
+		require.Len(t, order.Damages(), 2)
+		assert.Equal(t, "damage 2", order.Damages()[1].Name())
+
+		require.NoError(t, order.RemoveDamage(order.Damages()[0].ID()))
+		require.Len(t, order.Damages(), 1)
+		assert.Equal(t, "damage 2", order.Damages()[0].Name())
+	})
+
+	t.Run("adds and removes phone conditions", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.AddPhoneCondition("condition 2"))
+		require.Len(t, order.PhoneConditions(), 2)
+		assert.Equal(t, "condition 2", order.PhoneConditions()[1].Name())
+
+		require.NoError(t, order.RemovePhoneCondition(order.PhoneConditions()[0].ID()))
+		require.NoError(t, order.RemovePhoneCondition(order.PhoneConditions()[0].ID()))
+		assert.Empty(t, order.PhoneConditions())
+	})
+
+	t.Run("adds and removes phone equipments", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.AddPhoneEquipment("equipment 2"))
+		require.Len(t, order.PhoneEquipments(), 2)
+		assert.Equal(t, "equipment 2", order.PhoneEquipments()[1].Name())
+
+		require.NoError(t, order.RemovePhoneEquipment(order.PhoneEquipments()[1].ID()))
+		require.Len(t, order.PhoneEquipments(), 1)
+		assert.Equal(t, "equipment 1", order.PhoneEquipments()[0].Name())
+	})
+
+	t.Run("rejects removing the last damage", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.RemoveDamage(order.Damages()[0].ID())
+		require.ErrorIs(t, err, apperror.ErrInvalidInput)
+
+		assert.Len(t, order.Damages(), 1)
+	})
+
+	t.Run("rejects items that are already on the order", func(t *testing.T) {
+		order := newOrder(t)
+
+		assert.ErrorIs(t, order.AddDamage("damage 1"), apperror.ErrInvalidInput)
+		assert.ErrorIs(t, order.AddPhoneCondition("condition 1"), apperror.ErrInvalidInput)
+		assert.ErrorIs(t, order.AddPhoneEquipment("equipment 1"), apperror.ErrInvalidInput)
+	})
+
+	t.Run("returns not found error when item is not on the order", func(t *testing.T) {
+		order := newOrder(t)
+
+		assert.ErrorIs(t, order.RemoveDamage(uuid.New()), apperror.ErrRepairOrderDamageNotFound)
+		assert.ErrorIs(t, order.RemovePhoneCondition(uuid.New()), apperror.ErrRepairOrderPhoneConditionNotFound)
+		assert.ErrorIs(t, order.RemovePhoneEquipment(uuid.New()), apperror.ErrRepairOrderPhoneEquipmentNotFound)
+	})
+
+	t.Run("rejects changes to finished order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.AddDamage("damage 2"))
+		require.NoError(t, order.Cancel(theTime, "customer changed their mind", optional.None[uint](), optional.None[domain.NewOrderRefundParams]()))
+
+		assert.ErrorIs(t, order.AddDamage("damage 3"), apperror.ErrInvalidStateTransition)
+		assert.ErrorIs(t, order.RemoveDamage(order.Damages()[0].ID()), apperror.ErrInvalidStateTransition)
+		assert.ErrorIs(t, order.AddPhoneCondition("condition 2"), apperror.ErrInvalidStateTransition)
+		assert.ErrorIs(t, order.RemovePhoneCondition(order.PhoneConditions()[0].ID()), apperror.ErrInvalidStateTransition)
+		assert.ErrorIs(t, order.AddPhoneEquipment("equipment 2"), apperror.ErrInvalidStateTransition)
+		assert.ErrorIs(t, order.RemovePhoneEquipment(order.PhoneEquipments()[0].ID()), apperror.ErrInvalidStateTransition)
+	})
+}
+
+func TestOrderPhotos(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	theIntakePhotoID := uuid.New()
+
+	newOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    time.Now(),
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{theIntakePhotoID},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    uuid.New(),
+		})
+		require.NoError(t, err)
+
+		return order
+	}
+
+	t.Run("adds a photo with stage and caption", func(t *testing.T) {
+		order := newOrder(t)
+		photoID := uuid.New()
+
+		require.NoError(t, order.AddPhoto(theTime, domain.NewOrderPhotoParams{
+			PhotoID: photoID,
+			Stage:   domain.OrderPhotoStageDiagnosis,
+			Caption: optional.Some("corrosion near the charging port"),
+		}))
+
+		require.Len(t, order.Photos(), 2)
+
+		got := order.Photos()[1]
+		assert.NotEqual(t, uuid.Nil, got.ID())
+		assert.Equal(t, photoID, got.PhotoID())
+		assert.Equal(t, domain.OrderPhotoStageDiagnosis, got.Stage())
+		assert.Equal(t, theTime, got.CreationTime())
+
+		caption := got.Caption()
+		assert.Equal(t, "corrosion near the charging port", caption.MustGet())
+	})
+
+	t.Run("adds photos to a completed order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.CompleteRepair(theTime, false))
+
+		err := order.AddPhoto(theTime, domain.NewOrderPhotoParams{PhotoID: uuid.New(), Stage: domain.OrderPhotoStageCompleted})
+		assert.NoError(t, err)
+	})
+
+	t.Run("rejects invalid photos", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			params domain.NewOrderPhotoParams
+		}{
+			{
+				name:   "photo is already attached",
+				params: domain.NewOrderPhotoParams{PhotoID: theIntakePhotoID, Stage: domain.OrderPhotoStageRepair},
+			},
+			{
+				name:   "stage is unknown",
+				params: domain.NewOrderPhotoParams{PhotoID: uuid.New(), Stage: domain.OrderPhotoStage("before")},
+			},
+			{
+				name: "caption is empty",
+				params: domain.NewOrderPhotoParams{
+					PhotoID: uuid.New(),
+					Stage:   domain.OrderPhotoStageRepair,
+					Caption: optional.Some(""),
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				order := newOrder(t)
+
+				err := order.AddPhoto(theTime, tc.params)
+				require.ErrorIs(t, err, apperror.ErrInvalidInput)
+
+				assert.Len(t, order.Photos(), 1)
+			})
+		}
+	})
+
+	t.Run("removes a photo", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.AddPhoto(theTime, domain.NewOrderPhotoParams{PhotoID: uuid.New(), Stage: domain.OrderPhotoStageRepair}))
+
+		require.NoError(t, order.RemovePhoto(order.Photos()[1].ID()))
+
+		require.Len(t, order.Photos(), 1)
+		assert.Equal(t, theIntakePhotoID, order.Photos()[0].PhotoID())
+	})
+
+	t.Run("removes an intake photo when another one is left", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.AddPhoto(theTime, domain.NewOrderPhotoParams{PhotoID: uuid.New(), Stage: domain.OrderPhotoStageIntake}))
+
+		require.NoError(t, order.RemovePhoto(order.Photos()[0].ID()))
+
+		require.Len(t, order.Photos(), 1)
+		assert.Equal(t, domain.OrderPhotoStageIntake, order.Photos()[0].Stage())
+	})
+
+	t.Run("rejects removing the last intake photo", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.AddPhoto(theTime, domain.NewOrderPhotoParams{PhotoID: uuid.New(), Stage: domain.OrderPhotoStageRepair}))
+
+		err := order.RemovePhoto(order.Photos()[0].ID())
+		require.ErrorIs(t, err, apperror.ErrInvalidInput)
+
+		assert.Len(t, order.Photos(), 2)
+	})
+
+	t.Run("returns not found error when photo is not attached", func(t *testing.T) {
+		order := newOrder(t)
+
+		err := order.RemovePhoto(uuid.New())
+		assert.ErrorIs(t, err, apperror.ErrRepairOrderPhotoNotFound)
+	})
+
+	t.Run("rejects changing photos of finished order", func(t *testing.T) {
+		order := newOrder(t)
+		require.NoError(t, order.AddPhoto(theTime, domain.NewOrderPhotoParams{PhotoID: uuid.New(), Stage: domain.OrderPhotoStageRepair}))
+		require.NoError(t, order.Cancel(theTime, "customer changed their mind", optional.None[uint](), optional.None[domain.NewOrderRefundParams]()))
+
+		err := order.AddPhoto(theTime, domain.NewOrderPhotoParams{PhotoID: uuid.New(), Stage: domain.OrderPhotoStageRepair})
+		require.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+
+		err = order.RemovePhoto(order.Photos()[1].ID())
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+	})
+}
+
+func TestOrderPickUpBalance(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+	newCompletedOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    time.Now(),
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{uuid.New()},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    uuid.New(),
+			DownPayment: optional.Some(domain.NewOrderPaymentParams{
+				Amount:          30,
+				PaymentMethodID: uuid.New(),
+			}),
+		})
+		require.NoError(t, err)
+
+		require.NoError(t, order.CompleteRepair(theTime, false))
+
+		return order
+	}
+
+	newRepayment := func(t *testing.T, amount uint) optional.Optional[domain.NewOrderPaymentParams] {
+		t.Helper()
+
+		return optional.Some(domain.NewOrderPaymentParams{Amount: amount, PaymentMethodID: uuid.New()})
+	}
+
+	t.Run("accepts repayment that settles the balance", func(t *testing.T) {
+		order := newCompletedOrder(t)
+
+		require.NoError(t, order.PickUpByCustomer(theTime, newRepayment(t, 70), optional.None[string]()))
+
+		writeOff := order.WriteOff()
+		assert.False(t, writeOff.IsSet())
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+
+	t.Run("rejects underpayment without write-off", func(t *testing.T) {
+		order := newCompletedOrder(t)
+
+		err := order.PickUpByCustomer(theTime, newRepayment(t, 60), optional.None[string]())
+		require.ErrorIs(t, err, apperror.ErrInvalidInput)
+		assert.Equal(t, domain.OrderStatusCompleted, order.Status())
+	})
+
+	t.Run("rejects overpayment without write-off", func(t *testing.T) {
+		order := newCompletedOrder(t)
+
+		err := order.PickUpByCustomer(theTime, newRepayment(t, 80), optional.None[string]())
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+
+	t.Run("writes off underpayment with reason", func(t *testing.T) {
+		order := newCompletedOrder(t)
+
+		require.NoError(t, order.PickUpByCustomer(theTime, newRepayment(t, 60), optional.Some("loyal customer")))
+
+		writeOff := order.WriteOff()
+		require.True(t, writeOff.IsSet())
+		assert.Equal(t, 10, writeOff.MustGet().Amount())
+		assert.Equal(t, "loyal customer", writeOff.MustGet().Reason())
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+
+	t.Run("writes off overpayment with reason", func(t *testing.T) {
+		order := newCompletedOrder(t)
+
+		require.NoError(t, order.PickUpByCustomer(theTime, newRepayment(t, 80), optional.Some("no change available")))
+
+		writeOff := order.WriteOff()
+		require.True(t, writeOff.IsSet())
+		assert.Equal(t, -10, writeOff.MustGet().Amount())
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+}
+
+func TestOrderRecordPayment(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	theMethodID := uuid.New()
+
+	newOrder := func(t *testing.T, downPayment optional.Optional[domain.NewOrderPaymentParams]) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    theTime,
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{uuid.New()},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    uuid.New(),
+			DownPayment:     downPayment,
+		})
+		require.NoError(t, err)
+
+		return order
+	}
+
+	withDownPayment := optional.Some(domain.NewOrderPaymentParams{Amount: 30, PaymentMethodID: theMethodID})
+
+	t.Run("records the down payment on creation", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+
+		require.Len(t, order.Payments(), 1)
+		assert.Equal(t, domain.OrderPaymentTypeDownPayment, order.Payments()[0].Type())
+		assert.Equal(t, theMethodID, order.Payments()[0].PaymentMethodID())
+		assert.Equal(t, theTime, order.Payments()[0].CreationTime())
+		assert.Equal(t, 30, order.PaidAmount())
+		assert.Equal(t, 70, order.OutstandingAmount())
+	})
+
+	t.Run("records partial payments with mixed methods", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+		otherMethodID := uuid.New()
+
+		require.NoError(t, order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypePartial,
+			domain.NewOrderPaymentParams{Amount: 20, PaymentMethodID: theMethodID},
+		))
+		require.NoError(t, order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypePartial,
+			domain.NewOrderPaymentParams{Amount: 50, PaymentMethodID: otherMethodID},
+		))
+
+		require.Len(t, order.Payments(), 3)
+		assert.Equal(t, otherMethodID, order.Payments()[2].PaymentMethodID())
+		assert.Equal(t, 100, order.PaidAmount())
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+
+	t.Run("records a down payment after creation", func(t *testing.T) {
+		order := newOrder(t, optional.None[domain.NewOrderPaymentParams]())
+
+		require.NoError(t, order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypeDownPayment,
+			domain.NewOrderPaymentParams{Amount: 40, PaymentMethodID: theMethodID},
+		))
+
+		assert.Equal(t, 40, order.PaidAmount())
+	})
+
+	t.Run("rejects a second down payment", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+
+		err := order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypeDownPayment,
+			domain.NewOrderPaymentParams{Amount: 10, PaymentMethodID: theMethodID},
+		)
+		require.ErrorIs(t, err, apperror.ErrInvalidInput)
+		assert.Len(t, order.Payments(), 1)
+	})
+
+	t.Run("rejects payment greater than the outstanding amount", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+
+		err := order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypePartial,
+			domain.NewOrderPaymentParams{Amount: 71, PaymentMethodID: theMethodID},
+		)
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+
+	t.Run("rejects zero payment", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+
+		err := order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypePartial,
+			domain.NewOrderPaymentParams{Amount: 0, PaymentMethodID: theMethodID},
+		)
+		assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+	})
+
+	t.Run("rejects final and refund payments", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+
+		for _, paymentType := range []domain.OrderPaymentType{domain.OrderPaymentTypeFinal, domain.OrderPaymentTypeRefund} {
+			err := order.RecordPayment(
+				theTime,
+				paymentType,
+				domain.NewOrderPaymentParams{Amount: 10, PaymentMethodID: theMethodID},
+			)
+			assert.ErrorIs(t, err, apperror.ErrInvalidInput)
+		}
+	})
+
+	t.Run("rejects payment for cancelled order", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+		require.NoError(t, order.Cancel(theTime, "customer changed their mind", optional.Some[uint](30), optional.None[domain.NewOrderRefundParams]()))
+
+		err := order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypePartial,
+			domain.NewOrderPaymentParams{Amount: 10, PaymentMethodID: theMethodID},
+		)
+		assert.ErrorIs(t, err, apperror.ErrInvalidStateTransition)
+	})
+
+	t.Run("records the final payment on pick up", func(t *testing.T) {
+		order := newOrder(t, withDownPayment)
+		require.NoError(t, order.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypePartial,
+			domain.NewOrderPaymentParams{Amount: 20, PaymentMethodID: theMethodID},
+		))
+		require.NoError(t, order.CompleteRepair(theTime, false))
+
+		require.NoError(t, order.PickUpByCustomer(
+			theTime,
+			optional.Some(domain.NewOrderPaymentParams{Amount: 50, PaymentMethodID: theMethodID}),
+			optional.None[string](),
+		))
+
+		require.Len(t, order.Payments(), 3)
+		assert.Equal(t, domain.OrderPaymentTypeFinal, order.Payments()[2].Type())
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+}
+
+func TestOrderCancel(t *testing.T) {
+	theTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	theMethodID := uuid.New()
+
+	newOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:    theTime,
+			Slug:            "slug",
+			StoreID:         uuid.New(),
+			CustomerName:    "John Doe",
+			ContactNumber:   theContactNumber,
+			PhoneType:       "Advan G5",
+			Color:           "White",
+			InitialCost:     100,
+			PhoneConditions: []string{"condition 1"},
+			PhoneEquipments: []string{"equipment 1"},
+			Damages:         []string{"damage 1"},
+			Photos:          []uuid.UUID{uuid.New()},
+			SalesPersonID:   uuid.New(),
+			TechnicianID:    uuid.New(),
+			DownPayment:     optional.Some(domain.NewOrderPaymentParams{Amount: 30, PaymentMethodID: theMethodID}),
+		})
+		require.NoError(t, err)
+
+		return order
+	}
+
+	newRefund := func(amount uint, reason string) optional.Optional[domain.NewOrderRefundParams] {
+		return optional.Some(domain.NewOrderRefundParams{Amount: amount, PaymentMethodID: theMethodID, Reason: reason})
+	}
+
+	t.Run("refunds the whole paid amount", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.Cancel(theTime, "customer declined", optional.None[uint](), newRefund(30, "repair cancelled")))
+
+		require.Len(t, order.Payments(), 2)
+
+		refund := order.Payments()[1]
+		assert.Equal(t, domain.OrderPaymentTypeRefund, refund.Type())
+		assert.Equal(t, -30, refund.SignedAmount())
+
+		reason := refund.Reason()
+		assert.Equal(t, "repair cancelled", reason.MustGet())
+
+		assert.Equal(t, 0, order.PaidAmount())
+		assert.Equal(t, 0, order.OutstandingAmount())
+		assert.Equal(t, domain.OrderStatusCancelled, order.Status())
+	})
+
+	t.Run("keeps the cancellation fee and refunds the rest", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.Cancel(theTime, "customer declined", optional.Some[uint](10), newRefund(20, "repair cancelled")))
+
+		fee := order.CancellationFee()
+		assert.Equal(t, uint(10), fee.MustGet())
+		assert.Equal(t, 10, order.PaidAmount())
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+
+	t.Run("does not need a refund when the fee covers the paid amount", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.Cancel(theTime, "customer declined", optional.Some[uint](30), optional.None[domain.NewOrderRefundParams]()))
+
+		assert.Len(t, order.Payments(), 1)
+		assert.Equal(t, 0, order.OutstandingAmount())
+	})
+
+	t.Run("rejects invalid settlements", func(t *testing.T) {
+		testCases := []struct {
+			name   string
+			fee    optional.Optional[uint]
+			refund optional.Optional[domain.NewOrderRefundParams]
+		}{
+			{
+				name:   "paid amount is not refunded",
+				fee:    optional.None[uint](),
+				refund: optional.None[domain.NewOrderRefundParams](),
+			},
+			{
+				name:   "refund is less than the refundable amount",
+				fee:    optional.Some[uint](10),
+				refund: newRefund(10, "repair cancelled"),
+			},
+			{
+				name:   "refund is greater than the refundable amount",
+				fee:    optional.None[uint](),
+				refund: newRefund(40, "repair cancelled"),
+			},
+			{
+				name:   "refund without anything to refund",
+				fee:    optional.Some[uint](30),
+				refund: newRefund(10, "repair cancelled"),
+			},
+			{
+				name:   "fee is greater than the paid amount",
+				fee:    optional.Some[uint](40),
+				refund: optional.None[domain.NewOrderRefundParams](),
+			},
+			{
+				name:   "fee is zero",
+				fee:    optional.Some[uint](0),
+				refund: newRefund(30, "repair cancelled"),
+			},
+			{
+				name:   "refund reason is empty",
+				fee:    optional.None[uint](),
+				refund: newRefund(30, ""),
+			},
+		}
+
+		for _, tc := range testCases {
+			t.Run(tc.name, func(t *testing.T) {
+				order := newOrder(t)
+
+				err := order.Cancel(theTime, "customer declined", tc.fee, tc.refund)
+				require.ErrorIs(t, err, apperror.ErrInvalidInput)
+
+				assert.Equal(t, domain.OrderStatusOpen, order.Status())
+				assert.Len(t, order.Payments(), 1)
+			})
+		}
+	})
+}
+
+func TestOrderEvents(t *testing.T) {
+	creationTime := time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	theTime := creationTime.Add(time.Hour)
+
+	newOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		theContactNumber, err := shareddomain.NewPhoneNumber("081234567890")
+		require.NoError(t, err)
+
+		order, err := domain.NewOrder(domain.NewOrderParams{
+			CreationTime:  creationTime,
+			Slug:          "slug",
+			StoreID:       uuid.New(),
+			CustomerName:  "John Doe",
+			ContactNumber: theContactNumber,
+			PhoneType:     "Advan G5",
+			Color:         "White",
+			InitialCost:   100,
+			Damages:       []string{"damage 1"},
+			Photos:        []uuid.UUID{uuid.New()},
+			SalesPersonID: uuid.New(),
+			TechnicianID:  uuid.New(),
+		})
+		require.NoError(t, err)
+
+		return order
+	}
+
+	eventTypes := func(events []domain.OrderEvent) []domain.OrderEventType {
+		types := make([]domain.OrderEventType, 0, len(events))
+		for _, event := range events {
+			types = append(types, event.Type())
+		}
+
+		return types
+	}
+
+	t.Run("records created event", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.Len(t, order.Events(), 1)
+
+		metadata := order.Events()[0].Metadata()
+		assert.Equal(t, domain.OrderEventTypeCreated, order.Events()[0].Type())
+		assert.Equal(t, order.ID(), metadata.OrderID)
+		assert.Equal(t, order.StoreID(), metadata.StoreID)
+		assert.Equal(t, creationTime, metadata.OccurredTime)
+		assert.NotEqual(t, uuid.Nil, metadata.EventID)
+	})
+
+	t.Run("records an event for each change", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.MutateCost(theTime, 20, "replace battery"))
+		require.NoError(t, order.ConfirmToCustomer(theTime, "replace LCD"))
+		require.NoError(t, order.CompleteRepair(theTime, true))
+		require.NoError(t, order.PickUpByCustomer(
+			theTime,
+			optional.Some(domain.NewOrderPaymentParams{Amount: 120, PaymentMethodID: uuid.New()}),
+			optional.None[string](),
+		))
+
+		events := order.Events()
+		assert.Equal(t, []domain.OrderEventType{
+			domain.OrderEventTypeCreated,
+			domain.OrderEventTypeCostMutated,
+			domain.OrderEventTypeConfirmed,
+			domain.OrderEventTypeCompleted,
+			domain.OrderEventTypePickedUp,
+		}, eventTypes(events))
+
+		costMutated, ok := events[1].(domain.OrderCostMutated)
+		require.True(t, ok)
+		assert.Equal(t, 20, costMutated.Amount)
+		assert.Equal(t, "replace battery", costMutated.Reason)
+		assert.Equal(t, theTime, costMutated.OccurredTime)
+
+		confirmed, ok := events[2].(domain.OrderConfirmed)
+		require.True(t, ok)
+		assert.Equal(t, "replace LCD", confirmed.Contents)
+	})
+
+	t.Run("records cancelled event", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.NoError(t, order.Cancel(
+			theTime,
+			"customer declined",
+			optional.None[uint](),
+			optional.None[domain.NewOrderRefundParams](),
+		))
+
+		events := order.Events()
+		require.Len(t, events, 2)
+
+		cancelled, ok := events[1].(domain.OrderCancelled)
+		require.True(t, ok)
+		assert.Equal(t, "customer declined", cancelled.Reason)
+	})
+
+	t.Run("does not record event when change fails", func(t *testing.T) {
+		order := newOrder(t)
+
+		require.Error(t, order.CompleteRepair(theTime, true))
+		require.Error(t, order.ConfirmToCustomer(theTime, ""))
+
+		assert.Equal(t, []domain.OrderEventType{domain.OrderEventTypeCreated}, eventTypes(order.Events()))
+	})
+
+	t.Run("clears recorded events", func(t *testing.T) {
+		order := newOrder(t)
+		order.ClearEvents()
+
+		require.NoError(t, order.ConfirmToCustomer(theTime, "replace LCD"))
+
+		assert.Equal(t, []domain.OrderEventType{domain.OrderEventTypeConfirmed}, eventTypes(order.Events()))
+	})
+}
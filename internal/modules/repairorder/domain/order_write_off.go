@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"fmt"
+
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+)
+
+// OrderWriteOff records the part of the total cost that was settled without
+// being paid. A negative amount means the customer paid more than the total.
+type OrderWriteOff interface {
+	Amount() int
+	Reason() string
+}
+
+type orderWriteOff struct {
+	amount int
+	reason string
+}
+
+func NewOrderWriteOff(amount int, reason string) (OrderWriteOff, error) {
+	if amount == 0 {
+		return nil, fmt.Errorf("%w: amount is zero", apperror.ErrInvalidInput)
+	}
+
+	if reason == "" {
+		return nil, fmt.Errorf("%w: reason is empty", apperror.ErrInvalidInput)
+	}
+
+	return orderWriteOff{amount: amount, reason: reason}, nil
+}
+
+func (o orderWriteOff) Amount() int {
+	return o.amount
+}
+
+func (o orderWriteOff) Reason() string {
+	return o.reason
+}
@@ -0,0 +1,25 @@
+package readmodel
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+type RepairOrderListFilter struct {
+	Status        optional.Optional[domain.OrderStatus]
+	TechnicianID  optional.Optional[uuid.UUID]
+	SalesPersonID optional.Optional[uuid.UUID]
+	CreatedFrom   optional.Optional[time.Time]
+	CreatedTo     optional.Optional[time.Time]
+	Search        optional.Optional[string]
+}
+
+// RepairOrderListCursor points at the last repair order of a page. Orders are
+// listed newest first, so the next page starts right after it.
+type RepairOrderListCursor struct {
+	CreationTime  time.Time
+	RepairOrderID uuid.UUID
+}
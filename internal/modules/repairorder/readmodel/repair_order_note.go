@@ -0,0 +1,8 @@
+package readmodel
+
+import "github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+
+type RepairOrderNote struct {
+	Note           domain.OrderNote
+	AuthorUsername string
+}
@@ -0,0 +1,27 @@
+package readmodel
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+)
+
+type StoreReceiptDetails struct {
+	Name          string
+	Address       string
+	PhoneNumber   string
+	WarrantyTerms optional.Optional[string]
+	HTMLTemplate  optional.Optional[string]
+	PDFTemplate   optional.Optional[string]
+	PaperWidth    int
+}
+
+type RepairOrderReceipt struct {
+	Store          StoreReceiptDetails
+	Order          domain.Order
+	GenerationTime time.Time
+
+	// Notes only holds the notes that are visible to the customer.
+	Notes []domain.OrderNote
+}
@@ -0,0 +1,23 @@
+package readmodel
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+type RepairOrderSummary struct {
+	ID            uuid.UUID
+	CreationTime  time.Time
+	Slug          string
+	Status        domain.OrderStatus
+	CustomerName  string
+	ContactNumber string
+	PhoneType     string
+	Color         string
+	IMEI          optional.Optional[string]
+	TechnicianID  uuid.UUID
+	SalesPersonID uuid.UUID
+}
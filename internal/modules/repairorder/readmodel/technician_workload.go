@@ -0,0 +1,18 @@
+package readmodel
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+// TechnicianWorkload counts the orders a technician is still working on,
+// which are the ones that are neither completed nor cancelled.
+type TechnicianWorkload struct {
+	TechnicianID            uuid.UUID
+	TechnicianName          string
+	OpenCount               int
+	ConfirmedCount          int
+	OldestOrderCreationTime optional.Optional[time.Time]
+}
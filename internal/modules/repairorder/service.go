@@ -1,34 +1,68 @@
 package repairorder
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"net/http"
 	"net/url"
+	"strings"
 	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/apierror"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
 	shareddomain "github.com/JosephJoshua/remana-backend/internal/modules/shared/domain"
 	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/pagination"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 )
 
 type Repository interface {
 	CreateRepairOrder(ctx context.Context, order domain.Order) error
+	GetRepairOrderByID(ctx context.Context, storeID uuid.UUID, repairOrderID uuid.UUID) (domain.Order, error)
+	GetRepairOrderBySlug(ctx context.Context, storeID uuid.UUID, slug string) (domain.Order, error)
+	ListRepairOrders(
+		ctx context.Context,
+		storeID uuid.UUID,
+		filter readmodel.RepairOrderListFilter,
+		cursor optional.Optional[readmodel.RepairOrderListCursor],
+		limit int,
+	) ([]readmodel.RepairOrderSummary, error)
+	CountRepairOrders(ctx context.Context, storeID uuid.UUID, filter readmodel.RepairOrderListFilter) (int, error)
+	GetTechnicianQueue(ctx context.Context, storeID uuid.UUID, technicianID uuid.UUID) ([]readmodel.RepairOrderSummary, error)
+	GetTechnicianWorkload(ctx context.Context, storeID uuid.UUID) ([]readmodel.TechnicianWorkload, error)
 	GetDamageNamesByIDs(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error)
 	GetPhoneConditionNamesByIDs(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error)
 	GetPhoneEquipmentNamesByIDs(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error)
 	DoesTechnicianExist(ctx context.Context, storeID uuid.UUID, technicianID uuid.UUID) (bool, error)
 	DoesSalesPersonExist(ctx context.Context, storeID uuid.UUID, salesPersonID uuid.UUID) (bool, error)
+	CountPhotosByIDs(ctx context.Context, storeID uuid.UUID, ids []uuid.UUID) (int, error)
 	DoesPaymentMethodExist(ctx context.Context, storeID uuid.UUID, paymentMethodID uuid.UUID) (bool, error)
+	DoesStoreRequireConfirmationBeforeCompletion(ctx context.Context, storeID uuid.UUID) (bool, error)
+	GetStoreReceiptDetails(ctx context.Context, storeID uuid.UUID) (readmodel.StoreReceiptDetails, error)
+	UpdateRepairOrder(ctx context.Context, order domain.Order) error
+	CreateRepairOrderNote(ctx context.Context, note domain.OrderNote) error
+	UpdateRepairOrderNote(ctx context.Context, note domain.OrderNote) error
+	GetRepairOrderNoteByID(ctx context.Context, repairOrderID uuid.UUID, noteID uuid.UUID) (domain.OrderNote, error)
+	GetRepairOrderNotes(
+		ctx context.Context,
+		repairOrderID uuid.UUID,
+		visibility optional.Optional[domain.OrderNoteVisibility],
+	) ([]readmodel.RepairOrderNote, error)
 }
 
+const (
+	defaultRepairOrderPageSize = 20
+	maxRepairOrderPageSize     = 100
+)
+
 type OrderSlugProvider interface {
 	Generate(ctx context.Context, storeID uuid.UUID) (string, error)
 }
@@ -41,12 +75,31 @@ type ResourceLocationProvider interface {
 	RepairOrder(orderID uuid.UUID) url.URL
 }
 
+type ReceiptRenderer interface {
+	RenderHTML(receipt readmodel.RepairOrderReceipt) ([]byte, error)
+	RenderPDF(receipt readmodel.RepairOrderReceipt) ([]byte, error)
+	RenderESCPOS(receipt readmodel.RepairOrderReceipt) ([]byte, error)
+}
+
+type LabelRenderer interface {
+	RenderPNG(orders []domain.Order) ([]byte, error)
+	RenderSVG(orders []domain.Order) ([]byte, error)
+}
+
+type AuditLog interface {
+	Record(ctx context.Context, change audit.Change) error
+	History(ctx context.Context, storeID uuid.UUID, entityType audit.EntityType, entityID uuid.UUID) ([]audit.Entry, error)
+}
+
 type Service struct {
 	timeProvider       TimeProvider
 	locationProvider   ResourceLocationProvider
 	repo               Repository
 	orderSlugProvider  OrderSlugProvider
 	permissionProvider permission.Provider
+	receiptRenderer    ReceiptRenderer
+	labelRenderer      LabelRenderer
+	auditLog           AuditLog
 }
 
 func NewService(
@@ -55,6 +108,9 @@ func NewService(
 	repo Repository,
 	permissionProvider permission.Provider,
 	orderSlugProvider OrderSlugProvider,
+	receiptRenderer ReceiptRenderer,
+	labelRenderer LabelRenderer,
+	auditLog AuditLog,
 ) *Service {
 	return &Service{
 		timeProvider:       timeProvider,
@@ -62,6 +118,9 @@ func NewService(
 		repo:               repo,
 		permissionProvider: permissionProvider,
 		orderSlugProvider:  orderSlugProvider,
+		receiptRenderer:    receiptRenderer,
+		labelRenderer:      labelRenderer,
+		auditLog:           auditLog,
 	}
 }
 
@@ -117,21 +176,22 @@ func (s *Service) CreateRepairOrder(
 		}
 	}
 
-	var downPayment optional.Optional[domain.OrderPayment]
+	var downPayment optional.Optional[domain.NewOrderPaymentParams]
 
 	if req.DownPayment.IsSet() {
 		if req.DownPayment.Value.Amount <= 0 {
 			return nil, apierror.ToAPIError(http.StatusBadRequest, "down payment amount must be greater than 0")
 		}
 
-		tmp, paymentErr := domain.NewOrderPayment(uint(req.DownPayment.Value.Amount), req.DownPayment.Value.Method)
-		if paymentErr != nil {
-			return nil, apierror.ToAPIError(http.StatusBadRequest, paymentErr.Error())
-		}
-
-		downPayment = optional.Some(tmp)
+		downPayment = optional.Some(domain.NewOrderPaymentParams{
+			Amount:          uint(req.DownPayment.Value.Amount),
+			PaymentMethodID: req.DownPayment.Value.Method,
+		})
 	}
 
+	// The same photo is only attached once, however often it's sent.
+	req.Photos = dedupeIDs(req.Photos)
+
 	if err = s.checkReferentialIntegrity(ctx, l, storeID, req); err != nil {
 		return nil, err
 	}
@@ -176,25 +236,31 @@ func (s *Service) CreateRepairOrder(
 		partsNotCheckedYet = optional.Some(req.PartsNotCheckedYet.Value)
 	}
 
+	var estimatedCompletionTime optional.Optional[time.Time]
+	if req.EstimatedCompletionTime.IsSet() {
+		estimatedCompletionTime = optional.Some(req.EstimatedCompletionTime.Value)
+	}
+
 	params := domain.NewOrderParams{
-		CreationTime:         creationTime,
-		Slug:                 slug,
-		StoreID:              storeID,
-		CustomerName:         req.CustomerName,
-		ContactNumber:        contactNumber,
-		PhoneType:            req.PhoneType,
-		Color:                req.Color,
-		InitialCost:          uint(req.InitialCost),
-		PhoneConditions:      phoneConditions,
-		PhoneEquipments:      phoneEquipments,
-		Damages:              damages,
-		Photos:               req.Photos,
-		SalesPersonID:        req.SalesPersonID,
-		TechnicianID:         req.TechnicianID,
-		Imei:                 imei,
-		PartsNotCheckedYet:   partsNotCheckedYet,
-		DownPayment:          downPayment,
-		PhoneSecurityDetails: phoneSecurityDetails,
+		CreationTime:            creationTime,
+		Slug:                    slug,
+		StoreID:                 storeID,
+		CustomerName:            req.CustomerName,
+		ContactNumber:           contactNumber,
+		PhoneType:               req.PhoneType,
+		Color:                   req.Color,
+		InitialCost:             uint(req.InitialCost),
+		PhoneConditions:         phoneConditions,
+		PhoneEquipments:         phoneEquipments,
+		Damages:                 damages,
+		Photos:                  req.Photos,
+		SalesPersonID:           req.SalesPersonID,
+		TechnicianID:            req.TechnicianID,
+		Imei:                    imei,
+		PartsNotCheckedYet:      partsNotCheckedYet,
+		DownPayment:             downPayment,
+		PhoneSecurityDetails:    phoneSecurityDetails,
+		EstimatedCompletionTime: estimatedCompletionTime,
 	}
 
 	repairOrder, err := domain.NewOrder(params)
@@ -209,51 +275,1583 @@ func (s *Service) CreateRepairOrder(
 		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create repair order")
 	}
 
+	if err = audit.RecordChange(ctx, s.auditLog, audit.Change{
+		Action:     audit.ActionRepairOrderCreated,
+		EntityType: audit.EntityTypeRepairOrder,
+		EntityID:   repairOrder.ID(),
+		After:      toAuditRepairOrder(repairOrder),
+	}); err != nil {
+		return nil, err
+	}
+
 	location := s.locationProvider.RepairOrder(repairOrder.ID())
 	return &genapi.CreateRepairOrderCreated{
 		Location: location,
 	}, nil
 }
 
-func (s *Service) checkReferentialIntegrity(
+func (s *Service) GetRepairOrder(
 	ctx context.Context,
-	l *zerolog.Logger,
-	storeID uuid.UUID,
-	req *genapi.CreateRepairOrderRequest,
-) error {
-	ok, err := s.repo.DoesTechnicianExist(ctx, storeID, req.TechnicianID)
+	params genapi.GetRepairOrderParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	order, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	return toAPIRepairOrder(order), nil
+}
+
+func (s *Service) GetRepairOrderBySlug(
+	ctx context.Context,
+	params genapi.GetRepairOrderBySlugParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	order, err := s.repo.GetRepairOrderBySlug(ctx, user.Store.ID, params.Slug)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by slug")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	return toAPIRepairOrder(order), nil
+}
 
+func (s *Service) ListRepairOrders(
+	ctx context.Context,
+	params genapi.ListRepairOrdersParams,
+) (*genapi.RepairOrderList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	filter, err := buildRepairOrderListFilter(params)
+	if err != nil {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+	}
+
+	cursor := optional.None[readmodel.RepairOrderListCursor]()
+	if params.Cursor.IsSet() {
+		creationTime, repairOrderID, decodeErr := pagination.DecodeCursor(params.Cursor.Value)
+		if decodeErr != nil {
+			return nil, apierror.ToAPIError(http.StatusBadRequest, "invalid cursor")
+		}
+
+		cursor = optional.Some(readmodel.RepairOrderListCursor{
+			CreationTime:  creationTime,
+			RepairOrderID: repairOrderID,
+		})
+	}
+
+	limit := params.Limit.Or(defaultRepairOrderPageSize)
+	if limit < 1 || limit > maxRepairOrderPageSize {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "limit must be between 1 and 100")
+	}
+
+	// One more order than requested is fetched to know whether there is a next page.
+	summaries, err := s.repo.ListRepairOrders(ctx, user.Store.ID, filter, cursor, limit+1)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to list repair orders")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to list repair orders")
+	}
+
+	totalCount, err := s.repo.CountRepairOrders(ctx, user.Store.ID, filter)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to count repair orders")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to count repair orders")
+	}
+
+	res := &genapi.RepairOrderList{
+		Items:      make([]genapi.RepairOrderSummary, 0, min(len(summaries), limit)),
+		TotalCount: totalCount,
+	}
+
+	if len(summaries) > limit {
+		summaries = summaries[:limit]
+
+		last := summaries[len(summaries)-1]
+		res.NextCursor = genapi.NewOptString(pagination.EncodeCursor(last.CreationTime, last.ID))
+	}
+
+	for _, summary := range summaries {
+		res.Items = append(res.Items, toAPIRepairOrderSummary(summary))
+	}
+
+	return res, nil
+}
+
+func (s *Service) GetTechnicianQueue(
+	ctx context.Context,
+	params genapi.GetTechnicianQueueParams,
+) (*genapi.TechnicianQueue, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewTechnicianWorkload()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	exists, err := s.repo.DoesTechnicianExist(ctx, user.Store.ID, params.TechnicianId)
 	if err != nil {
 		l.Error().Err(err).Msg("failed to check if technician exists")
-		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if technician exists")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check if technician exists")
+	}
+
+	if !exists {
+		return nil, apierror.ToAPIError(http.StatusNotFound, "technician not found")
+	}
+
+	summaries, err := s.repo.GetTechnicianQueue(ctx, user.Store.ID, params.TechnicianId)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get technician queue")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get technician queue")
+	}
+
+	res := &genapi.TechnicianQueue{
+		Items: make([]genapi.RepairOrderSummary, 0, len(summaries)),
+	}
+
+	for _, summary := range summaries {
+		res.Items = append(res.Items, toAPIRepairOrderSummary(summary))
 	}
 
+	return res, nil
+}
+
+func (s *Service) GetTechnicianWorkload(ctx context.Context) (*genapi.TechnicianWorkload, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
 	if !ok {
-		return apierror.ToAPIError(http.StatusBadRequest, "technician does not exist")
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	ok, err = s.repo.DoesSalesPersonExist(ctx, storeID, req.SalesPersonID)
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewTechnicianWorkload()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
 
+	workloads, err := s.repo.GetTechnicianWorkload(ctx, user.Store.ID)
 	if err != nil {
-		l.Error().Err(err).Msg("failed to check if sales person exists")
-		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if sales person exists")
+		l.Error().Err(err).Msg("failed to get technician workload")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get technician workload")
+	}
+
+	res := &genapi.TechnicianWorkload{
+		Items: make([]genapi.TechnicianWorkloadItem, 0, len(workloads)),
+	}
+
+	for _, workload := range workloads {
+		item := genapi.TechnicianWorkloadItem{
+			TechnicianID:   workload.TechnicianID,
+			TechnicianName: workload.TechnicianName,
+			OpenCount:      workload.OpenCount,
+			ConfirmedCount: workload.ConfirmedCount,
+		}
+
+		if oldest, ok := workload.OldestOrderCreationTime.Get(); ok {
+			item.OldestOrderCreationTime = genapi.NewOptDateTime(oldest)
+		}
+
+		res.Items = append(res.Items, item)
 	}
 
+	return res, nil
+}
+
+func (s *Service) AddRepairOrderCost(
+	ctx context.Context,
+	req *genapi.AddRepairOrderCostRequest,
+	params genapi.AddRepairOrderCostParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.AddRepairOrderCost(),
+		audit.ActionRepairOrderCostAdded,
+		func(order domain.Order) error {
+			return order.MutateCost(s.timeProvider.Now(), req.Amount, strings.TrimSpace(req.Reason))
+		},
+	)
+}
+
+func (s *Service) RecordRepairOrderPayment(
+	ctx context.Context,
+	req *genapi.RecordRepairOrderPaymentRequest,
+	params genapi.RecordRepairOrderPaymentParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.RecordRepairOrderPayment(),
+		audit.ActionRepairOrderPaymentRecorded,
+		func(order domain.Order) error {
+			if req.Amount <= 0 {
+				return apierror.ToAPIError(http.StatusBadRequest, "payment amount must be greater than 0")
+			}
+
+			if err := s.checkPaymentMethodExists(ctx, order.StoreID(), req.Method); err != nil {
+				return err
+			}
+
+			paymentType, err := domain.NewOrderPaymentType(string(req.Type))
+			if err != nil {
+				return err
+			}
+
+			return order.RecordPayment(s.timeProvider.Now(), paymentType, domain.NewOrderPaymentParams{
+				Amount:          uint(req.Amount),
+				PaymentMethodID: req.Method,
+			})
+		},
+	)
+}
+
+func (s *Service) ListRepairOrderPayments(
+	ctx context.Context,
+	params genapi.ListRepairOrderPaymentsParams,
+) (*genapi.RepairOrderPaymentList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
 	if !ok {
-		return apierror.ToAPIError(http.StatusBadRequest, "sales person does not exist")
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
 	}
 
-	if req.DownPayment.IsSet() {
-		ok, err = s.repo.DoesPaymentMethodExist(ctx, storeID, req.DownPayment.Value.GetMethod())
-		if err != nil {
-			l.Error().Err(err).Msg("failed to check if payment method exists")
-			return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if payment method exists")
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrderPayments()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	order, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
 		}
 
-		if !ok {
-			return apierror.ToAPIError(http.StatusBadRequest, "payment method does not exist")
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	return &genapi.RepairOrderPaymentList{
+		Items:             toAPIRepairOrderPayments(order.Payments()),
+		TotalCost:         order.TotalCost(),
+		PaidAmount:        order.PaidAmount(),
+		OutstandingAmount: order.OutstandingAmount(),
+	}, nil
+}
+
+func (s *Service) GetRepairOrderHistory(
+	ctx context.Context,
+	params genapi.GetRepairOrderHistoryParams,
+) (*genapi.AuditLogEntryList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrderHistory()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if _, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId); err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
 		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
 	}
 
-	return nil
+	entries, err := s.auditLog.History(ctx, user.Store.ID, audit.EntityTypeRepairOrder, params.RepairOrderId)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get repair order history")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order history")
+	}
+
+	res := &genapi.AuditLogEntryList{
+		Items: make([]genapi.AuditLogEntry, 0, len(entries)),
+	}
+
+	for _, entry := range entries {
+		res.Items = append(res.Items, audit.ToAPIEntry(entry))
+	}
+
+	return res, nil
+}
+
+func (s *Service) ListRepairOrderNotes(
+	ctx context.Context,
+	params genapi.ListRepairOrderNotesParams,
+) (*genapi.RepairOrderNoteList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if _, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId); err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	notes, err := s.repo.GetRepairOrderNotes(ctx, params.RepairOrderId, optional.None[domain.OrderNoteVisibility]())
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get repair order notes")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order notes")
+	}
+
+	res := &genapi.RepairOrderNoteList{
+		Items: make([]genapi.RepairOrderNote, 0, len(notes)),
+	}
+
+	for _, note := range notes {
+		res.Items = append(res.Items, *toAPIRepairOrderNote(note.Note, note.AuthorUsername))
+	}
+
+	return res, nil
+}
+
+func (s *Service) AddRepairOrderNote(
+	ctx context.Context,
+	req *genapi.AddRepairOrderNoteRequest,
+	params genapi.AddRepairOrderNoteParams,
+) (*genapi.RepairOrderNote, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.AddRepairOrderNote()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if _, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId); err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	visibility, err := domain.NewOrderNoteVisibility(string(req.Visibility))
+	if err != nil {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+	}
+
+	note, err := domain.NewOrderNote(domain.NewOrderNoteParams{
+		OrderID:      params.RepairOrderId,
+		AuthorID:     user.ID,
+		Body:         strings.TrimSpace(req.Body),
+		Visibility:   visibility,
+		CreationTime: s.timeProvider.Now(),
+	})
+
+	if err != nil {
+		if errors.Is(err, apperror.ErrInvalidInput) {
+			return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+		}
+
+		l.Error().Err(err).Msg("failed to create repair order note")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create repair order note")
+	}
+
+	if err = s.repo.CreateRepairOrderNote(ctx, note); err != nil {
+		l.Error().Err(err).Msg("failed to create repair order note")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create repair order note")
+	}
+
+	res := toAPIRepairOrderNote(note, user.Username)
+
+	if err = audit.RecordChange(ctx, s.auditLog, audit.Change{
+		Action:     audit.ActionRepairOrderNoteAdded,
+		EntityType: audit.EntityTypeRepairOrder,
+		EntityID:   note.OrderID(),
+		After:      res,
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *Service) EditRepairOrderNote(
+	ctx context.Context,
+	req *genapi.EditRepairOrderNoteRequest,
+	params genapi.EditRepairOrderNoteParams,
+) (*genapi.RepairOrderNote, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.AddRepairOrderNote()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if _, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId); err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	note, err := s.repo.GetRepairOrderNoteByID(ctx, params.RepairOrderId, params.NoteId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNoteNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "note not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order note by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order note")
+	}
+
+	if note.AuthorID() != user.ID {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "only the author can edit a note")
+	}
+
+	visibility, err := domain.NewOrderNoteVisibility(string(req.Visibility))
+	if err != nil {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+	}
+
+	before := toAPIRepairOrderNote(note, user.Username)
+
+	if err = note.Edit(s.timeProvider.Now(), strings.TrimSpace(req.Body), visibility); err != nil {
+		switch {
+		case errors.Is(err, apperror.ErrInvalidStateTransition):
+			return nil, apierror.ToAPIError(http.StatusConflict, err.Error())
+		case errors.Is(err, apperror.ErrInvalidInput):
+			return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+		default:
+			l.Error().Err(err).Msg("failed to edit repair order note")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to update repair order note")
+		}
+	}
+
+	if err = s.repo.UpdateRepairOrderNote(ctx, note); err != nil {
+		l.Error().Err(err).Msg("failed to update repair order note")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to update repair order note")
+	}
+
+	res := toAPIRepairOrderNote(note, user.Username)
+
+	if err = audit.RecordChange(ctx, s.auditLog, audit.Change{
+		Action:     audit.ActionRepairOrderNoteEdited,
+		EntityType: audit.EntityTypeRepairOrder,
+		EntityID:   note.OrderID(),
+		Before:     before,
+		After:      res,
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *Service) GetRepairOrderReceipt(
+	ctx context.Context,
+	params genapi.GetRepairOrderReceiptParams,
+) (genapi.GetRepairOrderReceiptRes, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	order, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	store, err := s.repo.GetStoreReceiptDetails(ctx, user.Store.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get store receipt details")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get store receipt details")
+	}
+
+	notes, err := s.repo.GetRepairOrderNotes(ctx, order.ID(), optional.Some(domain.OrderNoteVisibilityCustomer))
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get repair order notes")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order notes")
+	}
+
+	receipt := readmodel.RepairOrderReceipt{
+		Store:          store,
+		Order:          order,
+		GenerationTime: s.timeProvider.Now(),
+		Notes:          make([]domain.OrderNote, 0, len(notes)),
+	}
+
+	for _, note := range notes {
+		receipt.Notes = append(receipt.Notes, note.Note)
+	}
+
+	switch params.Format.Or(genapi.GetRepairOrderReceiptFormatHTML) {
+	case genapi.GetRepairOrderReceiptFormatPdf:
+		data, renderErr := s.receiptRenderer.RenderPDF(receipt)
+		if renderErr != nil {
+			l.Error().Err(renderErr).Msg("failed to render PDF receipt")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to render receipt")
+		}
+
+		return &genapi.GetRepairOrderReceiptOKApplicationPdf{Data: bytes.NewReader(data)}, nil
+
+	case genapi.GetRepairOrderReceiptFormatEscpos:
+		data, renderErr := s.receiptRenderer.RenderESCPOS(receipt)
+		if renderErr != nil {
+			l.Error().Err(renderErr).Msg("failed to render ESC/POS receipt")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to render receipt")
+		}
+
+		return &genapi.GetRepairOrderReceiptOKApplicationOctetStream{Data: bytes.NewReader(data)}, nil
+
+	default:
+		data, renderErr := s.receiptRenderer.RenderHTML(receipt)
+		if renderErr != nil {
+			l.Error().Err(renderErr).Msg("failed to render HTML receipt")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to render receipt")
+		}
+
+		return &genapi.GetRepairOrderReceiptOKTextHTML{Data: bytes.NewReader(data)}, nil
+	}
+}
+
+func (s *Service) GetRepairOrderLabel(
+	ctx context.Context,
+	params genapi.GetRepairOrderLabelParams,
+) (genapi.GetRepairOrderLabelRes, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	order, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, params.RepairOrderId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	if params.Format.Or(genapi.GetRepairOrderLabelFormatPNG) == genapi.GetRepairOrderLabelFormatSvg {
+		data, renderErr := s.labelRenderer.RenderSVG([]domain.Order{order})
+		if renderErr != nil {
+			l.Error().Err(renderErr).Msg("failed to render SVG label")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to render label")
+		}
+
+		return &genapi.GetRepairOrderLabelOKImageSvgXML{Data: bytes.NewReader(data)}, nil
+	}
+
+	data, err := s.labelRenderer.RenderPNG([]domain.Order{order})
+	if err != nil {
+		l.Error().Err(err).Msg("failed to render PNG label")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to render label")
+	}
+
+	return &genapi.GetRepairOrderLabelOKImagePNG{Data: bytes.NewReader(data)}, nil
+}
+
+func (s *Service) RenderRepairOrderLabels(
+	ctx context.Context,
+	req *genapi.RenderRepairOrderLabelsRequest,
+) (genapi.RenderRepairOrderLabelsRes, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewRepairOrder()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	orders := make([]domain.Order, 0, len(req.RepairOrderIds))
+	for _, id := range req.RepairOrderIds {
+		order, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, id)
+		if err != nil {
+			if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+				return nil, apierror.ToAPIError(http.StatusBadRequest, "one or more repair orders do not exist")
+			}
+
+			l.Error().Err(err).Msg("failed to get repair order by ID")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+		}
+
+		orders = append(orders, order)
+	}
+
+	if req.Format.Or(genapi.RenderRepairOrderLabelsRequestFormatPNG) == genapi.RenderRepairOrderLabelsRequestFormatSvg {
+		data, renderErr := s.labelRenderer.RenderSVG(orders)
+		if renderErr != nil {
+			l.Error().Err(renderErr).Msg("failed to render SVG labels")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to render labels")
+		}
+
+		return &genapi.RenderRepairOrderLabelsOKImageSvgXML{Data: bytes.NewReader(data)}, nil
+	}
+
+	data, err := s.labelRenderer.RenderPNG(orders)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to render PNG labels")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to render labels")
+	}
+
+	return &genapi.RenderRepairOrderLabelsOKImagePNG{Data: bytes.NewReader(data)}, nil
+}
+
+func (s *Service) ChangeRepairOrderTechnician(
+	ctx context.Context,
+	req *genapi.ChangeRepairOrderTechnicianRequest,
+	params genapi.ChangeRepairOrderTechnicianParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.ChangeRepairOrderTechnician(),
+		audit.ActionRepairOrderTechnicianChanged,
+		func(order domain.Order) error {
+			ok, err := s.repo.DoesTechnicianExist(ctx, order.StoreID(), req.TechnicianID)
+			if err != nil {
+				l.Error().Err(err).Msg("failed to check if technician exists")
+				return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if technician exists")
+			}
+
+			if !ok {
+				return apierror.ToAPIError(http.StatusBadRequest, "technician does not exist")
+			}
+
+			return order.ChangeTechnician(s.timeProvider.Now(), req.TechnicianID, strings.TrimSpace(req.Reason))
+		},
+	)
+}
+
+func (s *Service) AddRepairOrderDamages(
+	ctx context.Context,
+	req *genapi.AddRepairOrderDamagesRequest,
+	params genapi.AddRepairOrderDamagesParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.EditRepairOrderIntake(),
+		audit.ActionRepairOrderDamagesAdded,
+		func(order domain.Order) error {
+			names, err := s.repo.GetDamageNamesByIDs(ctx, order.StoreID(), req.DamageTypes)
+			if err != nil {
+				if errors.Is(err, apperror.ErrDamageNotFound) {
+					return apierror.ToAPIError(http.StatusBadRequest, "one or more damage types do not exist")
+				}
+
+				l.Error().Err(err).Msg("failed to get damage names by IDs")
+				return apierror.ToAPIError(http.StatusInternalServerError, "failed to get damage names by IDs")
+			}
+
+			for _, name := range names {
+				if err = order.AddDamage(name); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+func (s *Service) RemoveRepairOrderDamage(
+	ctx context.Context,
+	params genapi.RemoveRepairOrderDamageParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.EditRepairOrderIntake(),
+		audit.ActionRepairOrderDamageRemoved,
+		func(order domain.Order) error {
+			err := order.RemoveDamage(params.RepairOrderDamageId)
+			if errors.Is(err, apperror.ErrRepairOrderDamageNotFound) {
+				return apierror.ToAPIError(http.StatusNotFound, "repair order damage not found")
+			}
+
+			return err
+		},
+	)
+}
+
+func (s *Service) AddRepairOrderPhoneConditions(
+	ctx context.Context,
+	req *genapi.AddRepairOrderPhoneConditionsRequest,
+	params genapi.AddRepairOrderPhoneConditionsParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.EditRepairOrderIntake(),
+		audit.ActionRepairOrderPhoneConditionsAdded,
+		func(order domain.Order) error {
+			names, err := s.repo.GetPhoneConditionNamesByIDs(ctx, order.StoreID(), req.PhoneConditions)
+			if err != nil {
+				if errors.Is(err, apperror.ErrPhoneConditionNotFound) {
+					return apierror.ToAPIError(http.StatusBadRequest, "one or more phone conditions do not exist")
+				}
+
+				l.Error().Err(err).Msg("failed to get phone condition names by IDs")
+				return apierror.ToAPIError(http.StatusInternalServerError, "failed to get phone condition names by IDs")
+			}
+
+			for _, name := range names {
+				if err = order.AddPhoneCondition(name); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+func (s *Service) RemoveRepairOrderPhoneCondition(
+	ctx context.Context,
+	params genapi.RemoveRepairOrderPhoneConditionParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.EditRepairOrderIntake(),
+		audit.ActionRepairOrderPhoneConditionRemoved,
+		func(order domain.Order) error {
+			err := order.RemovePhoneCondition(params.RepairOrderPhoneConditionId)
+			if errors.Is(err, apperror.ErrRepairOrderPhoneConditionNotFound) {
+				return apierror.ToAPIError(http.StatusNotFound, "repair order phone condition not found")
+			}
+
+			return err
+		},
+	)
+}
+
+func (s *Service) AddRepairOrderPhoneEquipments(
+	ctx context.Context,
+	req *genapi.AddRepairOrderPhoneEquipmentsRequest,
+	params genapi.AddRepairOrderPhoneEquipmentsParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.EditRepairOrderIntake(),
+		audit.ActionRepairOrderPhoneEquipmentsAdded,
+		func(order domain.Order) error {
+			names, err := s.repo.GetPhoneEquipmentNamesByIDs(ctx, order.StoreID(), req.PhoneEquipments)
+			if err != nil {
+				if errors.Is(err, apperror.ErrPhoneEquipmentNotFound) {
+					return apierror.ToAPIError(http.StatusBadRequest, "one or more phone equipments do not exist")
+				}
+
+				l.Error().Err(err).Msg("failed to get phone equipment names by IDs")
+				return apierror.ToAPIError(http.StatusInternalServerError, "failed to get phone equipment names by IDs")
+			}
+
+			for _, name := range names {
+				if err = order.AddPhoneEquipment(name); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	)
+}
+
+func (s *Service) RemoveRepairOrderPhoneEquipment(
+	ctx context.Context,
+	params genapi.RemoveRepairOrderPhoneEquipmentParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.EditRepairOrderIntake(),
+		audit.ActionRepairOrderPhoneEquipmentRemoved,
+		func(order domain.Order) error {
+			err := order.RemovePhoneEquipment(params.RepairOrderPhoneEquipmentId)
+			if errors.Is(err, apperror.ErrRepairOrderPhoneEquipmentNotFound) {
+				return apierror.ToAPIError(http.StatusNotFound, "repair order phone equipment not found")
+			}
+
+			return err
+		},
+	)
+}
+
+func (s *Service) AddRepairOrderPhoto(
+	ctx context.Context,
+	req *genapi.AddRepairOrderPhotoRequest,
+	params genapi.AddRepairOrderPhotoParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.AddRepairOrderPhoto(),
+		audit.ActionRepairOrderPhotoAdded,
+		func(order domain.Order) error {
+			stage, err := domain.NewOrderPhotoStage(string(req.Stage))
+			if err != nil {
+				return err
+			}
+
+			photoCount, err := s.repo.CountPhotosByIDs(ctx, order.StoreID(), []uuid.UUID{req.PhotoID})
+			if err != nil {
+				l.Error().Err(err).Msg("failed to count photos")
+				return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if photo exists")
+			}
+
+			if photoCount == 0 {
+				return apierror.ToAPIError(http.StatusBadRequest, "photo does not exist")
+			}
+
+			var caption optional.Optional[string]
+			if req.Caption.IsSet() {
+				if value := strings.TrimSpace(req.Caption.Value); value != "" {
+					caption = optional.Some(value)
+				}
+			}
+
+			return order.AddPhoto(s.timeProvider.Now(), domain.NewOrderPhotoParams{
+				PhotoID: req.PhotoID,
+				Stage:   stage,
+				Caption: caption,
+			})
+		},
+	)
+}
+
+func (s *Service) RemoveRepairOrderPhoto(
+	ctx context.Context,
+	params genapi.RemoveRepairOrderPhotoParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.RemoveRepairOrderPhoto(),
+		audit.ActionRepairOrderPhotoRemoved,
+		func(order domain.Order) error {
+			err := order.RemovePhoto(params.RepairOrderPhotoId)
+			if errors.Is(err, apperror.ErrRepairOrderPhotoNotFound) {
+				return apierror.ToAPIError(http.StatusNotFound, "repair order photo not found")
+			}
+
+			return err
+		},
+	)
+}
+
+func (s *Service) ConfirmRepairOrder(
+	ctx context.Context,
+	req *genapi.ConfirmRepairOrderRequest,
+	params genapi.ConfirmRepairOrderParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.ConfirmRepairOrder(),
+		audit.ActionRepairOrderConfirmed,
+		func(order domain.Order) error {
+			return order.ConfirmToCustomer(s.timeProvider.Now(), strings.TrimSpace(req.Contents))
+		},
+	)
+}
+
+func (s *Service) CompleteRepairOrder(
+	ctx context.Context,
+	params genapi.CompleteRepairOrderParams,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.CompleteRepairOrder(),
+		audit.ActionRepairOrderCompleted,
+		func(order domain.Order) error {
+			requiresConfirmation, err := s.repo.DoesStoreRequireConfirmationBeforeCompletion(ctx, order.StoreID())
+			if err != nil {
+				l.Error().Err(err).Msg("failed to check if store requires confirmation before completion")
+				return apierror.ToAPIError(http.StatusInternalServerError, "failed to get store settings")
+			}
+
+			return order.CompleteRepair(s.timeProvider.Now(), requiresConfirmation)
+		},
+	)
+}
+
+func (s *Service) PickUpRepairOrder(
+	ctx context.Context,
+	req genapi.OptPickUpRepairOrderRequest,
+	params genapi.PickUpRepairOrderParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.PickUpRepairOrder(),
+		audit.ActionRepairOrderPickedUp,
+		func(order domain.Order) error {
+			var repayment optional.Optional[domain.NewOrderPaymentParams]
+
+			if req.IsSet() && req.Value.Repayment.IsSet() {
+				value := req.Value.Repayment.Value
+				if value.Amount <= 0 {
+					return apierror.ToAPIError(http.StatusBadRequest, "repayment amount must be greater than 0")
+				}
+
+				if err := s.checkPaymentMethodExists(ctx, order.StoreID(), value.Method); err != nil {
+					return err
+				}
+
+				repayment = optional.Some(domain.NewOrderPaymentParams{
+					Amount:          uint(value.Amount),
+					PaymentMethodID: value.Method,
+				})
+			}
+
+			var writeOffReason optional.Optional[string]
+
+			if req.IsSet() && req.Value.WriteOffReason.IsSet() {
+				if reason := strings.TrimSpace(req.Value.WriteOffReason.Value); reason != "" {
+					writeOffReason = optional.Some(reason)
+				}
+			}
+
+			return order.PickUpByCustomer(s.timeProvider.Now(), repayment, writeOffReason)
+		},
+	)
+}
+
+func (s *Service) CancelRepairOrder(
+	ctx context.Context,
+	req *genapi.CancelRepairOrderRequest,
+	params genapi.CancelRepairOrderParams,
+) (*genapi.RepairOrder, error) {
+	return s.updateRepairOrder(
+		ctx,
+		params.RepairOrderId,
+		permission.CancelRepairOrder(),
+		audit.ActionRepairOrderCancelled,
+		func(order domain.Order) error {
+			var fee optional.Optional[uint]
+
+			if req.Fee.IsSet() {
+				if req.Fee.Value <= 0 {
+					return apierror.ToAPIError(http.StatusBadRequest, "cancellation fee must be greater than 0")
+				}
+
+				fee = optional.Some(uint(req.Fee.Value))
+			}
+
+			var refund optional.Optional[domain.NewOrderRefundParams]
+
+			if req.Refund.IsSet() {
+				if err := s.checkPermission(ctx, permission.RefundRepairOrder()); err != nil {
+					return err
+				}
+
+				value := req.Refund.Value
+				if value.Amount <= 0 {
+					return apierror.ToAPIError(http.StatusBadRequest, "refund amount must be greater than 0")
+				}
+
+				if err := s.checkPaymentMethodExists(ctx, order.StoreID(), value.Method); err != nil {
+					return err
+				}
+
+				refund = optional.Some(domain.NewOrderRefundParams{
+					Amount:          uint(value.Amount),
+					PaymentMethodID: value.Method,
+					Reason:          strings.TrimSpace(value.Reason),
+				})
+			}
+
+			return order.Cancel(s.timeProvider.Now(), strings.TrimSpace(req.Reason), fee, refund)
+		},
+	)
+}
+
+// checkPermission is for actions that need a permission on top of the one
+// the endpoint is gated by.
+func (s *Service) checkPermission(ctx context.Context, perm permission.Permission) error {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, perm); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	return nil
+}
+
+func (s *Service) checkPaymentMethodExists(ctx context.Context, storeID uuid.UUID, methodID uuid.UUID) error {
+	ok, err := s.repo.DoesPaymentMethodExist(ctx, storeID, methodID)
+	if err != nil {
+		zerolog.Ctx(ctx).Error().Err(err).Msg("failed to check if payment method exists")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if payment method exists")
+	}
+
+	if !ok {
+		return apierror.ToAPIError(http.StatusBadRequest, "payment method does not exist")
+	}
+
+	return nil
+}
+
+// updateRepairOrder loads the order, applies the change and persists
+// the result. The change may return an API error to respond with as is.
+func (s *Service) updateRepairOrder(
+	ctx context.Context,
+	repairOrderID uuid.UUID,
+	perm permission.Permission,
+	action audit.Action,
+	change func(order domain.Order) error,
+) (*genapi.RepairOrder, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, perm); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	order, err := s.repo.GetRepairOrderByID(ctx, user.Store.ID, repairOrderID)
+	if err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "repair order not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get repair order by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get repair order")
+	}
+
+	before := toAuditRepairOrder(order)
+
+	if err = change(order); err != nil {
+		var apiErr *genapi.ErrorStatusCode
+
+		switch {
+		case errors.As(err, &apiErr):
+			return nil, apiErr
+		case errors.Is(err, apperror.ErrInvalidStateTransition):
+			return nil, apierror.ToAPIError(http.StatusConflict, err.Error())
+		case errors.Is(err, apperror.ErrInvalidInput):
+			return nil, apierror.ToAPIError(http.StatusBadRequest, err.Error())
+		default:
+			l.Error().Err(err).Msg("failed to change repair order")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to update repair order")
+		}
+	}
+
+	if err = s.repo.UpdateRepairOrder(ctx, order); err != nil {
+		if errors.Is(err, apperror.ErrRepairOrderConcurrentUpdate) {
+			return nil, apierror.ToAPIError(
+				http.StatusConflict,
+				"repair order was changed by someone else. please reload it and try again",
+			)
+		}
+
+		l.Error().Err(err).Msg("failed to update repair order")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to update repair order")
+	}
+
+	if err = audit.RecordChange(ctx, s.auditLog, audit.Change{
+		Action:     action,
+		EntityType: audit.EntityTypeRepairOrder,
+		EntityID:   order.ID(),
+		Before:     before,
+		After:      toAuditRepairOrder(order),
+	}); err != nil {
+		return nil, err
+	}
+
+	return toAPIRepairOrder(order), nil
+}
+
+// dedupeIDs returns ids without repeats, keeping the order they first appear in.
+func dedupeIDs(ids []uuid.UUID) []uuid.UUID {
+	seen := make(map[uuid.UUID]struct{}, len(ids))
+	deduped := make([]uuid.UUID, 0, len(ids))
+
+	for _, id := range ids {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+
+		seen[id] = struct{}{}
+		deduped = append(deduped, id)
+	}
+
+	return deduped
+}
+
+func (s *Service) checkReferentialIntegrity(
+	ctx context.Context,
+	l *zerolog.Logger,
+	storeID uuid.UUID,
+	req *genapi.CreateRepairOrderRequest,
+) error {
+	ok, err := s.repo.DoesTechnicianExist(ctx, storeID, req.TechnicianID)
+
+	if err != nil {
+		l.Error().Err(err).Msg("failed to check if technician exists")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if technician exists")
+	}
+
+	if !ok {
+		return apierror.ToAPIError(http.StatusBadRequest, "technician does not exist")
+	}
+
+	ok, err = s.repo.DoesSalesPersonExist(ctx, storeID, req.SalesPersonID)
+
+	if err != nil {
+		l.Error().Err(err).Msg("failed to check if sales person exists")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if sales person exists")
+	}
+
+	if !ok {
+		return apierror.ToAPIError(http.StatusBadRequest, "sales person does not exist")
+	}
+
+	photoCount, err := s.repo.CountPhotosByIDs(ctx, storeID, req.Photos)
+
+	if err != nil {
+		l.Error().Err(err).Msg("failed to count photos")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if photos exist")
+	}
+
+	if photoCount != len(req.Photos) {
+		return apierror.ToAPIError(http.StatusBadRequest, "photo does not exist")
+	}
+
+	if req.DownPayment.IsSet() {
+		ok, err = s.repo.DoesPaymentMethodExist(ctx, storeID, req.DownPayment.Value.GetMethod())
+		if err != nil {
+			l.Error().Err(err).Msg("failed to check if payment method exists")
+			return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if payment method exists")
+		}
+
+		if !ok {
+			return apierror.ToAPIError(http.StatusBadRequest, "payment method does not exist")
+		}
+	}
+
+	return nil
+}
+
+func buildRepairOrderListFilter(params genapi.ListRepairOrdersParams) (readmodel.RepairOrderListFilter, error) {
+	filter := readmodel.RepairOrderListFilter{}
+
+	if params.Status.IsSet() {
+		status, err := domain.NewOrderStatus(string(params.Status.Value))
+		if err != nil {
+			return filter, err
+		}
+
+		filter.Status = optional.Some(status)
+	}
+
+	if params.TechnicianID.IsSet() {
+		filter.TechnicianID = optional.Some(params.TechnicianID.Value)
+	}
+
+	if params.SalesPersonID.IsSet() {
+		filter.SalesPersonID = optional.Some(params.SalesPersonID.Value)
+	}
+
+	if params.CreatedFrom.IsSet() {
+		filter.CreatedFrom = optional.Some(params.CreatedFrom.Value)
+	}
+
+	if params.CreatedTo.IsSet() {
+		filter.CreatedTo = optional.Some(params.CreatedTo.Value)
+	}
+
+	if params.CreatedFrom.IsSet() && params.CreatedTo.IsSet() && !params.CreatedFrom.Value.Before(params.CreatedTo.Value) {
+		return filter, errors.New("created_from must be before created_to")
+	}
+
+	if params.Q.IsSet() {
+		if search := strings.TrimSpace(params.Q.Value); search != "" {
+			filter.Search = optional.Some(search)
+		}
+	}
+
+	return filter, nil
+}
+
+func toAPIRepairOrder(order domain.Order) *genapi.RepairOrder {
+	costs := make([]genapi.RepairOrderCostsItem, 0, len(order.Costs()))
+	for _, cost := range order.Costs() {
+		reason := genapi.OptString{}
+		if value, ok := cost.Reason().PointerValue().Get(); ok {
+			reason = genapi.NewOptString(value)
+		}
+
+		costs = append(costs, genapi.RepairOrderCostsItem{
+			ID:           cost.ID(),
+			Amount:       cost.Amount(),
+			Reason:       reason,
+			IsInitial:    cost.IsInitial(),
+			CreationTime: cost.CreationTime(),
+		})
+	}
+
+	damages := make([]genapi.RepairOrderDamagesItem, 0, len(order.Damages()))
+	for _, damage := range order.Damages() {
+		damages = append(damages, genapi.RepairOrderDamagesItem{
+			ID:   damage.ID(),
+			Name: damage.Name(),
+		})
+	}
+
+	phoneConditions := make([]genapi.RepairOrderPhoneConditionsItem, 0, len(order.PhoneConditions()))
+	for _, condition := range order.PhoneConditions() {
+		phoneConditions = append(phoneConditions, genapi.RepairOrderPhoneConditionsItem{
+			ID:   condition.ID(),
+			Name: condition.Name(),
+		})
+	}
+
+	phoneEquipments := make([]genapi.RepairOrderPhoneEquipmentsItem, 0, len(order.PhoneEquipments()))
+	for _, equipment := range order.PhoneEquipments() {
+		phoneEquipments = append(phoneEquipments, genapi.RepairOrderPhoneEquipmentsItem{
+			ID:   equipment.ID(),
+			Name: equipment.Name(),
+		})
+	}
+
+	assignments := make([]genapi.RepairOrderTechnicianAssignmentsItem, 0, len(order.TechnicianAssignments()))
+	for _, assignment := range order.TechnicianAssignments() {
+		assignments = append(assignments, genapi.RepairOrderTechnicianAssignmentsItem{
+			ID:                   assignment.ID(),
+			PreviousTechnicianID: assignment.PreviousTechnicianID(),
+			TechnicianID:         assignment.TechnicianID(),
+			Reason:               assignment.Reason(),
+			CreationTime:         assignment.CreationTime(),
+		})
+	}
+
+	photos := make([]genapi.RepairOrderPhotosItem, 0, len(order.Photos()))
+	for _, photo := range order.Photos() {
+		var caption genapi.OptString
+		if value, ok := photo.Caption().PointerValue().Get(); ok {
+			caption = genapi.NewOptString(value)
+		}
+
+		photos = append(photos, genapi.RepairOrderPhotosItem{
+			ID:           photo.ID(),
+			PhotoID:      photo.PhotoID(),
+			Stage:        genapi.RepairOrderPhotoStage(photo.Stage()),
+			Caption:      caption,
+			CreationTime: photo.CreationTime(),
+		})
+	}
+
+	res := &genapi.RepairOrder{
+		ID:                    order.ID(),
+		Slug:                  order.Slug(),
+		CreationTime:          order.CreationTime(),
+		CustomerName:          order.CustomerName(),
+		ContactPhoneNumber:    order.ContactNumber().Value(),
+		PhoneType:             order.PhoneType(),
+		Color:                 order.Color(),
+		SalesPersonID:         order.SalesPersonID(),
+		TechnicianID:          order.TechnicianID(),
+		TechnicianAssignments: assignments,
+		Costs:                 costs,
+		TotalCost:             order.TotalCost(),
+		PaidAmount:            order.PaidAmount(),
+		OutstandingAmount:     order.OutstandingAmount(),
+		Payments:              toAPIRepairOrderPayments(order.Payments()),
+		Damages:               damages,
+		PhoneConditions:       phoneConditions,
+		PhoneEquipments:       phoneEquipments,
+		Photos:                photos,
+	}
+
+	if imei, ok := order.IMEI().PointerValue().Get(); ok {
+		res.Imei = genapi.NewOptString(imei)
+	}
+
+	if partsNotCheckedYet, ok := order.PartsNotCheckedYet().PointerValue().Get(); ok {
+		res.PartsNotCheckedYet = genapi.NewOptString(partsNotCheckedYet)
+	}
+
+	details, ok := order.PhoneSecurityDetails().PointerValue().Get()
+	if ok && details.Type() != domain.PhoneSecurityTypeNone {
+		res.Passcode = genapi.NewOptRepairOrderPasscode(genapi.RepairOrderPasscode{
+			IsPatternLocked: details.Type() == domain.PhoneSecurityTypePattern,
+			Value:           details.Value(),
+		})
+	}
+
+	if writeOff, ok := order.WriteOff().PointerValue().Get(); ok {
+		res.WriteOff = genapi.NewOptRepairOrderWriteOff(genapi.RepairOrderWriteOff{
+			Amount: writeOff.Amount(),
+			Reason: writeOff.Reason(),
+		})
+	}
+
+	if confirmationTime, ok := order.ConfirmationTime().PointerValue().Get(); ok {
+		contents, _ := order.ConfirmationContents().PointerValue().Get()
+
+		res.Confirmation = genapi.NewOptRepairOrderConfirmation(genapi.RepairOrderConfirmation{
+			Time:     confirmationTime,
+			Contents: contents,
+		})
+	}
+
+	if estimatedCompletionTime, ok := order.EstimatedCompletionTime().PointerValue().Get(); ok {
+		res.EstimatedCompletionTime = genapi.NewOptDateTime(estimatedCompletionTime)
+	}
+
+	if completionTime, ok := order.CompletionTime().PointerValue().Get(); ok {
+		res.CompletionTime = genapi.NewOptDateTime(completionTime)
+	}
+
+	if pickUpTime, ok := order.PickUpTime().PointerValue().Get(); ok {
+		res.PickUpTime = genapi.NewOptDateTime(pickUpTime)
+	}
+
+	if cancellationTime, ok := order.CancellationTime().PointerValue().Get(); ok {
+		reason, _ := order.CancellationReason().PointerValue().Get()
+
+		cancellation := genapi.RepairOrderCancellation{
+			Time:   cancellationTime,
+			Reason: reason,
+		}
+
+		if fee, hasFee := order.CancellationFee().PointerValue().Get(); hasFee {
+			cancellation.Fee = genapi.NewOptInt(int(fee))
+		}
+
+		res.Cancellation = genapi.NewOptRepairOrderCancellation(cancellation)
+	}
+
+	return res
+}
+
+func toAPIRepairOrderSummary(summary readmodel.RepairOrderSummary) genapi.RepairOrderSummary {
+	item := genapi.RepairOrderSummary{
+		ID:                 summary.ID,
+		Slug:               summary.Slug,
+		Status:             genapi.RepairOrderSummaryStatus(summary.Status),
+		CreationTime:       summary.CreationTime,
+		CustomerName:       summary.CustomerName,
+		ContactPhoneNumber: summary.ContactNumber,
+		PhoneType:          summary.PhoneType,
+		Color:              summary.Color,
+		TechnicianID:       summary.TechnicianID,
+		SalesPersonID:      summary.SalesPersonID,
+	}
+
+	if imei, ok := summary.IMEI.Get(); ok {
+		item.Imei = genapi.NewOptString(imei)
+	}
+
+	return item
+}
+
+func toAPIRepairOrderNote(note domain.OrderNote, authorUsername string) *genapi.RepairOrderNote {
+	res := &genapi.RepairOrderNote{
+		ID:             note.ID(),
+		Body:           note.Body(),
+		Visibility:     genapi.RepairOrderNoteVisibility(note.Visibility()),
+		AuthorID:       note.AuthorID(),
+		AuthorUsername: authorUsername,
+		CreationTime:   note.CreationTime(),
+	}
+
+	if editTime, ok := note.EditTime().PointerValue().Get(); ok {
+		res.EditTime = genapi.NewOptDateTime(editTime)
+	}
+
+	return res
+}
+
+// toAuditRepairOrder is the state of an order as recorded in the audit log.
+// The passcode is left out so it doesn't outlive the order in the log.
+func toAuditRepairOrder(order domain.Order) *genapi.RepairOrder {
+	res := toAPIRepairOrder(order)
+	res.Passcode = genapi.OptRepairOrderPasscode{}
+
+	return res
+}
+
+func toAPIRepairOrderPayments(payments []domain.OrderPayment) []genapi.RepairOrderPayment {
+	res := make([]genapi.RepairOrderPayment, 0, len(payments))
+	for _, payment := range payments {
+		item := genapi.RepairOrderPayment{
+			ID:           payment.ID(),
+			Type:         genapi.RepairOrderPaymentType(payment.Type()),
+			Amount:       int(payment.Amount()),
+			Method:       payment.PaymentMethodID(),
+			CreationTime: payment.CreationTime(),
+		}
+
+		if reason, ok := payment.Reason().PointerValue().Get(); ok {
+			item.Reason = genapi.NewOptString(reason)
+		}
+
+		res = append(res, item)
+	}
+
+	return res
 }
@@ -6,8 +6,10 @@ package repairorder_test
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"net/url"
+	"slices"
 	"testing"
 	"time"
 
@@ -16,10 +18,14 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder"
 	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	repairorderreadmodel "github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+	shareddomain "github.com/JosephJoshua/remana-backend/internal/modules/shared/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/testutil"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -36,6 +42,7 @@ func TestCreateRepairOrder(t *testing.T) {
 		theTechnicianID    = uuid.New()
 		theSalesPersonID   = uuid.New()
 		thePaymentMethodID = uuid.New()
+		thePhotoID         = uuid.New()
 
 		theDamages = []damage{
 			{id: uuid.New(), name: "Screen"},
@@ -72,6 +79,7 @@ func TestCreateRepairOrder(t *testing.T) {
 			technicianID:    theTechnicianID,
 			salesPersonID:   theSalesPersonID,
 			paymentMethodID: thePaymentMethodID,
+			photoIDs:        []uuid.UUID{thePhotoID},
 		}
 	}
 
@@ -87,7 +95,7 @@ func TestCreateRepairOrder(t *testing.T) {
 			DamageTypes:        []uuid.UUID{theDamages[0].id},
 			PhoneConditions:    []uuid.UUID{},
 			PhoneEquipments:    []uuid.UUID{},
-			Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+			Photos:             []uuid.UUID{thePhotoID},
 			Imei:               genapi.NewOptString("123456789012345"),
 			PartsNotCheckedYet: genapi.NewOptString("Battery"),
 			Passcode: genapi.NewOptCreateRepairOrderRequestPasscode(genapi.CreateRepairOrderRequestPasscode{
@@ -121,7 +129,7 @@ func TestCreateRepairOrder(t *testing.T) {
 					DamageTypes:        []uuid.UUID{theDamages[0].id},
 					PhoneConditions:    []uuid.UUID{thePhoneConditions[0].id},
 					PhoneEquipments:    []uuid.UUID{thePhoneEquipments[0].id},
-					Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+					Photos:             []uuid.UUID{thePhotoID},
 				},
 			},
 			{
@@ -137,7 +145,7 @@ func TestCreateRepairOrder(t *testing.T) {
 					DamageTypes:        []uuid.UUID{theDamages[0].id},
 					PhoneConditions:    []uuid.UUID{thePhoneConditions[0].id},
 					PhoneEquipments:    []uuid.UUID{thePhoneEquipments[0].id},
-					Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+					Photos:             []uuid.UUID{thePhotoID},
 					Imei:               genapi.NewOptString("123456789012345"),
 				},
 			},
@@ -154,7 +162,7 @@ func TestCreateRepairOrder(t *testing.T) {
 					DamageTypes:        []uuid.UUID{theDamages[0].id},
 					PhoneConditions:    []uuid.UUID{thePhoneConditions[0].id},
 					PhoneEquipments:    []uuid.UUID{thePhoneEquipments[0].id},
-					Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+					Photos:             []uuid.UUID{thePhotoID},
 					PartsNotCheckedYet: genapi.NewOptString("Battery"),
 				},
 			},
@@ -171,7 +179,7 @@ func TestCreateRepairOrder(t *testing.T) {
 					DamageTypes:        []uuid.UUID{theDamages[0].id},
 					PhoneConditions:    []uuid.UUID{thePhoneConditions[0].id},
 					PhoneEquipments:    []uuid.UUID{thePhoneEquipments[0].id},
-					Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+					Photos:             []uuid.UUID{thePhotoID},
 					Passcode: genapi.NewOptCreateRepairOrderRequestPasscode(genapi.CreateRepairOrderRequestPasscode{
 						Value:           "1234",
 						IsPatternLocked: false,
@@ -191,7 +199,7 @@ func TestCreateRepairOrder(t *testing.T) {
 					DamageTypes:        []uuid.UUID{theDamages[0].id},
 					PhoneConditions:    []uuid.UUID{thePhoneConditions[0].id},
 					PhoneEquipments:    []uuid.UUID{thePhoneEquipments[0].id},
-					Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+					Photos:             []uuid.UUID{thePhotoID},
 					Passcode: genapi.NewOptCreateRepairOrderRequestPasscode(genapi.CreateRepairOrderRequestPasscode{
 						Value:           "1234",
 						IsPatternLocked: true,
@@ -211,7 +219,7 @@ func TestCreateRepairOrder(t *testing.T) {
 					DamageTypes:        []uuid.UUID{theDamages[0].id},
 					PhoneConditions:    []uuid.UUID{thePhoneConditions[0].id},
 					PhoneEquipments:    []uuid.UUID{thePhoneEquipments[0].id},
-					Photos:             []url.URL{{Host: "example.com", Scheme: "http"}},
+					Photos:             []uuid.UUID{thePhotoID},
 					DownPayment: genapi.NewOptCreateRepairOrderRequestDownPayment(genapi.CreateRepairOrderRequestDownPayment{
 						Amount: 100,
 						Method: thePaymentMethodID,
@@ -233,6 +241,9 @@ func TestCreateRepairOrder(t *testing.T) {
 					repo,
 					qualifyingPermissionProvider,
 					testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+					testutil.NewReceiptRendererStub(),
+					testutil.NewLabelRendererStub(),
+					testutil.NewAuditLogStub(),
 				)
 
 				_, err := s.CreateRepairOrder(requestCtx, tc.req)
@@ -255,12 +266,12 @@ func TestCreateRepairOrder(t *testing.T) {
 				assert.Equal(t, len(tc.req.PhoneEquipments), len(repo.calledWithOrder.PhoneEquipments()))
 				assert.Equal(t, len(tc.req.Photos), len(repo.calledWithOrder.Photos()))
 
-				gotPhotoURLs := []url.URL{}
+				gotPhotoIDs := []uuid.UUID{}
 				for _, photo := range repo.calledWithOrder.Photos() {
-					gotPhotoURLs = append(gotPhotoURLs, photo.URL())
+					gotPhotoIDs = append(gotPhotoIDs, photo.PhotoID())
 				}
 
-				assert.Equal(t, tc.req.Photos, gotPhotoURLs)
+				assert.Equal(t, tc.req.Photos, gotPhotoIDs)
 
 				if tc.req.Imei.IsSet() {
 					require.True(t, repo.calledWithOrder.IMEI().PointerValue().IsSet())
@@ -282,10 +293,12 @@ func TestCreateRepairOrder(t *testing.T) {
 				}
 
 				if tc.req.DownPayment.IsSet() {
-					require.True(t, repo.calledWithOrder.DownPayment().PointerValue().IsSet())
+					require.Len(t, repo.calledWithOrder.Payments(), 1)
 
-					assert.Equal(t, uint(tc.req.DownPayment.Value.Amount), repo.calledWithOrder.DownPayment().PointerValue().MustGet().Amount())
-					assert.Equal(t, tc.req.DownPayment.Value.Method, repo.calledWithOrder.DownPayment().PointerValue().MustGet().PaymentMethodID())
+					gotPayment := repo.calledWithOrder.Payments()[0]
+					assert.Equal(t, domain.OrderPaymentTypeDownPayment, gotPayment.Type())
+					assert.Equal(t, uint(tc.req.DownPayment.Value.Amount), gotPayment.Amount())
+					assert.Equal(t, tc.req.DownPayment.Value.Method, gotPayment.PaymentMethodID())
 				}
 			})
 		}
@@ -305,6 +318,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			slugProvider,
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -316,6 +332,45 @@ func TestCreateRepairOrder(t *testing.T) {
 		assert.Equal(t, theSlug, repo.calledWithOrder.Slug())
 	})
 
+	t.Run("records the created order in the audit log", func(t *testing.T) {
+		t.Parallel()
+
+		repo := baseRepo()
+		auditLog := testutil.NewAuditLogStub()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			qualifyingPermissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+
+		req := validRequest()
+		req.Passcode = genapi.NewOptCreateRepairOrderRequestPasscode(genapi.CreateRepairOrderRequestPasscode{
+			IsPatternLocked: false,
+			Value:           "123456",
+		})
+
+		_, err := s.CreateRepairOrder(requestCtx, &req)
+		require.NoError(t, err)
+		require.Len(t, auditLog.Changes, 1)
+
+		got := auditLog.Changes[0]
+		assert.Equal(t, audit.ActionRepairOrderCreated, got.Action)
+		assert.Equal(t, audit.EntityTypeRepairOrder, got.EntityType)
+		assert.Equal(t, repo.calledWithOrder.ID(), got.EntityID)
+		assert.Nil(t, got.Before)
+
+		after, ok := got.After.(*genapi.RepairOrder)
+		require.True(t, ok)
+		assert.Equal(t, repo.calledWithOrder.ID(), after.ID)
+		assert.False(t, after.Passcode.IsSet(), "passcode shouldn't be recorded in the audit log")
+	})
+
 	t.Run("creates order with current time", func(t *testing.T) {
 		t.Parallel()
 
@@ -328,6 +383,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -339,6 +397,35 @@ func TestCreateRepairOrder(t *testing.T) {
 		assert.Equal(t, now, repo.calledWithOrder.CreationTime())
 	})
 
+	t.Run("creates order with estimated completion time", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1713917762, 0)
+		estimate := now.Add(48 * time.Hour)
+		repo := baseRepo()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(now),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			qualifyingPermissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		req := validRequest()
+		req.EstimatedCompletionTime = genapi.NewOptDateTime(estimate)
+
+		_, err := s.CreateRepairOrder(requestCtx, &req)
+
+		require.NoError(t, err)
+		require.NotNil(t, repo.calledWithOrder)
+
+		assert.Equal(t, optional.Some(estimate), repo.calledWithOrder.EstimatedCompletionTime())
+	})
+
 	t.Run("creates order with damage names", func(t *testing.T) {
 		t.Parallel()
 
@@ -357,6 +444,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -402,6 +492,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -428,6 +521,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -472,6 +568,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -498,6 +597,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -542,6 +644,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -564,6 +669,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -595,6 +703,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -619,6 +730,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -643,6 +757,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -655,6 +772,61 @@ func TestCreateRepairOrder(t *testing.T) {
 		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
 	})
 
+	t.Run("returns bad request when photo does not belong to the store", func(t *testing.T) {
+		t.Parallel()
+
+		repo := baseRepo()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			qualifyingPermissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		req := validRequest()
+		req.Photos = []uuid.UUID{thePhotoID, uuid.New()}
+
+		_, err := s.CreateRepairOrder(requestCtx, &req)
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+		assert.Nil(t, repo.calledWithOrder)
+	})
+
+	t.Run("attaches the same photo only once", func(t *testing.T) {
+		t.Parallel()
+
+		repo := baseRepo()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			qualifyingPermissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		req := validRequest()
+		req.Photos = []uuid.UUID{thePhotoID, thePhotoID}
+
+		_, err := s.CreateRepairOrder(requestCtx, &req)
+		require.NoError(t, err)
+		require.NotNil(t, repo.calledWithOrder)
+
+		gotPhotoIDs := make([]uuid.UUID, 0, len(repo.calledWithOrder.Photos()))
+		for _, photo := range repo.calledWithOrder.Photos() {
+			gotPhotoIDs = append(gotPhotoIDs, photo.PhotoID())
+		}
+
+		assert.Equal(t, []uuid.UUID{thePhotoID}, gotPhotoIDs)
+	})
+
 	t.Run("returns bad request when contact phone number is invalid", func(t *testing.T) {
 		t.Parallel()
 
@@ -689,6 +861,9 @@ func TestCreateRepairOrder(t *testing.T) {
 					repo,
 					qualifyingPermissionProvider,
 					testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+					testutil.NewReceiptRendererStub(),
+					testutil.NewLabelRendererStub(),
+					testutil.NewAuditLogStub(),
 				)
 
 				req := validRequest()
@@ -710,6 +885,9 @@ func TestCreateRepairOrder(t *testing.T) {
 			repo,
 			qualifyingPermissionProvider,
 			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
 		)
 
 		req := validRequest()
@@ -748,6 +926,15 @@ func TestCreateRepairOrder(t *testing.T) {
 					repo.salesPersonExistsErr = errors.New("oh no!")
 				},
 			},
+			{
+				name: "when repository.CountPhotosByIDs() errors",
+				setup: func(repo *repositoryStub,
+					_ *testutil.ResourceLocationProviderStub,
+					_ *testutil.PermissionProviderStub,
+					_ *testutil.OrderSlugProviderStub) {
+					repo.photoCountErr = errors.New("oh no!")
+				},
+			},
 			{
 				name: "when repository.DoesPaymentMethodExist() errors",
 				setup: func(repo *repositoryStub,
@@ -845,6 +1032,9 @@ func TestCreateRepairOrder(t *testing.T) {
 					repo,
 					permissionProvider,
 					slugProvider,
+					testutil.NewReceiptRendererStub(),
+					testutil.NewLabelRendererStub(),
+					testutil.NewAuditLogStub(),
 				)
 
 				req := validRequest()
@@ -856,149 +1046,4284 @@ func TestCreateRepairOrder(t *testing.T) {
 	})
 }
 
-type damage struct {
-	id   uuid.UUID
-	name string
-}
-
-type phoneCondition struct {
-	id   uuid.UUID
-	name string
-}
+func TestGetRepairOrder(t *testing.T) {
+	t.Parallel()
 
-type phoneEquipment struct {
-	id   uuid.UUID
-	name string
-}
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+	)
 
-type repositoryStub struct {
-	damages                []damage
-	phoneConditions        []phoneCondition
-	phoneEquipments        []phoneEquipment
-	technicianID           uuid.UUID
-	salesPersonID          uuid.UUID
-	paymentMethodID        uuid.UUID
-	calledWithOrder        domain.Order
-	createErr              error
-	damageNameErr          error
-	phoneConditionNameErr  error
-	phoneEquipmentNameErr  error
-	storeExistsErr         error
-	technicianExistsErr    error
-	salesPersonExistsErr   error
-	paymentMethodExistsErr error
-}
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
 
-func (r *repositoryStub) CreateRepairOrder(_ context.Context, order domain.Order) error {
-	if r.createErr != nil {
-		return r.createErr
-	}
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
 
-	r.calledWithOrder = order
-	return nil
-}
+	theOrder := newTestOrder(t, theStoreID)
+	otherStoreOrder := newTestOrder(t, uuid.New())
 
-func (r *repositoryStub) GetDamageNamesByIDs(_ context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error) {
-	if r.damageNameErr != nil {
-		return []string{}, r.damageNameErr
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
 	}
 
-	names := []string{}
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrder(),
+		}, nil)
+	}
 
-	for _, id := range ids {
-		found := false
+	t.Run("returns repair order when it exists", func(t *testing.T) {
+		t.Parallel()
 
-		for _, damage := range r.damages {
-			if damage.id == id {
-				names = append(names, damage.name)
-				found = true
+		s := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider())
 
-				break
-			}
-		}
+		got, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrder.ID()})
+		require.NoError(t, err)
 
-		if !found {
-			return []string{}, apperror.ErrDamageNotFound
-		}
-	}
+		assert.Equal(t, theOrder.ID(), got.ID)
+		assert.Equal(t, theOrder.Slug(), got.Slug)
+		assert.Equal(t, theOrder.CustomerName(), got.CustomerName)
+		assert.Equal(t, theOrder.ContactNumber().Value(), got.ContactPhoneNumber)
+		assert.Equal(t, "123456789012345", got.Imei.Value)
+		assert.True(t, got.Passcode.Value.IsPatternLocked)
+		require.Len(t, got.Payments, 1)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeDownPayment, got.Payments[0].Type)
+		assert.Equal(t, 50, got.Payments[0].Amount)
+		assert.Equal(t, 50, got.OutstandingAmount)
+		assert.False(t, got.Cancellation.IsSet())
+
+		require.Len(t, got.Costs, 1)
+		assert.True(t, got.Costs[0].IsInitial)
+		assert.Equal(t, theOrder.Costs()[0].Amount(), got.Costs[0].Amount)
+
+		require.Len(t, got.Damages, 1)
+		assert.Equal(t, theOrder.Damages()[0].Name(), got.Damages[0].Name)
+
+		require.Len(t, got.Photos, 1)
+		assert.Equal(t, theOrder.Photos()[0].PhotoID(), got.Photos[0].PhotoID)
+	})
 
-	return names, nil
-}
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
 
-func (r *repositoryStub) GetPhoneConditionNamesByIDs(_ context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error) {
-	if r.phoneConditionNameErr != nil {
-		return []string{}, r.phoneConditionNameErr
-	}
+		s := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider())
 
-	names := []string{}
+		_, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: uuid.New()})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
 
-	for _, id := range ids {
-		found := false
+	t.Run("returns not found when repair order belongs to another store", func(t *testing.T) {
+		t.Parallel()
 
-		for _, phoneCondition := range r.phoneConditions {
-			if phoneCondition.id == id {
-				names = append(names, phoneCondition.name)
-				found = true
+		s := newService(&repositoryStub{orders: []domain.Order{otherStoreOrder}}, qualifyingPermissionProvider())
 
-				break
-			}
-		}
+		_, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: otherStoreOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
 
-		if !found {
-			return []string{}, apperror.ErrPhoneConditionNotFound
-		}
-	}
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
 
-	return names, nil
-}
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		)
 
-func (r *repositoryStub) GetPhoneEquipmentNamesByIDs(_ context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error) {
-	if r.phoneEquipmentNameErr != nil {
-		return []string{}, r.phoneEquipmentNameErr
-	}
+		_, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
 
-	names := []string{}
+	t.Run("returns unauthorized when user is missing from context", func(t *testing.T) {
+		t.Parallel()
 
-	for _, id := range ids {
-		found := false
+		s := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider())
+		emptyCtx := testutil.RequestContextWithLogger(context.Background())
 
-		for _, equipment := range r.phoneEquipments {
-			if equipment.id == id {
-				names = append(names, equipment.name)
-				found = true
+		_, err := s.GetRepairOrder(emptyCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
 
-				break
-			}
+	t.Run("returns internal server error", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			setup func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub)
+		}{
+			{
+				name: "when repository.GetRepairOrderByID() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.getOrderErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when permissionProvider.Can() errors",
+				setup: func(_ *repositoryStub, permissionProvider *testutil.PermissionProviderStub) {
+					permissionProvider.SetError(errors.New("oh no!"))
+				},
+			},
 		}
 
-		if !found {
-			return []string{}, apperror.ErrPhoneEquipmentNotFound
-		}
-	}
+		for _, tc := range testCases {
+			tc := tc
 
-	return names, nil
-}
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
 
-func (r *repositoryStub) DoesTechnicianExist(_ context.Context, storeID uuid.UUID, technicianID uuid.UUID) (bool, error) {
-	if r.technicianExistsErr != nil {
-		return false, r.technicianExistsErr
-	}
+				repo := &repositoryStub{orders: []domain.Order{theOrder}}
+				permissionProvider := qualifyingPermissionProvider()
 
-	return technicianID == r.technicianID, nil
-}
+				tc.setup(repo, permissionProvider)
 
-func (r *repositoryStub) DoesSalesPersonExist(_ context.Context, storeID uuid.UUID, salesPersonID uuid.UUID) (bool, error) {
-	if r.salesPersonExistsErr != nil {
-		return false, r.salesPersonExistsErr
-	}
+				s := newService(repo, permissionProvider)
 
-	return salesPersonID == r.salesPersonID, nil
+				_, err := s.GetRepairOrder(requestCtx, genapi.GetRepairOrderParams{RepairOrderId: theOrder.ID()})
+				testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+			})
+		}
+	})
 }
 
-func (r *repositoryStub) DoesPaymentMethodExist(_ context.Context, storeID uuid.UUID, paymentMethodID uuid.UUID) (bool, error) {
-	if r.paymentMethodExistsErr != nil {
-		return false, r.paymentMethodExistsErr
-	}
+func TestGetRepairOrderBySlug(t *testing.T) {
+	t.Parallel()
 
-	return paymentMethodID == r.paymentMethodID, nil
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	theOrder := newTestOrder(t, theStoreID)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrder(),
+		}, nil)
+	}
+
+	t.Run("returns repair order when it exists", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider())
+
+		got, err := s.GetRepairOrderBySlug(requestCtx, genapi.GetRepairOrderBySlugParams{Slug: theOrder.Slug()})
+		require.NoError(t, err)
+
+		assert.Equal(t, theOrder.ID(), got.ID)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider())
+
+		_, err := s.GetRepairOrderBySlug(requestCtx, genapi.GetRepairOrderBySlugParams{Slug: "unknown-slug"})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		)
+
+		_, err := s.GetRepairOrderBySlug(requestCtx, genapi.GetRepairOrderBySlugParams{Slug: theOrder.Slug()})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error when repository.GetRepairOrderBySlug() errors", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, getOrderErr: errors.New("oh no!")},
+			qualifyingPermissionProvider(),
+		)
+
+		_, err := s.GetRepairOrderBySlug(requestCtx, genapi.GetRepairOrderBySlugParams{Slug: theOrder.Slug()})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestListRepairOrders(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID       = uuid.New()
+		theStoreID      = uuid.New()
+		theTechnicianID = uuid.New()
+		baseTime        = time.Unix(1713917762, 0)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	// Newest first, like the repository returns them.
+	theSummaries := make([]repairorderreadmodel.RepairOrderSummary, 0, 5)
+	for i := 0; i < 5; i++ {
+		theSummaries = append(theSummaries, repairorderreadmodel.RepairOrderSummary{
+			ID:           uuid.New(),
+			CreationTime: baseTime.Add(-time.Duration(i) * time.Hour),
+			Slug:         "slug",
+			Status:       domain.OrderStatusOpen,
+			TechnicianID: theTechnicianID,
+			IMEI:         optional.Some("123456789012345"),
+		})
+	}
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrder(),
+		}, nil)
+	}
+
+	t.Run("returns pages of repair orders connected by cursors", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{summaries: theSummaries}, qualifyingPermissionProvider())
+
+		firstPage, err := s.ListRepairOrders(requestCtx, genapi.ListRepairOrdersParams{
+			Limit: genapi.NewOptInt(2),
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, len(theSummaries), firstPage.TotalCount)
+		require.Len(t, firstPage.Items, 2)
+		assert.Equal(t, theSummaries[0].ID, firstPage.Items[0].ID)
+		assert.Equal(t, theSummaries[1].ID, firstPage.Items[1].ID)
+		assert.Equal(t, genapi.RepairOrderSummaryStatusOpen, firstPage.Items[0].Status)
+		assert.Equal(t, "123456789012345", firstPage.Items[0].Imei.Value)
+		require.True(t, firstPage.NextCursor.IsSet())
+
+		secondPage, err := s.ListRepairOrders(requestCtx, genapi.ListRepairOrdersParams{
+			Limit:  genapi.NewOptInt(2),
+			Cursor: firstPage.NextCursor,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, secondPage.Items, 2)
+		assert.Equal(t, theSummaries[2].ID, secondPage.Items[0].ID)
+		assert.Equal(t, theSummaries[3].ID, secondPage.Items[1].ID)
+		require.True(t, secondPage.NextCursor.IsSet())
+
+		lastPage, err := s.ListRepairOrders(requestCtx, genapi.ListRepairOrdersParams{
+			Limit:  genapi.NewOptInt(2),
+			Cursor: secondPage.NextCursor,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, lastPage.Items, 1)
+		assert.Equal(t, theSummaries[4].ID, lastPage.Items[0].ID)
+		assert.False(t, lastPage.NextCursor.IsSet())
+	})
+
+	t.Run("passes filters to repository", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{summaries: theSummaries}
+		s := newService(repo, qualifyingPermissionProvider())
+
+		_, err := s.ListRepairOrders(requestCtx, genapi.ListRepairOrdersParams{
+			Status:       genapi.NewOptListRepairOrdersStatus(genapi.ListRepairOrdersStatusPickedUp),
+			TechnicianID: genapi.NewOptUUID(theTechnicianID),
+			CreatedFrom:  genapi.NewOptDateTime(baseTime.Add(-time.Hour)),
+			CreatedTo:    genapi.NewOptDateTime(baseTime),
+			Q:            genapi.NewOptString("  john  "),
+		})
+		require.NoError(t, err)
+
+		filter := repo.calledWithFilter
+
+		assert.Equal(t, domain.OrderStatusPickedUp, filter.Status.MustGet())
+		assert.Equal(t, theTechnicianID, filter.TechnicianID.MustGet())
+		assert.False(t, filter.SalesPersonID.IsSet())
+		assert.Equal(t, baseTime.Add(-time.Hour), filter.CreatedFrom.MustGet())
+		assert.Equal(t, baseTime, filter.CreatedTo.MustGet())
+		assert.Equal(t, "john", filter.Search.MustGet())
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		t.Parallel()
+
+		testCases := []struct {
+			name   string
+			params genapi.ListRepairOrdersParams
+		}{
+			{
+				name:   "when cursor is malformed",
+				params: genapi.ListRepairOrdersParams{Cursor: genapi.NewOptString("not a cursor")},
+			},
+			{
+				name:   "when limit is too large",
+				params: genapi.ListRepairOrdersParams{Limit: genapi.NewOptInt(101)},
+			},
+			{
+				name:   "when limit is zero",
+				params: genapi.ListRepairOrdersParams{Limit: genapi.NewOptInt(0)},
+			},
+			{
+				name: "when created_from is not before created_to",
+				params: genapi.ListRepairOrdersParams{
+					CreatedFrom: genapi.NewOptDateTime(baseTime),
+					CreatedTo:   genapi.NewOptDateTime(baseTime),
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				s := newService(&repositoryStub{summaries: theSummaries}, qualifyingPermissionProvider())
+
+				_, err := s.ListRepairOrders(requestCtx, tc.params)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+			})
+		}
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{summaries: theSummaries},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		)
+
+		_, err := s.ListRepairOrders(requestCtx, genapi.ListRepairOrdersParams{})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{summaries: theSummaries}, qualifyingPermissionProvider())
+		emptyCtx := testutil.RequestContextWithLogger(context.Background())
+
+		_, err := s.ListRepairOrders(emptyCtx, genapi.ListRepairOrdersParams{})
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+
+	t.Run("returns internal server error", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			setup func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub)
+		}{
+			{
+				name: "when repository.ListRepairOrders() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.listErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when repository.CountRepairOrders() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.countErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when permissionProvider.Can() errors",
+				setup: func(_ *repositoryStub, permissionProvider *testutil.PermissionProviderStub) {
+					permissionProvider.SetError(errors.New("oh no!"))
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				repo := &repositoryStub{summaries: theSummaries}
+				permissionProvider := qualifyingPermissionProvider()
+
+				tc.setup(repo, permissionProvider)
+
+				s := newService(repo, permissionProvider)
+
+				_, err := s.ListRepairOrders(requestCtx, genapi.ListRepairOrdersParams{})
+				testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+			})
+		}
+	})
+}
+
+func TestGetTechnicianQueue(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID       = uuid.New()
+		theStoreID      = uuid.New()
+		theTechnicianID = uuid.New()
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	theQueue := []repairorderreadmodel.RepairOrderSummary{
+		{
+			ID:           uuid.New(),
+			CreationTime: time.Unix(1713917762, 0),
+			Slug:         "R1",
+			Status:       domain.OrderStatusConfirmed,
+			IMEI:         optional.Some("123456789012345"),
+			TechnicianID: theTechnicianID,
+		},
+		{
+			ID:           uuid.New(),
+			CreationTime: time.Unix(1713917762, 0).Add(time.Hour),
+			Slug:         "R2",
+			Status:       domain.OrderStatusOpen,
+			TechnicianID: theTechnicianID,
+		},
+	}
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewTechnicianWorkload(),
+		}, nil)
+	}
+
+	t.Run("returns the queue of the technician", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{technicianID: theTechnicianID, queue: theQueue}
+		s := newService(repo, qualifyingPermissionProvider())
+
+		got, err := s.GetTechnicianQueue(requestCtx, genapi.GetTechnicianQueueParams{TechnicianId: theTechnicianID})
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, theQueue[0].ID, got.Items[0].ID)
+		assert.Equal(t, genapi.RepairOrderSummaryStatusConfirmed, got.Items[0].Status)
+		assert.Equal(t, "123456789012345", got.Items[0].Imei.Value)
+		assert.Equal(t, theQueue[1].ID, got.Items[1].ID)
+		assert.False(t, got.Items[1].Imei.IsSet())
+	})
+
+	t.Run("returns empty list when technician has nothing to do", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{technicianID: theTechnicianID}, qualifyingPermissionProvider())
+
+		got, err := s.GetTechnicianQueue(requestCtx, genapi.GetTechnicianQueueParams{TechnicianId: theTechnicianID})
+		require.NoError(t, err)
+
+		assert.NotNil(t, got.Items)
+		assert.Empty(t, got.Items)
+	})
+
+	t.Run("returns not found when technician does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{technicianID: theTechnicianID, queue: theQueue}, qualifyingPermissionProvider())
+
+		_, err := s.GetTechnicianQueue(requestCtx, genapi.GetTechnicianQueueParams{TechnicianId: uuid.New()})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{technicianID: theTechnicianID, queue: theQueue},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		)
+
+		_, err := s.GetTechnicianQueue(requestCtx, genapi.GetTechnicianQueueParams{TechnicianId: theTechnicianID})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{technicianID: theTechnicianID}, qualifyingPermissionProvider())
+		emptyCtx := testutil.RequestContextWithLogger(context.Background())
+
+		_, err := s.GetTechnicianQueue(emptyCtx, genapi.GetTechnicianQueueParams{TechnicianId: theTechnicianID})
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+
+	t.Run("returns internal server error", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			setup func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub)
+		}{
+			{
+				name: "when repository.DoesTechnicianExist() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.technicianExistsErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when repository.GetTechnicianQueue() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.queueErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when permissionProvider.Can() errors",
+				setup: func(_ *repositoryStub, permissionProvider *testutil.PermissionProviderStub) {
+					permissionProvider.SetError(errors.New("oh no!"))
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				repo := &repositoryStub{technicianID: theTechnicianID, queue: theQueue}
+				permissionProvider := qualifyingPermissionProvider()
+
+				tc.setup(repo, permissionProvider)
+
+				s := newService(repo, permissionProvider)
+
+				_, err := s.GetTechnicianQueue(requestCtx, genapi.GetTechnicianQueueParams{TechnicianId: theTechnicianID})
+				testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+			})
+		}
+	})
+}
+
+func TestGetTechnicianWorkload(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Unix(1713917762, 0)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	theWorkloads := []repairorderreadmodel.TechnicianWorkload{
+		{
+			TechnicianID:            uuid.New(),
+			TechnicianName:          "Andi",
+			OpenCount:               2,
+			ConfirmedCount:          1,
+			OldestOrderCreationTime: optional.Some(theTime),
+		},
+		{
+			TechnicianID:            uuid.New(),
+			TechnicianName:          "Budi",
+			OldestOrderCreationTime: optional.None[time.Time](),
+		},
+	}
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewTechnicianWorkload(),
+		}, nil)
+	}
+
+	t.Run("returns the workload of every technician", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{workloads: theWorkloads}, qualifyingPermissionProvider())
+
+		got, err := s.GetTechnicianWorkload(requestCtx)
+		require.NoError(t, err)
+
+		assert.Equal(t, []genapi.TechnicianWorkloadItem{
+			{
+				TechnicianID:            theWorkloads[0].TechnicianID,
+				TechnicianName:          "Andi",
+				OpenCount:               2,
+				ConfirmedCount:          1,
+				OldestOrderCreationTime: genapi.NewOptDateTime(theTime),
+			},
+			{
+				TechnicianID:   theWorkloads[1].TechnicianID,
+				TechnicianName: "Budi",
+			},
+		}, got.Items)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{workloads: theWorkloads},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		)
+
+		_, err := s.GetTechnicianWorkload(requestCtx)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{workloads: theWorkloads}, qualifyingPermissionProvider())
+		emptyCtx := testutil.RequestContextWithLogger(context.Background())
+
+		_, err := s.GetTechnicianWorkload(emptyCtx)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+
+	t.Run("returns internal server error", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			setup func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub)
+		}{
+			{
+				name: "when repository.GetTechnicianWorkload() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.workloadErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when permissionProvider.Can() errors",
+				setup: func(_ *repositoryStub, permissionProvider *testutil.PermissionProviderStub) {
+					permissionProvider.SetError(errors.New("oh no!"))
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				repo := &repositoryStub{workloads: theWorkloads}
+				permissionProvider := qualifyingPermissionProvider()
+
+				tc.setup(repo, permissionProvider)
+
+				s := newService(repo, permissionProvider)
+
+				_, err := s.GetTechnicianWorkload(requestCtx)
+				testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+			})
+		}
+	})
+}
+
+func TestAddRepairOrderCost(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.AddRepairOrderCost(),
+		}, nil)
+	}
+
+	t.Run("adds cost adjustment", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+
+		got, err := newService(repo, qualifyingPermissionProvider()).AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: -30, Reason: "Discount"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Costs, 2)
+		assert.Equal(t, -30, got.Costs[1].Amount)
+		assert.Equal(t, "Discount", got.Costs[1].Reason.Value)
+		assert.False(t, got.Costs[1].IsInitial)
+		assert.Equal(t, theTime, got.Costs[1].CreationTime)
+
+		assert.Equal(t, 70, got.TotalCost)
+		assert.Equal(t, 50, got.PaidAmount)
+		assert.Equal(t, 20, got.OutstandingAmount)
+
+		require.NotNil(t, repo.updatedOrder)
+		assert.Len(t, repo.updatedOrder.Costs(), 2)
+	})
+
+	t.Run("records the change in the audit log", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		auditLog := testutil.NewAuditLogStub()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+
+		_, err := s.AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 250, Reason: "Replacement part"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+		require.Len(t, auditLog.Changes, 1)
+
+		got := auditLog.Changes[0]
+		assert.Equal(t, audit.ActionRepairOrderCostAdded, got.Action)
+		assert.Equal(t, audit.EntityTypeRepairOrder, got.EntityType)
+		assert.Equal(t, theOrder.ID(), got.EntityID)
+
+		before, ok := got.Before.(*genapi.RepairOrder)
+		require.True(t, ok)
+		assert.Equal(t, 100, before.TotalCost)
+		assert.Len(t, before.Costs, 1)
+
+		after, ok := got.After.(*genapi.RepairOrder)
+		require.True(t, ok)
+		assert.Equal(t, 350, after.TotalCost)
+		assert.Len(t, after.Costs, 2)
+	})
+
+	t.Run("does not record rejected changes in the audit log", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		auditLog := testutil.NewAuditLogStub()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+
+		_, err := s.AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 0, Reason: "Nothing"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+		assert.Empty(t, auditLog.Changes)
+	})
+
+	t.Run("returns internal server error when recording in the audit log fails", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.SetError(errors.New("oh no!"))
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			qualifyingPermissionProvider(),
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+
+		_, err := s.AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 250, Reason: "Replacement part"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  genapi.AddRepairOrderCostRequest
+		}{
+			{
+				name: "when reason is empty",
+				req:  genapi.AddRepairOrderCostRequest{Amount: 10, Reason: " "},
+			},
+			{
+				name: "when amount is zero",
+				req:  genapi.AddRepairOrderCostRequest{Amount: 0, Reason: "Nothing"},
+			},
+			{
+				name: "when total would be less than the paid amount",
+				req:  genapi.AddRepairOrderCostRequest{Amount: -51, Reason: "Discount"},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}}
+
+				_, err := newService(repo, qualifyingPermissionProvider()).AddRepairOrderCost(
+					requestCtx,
+					&tc.req,
+					genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+				assert.Nil(t, repo.updatedOrder)
+			})
+		}
+	})
+
+	t.Run("returns conflict when repair order is picked up", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.CompleteRepair(theTime, false))
+		require.NoError(t, theOrder.PickUpByCustomer(theTime, optional.None[domain.NewOrderPaymentParams](), optional.Some("Waived")))
+
+		_, err := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider()).AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 10, Reason: "Replaced battery"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		).AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 10, Reason: "Replaced battery"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error when repository.UpdateRepairOrder() errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, updateErr: errors.New("oh no!")}
+
+		_, err := newService(repo, qualifyingPermissionProvider()).AddRepairOrderCost(
+			requestCtx,
+			&genapi.AddRepairOrderCostRequest{Amount: 10, Reason: "Replaced battery"},
+			genapi.AddRepairOrderCostParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestRecordRepairOrderPayment(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID          = uuid.New()
+		theStoreID         = uuid.New()
+		thePaymentMethodID = uuid.New()
+		theTime            = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.RecordRepairOrderPayment(),
+		}, nil)
+	}
+
+	t.Run("records partial payment", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+		got, err := newService(repo, qualifyingPermissionProvider()).RecordRepairOrderPayment(
+			requestCtx,
+			&genapi.RecordRepairOrderPaymentRequest{
+				Type:   genapi.RecordRepairOrderPaymentRequestTypePartial,
+				Amount: 30,
+				Method: thePaymentMethodID,
+			},
+			genapi.RecordRepairOrderPaymentParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Payments, 2)
+		assert.Equal(t, genapi.RepairOrderPaymentTypePartial, got.Payments[1].Type)
+		assert.Equal(t, 30, got.Payments[1].Amount)
+		assert.Equal(t, thePaymentMethodID, got.Payments[1].Method)
+		assert.Equal(t, theTime, got.Payments[1].CreationTime)
+
+		assert.Equal(t, 80, got.PaidAmount)
+		assert.Equal(t, 20, got.OutstandingAmount)
+
+		require.NotNil(t, repo.updatedOrder)
+		assert.Len(t, repo.updatedOrder.Payments(), 2)
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  genapi.RecordRepairOrderPaymentRequest
+		}{
+			{
+				name: "when amount is zero",
+				req: genapi.RecordRepairOrderPaymentRequest{
+					Type:   genapi.RecordRepairOrderPaymentRequestTypePartial,
+					Amount: 0,
+					Method: thePaymentMethodID,
+				},
+			},
+			{
+				name: "when payment method does not exist",
+				req: genapi.RecordRepairOrderPaymentRequest{
+					Type:   genapi.RecordRepairOrderPaymentRequestTypePartial,
+					Amount: 10,
+					Method: uuid.New(),
+				},
+			},
+			{
+				name: "when amount is greater than the outstanding amount",
+				req: genapi.RecordRepairOrderPaymentRequest{
+					Type:   genapi.RecordRepairOrderPaymentRequestTypePartial,
+					Amount: 51,
+					Method: thePaymentMethodID,
+				},
+			},
+			{
+				name: "when order already has a down payment",
+				req: genapi.RecordRepairOrderPaymentRequest{
+					Type:   genapi.RecordRepairOrderPaymentRequestTypeDownPayment,
+					Amount: 10,
+					Method: thePaymentMethodID,
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+				_, err := newService(repo, qualifyingPermissionProvider()).RecordRepairOrderPayment(
+					requestCtx,
+					&tc.req,
+					genapi.RecordRepairOrderPaymentParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+				assert.Nil(t, repo.updatedOrder)
+			})
+		}
+	})
+
+	t.Run("returns conflict when repair order is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.Cancel(theTime, "Customer changed their mind", optional.Some[uint](50), optional.None[domain.NewOrderRefundParams]()))
+
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+		_, err := newService(repo, qualifyingPermissionProvider()).RecordRepairOrderPayment(
+			requestCtx,
+			&genapi.RecordRepairOrderPaymentRequest{
+				Type:   genapi.RecordRepairOrderPaymentRequestTypePartial,
+				Amount: 10,
+				Method: thePaymentMethodID,
+			},
+			genapi.RecordRepairOrderPaymentParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		).RecordRepairOrderPayment(
+			requestCtx,
+			&genapi.RecordRepairOrderPaymentRequest{
+				Type:   genapi.RecordRepairOrderPaymentRequestTypePartial,
+				Amount: 10,
+				Method: thePaymentMethodID,
+			},
+			genapi.RecordRepairOrderPaymentParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+}
+
+func TestListRepairOrderPayments(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrderPayments(),
+		}, nil)
+	}
+
+	t.Run("returns payments and totals", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.RecordPayment(
+			theTime,
+			domain.OrderPaymentTypePartial,
+			domain.NewOrderPaymentParams{Amount: 20, PaymentMethodID: uuid.New()},
+		))
+
+		got, err := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider()).ListRepairOrderPayments(
+			requestCtx,
+			genapi.ListRepairOrderPaymentsParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeDownPayment, got.Items[0].Type)
+		assert.Equal(t, genapi.RepairOrderPaymentTypePartial, got.Items[1].Type)
+		assert.Equal(t, 20, got.Items[1].Amount)
+
+		assert.Equal(t, 100, got.TotalCost)
+		assert.Equal(t, 70, got.PaidAmount)
+		assert.Equal(t, 30, got.OutstandingAmount)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).ListRepairOrderPayments(
+			requestCtx,
+			genapi.ListRepairOrderPaymentsParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		).ListRepairOrderPayments(
+			requestCtx,
+			genapi.ListRepairOrderPaymentsParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).ListRepairOrderPayments(
+			testutil.RequestContextWithLogger(context.Background()),
+			genapi.ListRepairOrderPaymentsParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestGetRepairOrderHistory(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		auditLog *testutil.AuditLogStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrderHistory(),
+		}, nil)
+	}
+
+	t.Run("returns the history of the order", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.Entries = []audit.Entry{
+			{
+				ID:            uuid.New(),
+				UserID:        uuid.New(),
+				Username:      "cashier",
+				CorrelationID: optional.Some("cojb2s8r0l2b9r7kmvd0"),
+				Action:        audit.ActionRepairOrderCreated,
+				EntityType:    audit.EntityTypeRepairOrder,
+				EntityID:      theOrder.ID(),
+				After:         []byte(`{"total_cost":200}`),
+				CreationTime:  time.Unix(1713917762, 0),
+			},
+			{
+				ID:           uuid.New(),
+				UserID:       uuid.New(),
+				Username:     "technician",
+				Action:       audit.ActionRepairOrderCostAdded,
+				EntityType:   audit.EntityTypeRepairOrder,
+				EntityID:     theOrder.ID(),
+				Before:       []byte(`{"total_cost":200}`),
+				After:        []byte(`{"total_cost":350}`),
+				CreationTime: time.Unix(1713917862, 0),
+			},
+		}
+
+		got, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			auditLog,
+		).GetRepairOrderHistory(requestCtx, genapi.GetRepairOrderHistoryParams{RepairOrderId: theOrder.ID()})
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, genapi.AuditLogActionRepairOrderCreated, got.Items[0].Action)
+		assert.Equal(t, "cashier", got.Items[0].Username)
+		assert.Equal(t, "cojb2s8r0l2b9r7kmvd0", got.Items[0].CorrelationID.Value)
+		assert.Empty(t, got.Items[0].Before)
+
+		assert.Equal(t, genapi.AuditLogActionRepairOrderCostAdded, got.Items[1].Action)
+		assert.False(t, got.Items[1].CorrelationID.IsSet())
+		assert.JSONEq(t, `{"total_cost":200}`, string(got.Items[1].Before))
+		assert.JSONEq(t, `{"total_cost":350}`, string(got.Items[1].After))
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider(), testutil.NewAuditLogStub()).GetRepairOrderHistory(
+			requestCtx,
+			genapi.GetRepairOrderHistoryParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns internal server error when audit log errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.SetError(errors.New("oh no!"))
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			auditLog,
+		).GetRepairOrderHistory(requestCtx, genapi.GetRepairOrderHistoryParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{permission.ViewRepairOrder()}, nil),
+			testutil.NewAuditLogStub(),
+		).GetRepairOrderHistory(requestCtx, genapi.GetRepairOrderHistoryParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider(), testutil.NewAuditLogStub()).GetRepairOrderHistory(
+			testutil.RequestContextWithLogger(context.Background()),
+			genapi.GetRepairOrderHistoryParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestListRepairOrderNotes(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrder(),
+		}, nil)
+	}
+
+	t.Run("returns both internal and customer-visible notes", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		editTime := time.Unix(1713917862, 0)
+
+		internalNote := newTestOrderNote(theOrder.ID(), uuid.New(), domain.OrderNoteVisibilityInternal, time.Unix(1713917762, 0))
+		customerNote := newTestOrderNote(theOrder.ID(), uuid.New(), domain.OrderNoteVisibilityCustomer, time.Unix(1713917800, 0))
+		require.NoError(t, customerNote.Note.Edit(editTime, "Screen ordered", domain.OrderNoteVisibilityCustomer))
+
+		got, err := newService(
+			&repositoryStub{
+				orders: []domain.Order{theOrder},
+				notes: []repairorderreadmodel.RepairOrderNote{
+					internalNote,
+					customerNote,
+					newTestOrderNote(uuid.New(), uuid.New(), domain.OrderNoteVisibilityInternal, time.Unix(1713917762, 0)),
+				},
+			},
+			qualifyingPermissionProvider(),
+		).ListRepairOrderNotes(requestCtx, genapi.ListRepairOrderNotesParams{RepairOrderId: theOrder.ID()})
+		require.NoError(t, err)
+
+		require.Len(t, got.Items, 2)
+		assert.Equal(t, internalNote.Note.ID(), got.Items[0].ID)
+		assert.Equal(t, genapi.RepairOrderNoteVisibilityInternal, got.Items[0].Visibility)
+		assert.Equal(t, internalNote.AuthorUsername, got.Items[0].AuthorUsername)
+		assert.False(t, got.Items[0].EditTime.IsSet())
+
+		assert.Equal(t, customerNote.Note.ID(), got.Items[1].ID)
+		assert.Equal(t, genapi.RepairOrderNoteVisibilityCustomer, got.Items[1].Visibility)
+		assert.Equal(t, "Screen ordered", got.Items[1].Body)
+		assert.Equal(t, editTime, got.Items[1].EditTime.Value)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).ListRepairOrderNotes(
+			requestCtx,
+			genapi.ListRepairOrderNotesParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns internal server error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, noteErr: errors.New("oh no!")},
+			qualifyingPermissionProvider(),
+		).ListRepairOrderNotes(requestCtx, genapi.ListRepairOrderNotesParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		).ListRepairOrderNotes(requestCtx, genapi.ListRepairOrderNotesParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).ListRepairOrderNotes(
+			testutil.RequestContextWithLogger(context.Background()),
+			genapi.ListRepairOrderNotesParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestAddRepairOrderNote(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID   = uuid.New()
+		theStoreID  = uuid.New()
+		theUserID   = uuid.New()
+		theUsername = "frontdesk"
+		theTime     = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.ID = theUserID
+			details.Username = theUsername
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.AddRepairOrderNote(),
+		}, nil)
+	}
+
+	validRequest := func() *genapi.AddRepairOrderNoteRequest {
+		return &genapi.AddRepairOrderNoteRequest{
+			Body:       "  Customer OK'd screen replacement by phone ",
+			Visibility: genapi.RepairOrderNoteVisibilityInternal,
+		}
+	}
+
+	t.Run("adds the note", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+
+		got, err := newService(repo, qualifyingPermissionProvider()).AddRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.AddRepairOrderNoteParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.createdNote)
+		assert.Equal(t, theOrder.ID(), repo.createdNote.OrderID())
+		assert.Equal(t, theUserID, repo.createdNote.AuthorID())
+		assert.Equal(t, "Customer OK'd screen replacement by phone", repo.createdNote.Body())
+		assert.Equal(t, domain.OrderNoteVisibilityInternal, repo.createdNote.Visibility())
+		assert.Equal(t, theTime, repo.createdNote.CreationTime())
+
+		assert.Equal(t, repo.createdNote.ID(), got.ID)
+		assert.Equal(t, theUsername, got.AuthorUsername)
+		assert.False(t, got.EditTime.IsSet())
+	})
+
+	t.Run("records the note in the audit log", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		auditLog := testutil.NewAuditLogStub()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+
+		got, err := s.AddRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.AddRepairOrderNoteParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionRepairOrderNoteAdded, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypeRepairOrder, auditLog.Changes[0].EntityType)
+		assert.Equal(t, theOrder.ID(), auditLog.Changes[0].EntityID)
+		assert.Nil(t, auditLog.Changes[0].Before)
+		assert.Equal(t, got, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns bad request when body is blank", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		req := validRequest()
+		req.Body = "   "
+
+		_, err := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider()).AddRepairOrderNote(
+			requestCtx,
+			req,
+			genapi.AddRepairOrderNoteParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).AddRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.AddRepairOrderNoteParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns internal server error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, noteErr: errors.New("oh no!")},
+			qualifyingPermissionProvider(),
+		).AddRepairOrderNote(requestCtx, validRequest(), genapi.AddRepairOrderNoteParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{permission.ViewRepairOrder()}, nil),
+		).AddRepairOrderNote(requestCtx, validRequest(), genapi.AddRepairOrderNoteParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).AddRepairOrderNote(
+			testutil.RequestContextWithLogger(context.Background()),
+			validRequest(),
+			genapi.AddRepairOrderNoteParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestEditRepairOrderNote(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID   = uuid.New()
+		theStoreID  = uuid.New()
+		theUserID   = uuid.New()
+		theUsername = "frontdesk"
+		theTime     = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.ID = theUserID
+			details.Username = theUsername
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.AddRepairOrderNote(),
+		}, nil)
+	}
+
+	validRequest := func() *genapi.EditRepairOrderNoteRequest {
+		return &genapi.EditRepairOrderNoteRequest{
+			Body:       "Screen replacement approved",
+			Visibility: genapi.RepairOrderNoteVisibilityCustomer,
+		}
+	}
+
+	t.Run("edits the note", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		theNote := newTestOrderNote(theOrder.ID(), theUserID, domain.OrderNoteVisibilityInternal, theTime.Add(-10*time.Minute))
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, notes: []repairorderreadmodel.RepairOrderNote{theNote}}
+
+		got, err := newService(repo, qualifyingPermissionProvider()).EditRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: theOrder.ID(), NoteId: theNote.Note.ID()},
+		)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.updatedNote)
+		assert.Equal(t, "Screen replacement approved", repo.updatedNote.Body())
+		assert.Equal(t, domain.OrderNoteVisibilityCustomer, repo.updatedNote.Visibility())
+
+		assert.Equal(t, genapi.RepairOrderNoteVisibilityCustomer, got.Visibility)
+		assert.Equal(t, theTime, got.EditTime.Value)
+	})
+
+	t.Run("records the edit in the audit log", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		theNote := newTestOrderNote(
+			theOrder.ID(),
+			theUserID,
+			domain.OrderNoteVisibilityInternal,
+			theTime.Add(-10*time.Minute),
+		)
+		auditLog := testutil.NewAuditLogStub()
+
+		s := repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			&repositoryStub{orders: []domain.Order{theOrder}, notes: []repairorderreadmodel.RepairOrderNote{theNote}},
+			qualifyingPermissionProvider(),
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+
+		got, err := s.EditRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: theOrder.ID(), NoteId: theNote.Note.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionRepairOrderNoteEdited, auditLog.Changes[0].Action)
+		assert.Equal(t, theOrder.ID(), auditLog.Changes[0].EntityID)
+
+		before, ok := auditLog.Changes[0].Before.(*genapi.RepairOrderNote)
+		require.True(t, ok)
+		assert.Equal(t, genapi.RepairOrderNoteVisibilityInternal, before.Visibility)
+		assert.Equal(t, got, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns conflict when edit window has passed", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		theNote := newTestOrderNote(theOrder.ID(), theUserID, domain.OrderNoteVisibilityInternal, theTime.Add(-16*time.Minute))
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, notes: []repairorderreadmodel.RepairOrderNote{theNote}}
+
+		_, err := newService(repo, qualifyingPermissionProvider()).EditRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: theOrder.ID(), NoteId: theNote.Note.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+		assert.Nil(t, repo.updatedNote)
+	})
+
+	t.Run("returns forbidden when user is not the author", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		theNote := newTestOrderNote(theOrder.ID(), uuid.New(), domain.OrderNoteVisibilityInternal, theTime)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, notes: []repairorderreadmodel.RepairOrderNote{theNote}}
+
+		_, err := newService(repo, qualifyingPermissionProvider()).EditRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: theOrder.ID(), NoteId: theNote.Note.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+		assert.Nil(t, repo.updatedNote)
+	})
+
+	t.Run("returns not found when note does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider()).EditRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: theOrder.ID(), NoteId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).EditRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: uuid.New(), NoteId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		theNote := newTestOrderNote(theOrder.ID(), theUserID, domain.OrderNoteVisibilityInternal, theTime)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, notes: []repairorderreadmodel.RepairOrderNote{theNote}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{permission.ViewRepairOrder()}, nil),
+		).EditRepairOrderNote(
+			requestCtx,
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: theOrder.ID(), NoteId: theNote.Note.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(&repositoryStub{}, qualifyingPermissionProvider()).EditRepairOrderNote(
+			testutil.RequestContextWithLogger(context.Background()),
+			validRequest(),
+			genapi.EditRepairOrderNoteParams{RepairOrderId: uuid.New(), NoteId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestGetRepairOrderReceipt(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		renderer *testutil.ReceiptRendererStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			renderer,
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrder(),
+		}, nil)
+	}
+
+	theStore := repairorderreadmodel.StoreReceiptDetails{
+		Name:          "Some Store",
+		Address:       "Some Address",
+		PhoneNumber:   "08123456789",
+		WarrantyTerms: optional.Some("30 days warranty"),
+	}
+
+	t.Run("renders HTML receipt by default", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		renderer := testutil.NewReceiptRendererStub()
+
+		got, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, storeReceiptDetails: theStore},
+			qualifyingPermissionProvider(),
+			renderer,
+		).GetRepairOrderReceipt(requestCtx, genapi.GetRepairOrderReceiptParams{RepairOrderId: theOrder.ID()})
+		require.NoError(t, err)
+
+		res, ok := got.(*genapi.GetRepairOrderReceiptOKTextHTML)
+		require.True(t, ok)
+
+		body, err := io.ReadAll(res)
+		require.NoError(t, err)
+		assert.Equal(t, "<html>"+theOrder.Slug()+"</html>", string(body))
+
+		assert.Equal(t, theStore, renderer.Receipt.Store)
+		assert.Equal(t, theOrder.ID(), renderer.Receipt.Order.ID())
+		assert.Equal(t, theTime, renderer.Receipt.GenerationTime)
+	})
+
+	t.Run("only includes customer-visible notes", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		customerNote := newTestOrderNote(theOrder.ID(), uuid.New(), domain.OrderNoteVisibilityCustomer, theTime)
+		renderer := testutil.NewReceiptRendererStub()
+
+		_, err := newService(
+			&repositoryStub{
+				orders:              []domain.Order{theOrder},
+				storeReceiptDetails: theStore,
+				notes: []repairorderreadmodel.RepairOrderNote{
+					newTestOrderNote(theOrder.ID(), uuid.New(), domain.OrderNoteVisibilityInternal, theTime),
+					customerNote,
+				},
+			},
+			qualifyingPermissionProvider(),
+			renderer,
+		).GetRepairOrderReceipt(requestCtx, genapi.GetRepairOrderReceiptParams{RepairOrderId: theOrder.ID()})
+		require.NoError(t, err)
+
+		require.Len(t, renderer.Receipt.Notes, 1)
+		assert.Equal(t, customerNote.Note.ID(), renderer.Receipt.Notes[0].ID())
+	})
+
+	t.Run("renders PDF receipt", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		got, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, storeReceiptDetails: theStore},
+			qualifyingPermissionProvider(),
+			testutil.NewReceiptRendererStub(),
+		).GetRepairOrderReceipt(requestCtx, genapi.GetRepairOrderReceiptParams{
+			RepairOrderId: theOrder.ID(),
+			Format:        genapi.NewOptGetRepairOrderReceiptFormat(genapi.GetRepairOrderReceiptFormatPdf),
+		})
+		require.NoError(t, err)
+
+		_, ok := got.(*genapi.GetRepairOrderReceiptOKApplicationPdf)
+		assert.True(t, ok)
+	})
+
+	t.Run("renders ESC/POS receipt", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		got, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, storeReceiptDetails: theStore},
+			qualifyingPermissionProvider(),
+			testutil.NewReceiptRendererStub(),
+		).GetRepairOrderReceipt(requestCtx, genapi.GetRepairOrderReceiptParams{
+			RepairOrderId: theOrder.ID(),
+			Format:        genapi.NewOptGetRepairOrderReceiptFormat(genapi.GetRepairOrderReceiptFormatEscpos),
+		})
+		require.NoError(t, err)
+
+		_, ok := got.(*genapi.GetRepairOrderReceiptOKApplicationOctetStream)
+		assert.True(t, ok)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(
+			&repositoryStub{},
+			qualifyingPermissionProvider(),
+			testutil.NewReceiptRendererStub(),
+		).GetRepairOrderReceipt(requestCtx, genapi.GetRepairOrderReceiptParams{RepairOrderId: uuid.New()})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns internal server error when rendering fails", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		renderer := testutil.NewReceiptRendererStub()
+		renderer.SetError(errors.New("oh no"))
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, storeReceiptDetails: theStore},
+			qualifyingPermissionProvider(),
+			renderer,
+		).GetRepairOrderReceipt(requestCtx, genapi.GetRepairOrderReceiptParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, storeReceiptDetails: theStore},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewReceiptRendererStub(),
+		).GetRepairOrderReceipt(requestCtx, genapi.GetRepairOrderReceiptParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(
+			&repositoryStub{},
+			qualifyingPermissionProvider(),
+			testutil.NewReceiptRendererStub(),
+		).GetRepairOrderReceipt(
+			testutil.RequestContextWithLogger(context.Background()),
+			genapi.GetRepairOrderReceiptParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestGetRepairOrderLabel(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		renderer *testutil.LabelRendererStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			renderer,
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrder(),
+		}, nil)
+	}
+
+	t.Run("renders PNG label by default", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		renderer := testutil.NewLabelRendererStub()
+
+		got, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			renderer,
+		).GetRepairOrderLabel(requestCtx, genapi.GetRepairOrderLabelParams{RepairOrderId: theOrder.ID()})
+		require.NoError(t, err)
+
+		_, ok := got.(*genapi.GetRepairOrderLabelOKImagePNG)
+		assert.True(t, ok)
+
+		require.Len(t, renderer.Orders, 1)
+		assert.Equal(t, theOrder.ID(), renderer.Orders[0].ID())
+	})
+
+	t.Run("renders SVG label", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		got, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			testutil.NewLabelRendererStub(),
+		).GetRepairOrderLabel(requestCtx, genapi.GetRepairOrderLabelParams{
+			RepairOrderId: theOrder.ID(),
+			Format:        genapi.NewOptGetRepairOrderLabelFormat(genapi.GetRepairOrderLabelFormatSvg),
+		})
+		require.NoError(t, err)
+
+		_, ok := got.(*genapi.GetRepairOrderLabelOKImageSvgXML)
+		assert.True(t, ok)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(
+			&repositoryStub{},
+			qualifyingPermissionProvider(),
+			testutil.NewLabelRendererStub(),
+		).GetRepairOrderLabel(requestCtx, genapi.GetRepairOrderLabelParams{RepairOrderId: uuid.New()})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewLabelRendererStub(),
+		).GetRepairOrderLabel(requestCtx, genapi.GetRepairOrderLabelParams{RepairOrderId: theOrder.ID()})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is not logged in", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := newService(
+			&repositoryStub{},
+			qualifyingPermissionProvider(),
+			testutil.NewLabelRendererStub(),
+		).GetRepairOrderLabel(
+			testutil.RequestContextWithLogger(context.Background()),
+			genapi.GetRepairOrderLabelParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+}
+
+func TestRenderRepairOrderLabels(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		renderer *testutil.LabelRendererStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			renderer,
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ViewRepairOrder(),
+		}, nil)
+	}
+
+	t.Run("renders labels in the requested order", func(t *testing.T) {
+		t.Parallel()
+
+		firstOrder := newTestOrder(t, theStoreID)
+		secondOrder := newTestOrder(t, theStoreID)
+		renderer := testutil.NewLabelRendererStub()
+
+		got, err := newService(
+			&repositoryStub{orders: []domain.Order{firstOrder, secondOrder}},
+			qualifyingPermissionProvider(),
+			renderer,
+		).RenderRepairOrderLabels(requestCtx, &genapi.RenderRepairOrderLabelsRequest{
+			RepairOrderIds: []uuid.UUID{secondOrder.ID(), firstOrder.ID()},
+			Format:         genapi.NewOptRenderRepairOrderLabelsRequestFormat(genapi.RenderRepairOrderLabelsRequestFormatSvg),
+		})
+		require.NoError(t, err)
+
+		_, ok := got.(*genapi.RenderRepairOrderLabelsOKImageSvgXML)
+		assert.True(t, ok)
+
+		require.Len(t, renderer.Orders, 2)
+		assert.Equal(t, secondOrder.ID(), renderer.Orders[0].ID())
+		assert.Equal(t, firstOrder.ID(), renderer.Orders[1].ID())
+	})
+
+	t.Run("returns bad request when a repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			testutil.NewLabelRendererStub(),
+		).RenderRepairOrderLabels(requestCtx, &genapi.RenderRepairOrderLabelsRequest{
+			RepairOrderIds: []uuid.UUID{theOrder.ID(), uuid.New()},
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+
+		_, err := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewLabelRendererStub(),
+		).RenderRepairOrderLabels(requestCtx, &genapi.RenderRepairOrderLabelsRequest{
+			RepairOrderIds: []uuid.UUID{theOrder.ID()},
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+}
+
+func TestChangeRepairOrderTechnician(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID       = uuid.New()
+		theStoreID      = uuid.New()
+		theTechnicianID = uuid.New()
+		theTime         = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		auditLog *testutil.AuditLogStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ChangeRepairOrderTechnician(),
+		}, nil)
+	}
+
+	theRequest := &genapi.ChangeRepairOrderTechnicianRequest{
+		TechnicianID: theTechnicianID,
+		Reason:       " Technician is on leave ",
+	}
+
+	t.Run("reassigns repair order", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		previousTechnicianID := theOrder.TechnicianID()
+
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, technicianID: theTechnicianID}
+		auditLog := testutil.NewAuditLogStub()
+		s := newService(repo, qualifyingPermissionProvider(), auditLog)
+
+		got, err := s.ChangeRepairOrderTechnician(
+			requestCtx,
+			theRequest,
+			genapi.ChangeRepairOrderTechnicianParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, theTechnicianID, got.TechnicianID)
+		require.Len(t, got.TechnicianAssignments, 1)
+		assert.Equal(t, previousTechnicianID, got.TechnicianAssignments[0].PreviousTechnicianID)
+		assert.Equal(t, theTechnicianID, got.TechnicianAssignments[0].TechnicianID)
+		assert.Equal(t, "Technician is on leave", got.TechnicianAssignments[0].Reason)
+		assert.Equal(t, theTime, got.TechnicianAssignments[0].CreationTime)
+
+		require.NotNil(t, repo.updatedOrder)
+		assert.Equal(t, theTechnicianID, repo.updatedOrder.TechnicianID())
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionRepairOrderTechnicianChanged, auditLog.Changes[0].Action)
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  *genapi.ChangeRepairOrderTechnicianRequest
+		}{
+			{
+				name: "when technician does not exist",
+				req:  &genapi.ChangeRepairOrderTechnicianRequest{TechnicianID: uuid.New(), Reason: "on leave"},
+			},
+			{
+				name: "when reason is empty",
+				req:  &genapi.ChangeRepairOrderTechnicianRequest{TechnicianID: theTechnicianID, Reason: "  "},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}, technicianID: theTechnicianID}
+				s := newService(repo, qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+				_, err := s.ChangeRepairOrderTechnician(
+					requestCtx,
+					tc.req,
+					genapi.ChangeRepairOrderTechnicianParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+				assert.Nil(t, repo.updatedOrder)
+			})
+		}
+	})
+
+	t.Run("returns conflict when repair order is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.Cancel(
+			theTime,
+			"customer changed their mind",
+			optional.Some[uint](50),
+			optional.None[domain.NewOrderRefundParams](),
+		))
+
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, technicianID: theTechnicianID},
+			qualifyingPermissionProvider(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ChangeRepairOrderTechnician(
+			requestCtx,
+			theRequest,
+			genapi.ChangeRepairOrderTechnicianParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{technicianID: theTechnicianID},
+			qualifyingPermissionProvider(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ChangeRepairOrderTechnician(
+			requestCtx,
+			theRequest,
+			genapi.ChangeRepairOrderTechnicianParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, technicianID: theTechnicianID},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ChangeRepairOrderTechnician(
+			requestCtx,
+			theRequest,
+			genapi.ChangeRepairOrderTechnicianParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			setup func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub)
+		}{
+			{
+				name: "when repository.DoesTechnicianExist() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.technicianExistsErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when repository.UpdateRepairOrder() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.updateErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when permissionProvider.Can() errors",
+				setup: func(_ *repositoryStub, permissionProvider *testutil.PermissionProviderStub) {
+					permissionProvider.SetError(errors.New("oh no!"))
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}, technicianID: theTechnicianID}
+				permissionProvider := qualifyingPermissionProvider()
+
+				tc.setup(repo, permissionProvider)
+
+				s := newService(repo, permissionProvider, testutil.NewAuditLogStub())
+
+				_, err := s.ChangeRepairOrderTechnician(
+					requestCtx,
+					theRequest,
+					genapi.ChangeRepairOrderTechnicianParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+			})
+		}
+	})
+}
+
+func TestEditRepairOrderIntake(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+
+		theDamage         = damage{id: uuid.New(), name: "Water damage"}
+		thePhoneCondition = phoneCondition{id: uuid.New(), name: "Back glass cracked"}
+		theEquipment      = phoneEquipment{id: uuid.New(), name: "SIM tray"}
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		auditLog *testutil.AuditLogStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.EditRepairOrderIntake(),
+		}, nil)
+	}
+
+	newRepo := func(order domain.Order) *repositoryStub {
+		return &repositoryStub{
+			orders:          []domain.Order{order},
+			damages:         []damage{theDamage},
+			phoneConditions: []phoneCondition{thePhoneCondition},
+			phoneEquipments: []phoneEquipment{theEquipment},
+		}
+	}
+
+	t.Run("adds and removes damages", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := newRepo(theOrder)
+		auditLog := testutil.NewAuditLogStub()
+		s := newService(repo, qualifyingPermissionProvider(), auditLog)
+
+		got, err := s.AddRepairOrderDamages(
+			requestCtx,
+			&genapi.AddRepairOrderDamagesRequest{DamageTypes: []uuid.UUID{theDamage.id}},
+			genapi.AddRepairOrderDamagesParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Damages, 2)
+		assert.Equal(t, theDamage.name, got.Damages[1].Name)
+
+		got, err = s.RemoveRepairOrderDamage(requestCtx, genapi.RemoveRepairOrderDamageParams{
+			RepairOrderId:       theOrder.ID(),
+			RepairOrderDamageId: got.Damages[0].ID,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, got.Damages, 1)
+		assert.Equal(t, theDamage.name, got.Damages[0].Name)
+
+		require.NotNil(t, repo.updatedOrder)
+		assert.Len(t, repo.updatedOrder.Damages(), 1)
+
+		require.Len(t, auditLog.Changes, 2)
+		assert.Equal(t, audit.ActionRepairOrderDamagesAdded, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.ActionRepairOrderDamageRemoved, auditLog.Changes[1].Action)
+	})
+
+	t.Run("adds and removes phone conditions", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		auditLog := testutil.NewAuditLogStub()
+		s := newService(newRepo(theOrder), qualifyingPermissionProvider(), auditLog)
+
+		got, err := s.AddRepairOrderPhoneConditions(
+			requestCtx,
+			&genapi.AddRepairOrderPhoneConditionsRequest{PhoneConditions: []uuid.UUID{thePhoneCondition.id}},
+			genapi.AddRepairOrderPhoneConditionsParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.PhoneConditions, 2)
+		assert.Equal(t, thePhoneCondition.name, got.PhoneConditions[1].Name)
+
+		got, err = s.RemoveRepairOrderPhoneCondition(requestCtx, genapi.RemoveRepairOrderPhoneConditionParams{
+			RepairOrderId:               theOrder.ID(),
+			RepairOrderPhoneConditionId: got.PhoneConditions[1].ID,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, got.PhoneConditions, 1)
+		assert.NotEqual(t, thePhoneCondition.name, got.PhoneConditions[0].Name)
+
+		require.Len(t, auditLog.Changes, 2)
+		assert.Equal(t, audit.ActionRepairOrderPhoneConditionsAdded, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.ActionRepairOrderPhoneConditionRemoved, auditLog.Changes[1].Action)
+	})
+
+	t.Run("adds and removes phone equipments", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		auditLog := testutil.NewAuditLogStub()
+		s := newService(newRepo(theOrder), qualifyingPermissionProvider(), auditLog)
+
+		got, err := s.AddRepairOrderPhoneEquipments(
+			requestCtx,
+			&genapi.AddRepairOrderPhoneEquipmentsRequest{PhoneEquipments: []uuid.UUID{theEquipment.id}},
+			genapi.AddRepairOrderPhoneEquipmentsParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.PhoneEquipments, 2)
+		assert.Equal(t, theEquipment.name, got.PhoneEquipments[1].Name)
+
+		got, err = s.RemoveRepairOrderPhoneEquipment(requestCtx, genapi.RemoveRepairOrderPhoneEquipmentParams{
+			RepairOrderId:               theOrder.ID(),
+			RepairOrderPhoneEquipmentId: got.PhoneEquipments[0].ID,
+		})
+		require.NoError(t, err)
+
+		require.Len(t, got.PhoneEquipments, 1)
+		assert.Equal(t, theEquipment.name, got.PhoneEquipments[0].Name)
+
+		require.Len(t, auditLog.Changes, 2)
+		assert.Equal(t, audit.ActionRepairOrderPhoneEquipmentsAdded, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.ActionRepairOrderPhoneEquipmentRemoved, auditLog.Changes[1].Action)
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			call func(s *repairorder.Service, order domain.Order) error
+		}{
+			{
+				name: "when damage type does not exist",
+				call: func(s *repairorder.Service, order domain.Order) error {
+					_, err := s.AddRepairOrderDamages(
+						requestCtx,
+						&genapi.AddRepairOrderDamagesRequest{DamageTypes: []uuid.UUID{uuid.New()}},
+						genapi.AddRepairOrderDamagesParams{RepairOrderId: order.ID()},
+					)
+					return err
+				},
+			},
+			{
+				name: "when phone condition does not exist",
+				call: func(s *repairorder.Service, order domain.Order) error {
+					_, err := s.AddRepairOrderPhoneConditions(
+						requestCtx,
+						&genapi.AddRepairOrderPhoneConditionsRequest{PhoneConditions: []uuid.UUID{uuid.New()}},
+						genapi.AddRepairOrderPhoneConditionsParams{RepairOrderId: order.ID()},
+					)
+					return err
+				},
+			},
+			{
+				name: "when phone equipment does not exist",
+				call: func(s *repairorder.Service, order domain.Order) error {
+					_, err := s.AddRepairOrderPhoneEquipments(
+						requestCtx,
+						&genapi.AddRepairOrderPhoneEquipmentsRequest{PhoneEquipments: []uuid.UUID{uuid.New()}},
+						genapi.AddRepairOrderPhoneEquipmentsParams{RepairOrderId: order.ID()},
+					)
+					return err
+				},
+			},
+			{
+				name: "when removing the last damage",
+				call: func(s *repairorder.Service, order domain.Order) error {
+					_, err := s.RemoveRepairOrderDamage(requestCtx, genapi.RemoveRepairOrderDamageParams{
+						RepairOrderId:       order.ID(),
+						RepairOrderDamageId: order.Damages()[0].ID(),
+					})
+					return err
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := newRepo(theOrder)
+				s := newService(repo, qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, tc.call(s, theOrder))
+				assert.Nil(t, repo.updatedOrder)
+			})
+		}
+	})
+
+	t.Run("returns not found when item is not on the repair order", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		s := newService(newRepo(theOrder), qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+		_, err := s.RemoveRepairOrderDamage(requestCtx, genapi.RemoveRepairOrderDamageParams{
+			RepairOrderId:       theOrder.ID(),
+			RepairOrderDamageId: uuid.New(),
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+
+		_, err = s.RemoveRepairOrderPhoneCondition(requestCtx, genapi.RemoveRepairOrderPhoneConditionParams{
+			RepairOrderId:               theOrder.ID(),
+			RepairOrderPhoneConditionId: uuid.New(),
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+
+		_, err = s.RemoveRepairOrderPhoneEquipment(requestCtx, genapi.RemoveRepairOrderPhoneEquipmentParams{
+			RepairOrderId:               theOrder.ID(),
+			RepairOrderPhoneEquipmentId: uuid.New(),
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns conflict when repair order is picked up", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.CompleteRepair(theTime, false))
+		require.NoError(t, theOrder.PickUpByCustomer(
+			theTime,
+			optional.Some(domain.NewOrderPaymentParams{Amount: 50, PaymentMethodID: uuid.New()}),
+			optional.None[string](),
+		))
+
+		s := newService(newRepo(theOrder), qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+		_, err := s.AddRepairOrderDamages(
+			requestCtx,
+			&genapi.AddRepairOrderDamagesRequest{DamageTypes: []uuid.UUID{theDamage.id}},
+			genapi.AddRepairOrderDamagesParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		s := newService(
+			newRepo(theOrder),
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.AddRepairOrderDamages(
+			requestCtx,
+			&genapi.AddRepairOrderDamagesRequest{DamageTypes: []uuid.UUID{theDamage.id}},
+			genapi.AddRepairOrderDamagesParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error when repository.GetDamageNamesByIDs() errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := newRepo(theOrder)
+		repo.damageNameErr = errors.New("oh no!")
+
+		s := newService(repo, qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+		_, err := s.AddRepairOrderDamages(
+			requestCtx,
+			&genapi.AddRepairOrderDamagesRequest{DamageTypes: []uuid.UUID{theDamage.id}},
+			genapi.AddRepairOrderDamagesParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestAddRepairOrderPhoto(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		thePhotoID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		auditLog *testutil.AuditLogStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.AddRepairOrderPhoto(),
+		}, nil)
+	}
+
+	theRequest := &genapi.AddRepairOrderPhotoRequest{
+		PhotoID: thePhotoID,
+		Stage:   genapi.RepairOrderPhotoStageDiagnosis,
+		Caption: genapi.NewOptString(" Corrosion near the charging port "),
+	}
+
+	t.Run("adds photo to repair order", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, photoIDs: []uuid.UUID{thePhotoID}}
+		auditLog := testutil.NewAuditLogStub()
+		s := newService(repo, qualifyingPermissionProvider(), auditLog)
+
+		got, err := s.AddRepairOrderPhoto(
+			requestCtx,
+			theRequest,
+			genapi.AddRepairOrderPhotoParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Photos, 2)
+		assert.Equal(t, genapi.RepairOrderPhotoStageIntake, got.Photos[0].Stage)
+		assert.Equal(t, thePhotoID, got.Photos[1].PhotoID)
+		assert.Equal(t, genapi.RepairOrderPhotoStageDiagnosis, got.Photos[1].Stage)
+		assert.Equal(t, genapi.NewOptString("Corrosion near the charging port"), got.Photos[1].Caption)
+		assert.Equal(t, theTime, got.Photos[1].CreationTime)
+
+		require.NotNil(t, repo.updatedOrder)
+		assert.Len(t, repo.updatedOrder.Photos(), 2)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionRepairOrderPhotoAdded, auditLog.Changes[0].Action)
+	})
+
+	t.Run("leaves caption unset when it is blank", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, photoIDs: []uuid.UUID{thePhotoID}}
+		s := newService(repo, qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+		got, err := s.AddRepairOrderPhoto(
+			requestCtx,
+			&genapi.AddRepairOrderPhotoRequest{
+				PhotoID: thePhotoID,
+				Stage:   genapi.RepairOrderPhotoStageRepair,
+				Caption: genapi.NewOptString("  "),
+			},
+			genapi.AddRepairOrderPhotoParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Photos, 2)
+		assert.False(t, got.Photos[1].Caption.IsSet())
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  *genapi.AddRepairOrderPhotoRequest
+		}{
+			{
+				name: "when photo does not exist",
+				req:  &genapi.AddRepairOrderPhotoRequest{PhotoID: uuid.New(), Stage: genapi.RepairOrderPhotoStageRepair},
+			},
+			{
+				name: "when stage is unknown",
+				req:  &genapi.AddRepairOrderPhotoRequest{PhotoID: thePhotoID, Stage: genapi.RepairOrderPhotoStage("before")},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}, photoIDs: []uuid.UUID{thePhotoID}}
+				s := newService(repo, qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+				_, err := s.AddRepairOrderPhoto(
+					requestCtx,
+					tc.req,
+					genapi.AddRepairOrderPhotoParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+				assert.Nil(t, repo.updatedOrder)
+			})
+		}
+	})
+
+	t.Run("returns conflict when repair order is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.Cancel(
+			theTime,
+			"customer changed their mind",
+			optional.Some[uint](50),
+			optional.None[domain.NewOrderRefundParams](),
+		))
+
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, photoIDs: []uuid.UUID{thePhotoID}},
+			qualifyingPermissionProvider(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.AddRepairOrderPhoto(
+			requestCtx,
+			theRequest,
+			genapi.AddRepairOrderPhotoParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}, photoIDs: []uuid.UUID{thePhotoID}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.AddRepairOrderPhoto(
+			requestCtx,
+			theRequest,
+			genapi.AddRepairOrderPhotoParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error when repository.CountPhotosByIDs() errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		s := newService(
+			&repositoryStub{
+				orders:        []domain.Order{theOrder},
+				photoIDs:      []uuid.UUID{thePhotoID},
+				photoCountErr: errors.New("oh no!"),
+			},
+			qualifyingPermissionProvider(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.AddRepairOrderPhoto(
+			requestCtx,
+			theRequest,
+			genapi.AddRepairOrderPhotoParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestRemoveRepairOrderPhoto(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(
+		repo *repositoryStub,
+		permissionProvider *testutil.PermissionProviderStub,
+		auditLog *testutil.AuditLogStub,
+	) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			auditLog,
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.RemoveRepairOrderPhoto(),
+		}, nil)
+	}
+
+	newOrderWithRepairPhoto := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		order := newTestOrder(t, theStoreID)
+		require.NoError(t, order.AddPhoto(theTime, domain.NewOrderPhotoParams{
+			PhotoID: uuid.New(),
+			Stage:   domain.OrderPhotoStageRepair,
+		}))
+
+		return order
+	}
+
+	t.Run("removes photo from repair order", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newOrderWithRepairPhoto(t)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+		auditLog := testutil.NewAuditLogStub()
+		s := newService(repo, qualifyingPermissionProvider(), auditLog)
+
+		got, err := s.RemoveRepairOrderPhoto(requestCtx, genapi.RemoveRepairOrderPhotoParams{
+			RepairOrderId:      theOrder.ID(),
+			RepairOrderPhotoId: theOrder.Photos()[1].ID(),
+		})
+		require.NoError(t, err)
+
+		require.Len(t, got.Photos, 1)
+		assert.Equal(t, genapi.RepairOrderPhotoStageIntake, got.Photos[0].Stage)
+
+		require.NotNil(t, repo.updatedOrder)
+		assert.Len(t, repo.updatedOrder.Photos(), 1)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionRepairOrderPhotoRemoved, auditLog.Changes[0].Action)
+	})
+
+	t.Run("returns bad request when removing the last intake photo", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newOrderWithRepairPhoto(t)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+		s := newService(repo, qualifyingPermissionProvider(), testutil.NewAuditLogStub())
+
+		_, err := s.RemoveRepairOrderPhoto(requestCtx, genapi.RemoveRepairOrderPhotoParams{
+			RepairOrderId:      theOrder.ID(),
+			RepairOrderPhotoId: theOrder.Photos()[0].ID(),
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+		assert.Nil(t, repo.updatedOrder)
+	})
+
+	t.Run("returns not found when photo is not attached to the repair order", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newOrderWithRepairPhoto(t)
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			qualifyingPermissionProvider(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.RemoveRepairOrderPhoto(requestCtx, genapi.RemoveRepairOrderPhotoParams{
+			RepairOrderId:      theOrder.ID(),
+			RepairOrderPhotoId: uuid.New(),
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newOrderWithRepairPhoto(t)
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.RemoveRepairOrderPhoto(requestCtx, genapi.RemoveRepairOrderPhotoParams{
+			RepairOrderId:      theOrder.ID(),
+			RepairOrderPhotoId: theOrder.Photos()[1].ID(),
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+}
+
+func TestConfirmRepairOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.ConfirmRepairOrder(),
+		}, nil)
+	}
+
+	t.Run("confirms repair order", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+		s := newService(repo, qualifyingPermissionProvider())
+
+		got, err := s.ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, theTime, got.Confirmation.Value.Time)
+		assert.Equal(t, "Replace the screen", got.Confirmation.Value.Contents)
+
+		require.NotNil(t, repo.updatedOrder)
+		assert.Equal(t, domain.OrderStatusConfirmed, repo.updatedOrder.Status())
+	})
+
+	t.Run("returns bad request when contents is empty", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+		s := newService(repo, qualifyingPermissionProvider())
+
+		_, err := s.ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "  "},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+		assert.Nil(t, repo.updatedOrder)
+	})
+
+	t.Run("returns conflict when repair order is already confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.ConfirmToCustomer(theTime, "Replace the screen"))
+
+		s := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider())
+
+		_, err := s.ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns conflict when repair order was updated concurrently", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{
+			orders:    []domain.Order{theOrder},
+			updateErr: apperror.ErrRepairOrderConcurrentUpdate,
+		}
+
+		_, err := newService(repo, qualifyingPermissionProvider()).ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns not found when repair order does not exist", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(&repositoryStub{}, qualifyingPermissionProvider())
+
+		_, err := s.ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: uuid.New()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when user does not have permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		s := newService(
+			&repositoryStub{orders: []domain.Order{theOrder}},
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+		)
+
+		_, err := s.ConfirmRepairOrder(
+			requestCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns unauthorized when user is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		s := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider())
+		emptyCtx := testutil.RequestContextWithLogger(context.Background())
+
+		_, err := s.ConfirmRepairOrder(
+			emptyCtx,
+			&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+			genapi.ConfirmRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+
+	t.Run("returns internal server error", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			setup func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub)
+		}{
+			{
+				name: "when repository.GetRepairOrderByID() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.getOrderErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when repository.UpdateRepairOrder() errors",
+				setup: func(repo *repositoryStub, _ *testutil.PermissionProviderStub) {
+					repo.updateErr = errors.New("oh no!")
+				},
+			},
+			{
+				name: "when permissionProvider.Can() errors",
+				setup: func(_ *repositoryStub, permissionProvider *testutil.PermissionProviderStub) {
+					permissionProvider.SetError(errors.New("oh no!"))
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}}
+				permissionProvider := qualifyingPermissionProvider()
+
+				tc.setup(repo, permissionProvider)
+
+				s := newService(repo, permissionProvider)
+
+				_, err := s.ConfirmRepairOrder(
+					requestCtx,
+					&genapi.ConfirmRepairOrderRequest{Contents: "Replace the screen"},
+					genapi.ConfirmRepairOrderParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+			})
+		}
+	})
+}
+
+func TestCompleteRepairOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID  = uuid.New()
+		theStoreID = uuid.New()
+		theTime    = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+				permission.CompleteRepairOrder(),
+			}, nil),
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	t.Run("completes unconfirmed repair order when store does not require confirmation", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+
+		got, err := newService(repo).CompleteRepairOrder(
+			requestCtx,
+			genapi.CompleteRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, theTime, got.CompletionTime.Value)
+		assert.Equal(t, domain.OrderStatusCompleted, repo.updatedOrder.Status())
+	})
+
+	t.Run("completes confirmed repair order when store requires confirmation", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.ConfirmToCustomer(theTime, "Replace the screen"))
+
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, requiresConfirmation: true}
+
+		_, err := newService(repo).CompleteRepairOrder(
+			requestCtx,
+			genapi.CompleteRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+	})
+
+	t.Run("returns conflict when store requires confirmation and order is not confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, requiresConfirmation: true}
+
+		_, err := newService(repo).CompleteRepairOrder(
+			requestCtx,
+			genapi.CompleteRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+		assert.Nil(t, repo.updatedOrder)
+	})
+
+	t.Run("returns conflict when repair order is cancelled", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.Cancel(theTime, "Customer declined", optional.Some[uint](50), optional.None[domain.NewOrderRefundParams]()))
+
+		_, err := newService(&repositoryStub{orders: []domain.Order{theOrder}}).CompleteRepairOrder(
+			requestCtx,
+			genapi.CompleteRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns internal server error when repository.DoesStoreRequireConfirmationBeforeCompletion() errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, storeSettingsErr: errors.New("oh no!")}
+
+		_, err := newService(repo).CompleteRepairOrder(
+			requestCtx,
+			genapi.CompleteRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestPickUpRepairOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID          = uuid.New()
+		theStoreID         = uuid.New()
+		thePaymentMethodID = uuid.New()
+		theTime            = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+				permission.PickUpRepairOrder(),
+			}, nil),
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	newCompletedOrder := func(t *testing.T) domain.Order {
+		t.Helper()
+
+		order := newTestOrder(t, theStoreID)
+		require.NoError(t, order.CompleteRepair(theTime, false))
+
+		return order
+	}
+
+	withRepayment := func(amount int, method uuid.UUID) genapi.OptPickUpRepairOrderRequest {
+		return genapi.NewOptPickUpRepairOrderRequest(genapi.PickUpRepairOrderRequest{
+			Repayment: genapi.NewOptPickUpRepairOrderRequestRepayment(genapi.PickUpRepairOrderRequestRepayment{
+				Amount: amount,
+				Method: method,
+			}),
+		})
+	}
+
+	t.Run("picks up completed repair order with repayment", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newCompletedOrder(t)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+		got, err := newService(repo).PickUpRepairOrder(
+			requestCtx,
+			withRepayment(50, thePaymentMethodID),
+			genapi.PickUpRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, theTime, got.PickUpTime.Value)
+		require.Len(t, got.Payments, 2)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeFinal, got.Payments[1].Type)
+		assert.Equal(t, 50, got.Payments[1].Amount)
+		assert.Equal(t, domain.OrderStatusPickedUp, repo.updatedOrder.Status())
+	})
+
+	t.Run("writes off the difference when reason is given", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newCompletedOrder(t)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+
+		got, err := newService(repo).PickUpRepairOrder(
+			requestCtx,
+			genapi.NewOptPickUpRepairOrderRequest(genapi.PickUpRepairOrderRequest{
+				WriteOffReason: genapi.NewOptString("Loyal customer"),
+			}),
+			genapi.PickUpRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		assert.Len(t, got.Payments, 1)
+		require.True(t, got.WriteOff.IsSet())
+		assert.Equal(t, 50, got.WriteOff.Value.Amount)
+		assert.Equal(t, "Loyal customer", got.WriteOff.Value.Reason)
+		assert.Equal(t, 0, got.OutstandingAmount)
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  genapi.OptPickUpRepairOrderRequest
+		}{
+			{
+				name: "when repayment amount is not positive",
+				req:  withRepayment(0, thePaymentMethodID),
+			},
+			{
+				name: "when payment method does not exist",
+				req:  withRepayment(50, uuid.New()),
+			},
+			{
+				name: "when payments do not add up to the total cost",
+				req:  withRepayment(40, thePaymentMethodID),
+			},
+			{
+				name: "when there is no repayment and no write-off reason",
+				req:  genapi.OptPickUpRepairOrderRequest{},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newCompletedOrder(t)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+				_, err := newService(repo).PickUpRepairOrder(
+					requestCtx,
+					tc.req,
+					genapi.PickUpRepairOrderParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+			})
+		}
+	})
+
+	t.Run("returns conflict", func(t *testing.T) {
+		testCases := []struct {
+			name  string
+			setup func(t *testing.T, order domain.Order)
+		}{
+			{
+				name:  "when repair order is not completed yet",
+				setup: func(_ *testing.T, _ domain.Order) {},
+			},
+			{
+				name: "when repair order is cancelled",
+				setup: func(t *testing.T, order domain.Order) {
+					require.NoError(t, order.Cancel(theTime, "Customer declined", optional.Some[uint](50), optional.None[domain.NewOrderRefundParams]()))
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				tc.setup(t, theOrder)
+
+				_, err := newService(&repositoryStub{orders: []domain.Order{theOrder}}).PickUpRepairOrder(
+					requestCtx,
+					genapi.OptPickUpRepairOrderRequest{},
+					genapi.PickUpRepairOrderParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+			})
+		}
+	})
+
+	t.Run("returns internal server error when repository.DoesPaymentMethodExist() errors", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newCompletedOrder(t)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodExistsErr: errors.New("oh no!")}
+
+		_, err := newService(repo).PickUpRepairOrder(
+			requestCtx,
+			withRepayment(50, thePaymentMethodID),
+			genapi.PickUpRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestCancelRepairOrder(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theRoleID          = uuid.New()
+		theStoreID         = uuid.New()
+		thePaymentMethodID = uuid.New()
+		theTime            = time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+
+	requestCtx := appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = theStoreID
+			details.Role.ID = theRoleID
+		}),
+	)
+
+	newService := func(repo *repositoryStub, permissionProvider *testutil.PermissionProviderStub) *repairorder.Service {
+		return repairorder.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForRepairOrder(url.URL{}),
+			repo,
+			permissionProvider,
+			testutil.NewRepairOrderSlugProviderStub("random-slug", nil),
+			testutil.NewReceiptRendererStub(),
+			testutil.NewLabelRendererStub(),
+			testutil.NewAuditLogStub(),
+		)
+	}
+
+	qualifyingPermissionProvider := func() *testutil.PermissionProviderStub {
+		return testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+			permission.CancelRepairOrder(),
+			permission.RefundRepairOrder(),
+		}, nil)
+	}
+
+	withRefund := func(amount int, method uuid.UUID, reason string) genapi.OptCancelRepairOrderRequestRefund {
+		return genapi.NewOptCancelRepairOrderRequestRefund(genapi.CancelRepairOrderRequestRefund{
+			Amount: amount,
+			Method: method,
+			Reason: reason,
+		})
+	}
+
+	t.Run("cancels repair order and keeps the paid amount as fee", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}}
+
+		got, err := newService(repo, qualifyingPermissionProvider()).CancelRepairOrder(
+			requestCtx,
+			&genapi.CancelRepairOrderRequest{Reason: "Customer declined", Fee: genapi.NewOptInt(50)},
+			genapi.CancelRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		assert.Equal(t, theTime, got.Cancellation.Value.Time)
+		assert.Equal(t, "Customer declined", got.Cancellation.Value.Reason)
+		assert.Equal(t, 50, got.Cancellation.Value.Fee.Value)
+		assert.Len(t, got.Payments, 1)
+		assert.Equal(t, 0, got.OutstandingAmount)
+		assert.Equal(t, domain.OrderStatusCancelled, repo.updatedOrder.Status())
+	})
+
+	t.Run("refunds what was paid beyond the fee", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+		got, err := newService(repo, qualifyingPermissionProvider()).CancelRepairOrder(
+			requestCtx,
+			&genapi.CancelRepairOrderRequest{
+				Reason: "Customer declined",
+				Fee:    genapi.NewOptInt(10),
+				Refund: withRefund(40, thePaymentMethodID, "Repair cancelled"),
+			},
+			genapi.CancelRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		require.NoError(t, err)
+
+		require.Len(t, got.Payments, 2)
+		assert.Equal(t, genapi.RepairOrderPaymentTypeRefund, got.Payments[1].Type)
+		assert.Equal(t, 40, got.Payments[1].Amount)
+		assert.Equal(t, thePaymentMethodID, got.Payments[1].Method)
+		assert.Equal(t, "Repair cancelled", got.Payments[1].Reason.Value)
+
+		assert.Equal(t, 10, got.PaidAmount)
+		assert.Equal(t, 0, got.OutstandingAmount)
+		assert.Equal(t, 10, got.Cancellation.Value.Fee.Value)
+	})
+
+	t.Run("returns bad request", func(t *testing.T) {
+		testCases := []struct {
+			name string
+			req  genapi.CancelRepairOrderRequest
+		}{
+			{
+				name: "when reason is empty",
+				req:  genapi.CancelRepairOrderRequest{Reason: "", Fee: genapi.NewOptInt(50)},
+			},
+			{
+				name: "when paid amount is neither kept nor refunded",
+				req:  genapi.CancelRepairOrderRequest{Reason: "Customer declined"},
+			},
+			{
+				name: "when fee is zero",
+				req: genapi.CancelRepairOrderRequest{
+					Reason: "Customer declined",
+					Fee:    genapi.NewOptInt(0),
+					Refund: withRefund(50, thePaymentMethodID, "Repair cancelled"),
+				},
+			},
+			{
+				name: "when fee is greater than the paid amount",
+				req:  genapi.CancelRepairOrderRequest{Reason: "Customer declined", Fee: genapi.NewOptInt(60)},
+			},
+			{
+				name: "when refund does not match the refundable amount",
+				req: genapi.CancelRepairOrderRequest{
+					Reason: "Customer declined",
+					Fee:    genapi.NewOptInt(10),
+					Refund: withRefund(50, thePaymentMethodID, "Repair cancelled"),
+				},
+			},
+			{
+				name: "when refund reason is empty",
+				req: genapi.CancelRepairOrderRequest{
+					Reason: "Customer declined",
+					Refund: withRefund(50, thePaymentMethodID, " "),
+				},
+			},
+			{
+				name: "when refund payment method does not exist",
+				req: genapi.CancelRepairOrderRequest{
+					Reason: "Customer declined",
+					Refund: withRefund(50, uuid.New(), "Repair cancelled"),
+				},
+			},
+		}
+
+		for _, tc := range testCases {
+			tc := tc
+
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				theOrder := newTestOrder(t, theStoreID)
+				repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+				_, err := newService(repo, qualifyingPermissionProvider()).CancelRepairOrder(
+					requestCtx,
+					&tc.req,
+					genapi.CancelRepairOrderParams{RepairOrderId: theOrder.ID()},
+				)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+				assert.Nil(t, repo.updatedOrder)
+			})
+		}
+	})
+
+	t.Run("returns forbidden when refunding without refund permission", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		repo := &repositoryStub{orders: []domain.Order{theOrder}, paymentMethodID: thePaymentMethodID}
+
+		_, err := newService(
+			repo,
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{
+				permission.CancelRepairOrder(),
+			}, nil),
+		).CancelRepairOrder(
+			requestCtx,
+			&genapi.CancelRepairOrderRequest{
+				Reason: "Customer declined",
+				Refund: withRefund(50, thePaymentMethodID, "Repair cancelled"),
+			},
+			genapi.CancelRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+		assert.Nil(t, repo.updatedOrder)
+	})
+
+	t.Run("returns conflict when repair order is already picked up", func(t *testing.T) {
+		t.Parallel()
+
+		theOrder := newTestOrder(t, theStoreID)
+		require.NoError(t, theOrder.CompleteRepair(theTime, false))
+		require.NoError(t, theOrder.PickUpByCustomer(theTime, optional.None[domain.NewOrderPaymentParams](), optional.Some("Waived")))
+
+		_, err := newService(&repositoryStub{orders: []domain.Order{theOrder}}, qualifyingPermissionProvider()).CancelRepairOrder(
+			requestCtx,
+			&genapi.CancelRepairOrderRequest{Reason: "Customer declined", Fee: genapi.NewOptInt(50)},
+			genapi.CancelRepairOrderParams{RepairOrderId: theOrder.ID()},
+		)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+}
+
+func newTestOrder(t *testing.T, storeID uuid.UUID) domain.Order {
+	t.Helper()
+
+	contactNumber, err := shareddomain.NewPhoneNumber("08123456789")
+	require.NoError(t, err)
+
+	pattern, err := domain.NewPatternSecurity("1234")
+	require.NoError(t, err)
+
+	order, err := domain.NewOrder(domain.NewOrderParams{
+		CreationTime:         time.Now(),
+		Slug:                 "slug-" + uuid.NewString(),
+		StoreID:              storeID,
+		CustomerName:         "John Doe",
+		ContactNumber:        contactNumber,
+		PhoneType:            "iPhone 12",
+		Color:                "Black",
+		InitialCost:          100,
+		PhoneConditions:      []string{"Screen broken"},
+		PhoneEquipments:      []string{"Battery"},
+		Damages:              []string{"Screen"},
+		Photos:               []uuid.UUID{uuid.New()},
+		SalesPersonID:        uuid.New(),
+		TechnicianID:         uuid.New(),
+		Imei:                 optional.Some("123456789012345"),
+		PhoneSecurityDetails: optional.Some(pattern),
+		DownPayment: optional.Some(domain.NewOrderPaymentParams{
+			Amount:          50,
+			PaymentMethodID: uuid.New(),
+		}),
+	})
+	require.NoError(t, err)
+
+	return order
+}
+
+func newTestOrderNote(
+	orderID uuid.UUID,
+	authorID uuid.UUID,
+	visibility domain.OrderNoteVisibility,
+	creationTime time.Time,
+) repairorderreadmodel.RepairOrderNote {
+	return repairorderreadmodel.RepairOrderNote{
+		Note: domain.RestoreOrderNote(domain.RestoreOrderNoteParams{
+			ID:           uuid.New(),
+			OrderID:      orderID,
+			AuthorID:     authorID,
+			Body:         "Customer OK'd screen replacement by phone",
+			Visibility:   visibility,
+			CreationTime: creationTime,
+			EditTime:     optional.None[time.Time](),
+		}),
+		AuthorUsername: "technician",
+	}
+}
+
+type damage struct {
+	id   uuid.UUID
+	name string
+}
+
+type phoneCondition struct {
+	id   uuid.UUID
+	name string
+}
+
+type phoneEquipment struct {
+	id   uuid.UUID
+	name string
+}
+
+type repositoryStub struct {
+	damages                []damage
+	phoneConditions        []phoneCondition
+	phoneEquipments        []phoneEquipment
+	technicianID           uuid.UUID
+	salesPersonID          uuid.UUID
+	paymentMethodID        uuid.UUID
+	photoIDs               []uuid.UUID
+	orders                 []domain.Order
+	summaries              []repairorderreadmodel.RepairOrderSummary
+	queue                  []repairorderreadmodel.RepairOrderSummary
+	workloads              []repairorderreadmodel.TechnicianWorkload
+	notes                  []repairorderreadmodel.RepairOrderNote
+	createdNote            domain.OrderNote
+	updatedNote            domain.OrderNote
+	calledWithFilter       repairorderreadmodel.RepairOrderListFilter
+	calledWithOrder        domain.Order
+	updatedOrder           domain.Order
+	requiresConfirmation   bool
+	storeReceiptDetails    repairorderreadmodel.StoreReceiptDetails
+	createErr              error
+	damageNameErr          error
+	phoneConditionNameErr  error
+	phoneEquipmentNameErr  error
+	storeExistsErr         error
+	technicianExistsErr    error
+	salesPersonExistsErr   error
+	paymentMethodExistsErr error
+	photoCountErr          error
+	getOrderErr            error
+	listErr                error
+	countErr               error
+	queueErr               error
+	workloadErr            error
+	storeSettingsErr       error
+	updateErr              error
+	noteErr                error
+}
+
+func (r *repositoryStub) CreateRepairOrder(_ context.Context, order domain.Order) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+
+	r.calledWithOrder = order
+	return nil
+}
+
+func (r *repositoryStub) GetRepairOrderByID(
+	_ context.Context,
+	storeID uuid.UUID,
+	repairOrderID uuid.UUID,
+) (domain.Order, error) {
+	if r.getOrderErr != nil {
+		return nil, r.getOrderErr
+	}
+
+	for _, order := range r.orders {
+		if order.StoreID() == storeID && order.ID() == repairOrderID {
+			return order, nil
+		}
+	}
+
+	return nil, apperror.ErrRepairOrderNotFound
+}
+
+func (r *repositoryStub) GetRepairOrderBySlug(_ context.Context, storeID uuid.UUID, slug string) (domain.Order, error) {
+	if r.getOrderErr != nil {
+		return nil, r.getOrderErr
+	}
+
+	for _, order := range r.orders {
+		if order.StoreID() == storeID && order.Slug() == slug {
+			return order, nil
+		}
+	}
+
+	return nil, apperror.ErrRepairOrderNotFound
+}
+
+func (r *repositoryStub) ListRepairOrders(
+	_ context.Context,
+	_ uuid.UUID,
+	filter repairorderreadmodel.RepairOrderListFilter,
+	cursor optional.Optional[repairorderreadmodel.RepairOrderListCursor],
+	limit int,
+) ([]repairorderreadmodel.RepairOrderSummary, error) {
+	if r.listErr != nil {
+		return nil, r.listErr
+	}
+
+	r.calledWithFilter = filter
+
+	start := 0
+	if c, ok := cursor.Get(); ok {
+		for i, summary := range r.summaries {
+			if summary.ID == c.RepairOrderID {
+				start = i + 1
+				break
+			}
+		}
+	}
+
+	end := min(start+limit, len(r.summaries))
+	return r.summaries[start:end], nil
+}
+
+func (r *repositoryStub) CountRepairOrders(
+	_ context.Context,
+	_ uuid.UUID,
+	_ repairorderreadmodel.RepairOrderListFilter,
+) (int, error) {
+	if r.countErr != nil {
+		return 0, r.countErr
+	}
+
+	return len(r.summaries), nil
+}
+
+func (r *repositoryStub) GetTechnicianQueue(
+	_ context.Context,
+	_ uuid.UUID,
+	_ uuid.UUID,
+) ([]repairorderreadmodel.RepairOrderSummary, error) {
+	if r.queueErr != nil {
+		return nil, r.queueErr
+	}
+
+	return r.queue, nil
+}
+
+func (r *repositoryStub) GetTechnicianWorkload(
+	_ context.Context,
+	_ uuid.UUID,
+) ([]repairorderreadmodel.TechnicianWorkload, error) {
+	if r.workloadErr != nil {
+		return nil, r.workloadErr
+	}
+
+	return r.workloads, nil
+}
+
+func (r *repositoryStub) GetDamageNamesByIDs(_ context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error) {
+	if r.damageNameErr != nil {
+		return []string{}, r.damageNameErr
+	}
+
+	names := []string{}
+
+	for _, id := range ids {
+		found := false
+
+		for _, damage := range r.damages {
+			if damage.id == id {
+				names = append(names, damage.name)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return []string{}, apperror.ErrDamageNotFound
+		}
+	}
+
+	return names, nil
+}
+
+func (r *repositoryStub) GetPhoneConditionNamesByIDs(_ context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error) {
+	if r.phoneConditionNameErr != nil {
+		return []string{}, r.phoneConditionNameErr
+	}
+
+	names := []string{}
+
+	for _, id := range ids {
+		found := false
+
+		for _, phoneCondition := range r.phoneConditions {
+			if phoneCondition.id == id {
+				names = append(names, phoneCondition.name)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return []string{}, apperror.ErrPhoneConditionNotFound
+		}
+	}
+
+	return names, nil
+}
+
+func (r *repositoryStub) GetPhoneEquipmentNamesByIDs(_ context.Context, storeID uuid.UUID, ids []uuid.UUID) ([]string, error) {
+	if r.phoneEquipmentNameErr != nil {
+		return []string{}, r.phoneEquipmentNameErr
+	}
+
+	names := []string{}
+
+	for _, id := range ids {
+		found := false
+
+		for _, equipment := range r.phoneEquipments {
+			if equipment.id == id {
+				names = append(names, equipment.name)
+				found = true
+
+				break
+			}
+		}
+
+		if !found {
+			return []string{}, apperror.ErrPhoneEquipmentNotFound
+		}
+	}
+
+	return names, nil
+}
+
+func (r *repositoryStub) DoesTechnicianExist(_ context.Context, storeID uuid.UUID, technicianID uuid.UUID) (bool, error) {
+	if r.technicianExistsErr != nil {
+		return false, r.technicianExistsErr
+	}
+
+	return technicianID == r.technicianID, nil
+}
+
+func (r *repositoryStub) CountPhotosByIDs(_ context.Context, _ uuid.UUID, ids []uuid.UUID) (int, error) {
+	if r.photoCountErr != nil {
+		return 0, r.photoCountErr
+	}
+
+	count := 0
+	for _, id := range r.photoIDs {
+		if slices.Contains(ids, id) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (r *repositoryStub) DoesSalesPersonExist(_ context.Context, storeID uuid.UUID, salesPersonID uuid.UUID) (bool, error) {
+	if r.salesPersonExistsErr != nil {
+		return false, r.salesPersonExistsErr
+	}
+
+	return salesPersonID == r.salesPersonID, nil
+}
+
+func (r *repositoryStub) DoesPaymentMethodExist(_ context.Context, storeID uuid.UUID, paymentMethodID uuid.UUID) (bool, error) {
+	if r.paymentMethodExistsErr != nil {
+		return false, r.paymentMethodExistsErr
+	}
+
+	return paymentMethodID == r.paymentMethodID, nil
+}
+
+func (r *repositoryStub) DoesStoreRequireConfirmationBeforeCompletion(_ context.Context, _ uuid.UUID) (bool, error) {
+	if r.storeSettingsErr != nil {
+		return false, r.storeSettingsErr
+	}
+
+	return r.requiresConfirmation, nil
+}
+
+func (r *repositoryStub) GetStoreReceiptDetails(
+	_ context.Context,
+	_ uuid.UUID,
+) (repairorderreadmodel.StoreReceiptDetails, error) {
+	if r.storeSettingsErr != nil {
+		return repairorderreadmodel.StoreReceiptDetails{}, r.storeSettingsErr
+	}
+
+	return r.storeReceiptDetails, nil
+}
+
+func (r *repositoryStub) UpdateRepairOrder(_ context.Context, order domain.Order) error {
+	if r.updateErr != nil {
+		return r.updateErr
+	}
+
+	r.updatedOrder = order
+	return nil
+}
+
+func (r *repositoryStub) CreateRepairOrderNote(_ context.Context, note domain.OrderNote) error {
+	if r.noteErr != nil {
+		return r.noteErr
+	}
+
+	r.createdNote = note
+	return nil
+}
+
+func (r *repositoryStub) UpdateRepairOrderNote(_ context.Context, note domain.OrderNote) error {
+	if r.noteErr != nil {
+		return r.noteErr
+	}
+
+	r.updatedNote = note
+	return nil
+}
+
+func (r *repositoryStub) GetRepairOrderNoteByID(
+	_ context.Context,
+	repairOrderID uuid.UUID,
+	noteID uuid.UUID,
+) (domain.OrderNote, error) {
+	if r.noteErr != nil {
+		return nil, r.noteErr
+	}
+
+	for _, note := range r.notes {
+		if note.Note.OrderID() == repairOrderID && note.Note.ID() == noteID {
+			return note.Note, nil
+		}
+	}
+
+	return nil, apperror.ErrRepairOrderNoteNotFound
+}
+
+func (r *repositoryStub) GetRepairOrderNotes(
+	_ context.Context,
+	repairOrderID uuid.UUID,
+	visibility optional.Optional[domain.OrderNoteVisibility],
+) ([]repairorderreadmodel.RepairOrderNote, error) {
+	if r.noteErr != nil {
+		return nil, r.noteErr
+	}
+
+	notes := make([]repairorderreadmodel.RepairOrderNote, 0, len(r.notes))
+	for _, note := range r.notes {
+		if note.Note.OrderID() != repairOrderID {
+			continue
+		}
+
+		if v, ok := visibility.Get(); ok && note.Note.Visibility() != v {
+			continue
+		}
+
+		notes = append(notes, note)
+	}
+
+	return notes, nil
 }
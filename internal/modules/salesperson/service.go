@@ -8,6 +8,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/apierror"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -22,21 +23,28 @@ type ResourceLocationProvider interface {
 	SalesPerson(salesPersonID uuid.UUID) url.URL
 }
 
+type AuditRecorder interface {
+	Record(ctx context.Context, change audit.Change) error
+}
+
 type Service struct {
 	resourceLocationProvider ResourceLocationProvider
 	permissionProvider       permission.Provider
 	repo                     Repository
+	auditRecorder            AuditRecorder
 }
 
 func NewService(
 	resourceLocationProvider ResourceLocationProvider,
 	permissionProvider permission.Provider,
 	repo Repository,
+	auditRecorder AuditRecorder,
 ) *Service {
 	return &Service{
 		resourceLocationProvider: resourceLocationProvider,
 		permissionProvider:       permissionProvider,
 		repo:                     repo,
+		auditRecorder:            auditRecorder,
 	}
 }
 
@@ -77,6 +85,15 @@ func (s *Service) CreateSalesPerson(
 		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create sales person")
 	}
 
+	if err := audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionSalesPersonCreated,
+		EntityType: audit.EntityTypeSalesPerson,
+		EntityID:   id,
+		After:      req,
+	}); err != nil {
+		return nil, err
+	}
+
 	location := s.resourceLocationProvider.SalesPerson(id)
 	return &genapi.CreateSalesPersonCreated{
 		Location: location,
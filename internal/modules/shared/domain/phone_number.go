@@ -9,6 +9,7 @@ import (
 
 type PhoneNumber interface {
 	Value() string
+	LastFourDigits() string
 }
 
 type phoneNumber struct {
@@ -31,3 +32,7 @@ func NewPhoneNumber(value string) (PhoneNumber, error) {
 func (p phoneNumber) Value() string {
 	return p.value
 }
+
+func (p phoneNumber) LastFourDigits() string {
+	return p.value[len(p.value)-4:]
+}
@@ -55,3 +55,12 @@ func TestNewPhoneNumber(t *testing.T) {
 		})
 	}
 }
+
+func TestPhoneNumberLastFourDigits(t *testing.T) {
+	t.Parallel()
+
+	got, err := domain.NewPhoneNumber("0812-3456-789")
+	require.NoError(t, err)
+
+	assert.Equal(t, "6789", got.LastFourDigits())
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
 	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/modules/technician"
@@ -56,6 +57,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateTechnicianRequest{
@@ -73,6 +75,55 @@ func TestCreateTechnician(t *testing.T) {
 		assert.Equal(t, theStoreID, repo.createCalledWith.storeID)
 	})
 
+	t.Run("records creation in audit log", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+
+		s := technician.NewService(
+			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		req := &genapi.CreateTechnicianRequest{
+			Name: "technician 1",
+		}
+
+		_, err := s.CreateTechnician(requestCtx, req)
+		require.NoError(t, err)
+
+		require.NotNil(t, repo.createCalledWith)
+		require.Len(t, auditLog.Changes, 1)
+
+		assert.Equal(t, audit.ActionTechnicianCreated, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypeTechnician, auditLog.Changes[0].EntityType)
+		assert.Equal(t, repo.createCalledWith.id, auditLog.Changes[0].EntityID)
+		assert.Equal(t, req, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns internal server error when audit log fails", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{storeID: theStoreID}
+		auditLog := testutil.NewAuditLogStub()
+		auditLog.SetError(errors.New("oh no"))
+
+		s := technician.NewService(
+			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		_, err := s.CreateTechnician(requestCtx, &genapi.CreateTechnicianRequest{
+			Name: "technician 1",
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+
 	t.Run("returns resource location when technician is created", func(t *testing.T) {
 		t.Parallel()
 
@@ -87,7 +138,7 @@ func TestCreateTechnician(t *testing.T) {
 		resourceLocationProvider := testutil.NewResourceLocationProviderStubForTechnician(theLocation)
 		repo := &repositoryStub{storeID: theStoreID}
 
-		s := technician.NewService(resourceLocationProvider, qualifyingPermissionProvider, repo)
+		s := technician.NewService(resourceLocationProvider, qualifyingPermissionProvider, repo, testutil.NewAuditLogStub())
 
 		got, err := s.CreateTechnician(requestCtx, &genapi.CreateTechnicianRequest{
 			Name: "technician 1",
@@ -111,6 +162,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		emptyCtx := testutil.RequestContextWithLogger(context.Background())
@@ -128,6 +180,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
 			&repositoryStub{},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateTechnician(requestCtx, &genapi.CreateTechnicianRequest{
@@ -144,6 +197,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateTechnician(requestCtx, &genapi.CreateTechnicianRequest{
@@ -165,6 +219,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			qualifyingPermissionProvider,
 			repo,
+			testutil.NewAuditLogStub(),
 		)
 
 		req := &genapi.CreateTechnicianRequest{
@@ -182,6 +237,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{nameTakenErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateTechnician(requestCtx, &genapi.CreateTechnicianRequest{
@@ -198,6 +254,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			qualifyingPermissionProvider,
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateTechnician(requestCtx, &genapi.CreateTechnicianRequest{
@@ -214,6 +271,7 @@ func TestCreateTechnician(t *testing.T) {
 			testutil.NewResourceLocationProviderStubForTechnician(url.URL{}),
 			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, errors.New("oh no!")),
 			&repositoryStub{createErr: errors.New("oh no!"), storeID: theStoreID},
+			testutil.NewAuditLogStub(),
 		)
 
 		_, err := s.CreateTechnician(requestCtx, &genapi.CreateTechnicianRequest{
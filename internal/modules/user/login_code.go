@@ -0,0 +1,25 @@
+package user
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+// LoginCode is the second factor store employees enter after their password.
+// Single-use codes are deleted once used; time-boxed codes can be reused
+// until they expire.
+type LoginCode struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	Code         string
+	SingleUse    bool
+	ExpiryTime   optional.Optional[time.Time]
+	IssuedBy     optional.Optional[uuid.UUID]
+	CreationTime time.Time
+}
+
+type LoginCodeGenerator interface {
+	Generate() (string, error)
+}
@@ -2,18 +2,92 @@ package user
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/apierror"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
 	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
 	"github.com/rs/zerolog"
 )
 
-type Service struct{}
+type Repository interface {
+	GetActiveUserSessions(ctx context.Context, userID uuid.UUID, now time.Time) ([]Session, error)
+	RevokeUserSession(ctx context.Context, userID uuid.UUID, sessionID uuid.UUID) error
+	RevokeOtherUserSessions(ctx context.Context, userID uuid.UUID, keepSessionID uuid.UUID) (int64, error)
+	RevokeAllUserSessions(ctx context.Context, userID uuid.UUID) (int64, error)
+	IsUserInStore(ctx context.Context, storeID uuid.UUID, userID uuid.UUID) (bool, error)
+}
+
+type LoginCodeRepository interface {
+	CreateLoginCode(ctx context.Context, code LoginCode) error
+	GetActiveLoginCodes(ctx context.Context, userID uuid.UUID, now time.Time) ([]LoginCode, error)
+	DeleteLoginCode(ctx context.Context, userID uuid.UUID, loginCodeID uuid.UUID) error
+}
+
+type TOTPRepository interface {
+	GetUserTOTP(ctx context.Context, userID uuid.UUID) (TOTP, error)
+	CreatePendingTOTP(ctx context.Context, userID uuid.UUID, secret string, now time.Time) error
+	ConfirmTOTP(ctx context.Context, userID uuid.UUID, usedStep int64, now time.Time, recoveryCodes []string) error
+	DeleteTOTP(ctx context.Context, userID uuid.UUID) error
+	UseRecoveryCode(ctx context.Context, userID uuid.UUID, recoveryCode string, now time.Time) error
+	CountUnusedRecoveryCodes(ctx context.Context, userID uuid.UUID) (int64, error)
+}
+
+type LoginThrottleRepository interface {
+	UnlockUserLogin(ctx context.Context, userID uuid.UUID) error
+}
 
-func NewService() *Service {
-	return &Service{}
+type AuditRecorder interface {
+	Record(ctx context.Context, change audit.Change) error
+}
+
+const recoveryCodeCount = 10
+
+type Service struct {
+	timeProvider       TimeProvider
+	permissionProvider permission.Provider
+	repo               Repository
+	loginCodeRepo      LoginCodeRepository
+	loginCodeGenerator LoginCodeGenerator
+	totpRepo           TOTPRepository
+	totpProvider       TOTPProvider
+	recoveryCodeGen    RecoveryCodeGenerator
+	loginThrottleRepo  LoginThrottleRepository
+	auditRecorder      AuditRecorder
+}
+
+func NewService(
+	timeProvider TimeProvider,
+	permissionProvider permission.Provider,
+	repo Repository,
+	loginCodeRepo LoginCodeRepository,
+	loginCodeGenerator LoginCodeGenerator,
+	totpRepo TOTPRepository,
+	totpProvider TOTPProvider,
+	recoveryCodeGen RecoveryCodeGenerator,
+	loginThrottleRepo LoginThrottleRepository,
+	auditRecorder AuditRecorder,
+) *Service {
+	return &Service{
+		timeProvider:       timeProvider,
+		permissionProvider: permissionProvider,
+		repo:               repo,
+		loginCodeRepo:      loginCodeRepo,
+		loginCodeGenerator: loginCodeGenerator,
+		totpRepo:           totpRepo,
+		totpProvider:       totpProvider,
+		recoveryCodeGen:    recoveryCodeGen,
+		loginThrottleRepo:  loginThrottleRepo,
+		auditRecorder:      auditRecorder,
+	}
 }
 
 func (s *Service) GetMyUserDetails(ctx context.Context) (*genapi.UserDetails, error) {
@@ -40,3 +114,571 @@ func (s *Service) GetMyUserDetails(ctx context.Context) (*genapi.UserDetails, er
 		},
 	}, nil
 }
+
+func (s *Service) ListMySessions(ctx context.Context) (*genapi.UserSessionList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	currentSessionID, _ := appcontext.GetSessionIDFromContext(ctx)
+
+	sessions, err := s.repo.GetActiveUserSessions(ctx, user.ID, s.timeProvider.Now())
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get active user sessions")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get sessions")
+	}
+
+	items := make([]genapi.UserSession, 0, len(sessions))
+	for _, session := range sessions {
+		item := genapi.UserSession{
+			ID:           session.ID,
+			IsCurrent:    session.ID == currentSessionID,
+			CreationTime: session.CreationTime,
+			LastSeenTime: session.LastSeenTime,
+		}
+
+		if userAgent, ok := session.UserAgent.Get(); ok {
+			item.UserAgent = genapi.NewOptString(userAgent)
+		}
+
+		if ip, ok := session.IPAddress.Get(); ok {
+			item.IPAddress = genapi.NewOptString(ip)
+		}
+
+		items = append(items, item)
+	}
+
+	return &genapi.UserSessionList{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) RevokeMySession(ctx context.Context, params genapi.RevokeMySessionParams) error {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	err := s.repo.RevokeUserSession(ctx, user.ID, params.SessionId)
+	if errors.Is(err, apperror.ErrUserSessionNotFound) {
+		return apierror.ToAPIError(http.StatusNotFound, "session not found")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to revoke user session")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to revoke session")
+	}
+
+	l.Info().Str("session_id", params.SessionId.String()).Msg("user session revoked")
+	return nil
+}
+
+func (s *Service) RevokeMyOtherSessions(ctx context.Context) error {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	currentSessionID, ok := appcontext.GetSessionIDFromContext(ctx)
+	if !ok {
+		l.Error().Msg("session ID is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	revoked, err := s.repo.RevokeOtherUserSessions(ctx, user.ID, currentSessionID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to revoke other user sessions")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to revoke sessions")
+	}
+
+	l.Info().Int64("revoked", revoked).Msg("other user sessions revoked")
+	return nil
+}
+
+func (s *Service) RevokeUserSessions(ctx context.Context, params genapi.RevokeUserSessionsParams) error {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.RevokeUserSessions()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if params.UserId == user.ID {
+		return apierror.ToAPIError(http.StatusBadRequest, "use /users/me/sessions to manage your own sessions")
+	}
+
+	if ok, err := s.repo.IsUserInStore(ctx, user.Store.ID, params.UserId); err != nil {
+		l.Error().Err(err).Msg("failed to check if user is in store")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if user exists")
+	} else if !ok {
+		return apierror.ToAPIError(http.StatusNotFound, "user not found")
+	}
+
+	revoked, err := s.repo.RevokeAllUserSessions(ctx, params.UserId)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to revoke all user sessions")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to revoke sessions")
+	}
+
+	l.Info().
+		Str("target_user_id", params.UserId.String()).
+		Int64("revoked", revoked).
+		Msg("user sessions revoked by admin")
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionUserSessionsRevoked,
+		EntityType: audit.EntityTypeUser,
+		EntityID:   params.UserId,
+		After:      map[string]int64{"revoked_sessions": revoked},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) UnlockUserLogin(ctx context.Context, params genapi.UnlockUserLoginParams) error {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.UnlockUserLogin()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if ok, err := s.repo.IsUserInStore(ctx, user.Store.ID, params.UserId); err != nil {
+		l.Error().Err(err).Msg("failed to check if user is in store")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check if user exists")
+	} else if !ok {
+		return apierror.ToAPIError(http.StatusNotFound, "user not found")
+	}
+
+	err := s.loginThrottleRepo.UnlockUserLogin(ctx, params.UserId)
+	if errors.Is(err, apperror.ErrUserNotFound) {
+		return apierror.ToAPIError(http.StatusNotFound, "user not found")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to unlock user login")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to unlock login")
+	}
+
+	l.Info().Str("target_user_id", params.UserId.String()).Msg("user login unlocked by admin")
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionUserLoginUnlocked,
+		EntityType: audit.EntityTypeUser,
+		EntityID:   params.UserId,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) IssueLoginCode(
+	ctx context.Context,
+	req *genapi.IssueLoginCodeRequest,
+	params genapi.IssueLoginCodeParams,
+) (*genapi.IssuedLoginCode, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, err := s.authorizeLoginCodeManagement(ctx, params.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	now := s.timeProvider.Now()
+	code := LoginCode{
+		ID:           uuid.New(),
+		UserID:       params.UserId,
+		SingleUse:    req.Type == genapi.LoginCodeTypeSingleUse,
+		ExpiryTime:   optional.None[time.Time](),
+		IssuedBy:     optional.Some(user.ID),
+		CreationTime: now,
+	}
+
+	if validFor, ok := req.ValidForMinutes.Get(); ok {
+		code.ExpiryTime = optional.Some(now.Add(time.Duration(validFor) * time.Minute))
+	} else if !code.SingleUse {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "valid_for_minutes is required for time-boxed codes")
+	}
+
+	if code.Code, err = s.loginCodeGenerator.Generate(); err != nil {
+		l.Error().Err(err).Msg("failed to generate login code")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to generate login code")
+	}
+
+	if err = s.loginCodeRepo.CreateLoginCode(ctx, code); err != nil {
+		l.Error().Err(err).Msg("failed to create login code")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create login code")
+	}
+
+	l.Info().
+		Str("target_user_id", params.UserId.String()).
+		Str("login_code_id", code.ID.String()).
+		Msg("login code issued")
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionLoginCodeIssued,
+		EntityType: audit.EntityTypeUser,
+		EntityID:   params.UserId,
+		After:      loginCodeToAuditEntry(code),
+	}); err != nil {
+		return nil, err
+	}
+
+	issued := &genapi.IssuedLoginCode{
+		ID:           code.ID,
+		Code:         code.Code,
+		Type:         req.Type,
+		CreationTime: code.CreationTime,
+	}
+
+	if expiryTime, ok := code.ExpiryTime.Get(); ok {
+		issued.ExpiryTime = genapi.NewOptDateTime(expiryTime)
+	}
+
+	return issued, nil
+}
+
+func (s *Service) ListLoginCodes(
+	ctx context.Context,
+	params genapi.ListLoginCodesParams,
+) (*genapi.LoginCodeList, error) {
+	l := zerolog.Ctx(ctx)
+
+	if _, err := s.authorizeLoginCodeManagement(ctx, params.UserId); err != nil {
+		return nil, err
+	}
+
+	codes, err := s.loginCodeRepo.GetActiveLoginCodes(ctx, params.UserId, s.timeProvider.Now())
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get active login codes")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get login codes")
+	}
+
+	items := make([]genapi.LoginCode, 0, len(codes))
+	for _, code := range codes {
+		item := genapi.LoginCode{
+			ID:           code.ID,
+			Type:         genapi.LoginCodeTypeTimeBoxed,
+			CreationTime: code.CreationTime,
+		}
+
+		if code.SingleUse {
+			item.Type = genapi.LoginCodeTypeSingleUse
+		}
+
+		if expiryTime, ok := code.ExpiryTime.Get(); ok {
+			item.ExpiryTime = genapi.NewOptDateTime(expiryTime)
+		}
+
+		if issuedBy, ok := code.IssuedBy.Get(); ok {
+			item.IssuedBy = genapi.NewOptUUID(issuedBy)
+		}
+
+		items = append(items, item)
+	}
+
+	return &genapi.LoginCodeList{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) RevokeLoginCode(ctx context.Context, params genapi.RevokeLoginCodeParams) error {
+	l := zerolog.Ctx(ctx)
+
+	if _, err := s.authorizeLoginCodeManagement(ctx, params.UserId); err != nil {
+		return err
+	}
+
+	err := s.loginCodeRepo.DeleteLoginCode(ctx, params.UserId, params.LoginCodeId)
+	if errors.Is(err, apperror.ErrLoginCodeNotFound) {
+		return apierror.ToAPIError(http.StatusNotFound, "login code not found")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to delete login code")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to revoke login code")
+	}
+
+	l.Info().
+		Str("target_user_id", params.UserId.String()).
+		Str("login_code_id", params.LoginCodeId.String()).
+		Msg("login code revoked")
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionLoginCodeRevoked,
+		EntityType: audit.EntityTypeUser,
+		EntityID:   params.UserId,
+		Before:     map[string]string{"login_code_id": params.LoginCodeId.String()},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// authorizeLoginCodeManagement returns the current user if they can manage
+// the login codes of targetUserID.
+func (s *Service) authorizeLoginCodeManagement(
+	ctx context.Context,
+	targetUserID uuid.UUID,
+) (*readmodel.UserDetails, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ManageLoginCodes()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if ok, err := s.repo.IsUserInStore(ctx, user.Store.ID, targetUserID); err != nil {
+		l.Error().Err(err).Msg("failed to check if user is in store")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check if user exists")
+	} else if !ok {
+		return nil, apierror.ToAPIError(http.StatusNotFound, "user not found")
+	}
+
+	return user, nil
+}
+
+// loginCodeToAuditEntry leaves out the code itself so it can't be read back
+// from the audit log.
+func loginCodeToAuditEntry(code LoginCode) map[string]any {
+	entry := map[string]any{
+		"login_code_id": code.ID,
+		"single_use":    code.SingleUse,
+	}
+
+	if expiryTime, ok := code.ExpiryTime.Get(); ok {
+		entry["expiry_time"] = expiryTime
+	}
+
+	return entry
+}
+
+func (s *Service) GetMyTOTPStatus(ctx context.Context) (*genapi.TOTPStatus, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	totp, err := s.totpRepo.GetUserTOTP(ctx, user.ID)
+	if errors.Is(err, apperror.ErrTOTPNotFound) || (err == nil && !totp.ConfirmationTime.IsSet()) {
+		return &genapi.TOTPStatus{Enabled: false}, nil
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to get user TOTP")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get TOTP status")
+	}
+
+	remaining, err := s.totpRepo.CountUnusedRecoveryCodes(ctx, user.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to count unused recovery codes")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get TOTP status")
+	}
+
+	return &genapi.TOTPStatus{
+		Enabled:                true,
+		RemainingRecoveryCodes: genapi.NewOptInt(int(remaining)),
+	}, nil
+}
+
+func (s *Service) EnrollMyTOTP(ctx context.Context) (*genapi.TOTPEnrollment, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	secret, provisioningURI, err := s.totpProvider.Generate(user.Username + "@" + user.Store.Code)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to generate TOTP secret")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to generate TOTP secret")
+	}
+
+	err = s.totpRepo.CreatePendingTOTP(ctx, user.ID, secret, s.timeProvider.Now())
+	if errors.Is(err, apperror.ErrTOTPAlreadyEnabled) {
+		return nil, apierror.ToAPIError(http.StatusConflict, "TOTP is already enabled. disable it first to enroll again")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to create pending TOTP")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to start TOTP enrollment")
+	}
+
+	l.Info().Msg("TOTP enrollment started")
+
+	return &genapi.TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: provisioningURI,
+	}, nil
+}
+
+func (s *Service) ConfirmMyTOTP(
+	ctx context.Context,
+	req *genapi.ConfirmTOTPRequest,
+) (*genapi.TOTPRecoveryCodes, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	totp, err := s.totpRepo.GetUserTOTP(ctx, user.ID)
+	if errors.Is(err, apperror.ErrTOTPNotFound) {
+		return nil, apierror.ToAPIError(http.StatusNotFound, "no TOTP enrollment found. please call /users/me/totp first")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to get user TOTP")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get TOTP enrollment")
+	}
+
+	if totp.ConfirmationTime.IsSet() {
+		return nil, apierror.ToAPIError(http.StatusConflict, "TOTP is already enabled")
+	}
+
+	now := s.timeProvider.Now()
+
+	step, ok := s.totpProvider.Validate(totp.Secret, req.Code, now)
+	if !ok {
+		l.Info().Msg("wrong TOTP code when confirming enrollment")
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "wrong TOTP code")
+	}
+
+	recoveryCodes := make([]string, 0, recoveryCodeCount)
+	for range recoveryCodeCount {
+		code, genErr := s.recoveryCodeGen.Generate()
+		if genErr != nil {
+			l.Error().Err(genErr).Msg("failed to generate recovery code")
+			return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to generate recovery codes")
+		}
+
+		recoveryCodes = append(recoveryCodes, code)
+	}
+
+	err = s.totpRepo.ConfirmTOTP(ctx, user.ID, step, now, recoveryCodes)
+	if errors.Is(err, apperror.ErrTOTPNotFound) {
+		// Enrollment was confirmed or disabled by another request in the meantime.
+		return nil, apierror.ToAPIError(http.StatusConflict, "TOTP enrollment has changed. please try again")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to confirm TOTP")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to enable TOTP")
+	}
+
+	l.Info().Msg("TOTP enabled")
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionTOTPEnabled,
+		EntityType: audit.EntityTypeUser,
+		EntityID:   user.ID,
+		Before:     map[string]bool{"totp_enabled": false},
+		After:      map[string]bool{"totp_enabled": true},
+	}); err != nil {
+		return nil, err
+	}
+
+	return &genapi.TOTPRecoveryCodes{
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+// DisableMyTOTP needs a TOTP or recovery code once TOTP is enabled so that a
+// stolen session alone can't turn the second factor off. A pending enrollment
+// can be cancelled without one.
+func (s *Service) DisableMyTOTP(ctx context.Context, req *genapi.DisableTOTPRequest) error {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	totp, err := s.totpRepo.GetUserTOTP(ctx, user.ID)
+	if errors.Is(err, apperror.ErrTOTPNotFound) {
+		return apierror.ToAPIError(http.StatusNotFound, "TOTP is not enabled")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to get user TOTP")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to get TOTP enrollment")
+	}
+
+	if totp.ConfirmationTime.IsSet() {
+		if err = s.checkTOTPOrRecoveryCode(ctx, user.ID, totp.Secret, req.Code); err != nil {
+			return err
+		}
+	}
+
+	err = s.totpRepo.DeleteTOTP(ctx, user.ID)
+	if errors.Is(err, apperror.ErrTOTPNotFound) {
+		return apierror.ToAPIError(http.StatusNotFound, "TOTP is not enabled")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to delete TOTP")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to disable TOTP")
+	}
+
+	l.Info().Msg("TOTP disabled")
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionTOTPDisabled,
+		EntityType: audit.EntityTypeUser,
+		EntityID:   user.ID,
+		Before:     map[string]bool{"totp_enabled": true},
+		After:      map[string]bool{"totp_enabled": false},
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) checkTOTPOrRecoveryCode(ctx context.Context, userID uuid.UUID, secret string, code string) error {
+	l := zerolog.Ctx(ctx)
+	now := s.timeProvider.Now()
+
+	if _, ok := s.totpProvider.Validate(secret, code, now); ok {
+		return nil
+	}
+
+	err := s.totpRepo.UseRecoveryCode(ctx, userID, code, now)
+	if errors.Is(err, apperror.ErrLoginCodeMismatch) {
+		l.Info().Msg("wrong TOTP or recovery code when disabling TOTP")
+		return apierror.ToAPIError(http.StatusBadRequest, "wrong TOTP or recovery code")
+	} else if err != nil {
+		l.Error().Err(err).Msg("failed to use recovery code")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check recovery code")
+	}
+
+	return nil
+}
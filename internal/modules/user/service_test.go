@@ -5,14 +5,22 @@ package user_test
 
 import (
 	"context"
+	"errors"
 	"net/http"
+	"slices"
 	"testing"
+	"time"
 
 	"github.com/JosephJoshua/remana-backend/internal/appconstant"
 	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
 	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
 	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
 	"github.com/JosephJoshua/remana-backend/internal/modules/user"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
 	"github.com/JosephJoshua/remana-backend/internal/testutil"
 	"github.com/google/uuid"
 	"github.com/rs/zerolog"
@@ -20,6 +28,336 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
+type repositoryStub struct {
+	sessions       []user.Session
+	err            error
+	usersInStore   []uuid.UUID
+	revokedIDs     []uuid.UUID
+	keptSessionID  uuid.UUID
+	revokedUserIDs []uuid.UUID
+}
+
+func (r *repositoryStub) GetActiveUserSessions(
+	_ context.Context,
+	userID uuid.UUID,
+	_ time.Time,
+) ([]user.Session, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var sessions []user.Session
+	for _, session := range r.sessions {
+		if session.UserID == userID {
+			sessions = append(sessions, session)
+		}
+	}
+
+	return sessions, nil
+}
+
+func (r *repositoryStub) RevokeUserSession(_ context.Context, userID uuid.UUID, sessionID uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for _, session := range r.sessions {
+		if session.ID == sessionID && session.UserID == userID {
+			r.revokedIDs = append(r.revokedIDs, sessionID)
+			return nil
+		}
+	}
+
+	return apperror.ErrUserSessionNotFound
+}
+
+func (r *repositoryStub) RevokeOtherUserSessions(
+	_ context.Context,
+	userID uuid.UUID,
+	keepSessionID uuid.UUID,
+) (int64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	r.keptSessionID = keepSessionID
+	r.revokedUserIDs = append(r.revokedUserIDs, userID)
+
+	return 1, nil
+}
+
+func (r *repositoryStub) RevokeAllUserSessions(_ context.Context, userID uuid.UUID) (int64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	r.revokedUserIDs = append(r.revokedUserIDs, userID)
+	return 2, nil
+}
+
+func (r *repositoryStub) IsUserInStore(_ context.Context, _ uuid.UUID, userID uuid.UUID) (bool, error) {
+	if r.err != nil {
+		return false, r.err
+	}
+
+	for _, id := range r.usersInStore {
+		if id == userID {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+type loginCodeRepositoryStub struct {
+	codes     []user.LoginCode
+	err       error
+	createErr error
+	deleted   []uuid.UUID
+}
+
+func (r *loginCodeRepositoryStub) CreateLoginCode(_ context.Context, code user.LoginCode) error {
+	if r.createErr != nil {
+		return r.createErr
+	}
+
+	r.codes = append(r.codes, code)
+	return nil
+}
+
+func (r *loginCodeRepositoryStub) GetActiveLoginCodes(
+	_ context.Context,
+	userID uuid.UUID,
+	_ time.Time,
+) ([]user.LoginCode, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var codes []user.LoginCode
+	for _, code := range r.codes {
+		if code.UserID == userID {
+			codes = append(codes, code)
+		}
+	}
+
+	return codes, nil
+}
+
+func (r *loginCodeRepositoryStub) DeleteLoginCode(_ context.Context, userID uuid.UUID, loginCodeID uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	for _, code := range r.codes {
+		if code.ID == loginCodeID && code.UserID == userID {
+			r.deleted = append(r.deleted, loginCodeID)
+			return nil
+		}
+	}
+
+	return apperror.ErrLoginCodeNotFound
+}
+
+type loginCodeGeneratorStub struct {
+	code string
+}
+
+func (g loginCodeGeneratorStub) Generate() (string, error) {
+	return g.code, nil
+}
+
+func newService(
+	repo *repositoryStub,
+	permissionProvider permission.Provider,
+	auditLog user.AuditRecorder,
+) *user.Service {
+	return newLoginCodeService(
+		repo,
+		&loginCodeRepositoryStub{},
+		testutil.NewTimeProviderStub(time.Now()),
+		permissionProvider,
+		auditLog,
+	)
+}
+
+func newLoginCodeService(
+	repo *repositoryStub,
+	loginCodeRepo *loginCodeRepositoryStub,
+	timeProvider user.TimeProvider,
+	permissionProvider permission.Provider,
+	auditLog user.AuditRecorder,
+) *user.Service {
+	return user.NewService(
+		timeProvider,
+		permissionProvider,
+		repo,
+		loginCodeRepo,
+		loginCodeGeneratorStub{code: "K7QM2XTP"},
+		&totpRepositoryStub{},
+		totpProviderStub{},
+		loginCodeGeneratorStub{code: "AAAAA-BBBBB"},
+		&loginThrottleRepositoryStub{},
+		auditLog,
+	)
+}
+
+type loginThrottleRepositoryStub struct {
+	unlocked []uuid.UUID
+}
+
+func (r *loginThrottleRepositoryStub) UnlockUserLogin(_ context.Context, userID uuid.UUID) error {
+	r.unlocked = append(r.unlocked, userID)
+	return nil
+}
+
+func newUnlockService(
+	repo *repositoryStub,
+	loginThrottleRepo *loginThrottleRepositoryStub,
+	permissionProvider permission.Provider,
+	auditLog user.AuditRecorder,
+) *user.Service {
+	return user.NewService(
+		testutil.NewTimeProviderStub(time.Now()),
+		permissionProvider,
+		repo,
+		&loginCodeRepositoryStub{},
+		loginCodeGeneratorStub{code: "K7QM2XTP"},
+		&totpRepositoryStub{},
+		totpProviderStub{},
+		loginCodeGeneratorStub{code: "AAAAA-BBBBB"},
+		loginThrottleRepo,
+		auditLog,
+	)
+}
+
+type totpRepositoryStub struct {
+	totp          *user.TOTP
+	usedStep      int64
+	recoveryCodes []string
+	err           error
+}
+
+func (r *totpRepositoryStub) GetUserTOTP(_ context.Context, _ uuid.UUID) (user.TOTP, error) {
+	if r.err != nil {
+		return user.TOTP{}, r.err
+	}
+
+	if r.totp == nil {
+		return user.TOTP{}, apperror.ErrTOTPNotFound
+	}
+
+	return *r.totp, nil
+}
+
+func (r *totpRepositoryStub) CreatePendingTOTP(_ context.Context, _ uuid.UUID, secret string, now time.Time) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.totp != nil && r.totp.ConfirmationTime.IsSet() {
+		return apperror.ErrTOTPAlreadyEnabled
+	}
+
+	r.totp = &user.TOTP{Secret: secret, CreationTime: now, ConfirmationTime: optional.None[time.Time]()}
+	return nil
+}
+
+func (r *totpRepositoryStub) ConfirmTOTP(
+	_ context.Context,
+	_ uuid.UUID,
+	usedStep int64,
+	now time.Time,
+	recoveryCodes []string,
+) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.totp == nil || r.totp.ConfirmationTime.IsSet() {
+		return apperror.ErrTOTPNotFound
+	}
+
+	r.totp.ConfirmationTime = optional.Some(now)
+	r.usedStep = usedStep
+	r.recoveryCodes = recoveryCodes
+
+	return nil
+}
+
+func (r *totpRepositoryStub) DeleteTOTP(_ context.Context, _ uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if r.totp == nil {
+		return apperror.ErrTOTPNotFound
+	}
+
+	r.totp = nil
+	r.recoveryCodes = nil
+
+	return nil
+}
+
+func (r *totpRepositoryStub) UseRecoveryCode(_ context.Context, _ uuid.UUID, recoveryCode string, _ time.Time) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	i := slices.Index(r.recoveryCodes, recoveryCode)
+	if i == -1 {
+		return apperror.ErrLoginCodeMismatch
+	}
+
+	r.recoveryCodes = slices.Delete(r.recoveryCodes, i, i+1)
+	return nil
+}
+
+func (r *totpRepositoryStub) CountUnusedRecoveryCodes(_ context.Context, _ uuid.UUID) (int64, error) {
+	if r.err != nil {
+		return 0, r.err
+	}
+
+	return int64(len(r.recoveryCodes)), nil
+}
+
+type totpProviderStub struct {
+	code string
+	step int64
+}
+
+func (p totpProviderStub) Generate(accountName string) (string, string, error) {
+	return "JBSWY3DPEHPK3PXP", "otpauth://totp/Remana:" + accountName + "?secret=JBSWY3DPEHPK3PXP", nil
+}
+
+func (p totpProviderStub) Validate(_ string, code string, _ time.Time) (int64, bool) {
+	if p.code == "" || p.code != code {
+		return 0, false
+	}
+
+	return p.step, true
+}
+
+func newTOTPService(
+	totpRepo *totpRepositoryStub,
+	totpProvider totpProviderStub,
+	auditLog user.AuditRecorder,
+) *user.Service {
+	return user.NewService(
+		testutil.NewTimeProviderStub(time.Unix(1713917762, 0)),
+		testutil.NewPermissionProviderStub(uuid.New(), nil, nil),
+		&repositoryStub{},
+		&loginCodeRepositoryStub{},
+		loginCodeGeneratorStub{code: "K7QM2XTP"},
+		totpRepo,
+		totpProvider,
+		loginCodeGeneratorStub{code: "AAAAA-BBBBB"},
+		&loginThrottleRepositoryStub{},
+		auditLog,
+	)
+}
+
 func TestGetMyUserDetails(t *testing.T) {
 	t.Parallel()
 
@@ -29,7 +367,11 @@ func TestGetMyUserDetails(t *testing.T) {
 	t.Run("returns internal server error if user is missing from context", func(t *testing.T) {
 		t.Parallel()
 
-		s := user.NewService()
+		s := newService(
+			&repositoryStub{},
+			testutil.NewPermissionProviderStub(uuid.New(), nil, nil),
+			testutil.NewAuditLogStub(),
+		)
 		_, err := s.GetMyUserDetails(requestCtx)
 
 		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
@@ -38,7 +380,11 @@ func TestGetMyUserDetails(t *testing.T) {
 	t.Run("returns user details", func(t *testing.T) {
 		t.Parallel()
 
-		s := user.NewService()
+		s := newService(
+			&repositoryStub{},
+			testutil.NewPermissionProviderStub(uuid.New(), nil, nil),
+			testutil.NewAuditLogStub(),
+		)
 
 		user := readmodel.UserDetails{
 			ID:       uuid.New(),
@@ -71,3 +417,780 @@ func TestGetMyUserDetails(t *testing.T) {
 		assert.Equal(t, user.Store.Code, got.Store.Code)
 	})
 }
+
+func TestListMySessions(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	var (
+		theUser             = testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+		theCurrentSessionID = uuid.New()
+		theOtherSessionID   = uuid.New()
+		theTime             = time.Unix(1713917762, 0)
+	)
+
+	sessions := []user.Session{
+		{
+			ID:           theCurrentSessionID,
+			UserID:       theUser.ID,
+			UserAgent:    optional.Some("Firefox"),
+			IPAddress:    optional.Some("203.0.113.7"),
+			CreationTime: theTime,
+			LastSeenTime: theTime,
+		},
+		{
+			ID:           theOtherSessionID,
+			UserID:       theUser.ID,
+			UserAgent:    optional.None[string](),
+			IPAddress:    optional.None[string](),
+			CreationTime: theTime,
+			LastSeenTime: theTime,
+		},
+		{
+			ID:     uuid.New(),
+			UserID: uuid.New(),
+		},
+	}
+
+	t.Run("lists the sessions of the user and marks the current one", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{sessions: sessions},
+			testutil.NewPermissionProviderStub(theUser.Role.ID, nil, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		ctx := appcontext.NewContextWithUser(requestCtx, theUser)
+		ctx = appcontext.NewContextWithSessionID(ctx, theCurrentSessionID)
+
+		got, err := s.ListMySessions(ctx)
+		require.NoError(t, err)
+		require.Len(t, got.Items, 2)
+
+		assert.Equal(t, theCurrentSessionID, got.Items[0].ID)
+		assert.True(t, got.Items[0].IsCurrent)
+		assert.Equal(t, genapi.NewOptString("Firefox"), got.Items[0].UserAgent)
+		assert.Equal(t, genapi.NewOptString("203.0.113.7"), got.Items[0].IPAddress)
+
+		assert.Equal(t, theOtherSessionID, got.Items[1].ID)
+		assert.False(t, got.Items[1].IsCurrent)
+		assert.False(t, got.Items[1].UserAgent.IsSet())
+	})
+
+	t.Run("returns internal server error when repo fails", func(t *testing.T) {
+		t.Parallel()
+
+		s := newService(
+			&repositoryStub{err: errors.New("oh no")},
+			testutil.NewPermissionProviderStub(theUser.Role.ID, nil, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ListMySessions(appcontext.NewContextWithUser(requestCtx, theUser))
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestRevokeMySessions(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	var (
+		theUser             = testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+		theCurrentSessionID = uuid.New()
+		theSession          = user.Session{ID: uuid.New(), UserID: theUser.ID}
+		theOtherUserSession = user.Session{ID: uuid.New(), UserID: uuid.New()}
+	)
+
+	newContext := func() context.Context {
+		ctx := appcontext.NewContextWithUser(requestCtx, theUser)
+		return appcontext.NewContextWithSessionID(ctx, theCurrentSessionID)
+	}
+
+	t.Run("revokes a session of the user", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{sessions: []user.Session{theSession}}
+		s := newService(repo, testutil.NewPermissionProviderStub(theUser.Role.ID, nil, nil), testutil.NewAuditLogStub())
+
+		err := s.RevokeMySession(newContext(), genapi.RevokeMySessionParams{SessionId: theSession.ID})
+		require.NoError(t, err)
+
+		assert.Equal(t, []uuid.UUID{theSession.ID}, repo.revokedIDs)
+	})
+
+	t.Run("returns not found for sessions of other users", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{sessions: []user.Session{theOtherUserSession}}
+		s := newService(repo, testutil.NewPermissionProviderStub(theUser.Role.ID, nil, nil), testutil.NewAuditLogStub())
+
+		err := s.RevokeMySession(newContext(), genapi.RevokeMySessionParams{SessionId: theOtherUserSession.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+		assert.Empty(t, repo.revokedIDs)
+	})
+
+	t.Run("revokes other sessions but keeps the current one", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{}
+		s := newService(repo, testutil.NewPermissionProviderStub(theUser.Role.ID, nil, nil), testutil.NewAuditLogStub())
+
+		require.NoError(t, s.RevokeMyOtherSessions(newContext()))
+
+		assert.Equal(t, theCurrentSessionID, repo.keptSessionID)
+		assert.Equal(t, []uuid.UUID{theUser.ID}, repo.revokedUserIDs)
+	})
+}
+
+func TestRevokeUserSessions(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	var (
+		theAdmin      = testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+		theEmployeeID = uuid.New()
+	)
+
+	ctx := appcontext.NewContextWithUser(requestCtx, theAdmin)
+
+	t.Run("returns forbidden without permission", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}}
+		s := newService(repo, testutil.NewPermissionProviderStub(theAdmin.Role.ID, nil, nil), testutil.NewAuditLogStub())
+
+		err := s.RevokeUserSessions(ctx, genapi.RevokeUserSessionsParams{UserId: theEmployeeID})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+		assert.Empty(t, repo.revokedUserIDs)
+	})
+
+	t.Run("returns bad request for the user's own sessions", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{usersInStore: []uuid.UUID{theAdmin.ID}}
+		s := newService(
+			repo,
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, []permission.Permission{permission.RevokeUserSessions()}, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		err := s.RevokeUserSessions(ctx, genapi.RevokeUserSessionsParams{UserId: theAdmin.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+	})
+
+	t.Run("returns not found for users outside the store", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{}
+		s := newService(
+			repo,
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, []permission.Permission{permission.RevokeUserSessions()}, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		err := s.RevokeUserSessions(ctx, genapi.RevokeUserSessionsParams{UserId: theEmployeeID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+		assert.Empty(t, repo.revokedUserIDs)
+	})
+
+	t.Run("revokes all sessions of the user and records it", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}}
+		auditLog := testutil.NewAuditLogStub()
+		s := newService(
+			repo,
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, []permission.Permission{permission.RevokeUserSessions()}, nil),
+			auditLog,
+		)
+
+		err := s.RevokeUserSessions(ctx, genapi.RevokeUserSessionsParams{UserId: theEmployeeID})
+		require.NoError(t, err)
+
+		assert.Equal(t, []uuid.UUID{theEmployeeID}, repo.revokedUserIDs)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionUserSessionsRevoked, auditLog.Changes[0].Action)
+		assert.Equal(t, audit.EntityTypeUser, auditLog.Changes[0].EntityType)
+		assert.Equal(t, theEmployeeID, auditLog.Changes[0].EntityID)
+	})
+}
+
+func TestIssueLoginCode(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	var (
+		theAdmin      = testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+		theEmployeeID = uuid.New()
+		theTime       = time.Unix(1713917762, 0)
+		thePerms      = []permission.Permission{permission.ManageLoginCodes()}
+	)
+
+	ctx := appcontext.NewContextWithUser(requestCtx, theAdmin)
+
+	testCases := []struct {
+		name              string
+		req               genapi.IssueLoginCodeRequest
+		userID            uuid.UUID
+		permissions       []permission.Permission
+		wantStatus        int
+		wantSingleUse     bool
+		wantExpiry        optional.Optional[time.Time]
+		wantAuditedChange bool
+	}{
+		{
+			name:        "returns forbidden without permission",
+			req:         genapi.IssueLoginCodeRequest{Type: genapi.LoginCodeTypeSingleUse},
+			userID:      theEmployeeID,
+			permissions: nil,
+			wantStatus:  http.StatusForbidden,
+		},
+		{
+			name:        "returns not found for users outside the store",
+			req:         genapi.IssueLoginCodeRequest{Type: genapi.LoginCodeTypeSingleUse},
+			userID:      uuid.New(),
+			permissions: thePerms,
+			wantStatus:  http.StatusNotFound,
+		},
+		{
+			name:        "returns bad request for time-boxed codes without validity",
+			req:         genapi.IssueLoginCodeRequest{Type: genapi.LoginCodeTypeTimeBoxed},
+			userID:      theEmployeeID,
+			permissions: thePerms,
+			wantStatus:  http.StatusBadRequest,
+		},
+		{
+			name:              "issues a single-use code that doesn't expire",
+			req:               genapi.IssueLoginCodeRequest{Type: genapi.LoginCodeTypeSingleUse},
+			userID:            theEmployeeID,
+			permissions:       thePerms,
+			wantSingleUse:     true,
+			wantExpiry:        optional.None[time.Time](),
+			wantAuditedChange: true,
+		},
+		{
+			name: "issues a time-boxed code",
+			req: genapi.IssueLoginCodeRequest{
+				Type:            genapi.LoginCodeTypeTimeBoxed,
+				ValidForMinutes: genapi.NewOptInt(480),
+			},
+			userID:            theEmployeeID,
+			permissions:       thePerms,
+			wantSingleUse:     false,
+			wantExpiry:        optional.Some(theTime.Add(8 * time.Hour)),
+			wantAuditedChange: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			loginCodeRepo := &loginCodeRepositoryStub{}
+			auditLog := testutil.NewAuditLogStub()
+			s := newLoginCodeService(
+				&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+				loginCodeRepo,
+				testutil.NewTimeProviderStub(theTime),
+				testutil.NewPermissionProviderStub(theAdmin.Role.ID, tc.permissions, nil),
+				auditLog,
+			)
+
+			got, err := s.IssueLoginCode(ctx, &tc.req, genapi.IssueLoginCodeParams{UserId: tc.userID})
+
+			if tc.wantStatus != 0 {
+				testutil.AssertAPIStatusCode(t, tc.wantStatus, err)
+				assert.Empty(t, loginCodeRepo.codes)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Len(t, loginCodeRepo.codes, 1)
+
+			created := loginCodeRepo.codes[0]
+			assert.Equal(t, tc.userID, created.UserID)
+			assert.Equal(t, "K7QM2XTP", created.Code)
+			assert.Equal(t, tc.wantSingleUse, created.SingleUse)
+			assert.Equal(t, tc.wantExpiry, created.ExpiryTime)
+			assert.Equal(t, optional.Some(theAdmin.ID), created.IssuedBy)
+			assert.Equal(t, theTime, created.CreationTime)
+
+			assert.Equal(t, created.ID, got.ID)
+			assert.Equal(t, "K7QM2XTP", got.Code)
+			assert.Equal(t, tc.req.Type, got.Type)
+			assert.Equal(t, tc.wantExpiry.IsSet(), got.ExpiryTime.IsSet())
+
+			require.Len(t, auditLog.Changes, 1)
+			assert.Equal(t, audit.ActionLoginCodeIssued, auditLog.Changes[0].Action)
+			assert.Equal(t, tc.userID, auditLog.Changes[0].EntityID)
+			assert.NotContains(t, auditLog.Changes[0].After, "code")
+		})
+	}
+}
+
+func TestListLoginCodes(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	var (
+		theAdmin      = testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+		theEmployeeID = uuid.New()
+		theTime       = time.Unix(1713917762, 0)
+		thePerms      = []permission.Permission{permission.ManageLoginCodes()}
+	)
+
+	ctx := appcontext.NewContextWithUser(requestCtx, theAdmin)
+
+	codes := []user.LoginCode{
+		{
+			ID:           uuid.New(),
+			UserID:       theEmployeeID,
+			Code:         "AAAAAAAA",
+			SingleUse:    true,
+			ExpiryTime:   optional.None[time.Time](),
+			IssuedBy:     optional.Some(theAdmin.ID),
+			CreationTime: theTime,
+		},
+		{
+			ID:           uuid.New(),
+			UserID:       theEmployeeID,
+			Code:         "BBBBBBBB",
+			SingleUse:    false,
+			ExpiryTime:   optional.Some(theTime.Add(time.Hour)),
+			IssuedBy:     optional.None[uuid.UUID](),
+			CreationTime: theTime,
+		},
+	}
+
+	t.Run("lists the active codes of the user", func(t *testing.T) {
+		t.Parallel()
+
+		s := newLoginCodeService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			&loginCodeRepositoryStub{codes: codes},
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, thePerms, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.ListLoginCodes(ctx, genapi.ListLoginCodesParams{UserId: theEmployeeID})
+		require.NoError(t, err)
+		require.Len(t, got.Items, 2)
+
+		assert.Equal(t, codes[0].ID, got.Items[0].ID)
+		assert.Equal(t, genapi.LoginCodeTypeSingleUse, got.Items[0].Type)
+		assert.False(t, got.Items[0].ExpiryTime.IsSet())
+		assert.Equal(t, genapi.NewOptUUID(theAdmin.ID), got.Items[0].IssuedBy)
+
+		assert.Equal(t, codes[1].ID, got.Items[1].ID)
+		assert.Equal(t, genapi.LoginCodeTypeTimeBoxed, got.Items[1].Type)
+		assert.Equal(t, genapi.NewOptDateTime(theTime.Add(time.Hour)), got.Items[1].ExpiryTime)
+		assert.False(t, got.Items[1].IssuedBy.IsSet())
+	})
+
+	t.Run("returns forbidden without permission", func(t *testing.T) {
+		t.Parallel()
+
+		s := newLoginCodeService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			&loginCodeRepositoryStub{codes: codes},
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, nil, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ListLoginCodes(ctx, genapi.ListLoginCodesParams{UserId: theEmployeeID})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error when repo fails", func(t *testing.T) {
+		t.Parallel()
+
+		s := newLoginCodeService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			&loginCodeRepositoryStub{err: errors.New("oh no")},
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, thePerms, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ListLoginCodes(ctx, genapi.ListLoginCodesParams{UserId: theEmployeeID})
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestRevokeLoginCode(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	var (
+		theAdmin      = testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+		theEmployeeID = uuid.New()
+		theCode       = user.LoginCode{ID: uuid.New(), UserID: theEmployeeID}
+		thePerms      = []permission.Permission{permission.ManageLoginCodes()}
+	)
+
+	ctx := appcontext.NewContextWithUser(requestCtx, theAdmin)
+
+	t.Run("revokes the code and records it", func(t *testing.T) {
+		t.Parallel()
+
+		loginCodeRepo := &loginCodeRepositoryStub{codes: []user.LoginCode{theCode}}
+		auditLog := testutil.NewAuditLogStub()
+		s := newLoginCodeService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			loginCodeRepo,
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, thePerms, nil),
+			auditLog,
+		)
+
+		err := s.RevokeLoginCode(ctx, genapi.RevokeLoginCodeParams{UserId: theEmployeeID, LoginCodeId: theCode.ID})
+		require.NoError(t, err)
+
+		assert.Equal(t, []uuid.UUID{theCode.ID}, loginCodeRepo.deleted)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionLoginCodeRevoked, auditLog.Changes[0].Action)
+		assert.Equal(t, theEmployeeID, auditLog.Changes[0].EntityID)
+	})
+
+	t.Run("returns not found for unknown codes", func(t *testing.T) {
+		t.Parallel()
+
+		loginCodeRepo := &loginCodeRepositoryStub{codes: []user.LoginCode{theCode}}
+		s := newLoginCodeService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			loginCodeRepo,
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, thePerms, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		err := s.RevokeLoginCode(ctx, genapi.RevokeLoginCodeParams{UserId: theEmployeeID, LoginCodeId: uuid.New()})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+		assert.Empty(t, loginCodeRepo.deleted)
+	})
+
+	t.Run("returns forbidden without permission", func(t *testing.T) {
+		t.Parallel()
+
+		loginCodeRepo := &loginCodeRepositoryStub{codes: []user.LoginCode{theCode}}
+		s := newLoginCodeService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			loginCodeRepo,
+			testutil.NewTimeProviderStub(time.Now()),
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, nil, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		err := s.RevokeLoginCode(ctx, genapi.RevokeLoginCodeParams{UserId: theEmployeeID, LoginCodeId: theCode.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+		assert.Empty(t, loginCodeRepo.deleted)
+	})
+}
+
+func TestEnrollMyTOTP(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	theUser := testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+	ctx := appcontext.NewContextWithUser(requestCtx, theUser)
+
+	t.Run("returns the secret and provisioning URI", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{}
+		s := newTOTPService(totpRepo, totpProviderStub{}, testutil.NewAuditLogStub())
+
+		got, err := s.EnrollMyTOTP(ctx)
+		require.NoError(t, err)
+
+		assert.Equal(t, "JBSWY3DPEHPK3PXP", got.Secret)
+		assert.Contains(t, got.ProvisioningURI, theUser.Username+"@"+theUser.Store.Code)
+
+		require.NotNil(t, totpRepo.totp)
+		assert.Equal(t, "JBSWY3DPEHPK3PXP", totpRepo.totp.Secret)
+		assert.False(t, totpRepo.totp.ConfirmationTime.IsSet())
+	})
+
+	t.Run("returns conflict when TOTP is already enabled", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp: &user.TOTP{Secret: "OLDSECRET", ConfirmationTime: optional.Some(time.Now())},
+		}
+		s := newTOTPService(totpRepo, totpProviderStub{}, testutil.NewAuditLogStub())
+
+		_, err := s.EnrollMyTOTP(ctx)
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+		assert.Equal(t, "OLDSECRET", totpRepo.totp.Secret)
+	})
+}
+
+func TestConfirmMyTOTP(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	theUser := testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+	ctx := appcontext.NewContextWithUser(requestCtx, theUser)
+
+	const (
+		theCode = "123456"
+		theStep = int64(57130592)
+	)
+
+	pending := func() *user.TOTP {
+		return &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.None[time.Time]()}
+	}
+
+	t.Run("enables TOTP and returns recovery codes", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{totp: pending()}
+		auditLog := testutil.NewAuditLogStub()
+		s := newTOTPService(totpRepo, totpProviderStub{code: theCode, step: theStep}, auditLog)
+
+		got, err := s.ConfirmMyTOTP(ctx, &genapi.ConfirmTOTPRequest{Code: theCode})
+		require.NoError(t, err)
+
+		assert.Len(t, got.RecoveryCodes, 10)
+		assert.Equal(t, got.RecoveryCodes, totpRepo.recoveryCodes)
+		assert.Equal(t, theStep, totpRepo.usedStep)
+		assert.True(t, totpRepo.totp.ConfirmationTime.IsSet())
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionTOTPEnabled, auditLog.Changes[0].Action)
+		assert.Equal(t, theUser.ID, auditLog.Changes[0].EntityID)
+	})
+
+	t.Run("returns bad request when code is wrong", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{totp: pending()}
+		s := newTOTPService(totpRepo, totpProviderStub{code: theCode, step: theStep}, testutil.NewAuditLogStub())
+
+		_, err := s.ConfirmMyTOTP(ctx, &genapi.ConfirmTOTPRequest{Code: "654321"})
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+		assert.False(t, totpRepo.totp.ConfirmationTime.IsSet())
+	})
+
+	t.Run("returns not found when enrollment hasn't been started", func(t *testing.T) {
+		t.Parallel()
+
+		s := newTOTPService(&totpRepositoryStub{}, totpProviderStub{code: theCode}, testutil.NewAuditLogStub())
+
+		_, err := s.ConfirmMyTOTP(ctx, &genapi.ConfirmTOTPRequest{Code: theCode})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns conflict when TOTP is already enabled", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp: &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.Some(time.Now())},
+		}
+		s := newTOTPService(totpRepo, totpProviderStub{code: theCode}, testutil.NewAuditLogStub())
+
+		_, err := s.ConfirmMyTOTP(ctx, &genapi.ConfirmTOTPRequest{Code: theCode})
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+}
+
+func TestMyTOTPStatusAndDisable(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	theUser := testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+	ctx := appcontext.NewContextWithUser(requestCtx, theUser)
+
+	t.Run("reports TOTP as disabled until confirmed", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp: &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.None[time.Time]()},
+		}
+		s := newTOTPService(totpRepo, totpProviderStub{}, testutil.NewAuditLogStub())
+
+		got, err := s.GetMyTOTPStatus(ctx)
+		require.NoError(t, err)
+
+		assert.False(t, got.Enabled)
+		assert.False(t, got.RemainingRecoveryCodes.IsSet())
+	})
+
+	t.Run("reports TOTP as enabled with remaining recovery codes", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp:          &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.Some(time.Now())},
+			recoveryCodes: []string{"AAAAA-BBBBB", "CCCCC-DDDDD"},
+		}
+		s := newTOTPService(totpRepo, totpProviderStub{}, testutil.NewAuditLogStub())
+
+		got, err := s.GetMyTOTPStatus(ctx)
+		require.NoError(t, err)
+
+		assert.True(t, got.Enabled)
+		assert.Equal(t, genapi.NewOptInt(2), got.RemainingRecoveryCodes)
+	})
+
+	t.Run("disables TOTP with a TOTP code and records it", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp: &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.Some(time.Now())},
+		}
+		auditLog := testutil.NewAuditLogStub()
+		s := newTOTPService(totpRepo, totpProviderStub{code: "123456"}, auditLog)
+
+		require.NoError(t, s.DisableMyTOTP(ctx, &genapi.DisableTOTPRequest{Code: "123456"}))
+		assert.Nil(t, totpRepo.totp)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionTOTPDisabled, auditLog.Changes[0].Action)
+	})
+
+	t.Run("disables TOTP with a recovery code", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp:          &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.Some(time.Now())},
+			recoveryCodes: []string{"AAAAA-BBBBB"},
+		}
+		s := newTOTPService(totpRepo, totpProviderStub{code: "123456"}, testutil.NewAuditLogStub())
+
+		require.NoError(t, s.DisableMyTOTP(ctx, &genapi.DisableTOTPRequest{Code: "AAAAA-BBBBB"}))
+		assert.Nil(t, totpRepo.totp)
+	})
+
+	t.Run("returns bad request when code is wrong", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp:          &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.Some(time.Now())},
+			recoveryCodes: []string{"AAAAA-BBBBB"},
+		}
+		auditLog := testutil.NewAuditLogStub()
+		s := newTOTPService(totpRepo, totpProviderStub{code: "123456"}, auditLog)
+
+		err := s.DisableMyTOTP(ctx, &genapi.DisableTOTPRequest{Code: "654321"})
+		testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+
+		assert.NotNil(t, totpRepo.totp)
+		assert.Len(t, totpRepo.recoveryCodes, 1)
+		assert.Empty(t, auditLog.Changes)
+	})
+
+	t.Run("cancels a pending enrollment without a code", func(t *testing.T) {
+		t.Parallel()
+
+		totpRepo := &totpRepositoryStub{
+			totp: &user.TOTP{Secret: "JBSWY3DPEHPK3PXP", ConfirmationTime: optional.None[time.Time]()},
+		}
+		s := newTOTPService(totpRepo, totpProviderStub{code: "123456"}, testutil.NewAuditLogStub())
+
+		require.NoError(t, s.DisableMyTOTP(ctx, &genapi.DisableTOTPRequest{Code: "000000"}))
+		assert.Nil(t, totpRepo.totp)
+	})
+
+	t.Run("returns not found when TOTP is not enabled", func(t *testing.T) {
+		t.Parallel()
+
+		s := newTOTPService(&totpRepositoryStub{}, totpProviderStub{}, testutil.NewAuditLogStub())
+
+		err := s.DisableMyTOTP(ctx, &genapi.DisableTOTPRequest{Code: "123456"})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+}
+
+func TestUnlockUserLogin(t *testing.T) {
+	t.Parallel()
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := testutil.RequestContextWithLogger(context.Background())
+
+	var (
+		theAdmin      = testutil.ModifiedUserDetails(func(_ *readmodel.UserDetails) {})
+		theEmployeeID = uuid.New()
+		thePerms      = []permission.Permission{permission.UnlockUserLogin()}
+	)
+
+	ctx := appcontext.NewContextWithUser(requestCtx, theAdmin)
+
+	t.Run("unlocks the user and records it", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := &loginThrottleRepositoryStub{}
+		auditLog := testutil.NewAuditLogStub()
+		s := newUnlockService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			throttleRepo,
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, thePerms, nil),
+			auditLog,
+		)
+
+		err := s.UnlockUserLogin(ctx, genapi.UnlockUserLoginParams{UserId: theEmployeeID})
+		require.NoError(t, err)
+
+		assert.Equal(t, []uuid.UUID{theEmployeeID}, throttleRepo.unlocked)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionUserLoginUnlocked, auditLog.Changes[0].Action)
+		assert.Equal(t, theEmployeeID, auditLog.Changes[0].EntityID)
+	})
+
+	t.Run("returns not found for users in other stores", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := &loginThrottleRepositoryStub{}
+		s := newUnlockService(
+			&repositoryStub{},
+			throttleRepo,
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, thePerms, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		err := s.UnlockUserLogin(ctx, genapi.UnlockUserLoginParams{UserId: theEmployeeID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+		assert.Empty(t, throttleRepo.unlocked)
+	})
+
+	t.Run("returns forbidden without permission", func(t *testing.T) {
+		t.Parallel()
+
+		throttleRepo := &loginThrottleRepositoryStub{}
+		s := newUnlockService(
+			&repositoryStub{usersInStore: []uuid.UUID{theEmployeeID}},
+			throttleRepo,
+			testutil.NewPermissionProviderStub(theAdmin.Role.ID, nil, nil),
+			testutil.NewAuditLogStub(),
+		)
+
+		err := s.UnlockUserLogin(ctx, genapi.UnlockUserLoginParams{UserId: theEmployeeID})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+		assert.Empty(t, throttleRepo.unlocked)
+	})
+}
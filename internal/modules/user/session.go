@@ -0,0 +1,22 @@
+package user
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+// Session is a logged-in device of a user.
+type Session struct {
+	ID           uuid.UUID
+	UserID       uuid.UUID
+	UserAgent    optional.Optional[string]
+	IPAddress    optional.Optional[string]
+	CreationTime time.Time
+	LastSeenTime time.Time
+}
+
+type TimeProvider interface {
+	Now() time.Time
+}
@@ -0,0 +1,24 @@
+package user
+
+import (
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+)
+
+// TOTP is an authenticator enrolled by a user. It isn't used to log in until
+// the user has confirmed it with a code from the authenticator.
+type TOTP struct {
+	Secret           string
+	CreationTime     time.Time
+	ConfirmationTime optional.Optional[time.Time]
+}
+
+type TOTPProvider interface {
+	Generate(accountName string) (secret string, provisioningURI string, err error)
+	Validate(secret string, code string, now time.Time) (step int64, ok bool)
+}
+
+type RecoveryCodeGenerator interface {
+	Generate() (string, error)
+}
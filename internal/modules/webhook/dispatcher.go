@@ -0,0 +1,233 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const (
+	dispatchBatchSize   = 20
+	dispatchMaxAttempts = 10
+
+	// dispatchLease is how long a claimed delivery is hidden from other
+	// dispatchers. It is retried after that if the dispatcher dies mid-send.
+	dispatchLease = 5 * time.Minute
+
+	retryBaseDelay = 30 * time.Second
+	retryMaxDelay  = 6 * time.Hour
+
+	// disableAfterFailures is how many attempts in a row can fail before the
+	// webhook is disabled. Its pending deliveries resume once it is enabled.
+	disableAfterFailures = 20
+)
+
+// Sender posts the body of a delivery to a webhook. It returns the status code
+// of the response, or an error if no response was received.
+type Sender interface {
+	Send(ctx context.Context, url string, header http.Header, body []byte) (int, error)
+}
+
+// PendingDelivery is a delivery that is due along with where to send it.
+type PendingDelivery struct {
+	ID        uuid.UUID
+	WebhookID uuid.UUID
+	EventID   uuid.UUID
+	EventType domain.OrderEventType
+	Payload   []byte
+	Attempts  int
+	URL       string
+	Secret    string
+}
+
+// DeliveryFailure is the outcome of a failed attempt. FailedTime is only set
+// when the delivery has run out of attempts.
+type DeliveryFailure struct {
+	StatusCode      optional.Optional[int]
+	Error           string
+	NextAttemptTime time.Time
+	FailedTime      optional.Optional[time.Time]
+}
+
+type DispatcherRepository interface {
+	ClaimPendingDeliveries(ctx context.Context, now time.Time, leaseUntil time.Time, limit int) ([]PendingDelivery, error)
+	MarkDeliverySucceeded(ctx context.Context, deliveryID uuid.UUID, statusCode int, deliveredTime time.Time) error
+	MarkDeliveryFailed(ctx context.Context, deliveryID uuid.UUID, failure DeliveryFailure) error
+	IncrementConsecutiveFailures(ctx context.Context, webhookID uuid.UUID) (int, error)
+	ResetConsecutiveFailures(ctx context.Context, webhookID uuid.UUID) error
+	DisableWebhook(ctx context.Context, webhookID uuid.UUID, disabledTime time.Time) error
+}
+
+// Dispatcher sends the queued deliveries to their webhooks, retrying failed
+// ones with exponential backoff until they run out of attempts.
+type Dispatcher struct {
+	repo         DispatcherRepository
+	sender       Sender
+	timeProvider TimeProvider
+}
+
+func NewDispatcher(repo DispatcherRepository, sender Sender, timeProvider TimeProvider) *Dispatcher {
+	return &Dispatcher{
+		repo:         repo,
+		sender:       sender,
+		timeProvider: timeProvider,
+	}
+}
+
+// Run dispatches pending deliveries every interval until ctx is done.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration) {
+	l := zerolog.Ctx(ctx)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		if err := d.DispatchPending(ctx); err != nil {
+			l.Error().Err(err).Msg("failed to dispatch webhook deliveries")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// DispatchPending sends a batch of deliveries that are due. A delivery whose
+// outcome can't be recorded is logged and doesn't stop the rest of the batch.
+func (d *Dispatcher) DispatchPending(ctx context.Context) error {
+	l := zerolog.Ctx(ctx)
+	now := d.timeProvider.Now()
+
+	deliveries, err := d.repo.ClaimPendingDeliveries(ctx, now, now.Add(dispatchLease), dispatchBatchSize)
+	if err != nil {
+		return fmt.Errorf("failed to claim pending webhook deliveries: %w", err)
+	}
+
+	for _, delivery := range deliveries {
+		if err = d.dispatch(ctx, delivery); err != nil {
+			l.Error().
+				Err(err).
+				Str("webhook_id", delivery.WebhookID.String()).
+				Str("delivery_id", delivery.ID.String()).
+				Msg("failed to record webhook delivery")
+		}
+	}
+
+	return nil
+}
+
+// dispatch sends a delivery and records the outcome. A delivery that can't be
+// recorded is claimed again once its lease runs out.
+func (d *Dispatcher) dispatch(ctx context.Context, delivery PendingDelivery) error {
+	statusCode, sendErr := d.send(ctx, delivery)
+	if sendErr == nil {
+		if err := d.repo.MarkDeliverySucceeded(ctx, delivery.ID, statusCode, d.timeProvider.Now()); err != nil {
+			return fmt.Errorf("failed to mark webhook delivery as succeeded: %w", err)
+		}
+
+		if err := d.repo.ResetConsecutiveFailures(ctx, delivery.WebhookID); err != nil {
+			return fmt.Errorf("failed to reset webhook failures: %w", err)
+		}
+
+		return nil
+	}
+
+	zerolog.Ctx(ctx).Warn().
+		Err(sendErr).
+		Str("webhook_id", delivery.WebhookID.String()).
+		Str("delivery_id", delivery.ID.String()).
+		Msg("failed to send webhook delivery")
+
+	return d.recordFailure(ctx, delivery, statusCode, sendErr)
+}
+
+// send posts the delivery and returns the status code of the response, which
+// is 0 if there was none. Any status code outside of 2xx is an error.
+func (d *Dispatcher) send(ctx context.Context, delivery PendingDelivery) (int, error) {
+	timestamp := d.timeProvider.Now()
+
+	header := http.Header{}
+	header.Set("Content-Type", "application/json")
+	header.Set(HeaderEvent, string(delivery.EventType))
+	header.Set(HeaderDelivery, delivery.ID.String())
+	header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	header.Set(HeaderSignature, Sign(delivery.Secret, timestamp, delivery.Payload))
+
+	statusCode, err := d.sender.Send(ctx, delivery.URL, header, delivery.Payload)
+	if err != nil {
+		return 0, err
+	}
+
+	if statusCode < 200 || statusCode > 299 {
+		return statusCode, fmt.Errorf("unexpected status code %d", statusCode)
+	}
+
+	return statusCode, nil
+}
+
+func (d *Dispatcher) recordFailure(
+	ctx context.Context,
+	delivery PendingDelivery,
+	statusCode int,
+	sendErr error,
+) error {
+	l := zerolog.Ctx(ctx)
+	now := d.timeProvider.Now()
+	attempts := delivery.Attempts + 1
+
+	failure := DeliveryFailure{
+		Error:           sendErr.Error(),
+		NextAttemptTime: now.Add(retryDelay(attempts)),
+	}
+
+	if statusCode != 0 {
+		failure.StatusCode = optional.Some(statusCode)
+	}
+
+	if attempts >= dispatchMaxAttempts {
+		failure.FailedTime = optional.Some(now)
+	}
+
+	if err := d.repo.MarkDeliveryFailed(ctx, delivery.ID, failure); err != nil {
+		return fmt.Errorf("failed to mark webhook delivery as failed: %w", err)
+	}
+
+	failures, err := d.repo.IncrementConsecutiveFailures(ctx, delivery.WebhookID)
+	if err != nil {
+		return fmt.Errorf("failed to increment webhook failures: %w", err)
+	}
+
+	if failures < disableAfterFailures {
+		return nil
+	}
+
+	if err = d.repo.DisableWebhook(ctx, delivery.WebhookID, now); err != nil {
+		return fmt.Errorf("failed to disable webhook: %w", err)
+	}
+
+	l.Warn().
+		Str("webhook_id", delivery.WebhookID.String()).
+		Int("consecutive_failures", failures).
+		Msg("disabled webhook after repeated failures")
+
+	return nil
+}
+
+// retryDelay returns how long to wait after the given number of failed attempts.
+func retryDelay(attempts int) time.Duration {
+	delay := retryBaseDelay
+	for i := 1; i < attempts && delay < retryMaxDelay; i++ {
+		delay *= 2
+	}
+
+	return min(delay, retryMaxDelay)
+}
@@ -0,0 +1,444 @@
+//go:build unit
+// +build unit
+
+package webhook_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/webhook"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDispatchPending(t *testing.T) {
+	t.Parallel()
+
+	const theSecret = "a-very-secret-key"
+
+	theTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	ctx := testutil.RequestContextWithLogger(context.Background())
+
+	newPending := func(url string, attempts int) webhook.PendingDelivery {
+		return webhook.PendingDelivery{
+			ID:        uuid.New(),
+			WebhookID: uuid.New(),
+			EventID:   uuid.New(),
+			EventType: domain.OrderEventTypeConfirmed,
+			Payload:   []byte(`{"type":"order_confirmed"}`),
+			Attempts:  attempts,
+			URL:       url,
+			Secret:    theSecret,
+		}
+	}
+
+	t.Run("posts signed deliveries and marks them as succeeded", func(t *testing.T) {
+		t.Parallel()
+
+		var (
+			gotHeader http.Header
+			gotBody   []byte
+		)
+
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			gotHeader = r.Header.Clone()
+
+			var err error
+			gotBody, err = io.ReadAll(r.Body)
+			assert.NoError(t, err)
+
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer receiver.Close()
+
+		pending := newPending(receiver.URL, 0)
+		repo := &dispatcherRepositoryStub{pending: []webhook.PendingDelivery{pending}}
+
+		d := webhook.NewDispatcher(repo, httpSender{}, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, d.DispatchPending(ctx))
+
+		require.NotNil(t, gotHeader)
+		assert.Equal(t, string(pending.Payload), string(gotBody))
+		assert.Equal(t, "application/json", gotHeader.Get("Content-Type"))
+		assert.Equal(t, "order_confirmed", gotHeader.Get(webhook.HeaderEvent))
+		assert.Equal(t, pending.ID.String(), gotHeader.Get(webhook.HeaderDelivery))
+		assert.Equal(t, "1714979289", gotHeader.Get(webhook.HeaderTimestamp))
+
+		mac := hmac.New(sha256.New, []byte(theSecret))
+		mac.Write([]byte("1714979289." + string(gotBody)))
+		assert.Equal(t, "sha256="+hex.EncodeToString(mac.Sum(nil)), gotHeader.Get(webhook.HeaderSignature))
+
+		assert.Equal(t, http.StatusNoContent, repo.succeeded[pending.ID])
+		assert.Equal(t, []uuid.UUID{pending.WebhookID}, repo.reset)
+		assert.Empty(t, repo.failed)
+	})
+
+	t.Run("claims deliveries that are due", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &dispatcherRepositoryStub{}
+
+		d := webhook.NewDispatcher(repo, &senderStub{}, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, d.DispatchPending(ctx))
+
+		assert.Equal(t, theTime, repo.claimedAt)
+		assert.True(t, repo.leaseUntil.After(theTime))
+	})
+
+	t.Run("records the status code when the receiver doesn't respond with 2xx", func(t *testing.T) {
+		t.Parallel()
+
+		receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer receiver.Close()
+
+		pending := newPending(receiver.URL, 0)
+		repo := &dispatcherRepositoryStub{pending: []webhook.PendingDelivery{pending}}
+
+		d := webhook.NewDispatcher(repo, httpSender{}, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, d.DispatchPending(ctx))
+
+		require.Contains(t, repo.failed, pending.ID)
+
+		failure := repo.failed[pending.ID]
+		assert.Equal(t, http.StatusServiceUnavailable, failure.StatusCode.GetOrElse(0))
+		assert.Equal(t, "unexpected status code 503", failure.Error)
+		assert.Empty(t, repo.succeeded)
+	})
+
+	t.Run("records the error when the receiver can't be reached", func(t *testing.T) {
+		t.Parallel()
+
+		pending := newPending("https://example.com", 0)
+		repo := &dispatcherRepositoryStub{pending: []webhook.PendingDelivery{pending}}
+
+		d := webhook.NewDispatcher(
+			repo,
+			&senderStub{err: errors.New("connection refused")},
+			testutil.NewTimeProviderStub(theTime),
+		)
+		require.NoError(t, d.DispatchPending(ctx))
+
+		require.Contains(t, repo.failed, pending.ID)
+
+		failure := repo.failed[pending.ID]
+		assert.False(t, failure.StatusCode.IsSet())
+		assert.Equal(t, "connection refused", failure.Error)
+	})
+
+	t.Run("retries failed deliveries with exponential backoff", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			attempts int
+			want     time.Duration
+		}{
+			{attempts: 0, want: 30 * time.Second},
+			{attempts: 1, want: time.Minute},
+			{attempts: 4, want: 8 * time.Minute},
+			{attempts: 8, want: 128 * time.Minute},
+		}
+
+		for _, tc := range tests {
+			pending := newPending("https://example.com", tc.attempts)
+			repo := &dispatcherRepositoryStub{pending: []webhook.PendingDelivery{pending}}
+
+			d := webhook.NewDispatcher(
+				repo,
+				&senderStub{statusCode: http.StatusInternalServerError},
+				testutil.NewTimeProviderStub(theTime),
+			)
+			require.NoError(t, d.DispatchPending(ctx))
+
+			failure := repo.failed[pending.ID]
+			assert.Equal(t, theTime.Add(tc.want), failure.NextAttemptTime, "after %d attempts", tc.attempts)
+			assert.False(t, failure.FailedTime.IsSet(), "after %d attempts", tc.attempts)
+		}
+	})
+
+	t.Run("gives up on deliveries that run out of attempts", func(t *testing.T) {
+		t.Parallel()
+
+		pending := newPending("https://example.com", 9)
+		repo := &dispatcherRepositoryStub{pending: []webhook.PendingDelivery{pending}}
+
+		d := webhook.NewDispatcher(
+			repo,
+			&senderStub{statusCode: http.StatusInternalServerError},
+			testutil.NewTimeProviderStub(theTime),
+		)
+		require.NoError(t, d.DispatchPending(ctx))
+
+		failure := repo.failed[pending.ID]
+		assert.Equal(t, theTime, failure.FailedTime.GetOrElse(time.Time{}))
+	})
+
+	t.Run("disables webhook after repeated failures", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name         string
+			failures     int
+			wantDisabled bool
+		}{
+			{name: "below threshold", failures: 18, wantDisabled: false},
+			{name: "at threshold", failures: 19, wantDisabled: true},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				pending := newPending("https://example.com", 0)
+				repo := &dispatcherRepositoryStub{
+					pending:  []webhook.PendingDelivery{pending},
+					failures: tc.failures,
+				}
+
+				d := webhook.NewDispatcher(
+					repo,
+					&senderStub{statusCode: http.StatusInternalServerError},
+					testutil.NewTimeProviderStub(theTime),
+				)
+				require.NoError(t, d.DispatchPending(ctx))
+
+				if tc.wantDisabled {
+					assert.Equal(t, map[uuid.UUID]time.Time{pending.WebhookID: theTime}, repo.disabled)
+				} else {
+					assert.Empty(t, repo.disabled)
+				}
+			})
+		}
+	})
+
+	t.Run("returns error when claiming deliveries fails", func(t *testing.T) {
+		t.Parallel()
+
+		d := webhook.NewDispatcher(
+			&dispatcherRepositoryStub{claimErr: errors.New("oh no!")},
+			&senderStub{},
+			testutil.NewTimeProviderStub(theTime),
+		)
+
+		assert.Error(t, d.DispatchPending(ctx))
+	})
+
+	t.Run("keeps dispatching when recording a delivery fails", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name   string
+			repo   *dispatcherRepositoryStub
+			sender *senderStub
+		}{
+			{
+				name: "marking delivery as succeeded",
+				repo: &dispatcherRepositoryStub{
+					pending: []webhook.PendingDelivery{
+						newPending("https://example.com", 0),
+						newPending("https://example.com", 0),
+					},
+					markErr: errors.New("oh no!"),
+				},
+				sender: &senderStub{},
+			},
+			{
+				name: "marking delivery as failed",
+				repo: &dispatcherRepositoryStub{
+					pending: []webhook.PendingDelivery{
+						newPending("https://example.com", 0),
+						newPending("https://example.com", 0),
+					},
+					markErr: errors.New("oh no!"),
+				},
+				sender: &senderStub{err: errors.New("connection refused")},
+			},
+			{
+				name: "counting failures",
+				repo: &dispatcherRepositoryStub{
+					pending: []webhook.PendingDelivery{
+						newPending("https://example.com", 0),
+						newPending("https://example.com", 0),
+					},
+					failuresErr: errors.New("oh no!"),
+				},
+				sender: &senderStub{err: errors.New("connection refused")},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				d := webhook.NewDispatcher(tc.repo, tc.sender, testutil.NewTimeProviderStub(theTime))
+				require.NoError(t, d.DispatchPending(ctx))
+
+				assert.Equal(t, 2, tc.sender.calls)
+			})
+		}
+	})
+}
+
+func TestSign(t *testing.T) {
+	t.Parallel()
+
+	theTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	body := []byte(`{"id":"1"}`)
+
+	got := webhook.Sign("secret", theTime, body)
+
+	assert.Equal(t, "sha256=", got[:7])
+	assert.Equal(t, got, webhook.Sign("secret", theTime, body))
+	assert.NotEqual(t, got, webhook.Sign("another secret", theTime, body))
+	assert.NotEqual(t, got, webhook.Sign("secret", theTime.Add(time.Second), body))
+	assert.NotEqual(t, got, webhook.Sign("secret", theTime, []byte(`{"id":"2"}`)))
+}
+
+type dispatcherRepositoryStub struct {
+	pending     []webhook.PendingDelivery
+	failures    int
+	claimErr    error
+	markErr     error
+	failuresErr error
+
+	claimedAt  time.Time
+	leaseUntil time.Time
+	succeeded  map[uuid.UUID]int
+	failed     map[uuid.UUID]webhook.DeliveryFailure
+	reset      []uuid.UUID
+	disabled   map[uuid.UUID]time.Time
+}
+
+func (d *dispatcherRepositoryStub) ClaimPendingDeliveries(
+	_ context.Context,
+	now time.Time,
+	leaseUntil time.Time,
+	_ int,
+) ([]webhook.PendingDelivery, error) {
+	if d.claimErr != nil {
+		return nil, d.claimErr
+	}
+
+	d.claimedAt = now
+	d.leaseUntil = leaseUntil
+
+	return d.pending, nil
+}
+
+func (d *dispatcherRepositoryStub) MarkDeliverySucceeded(
+	_ context.Context,
+	deliveryID uuid.UUID,
+	statusCode int,
+	_ time.Time,
+) error {
+	if d.markErr != nil {
+		return d.markErr
+	}
+
+	if d.succeeded == nil {
+		d.succeeded = make(map[uuid.UUID]int)
+	}
+
+	d.succeeded[deliveryID] = statusCode
+	return nil
+}
+
+func (d *dispatcherRepositoryStub) MarkDeliveryFailed(
+	_ context.Context,
+	deliveryID uuid.UUID,
+	failure webhook.DeliveryFailure,
+) error {
+	if d.markErr != nil {
+		return d.markErr
+	}
+
+	if d.failed == nil {
+		d.failed = make(map[uuid.UUID]webhook.DeliveryFailure)
+	}
+
+	d.failed[deliveryID] = failure
+	return nil
+}
+
+func (d *dispatcherRepositoryStub) IncrementConsecutiveFailures(_ context.Context, _ uuid.UUID) (int, error) {
+	if d.failuresErr != nil {
+		return 0, d.failuresErr
+	}
+
+	d.failures++
+	return d.failures, nil
+}
+
+func (d *dispatcherRepositoryStub) ResetConsecutiveFailures(_ context.Context, webhookID uuid.UUID) error {
+	d.reset = append(d.reset, webhookID)
+	return nil
+}
+
+func (d *dispatcherRepositoryStub) DisableWebhook(_ context.Context, webhookID uuid.UUID, disabledTime time.Time) error {
+	if d.disabled == nil {
+		d.disabled = make(map[uuid.UUID]time.Time)
+	}
+
+	d.disabled[webhookID] = disabledTime
+	return nil
+}
+
+type senderStub struct {
+	statusCode int
+	err        error
+	calls      int
+}
+
+func (s *senderStub) Send(_ context.Context, _ string, _ http.Header, _ []byte) (int, error) {
+	s.calls++
+
+	if s.err != nil {
+		return 0, s.err
+	}
+
+	if s.statusCode == 0 {
+		return http.StatusOK, nil
+	}
+
+	return s.statusCode, nil
+}
+
+// httpSender posts deliveries for real so they can be checked by an httptest
+// receiver.
+type httpSender struct{}
+
+func (httpSender) Send(ctx context.Context, url string, header http.Header, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header = header
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	return res.StatusCode, nil
+}
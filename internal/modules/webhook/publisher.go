@@ -0,0 +1,137 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/google/uuid"
+)
+
+type PublisherRepository interface {
+	GetEnabledWebhooksForEvent(
+		ctx context.Context,
+		storeID uuid.UUID,
+		eventType domain.OrderEventType,
+	) ([]Webhook, error)
+	CreateDelivery(ctx context.Context, delivery Delivery) error
+}
+
+// Publisher subscribes to order events and queues a delivery of each event
+// for every enabled webhook of the store that is subscribed to it.
+type Publisher struct {
+	repo         PublisherRepository
+	timeProvider TimeProvider
+}
+
+func NewPublisher(repo PublisherRepository, timeProvider TimeProvider) *Publisher {
+	return &Publisher{
+		repo:         repo,
+		timeProvider: timeProvider,
+	}
+}
+
+// HandleOrderEvent queues the deliveries of the event. A webhook only gets one
+// delivery per event, so handling the same event twice queues it once.
+func (p *Publisher) HandleOrderEvent(ctx context.Context, event domain.OrderEvent) error {
+	metadata := event.Metadata()
+
+	webhooks, err := p.repo.GetEnabledWebhooksForEvent(ctx, metadata.StoreID, event.Type())
+	if err != nil {
+		return fmt.Errorf("failed to get webhooks for event: %w", err)
+	}
+
+	if len(webhooks) == 0 {
+		return nil
+	}
+
+	payload, err := marshalEventPayload(event)
+	if err != nil {
+		return fmt.Errorf("failed to marshal %s event: %w", event.Type(), err)
+	}
+
+	now := p.timeProvider.Now()
+
+	for _, w := range webhooks {
+		if err = p.repo.CreateDelivery(ctx, Delivery{
+			ID:           uuid.New(),
+			WebhookID:    w.ID,
+			EventID:      metadata.EventID,
+			EventType:    event.Type(),
+			Payload:      payload,
+			CreationTime: now,
+		}); err != nil {
+			return fmt.Errorf("failed to create delivery for webhook %s: %w", w.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// eventPayload is the body of a delivery. Data holds the fields specific to
+// the type of the event.
+type eventPayload struct {
+	ID            uuid.UUID             `json:"id"`
+	Type          domain.OrderEventType `json:"type"`
+	StoreID       uuid.UUID             `json:"store_id"`
+	RepairOrderID uuid.UUID             `json:"repair_order_id"`
+	OccurredTime  time.Time             `json:"occurred_time"`
+	Data          any                   `json:"data"`
+}
+
+type (
+	orderCostMutatedData struct {
+		Amount int    `json:"amount"`
+		Reason string `json:"reason"`
+	}
+
+	orderTechnicianChangedData struct {
+		PreviousTechnicianID uuid.UUID `json:"previous_technician_id"`
+		TechnicianID         uuid.UUID `json:"technician_id"`
+		Reason               string    `json:"reason"`
+	}
+
+	orderConfirmedData struct {
+		Contents string `json:"contents"`
+	}
+
+	orderCancelledData struct {
+		Reason string `json:"reason"`
+	}
+)
+
+func marshalEventPayload(event domain.OrderEvent) ([]byte, error) {
+	var data any
+
+	switch e := event.(type) {
+	case domain.OrderCostMutated:
+		data = orderCostMutatedData{Amount: e.Amount, Reason: e.Reason}
+	case domain.OrderTechnicianChanged:
+		data = orderTechnicianChangedData{
+			PreviousTechnicianID: e.PreviousTechnicianID,
+			TechnicianID:         e.TechnicianID,
+			Reason:               e.Reason,
+		}
+	case domain.OrderConfirmed:
+		data = orderConfirmedData{Contents: e.Contents}
+	case domain.OrderCancelled:
+		data = orderCancelledData{Reason: e.Reason}
+	case domain.OrderCreated, domain.OrderCompleted, domain.OrderPickedUp:
+		data = struct{}{}
+	default:
+		return nil, fmt.Errorf("unknown event type %T", event)
+	}
+
+	metadata := event.Metadata()
+
+	return json.Marshal(eventPayload{
+		ID:            metadata.EventID,
+		Type:          event.Type(),
+		StoreID:       metadata.StoreID,
+		RepairOrderID: metadata.OrderID,
+		OccurredTime:  metadata.OccurredTime,
+		Data:          data,
+	})
+}
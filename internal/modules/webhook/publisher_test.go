@@ -0,0 +1,156 @@
+//go:build unit
+// +build unit
+
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/webhook"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandleOrderEvent(t *testing.T) {
+	t.Parallel()
+
+	theTime := time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+
+	theEvent := domain.OrderCancelled{
+		OrderEventMetadata: domain.OrderEventMetadata{
+			EventID:      uuid.New(),
+			OrderID:      uuid.New(),
+			StoreID:      uuid.New(),
+			OccurredTime: theTime.Add(-time.Minute),
+		},
+		Reason: "customer changed their mind",
+	}
+
+	t.Run("queues a delivery for every subscribed webhook", func(t *testing.T) {
+		t.Parallel()
+
+		webhooks := []webhook.Webhook{{ID: uuid.New()}, {ID: uuid.New()}}
+		repo := &publisherRepositoryStub{webhooks: webhooks}
+
+		p := webhook.NewPublisher(repo, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, p.HandleOrderEvent(context.Background(), theEvent))
+
+		assert.Equal(t, theEvent.StoreID, repo.storeID)
+		assert.Equal(t, domain.OrderEventTypeCancelled, repo.eventType)
+
+		require.Len(t, repo.created, 2)
+
+		for i, d := range repo.created {
+			assert.Equal(t, webhooks[i].ID, d.WebhookID)
+			assert.Equal(t, theEvent.EventID, d.EventID)
+			assert.Equal(t, domain.OrderEventTypeCancelled, d.EventType)
+			assert.Equal(t, theTime, d.CreationTime)
+			assert.False(t, d.ReplayOf.IsSet())
+		}
+	})
+
+	t.Run("payload describes the event", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &publisherRepositoryStub{webhooks: []webhook.Webhook{{ID: uuid.New()}}}
+
+		p := webhook.NewPublisher(repo, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, p.HandleOrderEvent(context.Background(), theEvent))
+
+		require.Len(t, repo.created, 1)
+
+		var got map[string]any
+		require.NoError(t, json.Unmarshal(repo.created[0].Payload, &got))
+
+		assert.Equal(t, map[string]any{
+			"id":              theEvent.EventID.String(),
+			"type":            "order_cancelled",
+			"store_id":        theEvent.StoreID.String(),
+			"repair_order_id": theEvent.OrderID.String(),
+			"occurred_time":   "2024-05-06T07:07:09Z",
+			"data":            map[string]any{"reason": "customer changed their mind"},
+		}, got)
+	})
+
+	t.Run("does nothing when no webhook is subscribed", func(t *testing.T) {
+		t.Parallel()
+
+		repo := &publisherRepositoryStub{}
+
+		p := webhook.NewPublisher(repo, testutil.NewTimeProviderStub(theTime))
+		require.NoError(t, p.HandleOrderEvent(context.Background(), theEvent))
+
+		assert.Empty(t, repo.created)
+	})
+
+	t.Run("returns error when repository errors", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			repo *publisherRepositoryStub
+		}{
+			{
+				name: "getting webhooks",
+				repo: &publisherRepositoryStub{getErr: errors.New("oh no!")},
+			},
+			{
+				name: "creating delivery",
+				repo: &publisherRepositoryStub{
+					webhooks:  []webhook.Webhook{{ID: uuid.New()}},
+					createErr: errors.New("oh no!"),
+				},
+			},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				p := webhook.NewPublisher(tc.repo, testutil.NewTimeProviderStub(theTime))
+				assert.Error(t, p.HandleOrderEvent(context.Background(), theEvent))
+			})
+		}
+	})
+}
+
+type publisherRepositoryStub struct {
+	webhooks  []webhook.Webhook
+	getErr    error
+	createErr error
+
+	storeID   uuid.UUID
+	eventType domain.OrderEventType
+	created   []webhook.Delivery
+}
+
+func (p *publisherRepositoryStub) GetEnabledWebhooksForEvent(
+	_ context.Context,
+	storeID uuid.UUID,
+	eventType domain.OrderEventType,
+) ([]webhook.Webhook, error) {
+	if p.getErr != nil {
+		return nil, p.getErr
+	}
+
+	p.storeID = storeID
+	p.eventType = eventType
+
+	return p.webhooks, nil
+}
+
+func (p *publisherRepositoryStub) CreateDelivery(_ context.Context, delivery webhook.Delivery) error {
+	if p.createErr != nil {
+		return p.createErr
+	}
+
+	p.created = append(p.created, delivery)
+	return nil
+}
@@ -0,0 +1,476 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/apierror"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+)
+
+const defaultDeliveryListLimit = 50
+
+type Repository interface {
+	CreateWebhook(ctx context.Context, webhook Webhook) error
+	GetWebhooksByStoreID(ctx context.Context, storeID uuid.UUID) ([]Webhook, error)
+	GetWebhookByID(ctx context.Context, storeID uuid.UUID, webhookID uuid.UUID) (Webhook, error)
+	UpdateWebhook(ctx context.Context, webhook Webhook) error
+	DeleteWebhook(ctx context.Context, storeID uuid.UUID, webhookID uuid.UUID) error
+	GetDeliveriesByWebhookID(ctx context.Context, webhookID uuid.UUID, limit int) ([]Delivery, error)
+	GetDeliveryByID(ctx context.Context, webhookID uuid.UUID, deliveryID uuid.UUID) (Delivery, error)
+	CreateDelivery(ctx context.Context, delivery Delivery) error
+}
+
+type ResourceLocationProvider interface {
+	Webhook(webhookID uuid.UUID) url.URL
+}
+
+type AuditRecorder interface {
+	Record(ctx context.Context, change audit.Change) error
+}
+
+type Service struct {
+	timeProvider             TimeProvider
+	resourceLocationProvider ResourceLocationProvider
+	permissionProvider       permission.Provider
+	repo                     Repository
+	auditRecorder            AuditRecorder
+}
+
+func NewService(
+	timeProvider TimeProvider,
+	resourceLocationProvider ResourceLocationProvider,
+	permissionProvider permission.Provider,
+	repo Repository,
+	auditRecorder AuditRecorder,
+) *Service {
+	return &Service{
+		timeProvider:             timeProvider,
+		resourceLocationProvider: resourceLocationProvider,
+		permissionProvider:       permissionProvider,
+		repo:                     repo,
+		auditRecorder:            auditRecorder,
+	}
+}
+
+func (s *Service) CreateWebhook(
+	ctx context.Context,
+	req *genapi.CreateWebhookRequest,
+) (*genapi.CreateWebhookCreated, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.CreateWebhook()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if !isValidURL(req.URL) {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "url must be an absolute http or https URL")
+	}
+
+	webhook := Webhook{
+		ID:           uuid.New(),
+		StoreID:      user.Store.ID,
+		URL:          req.URL.String(),
+		Secret:       req.Secret,
+		EventTypes:   fromAPIEventTypes(req.EventTypes),
+		Enabled:      true,
+		CreationTime: s.timeProvider.Now(),
+	}
+
+	if err := s.repo.CreateWebhook(ctx, webhook); err != nil {
+		l.Error().Err(err).Msg("failed to create webhook")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to create webhook")
+	}
+
+	if err := audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionWebhookCreated,
+		EntityType: audit.EntityTypeWebhook,
+		EntityID:   webhook.ID,
+		After:      toAPIWebhook(webhook),
+	}); err != nil {
+		return nil, err
+	}
+
+	location := s.resourceLocationProvider.Webhook(webhook.ID)
+	return &genapi.CreateWebhookCreated{
+		Location: location,
+	}, nil
+}
+
+func (s *Service) ListWebhooks(ctx context.Context) (*genapi.WebhookList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewWebhooks()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	webhooks, err := s.repo.GetWebhooksByStoreID(ctx, user.Store.ID)
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get webhooks")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhooks")
+	}
+
+	items := make([]genapi.Webhook, 0, len(webhooks))
+	for _, w := range webhooks {
+		items = append(items, *toAPIWebhook(w))
+	}
+
+	return &genapi.WebhookList{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) GetWebhook(ctx context.Context, params genapi.GetWebhookParams) (*genapi.Webhook, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewWebhooks()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	webhook, err := s.repo.GetWebhookByID(ctx, user.Store.ID, params.WebhookId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrWebhookNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "webhook not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get webhook by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhook")
+	}
+
+	return toAPIWebhook(webhook), nil
+}
+
+func (s *Service) UpdateWebhook(
+	ctx context.Context,
+	req *genapi.UpdateWebhookRequest,
+	params genapi.UpdateWebhookParams,
+) (*genapi.Webhook, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.UpdateWebhook()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	if !isValidURL(req.URL) {
+		return nil, apierror.ToAPIError(http.StatusBadRequest, "url must be an absolute http or https URL")
+	}
+
+	webhook, err := s.repo.GetWebhookByID(ctx, user.Store.ID, params.WebhookId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrWebhookNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "webhook not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get webhook by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhook")
+	}
+
+	before := toAPIWebhook(webhook)
+
+	webhook.URL = req.URL.String()
+	webhook.EventTypes = fromAPIEventTypes(req.EventTypes)
+
+	if secret, ok := req.Secret.Get(); ok {
+		webhook.Secret = secret
+	}
+
+	switch {
+	case req.Enabled && !webhook.Enabled:
+		webhook.ConsecutiveFailures = 0
+		webhook.DisabledTime = optional.None[time.Time]()
+	case !req.Enabled && webhook.Enabled:
+		webhook.DisabledTime = optional.Some(s.timeProvider.Now())
+	}
+
+	webhook.Enabled = req.Enabled
+
+	if err = s.repo.UpdateWebhook(ctx, webhook); err != nil {
+		if errors.Is(err, apperror.ErrWebhookNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "webhook not found")
+		}
+
+		l.Error().Err(err).Msg("failed to update webhook")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to update webhook")
+	}
+
+	res := toAPIWebhook(webhook)
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionWebhookUpdated,
+		EntityType: audit.EntityTypeWebhook,
+		EntityID:   webhook.ID,
+		Before:     before,
+		After:      res,
+	}); err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (s *Service) DeleteWebhook(ctx context.Context, params genapi.DeleteWebhookParams) error {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.DeleteWebhook()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	webhook, err := s.repo.GetWebhookByID(ctx, user.Store.ID, params.WebhookId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrWebhookNotFound) {
+			return apierror.ToAPIError(http.StatusNotFound, "webhook not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get webhook by ID")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhook")
+	}
+
+	if err = s.repo.DeleteWebhook(ctx, user.Store.ID, params.WebhookId); err != nil {
+		if errors.Is(err, apperror.ErrWebhookNotFound) {
+			return apierror.ToAPIError(http.StatusNotFound, "webhook not found")
+		}
+
+		l.Error().Err(err).Msg("failed to delete webhook")
+		return apierror.ToAPIError(http.StatusInternalServerError, "failed to delete webhook")
+	}
+
+	if err = audit.RecordChange(ctx, s.auditRecorder, audit.Change{
+		Action:     audit.ActionWebhookDeleted,
+		EntityType: audit.EntityTypeWebhook,
+		EntityID:   webhook.ID,
+		Before:     toAPIWebhook(webhook),
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) ListWebhookDeliveries(
+	ctx context.Context,
+	params genapi.ListWebhookDeliveriesParams,
+) (*genapi.WebhookDeliveryList, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ViewWebhooks()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	webhook, err := s.repo.GetWebhookByID(ctx, user.Store.ID, params.WebhookId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrWebhookNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "webhook not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get webhook by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhook")
+	}
+
+	deliveries, err := s.repo.GetDeliveriesByWebhookID(ctx, webhook.ID, params.Limit.Or(defaultDeliveryListLimit))
+	if err != nil {
+		l.Error().Err(err).Msg("failed to get webhook deliveries")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhook deliveries")
+	}
+
+	items := make([]genapi.WebhookDelivery, 0, len(deliveries))
+	for _, d := range deliveries {
+		items = append(items, *toAPIWebhookDelivery(d))
+	}
+
+	return &genapi.WebhookDeliveryList{
+		Items: items,
+	}, nil
+}
+
+func (s *Service) ReplayWebhookDelivery(
+	ctx context.Context,
+	params genapi.ReplayWebhookDeliveryParams,
+) (*genapi.WebhookDelivery, error) {
+	l := zerolog.Ctx(ctx)
+
+	user, ok := appcontext.GetUserFromContext(ctx)
+	if !ok {
+		l.Error().Msg("user is missing from context")
+		return nil, apierror.ToAPIError(http.StatusUnauthorized, "unauthorized")
+	}
+
+	if can, err := s.permissionProvider.Can(ctx, user.Role.ID, permission.ReplayWebhookDelivery()); err != nil {
+		l.Error().Err(err).Msg("failed to check permission")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to check permission")
+	} else if !can {
+		return nil, apierror.ToAPIError(http.StatusForbidden, "insufficient permissions")
+	}
+
+	webhook, err := s.repo.GetWebhookByID(ctx, user.Store.ID, params.WebhookId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrWebhookNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "webhook not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get webhook by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhook")
+	}
+
+	if !webhook.Enabled {
+		return nil, apierror.ToAPIError(http.StatusConflict, "webhook is disabled")
+	}
+
+	original, err := s.repo.GetDeliveryByID(ctx, webhook.ID, params.DeliveryId)
+	if err != nil {
+		if errors.Is(err, apperror.ErrWebhookDeliveryNotFound) {
+			return nil, apierror.ToAPIError(http.StatusNotFound, "webhook delivery not found")
+		}
+
+		l.Error().Err(err).Msg("failed to get webhook delivery by ID")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to get webhook delivery")
+	}
+
+	replay := Delivery{
+		ID:           uuid.New(),
+		WebhookID:    webhook.ID,
+		EventID:      original.EventID,
+		EventType:    original.EventType,
+		Payload:      original.Payload,
+		ReplayOf:     optional.Some(original.ID),
+		CreationTime: s.timeProvider.Now(),
+	}
+
+	if err = s.repo.CreateDelivery(ctx, replay); err != nil {
+		l.Error().Err(err).Msg("failed to create webhook delivery")
+		return nil, apierror.ToAPIError(http.StatusInternalServerError, "failed to replay webhook delivery")
+	}
+
+	return toAPIWebhookDelivery(replay), nil
+}
+
+func isValidURL(u url.URL) bool {
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+func fromAPIEventTypes(eventTypes []genapi.WebhookEventType) []domain.OrderEventType {
+	res := make([]domain.OrderEventType, 0, len(eventTypes))
+	for _, t := range eventTypes {
+		res = append(res, domain.OrderEventType(t))
+	}
+
+	return res
+}
+
+func toAPIWebhook(webhook Webhook) *genapi.Webhook {
+	eventTypes := make([]genapi.WebhookEventType, 0, len(webhook.EventTypes))
+	for _, t := range webhook.EventTypes {
+		eventTypes = append(eventTypes, genapi.WebhookEventType(t))
+	}
+
+	// The URL was validated when the webhook was saved.
+	u, _ := url.Parse(webhook.URL)
+
+	res := &genapi.Webhook{
+		ID:                  webhook.ID,
+		URL:                 *u,
+		EventTypes:          eventTypes,
+		Enabled:             webhook.Enabled,
+		ConsecutiveFailures: webhook.ConsecutiveFailures,
+		CreationTime:        webhook.CreationTime,
+	}
+
+	if disabledTime, ok := webhook.DisabledTime.Get(); ok {
+		res.DisabledTime = genapi.NewOptDateTime(disabledTime)
+	}
+
+	return res
+}
+
+func toAPIWebhookDelivery(delivery Delivery) *genapi.WebhookDelivery {
+	res := &genapi.WebhookDelivery{
+		ID:           delivery.ID,
+		EventID:      delivery.EventID,
+		EventType:    genapi.WebhookEventType(delivery.EventType),
+		Status:       genapi.WebhookDeliveryStatus(delivery.Status()),
+		Attempts:     delivery.Attempts,
+		CreationTime: delivery.CreationTime,
+	}
+
+	if replayOf, ok := delivery.ReplayOf.Get(); ok {
+		res.ReplayOf = genapi.NewOptUUID(replayOf)
+	}
+
+	if statusCode, ok := delivery.LastStatusCode.Get(); ok {
+		res.LastStatusCode = genapi.NewOptInt(statusCode)
+	}
+
+	if lastError, ok := delivery.LastError.Get(); ok {
+		res.LastError = genapi.NewOptString(lastError)
+	}
+
+	if deliveredTime, ok := delivery.DeliveredTime.Get(); ok {
+		res.DeliveredTime = genapi.NewOptDateTime(deliveredTime)
+	}
+
+	return res
+}
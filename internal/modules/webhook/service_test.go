@@ -0,0 +1,843 @@
+//go:build unit
+// +build unit
+
+package webhook_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/appconstant"
+	"github.com/JosephJoshua/remana-backend/internal/appcontext"
+	"github.com/JosephJoshua/remana-backend/internal/apperror"
+	"github.com/JosephJoshua/remana-backend/internal/genapi"
+	"github.com/JosephJoshua/remana-backend/internal/logger"
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/JosephJoshua/remana-backend/internal/modules/auth/readmodel"
+	"github.com/JosephJoshua/remana-backend/internal/modules/permission"
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/modules/webhook"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/JosephJoshua/remana-backend/internal/testutil"
+	"github.com/google/uuid"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.CreateWebhook()},
+		nil,
+	)
+
+	validRequest := func() *genapi.CreateWebhookRequest {
+		return &genapi.CreateWebhookRequest{
+			URL:    url.URL{Scheme: "https", Host: "example.com", Path: "/hook"},
+			Secret: "a-very-secret-key",
+			EventTypes: []genapi.WebhookEventType{
+				genapi.WebhookEventTypeOrderConfirmed,
+				genapi.WebhookEventTypeOrderCancelled,
+			},
+		}
+	}
+
+	t.Run("creates enabled webhook when request is valid", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		auditLog := testutil.NewAuditLogStub()
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		_, err := s.CreateWebhook(requestCtx, validRequest())
+		require.NoError(t, err)
+
+		require.Len(t, repo.webhooks, 1)
+
+		for _, got := range repo.webhooks {
+			require.Len(t, auditLog.Changes, 1)
+			assert.Equal(t, audit.ActionWebhookCreated, auditLog.Changes[0].Action)
+			assert.Equal(t, audit.EntityTypeWebhook, auditLog.Changes[0].EntityType)
+			assert.Equal(t, got.ID, auditLog.Changes[0].EntityID)
+
+			assert.Equal(t, theStoreID, got.StoreID)
+			assert.Equal(t, "https://example.com/hook", got.URL)
+			assert.Equal(t, "a-very-secret-key", got.Secret)
+			assert.Equal(t, []domain.OrderEventType{
+				domain.OrderEventTypeConfirmed,
+				domain.OrderEventTypeCancelled,
+			}, got.EventTypes)
+			assert.True(t, got.Enabled)
+			assert.Equal(t, theTime, got.CreationTime)
+		}
+	})
+
+	t.Run("returns resource location when webhook is created", func(t *testing.T) {
+		t.Parallel()
+
+		theLocation := url.URL{Path: "/webhooks/ef21dc9e-c364-41cd-8c03-fa289d11e3a7"}
+		resourceLocationProvider := testutil.NewResourceLocationProviderStubForWebhook(theLocation)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			resourceLocationProvider,
+			qualifyingPermissionProvider,
+			newRepositoryStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.CreateWebhook(requestCtx, validRequest())
+		require.NoError(t, err)
+
+		assert.Equal(t, theLocation, got.Location)
+		assert.True(t, resourceLocationProvider.WebhookID.IsSet())
+	})
+
+	t.Run("returns bad request when url is not http or https", func(t *testing.T) {
+		t.Parallel()
+
+		tests := []struct {
+			name string
+			url  url.URL
+		}{
+			{name: "relative", url: url.URL{Path: "/hook"}},
+			{name: "ftp", url: url.URL{Scheme: "ftp", Host: "example.com"}},
+		}
+
+		for _, tc := range tests {
+			t.Run(tc.name, func(t *testing.T) {
+				t.Parallel()
+
+				s := webhook.NewService(
+					testutil.NewTimeProviderStub(theTime),
+					testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+					qualifyingPermissionProvider,
+					newRepositoryStub(),
+					testutil.NewAuditLogStub(),
+				)
+
+				req := validRequest()
+				req.URL = tc.url
+
+				_, err := s.CreateWebhook(requestCtx, req)
+				testutil.AssertAPIStatusCode(t, http.StatusBadRequest, err)
+			})
+		}
+	})
+
+	t.Run("returns unauthorized when user is missing from context", func(t *testing.T) {
+		t.Parallel()
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			newRepositoryStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.CreateWebhook(testutil.RequestContextWithLogger(context.Background()), validRequest())
+		testutil.AssertAPIStatusCode(t, http.StatusUnauthorized, err)
+	})
+
+	t.Run("returns forbidden when role doesn't have permission", func(t *testing.T) {
+		t.Parallel()
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			newRepositoryStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.CreateWebhook(requestCtx, validRequest())
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+
+	t.Run("returns internal server error when repository.CreateWebhook() errors", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		repo.err = errors.New("oh no!")
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.CreateWebhook(requestCtx, validRequest())
+		testutil.AssertAPIStatusCode(t, http.StatusInternalServerError, err)
+	})
+}
+
+func TestGetWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.ViewWebhooks()},
+		nil,
+	)
+
+	t.Run("returns webhook without its secret", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.GetWebhook(requestCtx, genapi.GetWebhookParams{WebhookId: existing.ID})
+		require.NoError(t, err)
+
+		assert.Equal(t, existing.ID, got.ID)
+		assert.Equal(t, existing.URL, got.URL.String())
+		assert.Equal(t, []genapi.WebhookEventType{genapi.WebhookEventTypeOrderCreated}, got.EventTypes)
+		assert.True(t, got.Enabled)
+	})
+
+	t.Run("returns not found when webhook belongs to another store", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(uuid.New(), true)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.GetWebhook(requestCtx, genapi.GetWebhookParams{WebhookId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when role doesn't have permission", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.GetWebhook(requestCtx, genapi.GetWebhookParams{WebhookId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+}
+
+func TestUpdateWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.UpdateWebhook()},
+		nil,
+	)
+
+	newRequest := func(enabled bool) *genapi.UpdateWebhookRequest {
+		return &genapi.UpdateWebhookRequest{
+			URL:        url.URL{Scheme: "https", Host: "example.org", Path: "/new"},
+			EventTypes: []genapi.WebhookEventType{},
+			Enabled:    enabled,
+		}
+	}
+
+	t.Run("updates webhook and keeps secret when it isn't set", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+		auditLog := testutil.NewAuditLogStub()
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		got, err := s.UpdateWebhook(requestCtx, newRequest(true), genapi.UpdateWebhookParams{WebhookId: existing.ID})
+		require.NoError(t, err)
+
+		assert.Equal(t, "https://example.org/new", got.URL.String())
+		assert.Empty(t, got.EventTypes)
+
+		saved := repo.webhooks[existing.ID]
+		assert.Equal(t, "https://example.org/new", saved.URL)
+		assert.Equal(t, existing.Secret, saved.Secret)
+		assert.Empty(t, saved.EventTypes)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionWebhookUpdated, auditLog.Changes[0].Action)
+		assert.Equal(t, existing.ID, auditLog.Changes[0].EntityID)
+		assert.NotNil(t, auditLog.Changes[0].Before)
+		assert.Equal(t, got, auditLog.Changes[0].After)
+	})
+
+	t.Run("replaces secret when it is set", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		req := newRequest(true)
+		req.Secret = genapi.NewOptString("another-secret-key")
+
+		_, err := s.UpdateWebhook(requestCtx, req, genapi.UpdateWebhookParams{WebhookId: existing.ID})
+		require.NoError(t, err)
+
+		assert.Equal(t, "another-secret-key", repo.webhooks[existing.ID].Secret)
+	})
+
+	t.Run("resets failures when a disabled webhook is enabled", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, false)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.UpdateWebhook(requestCtx, newRequest(true), genapi.UpdateWebhookParams{WebhookId: existing.ID})
+		require.NoError(t, err)
+
+		assert.True(t, got.Enabled)
+		assert.Equal(t, 0, got.ConsecutiveFailures)
+		assert.False(t, got.DisabledTime.IsSet())
+	})
+
+	t.Run("records when webhook is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.UpdateWebhook(requestCtx, newRequest(false), genapi.UpdateWebhookParams{WebhookId: existing.ID})
+		require.NoError(t, err)
+
+		assert.False(t, got.Enabled)
+		assert.Equal(t, genapi.NewOptDateTime(theTime), got.DisabledTime)
+	})
+
+	t.Run("returns not found when webhook doesn't exist", func(t *testing.T) {
+		t.Parallel()
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			newRepositoryStub(),
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.UpdateWebhook(requestCtx, newRequest(true), genapi.UpdateWebhookParams{WebhookId: uuid.New()})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+}
+
+func TestDeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.DeleteWebhook()},
+		nil,
+	)
+
+	t.Run("deletes webhook", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+		auditLog := testutil.NewAuditLogStub()
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			auditLog,
+		)
+
+		require.NoError(t, s.DeleteWebhook(requestCtx, genapi.DeleteWebhookParams{WebhookId: existing.ID}))
+		assert.NotContains(t, repo.webhooks, existing.ID)
+
+		require.Len(t, auditLog.Changes, 1)
+		assert.Equal(t, audit.ActionWebhookDeleted, auditLog.Changes[0].Action)
+		assert.Equal(t, existing.ID, auditLog.Changes[0].EntityID)
+		assert.Nil(t, auditLog.Changes[0].After)
+	})
+
+	t.Run("returns not found when webhook belongs to another store", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(uuid.New(), true)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		err := s.DeleteWebhook(requestCtx, genapi.DeleteWebhookParams{WebhookId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+		assert.Contains(t, repo.webhooks, existing.ID)
+	})
+}
+
+func TestListWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.ViewWebhooks()},
+		nil,
+	)
+
+	t.Run("returns deliveries with their status", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+
+		delivered := repo.addDelivery(existing.ID)
+		delivered.Attempts = 1
+		delivered.LastStatusCode = optional.Some(http.StatusOK)
+		delivered.DeliveredTime = optional.Some(theTime)
+		repo.deliveries[delivered.ID] = delivered
+
+		failed := repo.addDelivery(existing.ID)
+		failed.Attempts = 10
+		failed.LastError = optional.Some("unexpected status code 500")
+		failed.FailedTime = optional.Some(theTime)
+		repo.deliveries[failed.ID] = failed
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.ListWebhookDeliveries(requestCtx, genapi.ListWebhookDeliveriesParams{WebhookId: existing.ID})
+		require.NoError(t, err)
+
+		assert.Equal(t, 50, repo.listLimit)
+
+		statuses := make(map[uuid.UUID]genapi.WebhookDeliveryStatus)
+		for _, item := range got.Items {
+			statuses[item.ID] = item.Status
+		}
+
+		assert.Equal(t, map[uuid.UUID]genapi.WebhookDeliveryStatus{
+			delivered.ID: genapi.WebhookDeliveryStatusDelivered,
+			failed.ID:    genapi.WebhookDeliveryStatusFailed,
+		}, statuses)
+	})
+
+	t.Run("returns not found when webhook belongs to another store", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(uuid.New(), true)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ListWebhookDeliveries(requestCtx, genapi.ListWebhookDeliveriesParams{WebhookId: existing.ID})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+}
+
+func TestReplayWebhookDelivery(t *testing.T) {
+	t.Parallel()
+
+	var (
+		theStoreID = uuid.New()
+		theRoleID  = uuid.New()
+		theTime    = time.Date(2024, 5, 6, 7, 8, 9, 0, time.UTC)
+	)
+
+	logger.Init(zerolog.ErrorLevel, appconstant.AppEnvDev)
+	requestCtx := newRequestContext(theStoreID, theRoleID)
+
+	qualifyingPermissionProvider := testutil.NewPermissionProviderStub(
+		theRoleID,
+		[]permission.Permission{permission.ReplayWebhookDelivery()},
+		nil,
+	)
+
+	t.Run("queues a copy of the delivery", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+		original := repo.addDelivery(existing.ID)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		got, err := s.ReplayWebhookDelivery(requestCtx, genapi.ReplayWebhookDeliveryParams{
+			WebhookId:  existing.ID,
+			DeliveryId: original.ID,
+		})
+		require.NoError(t, err)
+
+		assert.NotEqual(t, original.ID, got.ID)
+		assert.Equal(t, genapi.NewOptUUID(original.ID), got.ReplayOf)
+		assert.Equal(t, genapi.WebhookDeliveryStatusPending, got.Status)
+
+		require.Contains(t, repo.deliveries, got.ID)
+
+		replay := repo.deliveries[got.ID]
+		assert.Equal(t, original.EventID, replay.EventID)
+		assert.Equal(t, original.Payload, replay.Payload)
+		assert.Equal(t, theTime, replay.CreationTime)
+	})
+
+	t.Run("returns conflict when webhook is disabled", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, false)
+		original := repo.addDelivery(existing.ID)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ReplayWebhookDelivery(requestCtx, genapi.ReplayWebhookDeliveryParams{
+			WebhookId:  existing.ID,
+			DeliveryId: original.ID,
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusConflict, err)
+	})
+
+	t.Run("returns not found when delivery belongs to another webhook", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+		another := repo.addWebhook(theStoreID, true)
+		original := repo.addDelivery(another.ID)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			qualifyingPermissionProvider,
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ReplayWebhookDelivery(requestCtx, genapi.ReplayWebhookDeliveryParams{
+			WebhookId:  existing.ID,
+			DeliveryId: original.ID,
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusNotFound, err)
+	})
+
+	t.Run("returns forbidden when role doesn't have permission", func(t *testing.T) {
+		t.Parallel()
+
+		repo := newRepositoryStub()
+		existing := repo.addWebhook(theStoreID, true)
+		original := repo.addDelivery(existing.ID)
+
+		s := webhook.NewService(
+			testutil.NewTimeProviderStub(theTime),
+			testutil.NewResourceLocationProviderStubForWebhook(url.URL{}),
+			testutil.NewPermissionProviderStub(theRoleID, []permission.Permission{}, nil),
+			repo,
+			testutil.NewAuditLogStub(),
+		)
+
+		_, err := s.ReplayWebhookDelivery(requestCtx, genapi.ReplayWebhookDeliveryParams{
+			WebhookId:  existing.ID,
+			DeliveryId: original.ID,
+		})
+		testutil.AssertAPIStatusCode(t, http.StatusForbidden, err)
+	})
+}
+
+func newRequestContext(storeID uuid.UUID, roleID uuid.UUID) context.Context {
+	return appcontext.NewContextWithUser(
+		testutil.RequestContextWithLogger(context.Background()),
+		testutil.ModifiedUserDetails(func(details *readmodel.UserDetails) {
+			details.Store.ID = storeID
+			details.Role.ID = roleID
+		}),
+	)
+}
+
+type repositoryStub struct {
+	webhooks   map[uuid.UUID]webhook.Webhook
+	deliveries map[uuid.UUID]webhook.Delivery
+	err        error
+
+	listLimit int
+}
+
+func newRepositoryStub() *repositoryStub {
+	return &repositoryStub{
+		webhooks:   make(map[uuid.UUID]webhook.Webhook),
+		deliveries: make(map[uuid.UUID]webhook.Delivery),
+	}
+}
+
+func (r *repositoryStub) addWebhook(storeID uuid.UUID, enabled bool) webhook.Webhook {
+	w := webhook.Webhook{
+		ID:         uuid.New(),
+		StoreID:    storeID,
+		URL:        "https://example.com/hook",
+		Secret:     "a-very-secret-key",
+		EventTypes: []domain.OrderEventType{domain.OrderEventTypeCreated},
+		Enabled:    enabled,
+	}
+
+	if !enabled {
+		w.ConsecutiveFailures = 20
+		w.DisabledTime = optional.Some(time.Now())
+	}
+
+	r.webhooks[w.ID] = w
+	return w
+}
+
+func (r *repositoryStub) addDelivery(webhookID uuid.UUID) webhook.Delivery {
+	d := webhook.Delivery{
+		ID:        uuid.New(),
+		WebhookID: webhookID,
+		EventID:   uuid.New(),
+		EventType: domain.OrderEventTypeCreated,
+		Payload:   []byte(`{"type":"order_created"}`),
+	}
+
+	r.deliveries[d.ID] = d
+	return d
+}
+
+func (r *repositoryStub) CreateWebhook(_ context.Context, w webhook.Webhook) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.webhooks[w.ID] = w
+	return nil
+}
+
+func (r *repositoryStub) GetWebhooksByStoreID(_ context.Context, storeID uuid.UUID) ([]webhook.Webhook, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	var res []webhook.Webhook
+	for _, w := range r.webhooks {
+		if w.StoreID == storeID {
+			res = append(res, w)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *repositoryStub) GetWebhookByID(_ context.Context, storeID uuid.UUID, webhookID uuid.UUID) (webhook.Webhook, error) {
+	if r.err != nil {
+		return webhook.Webhook{}, r.err
+	}
+
+	w, ok := r.webhooks[webhookID]
+	if !ok || w.StoreID != storeID {
+		return webhook.Webhook{}, apperror.ErrWebhookNotFound
+	}
+
+	return w, nil
+}
+
+func (r *repositoryStub) UpdateWebhook(_ context.Context, w webhook.Webhook) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if existing, ok := r.webhooks[w.ID]; !ok || existing.StoreID != w.StoreID {
+		return apperror.ErrWebhookNotFound
+	}
+
+	r.webhooks[w.ID] = w
+	return nil
+}
+
+func (r *repositoryStub) DeleteWebhook(_ context.Context, storeID uuid.UUID, webhookID uuid.UUID) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	if existing, ok := r.webhooks[webhookID]; !ok || existing.StoreID != storeID {
+		return apperror.ErrWebhookNotFound
+	}
+
+	delete(r.webhooks, webhookID)
+	return nil
+}
+
+func (r *repositoryStub) GetDeliveriesByWebhookID(
+	_ context.Context,
+	webhookID uuid.UUID,
+	limit int,
+) ([]webhook.Delivery, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	r.listLimit = limit
+
+	var res []webhook.Delivery
+	for _, d := range r.deliveries {
+		if d.WebhookID == webhookID {
+			res = append(res, d)
+		}
+	}
+
+	return res, nil
+}
+
+func (r *repositoryStub) GetDeliveryByID(
+	_ context.Context,
+	webhookID uuid.UUID,
+	deliveryID uuid.UUID,
+) (webhook.Delivery, error) {
+	if r.err != nil {
+		return webhook.Delivery{}, r.err
+	}
+
+	d, ok := r.deliveries[deliveryID]
+	if !ok || d.WebhookID != webhookID {
+		return webhook.Delivery{}, apperror.ErrWebhookDeliveryNotFound
+	}
+
+	return d, nil
+}
+
+func (r *repositoryStub) CreateDelivery(_ context.Context, d webhook.Delivery) error {
+	if r.err != nil {
+		return r.err
+	}
+
+	r.deliveries[d.ID] = d
+	return nil
+}
@@ -0,0 +1,87 @@
+package webhook
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"time"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+	"github.com/JosephJoshua/remana-backend/internal/optional"
+	"github.com/google/uuid"
+)
+
+// Headers sent with every delivery. Receivers verify a delivery by computing
+// Sign over the timestamp header and the raw body with their secret.
+const (
+	HeaderEvent     = "X-Remana-Event"
+	HeaderDelivery  = "X-Remana-Delivery"
+	HeaderTimestamp = "X-Remana-Timestamp"
+	HeaderSignature = "X-Remana-Signature"
+)
+
+// Webhook is a subscription of a store integration to order events. An empty
+// EventTypes subscribes it to every event.
+type Webhook struct {
+	ID                  uuid.UUID
+	StoreID             uuid.UUID
+	URL                 string
+	Secret              string
+	EventTypes          []domain.OrderEventType
+	Enabled             bool
+	ConsecutiveFailures int
+	DisabledTime        optional.Optional[time.Time]
+	CreationTime        time.Time
+}
+
+type DeliveryStatus string
+
+const (
+	DeliveryStatusPending   = DeliveryStatus("pending")
+	DeliveryStatusDelivered = DeliveryStatus("delivered")
+	DeliveryStatusFailed    = DeliveryStatus("failed")
+)
+
+// Delivery is a single event sent, or waiting to be sent, to a webhook.
+type Delivery struct {
+	ID             uuid.UUID
+	WebhookID      uuid.UUID
+	EventID        uuid.UUID
+	EventType      domain.OrderEventType
+	Payload        []byte
+	ReplayOf       optional.Optional[uuid.UUID]
+	CreationTime   time.Time
+	Attempts       int
+	LastStatusCode optional.Optional[int]
+	LastError      optional.Optional[string]
+	DeliveredTime  optional.Optional[time.Time]
+	FailedTime     optional.Optional[time.Time]
+}
+
+func (d Delivery) Status() DeliveryStatus {
+	if d.DeliveredTime.IsSet() {
+		return DeliveryStatusDelivered
+	}
+
+	if d.FailedTime.IsSet() {
+		return DeliveryStatusFailed
+	}
+
+	return DeliveryStatusPending
+}
+
+type TimeProvider interface {
+	Now() time.Time
+}
+
+// Sign returns the value of the signature header of a delivery: the hex
+// encoded HMAC-SHA256 of "<unix timestamp>.<body>" keyed with the secret.
+func Sign(secret string, timestamp time.Time, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,43 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EncodeCursor encodes a keyset pagination cursor pointing at the row with the
+// given creation time and ID into an opaque, URL-safe string.
+func EncodeCursor(creationTime time.Time, id uuid.UUID) string {
+	raw := creationTime.UTC().Format(time.RFC3339Nano) + "|" + id.String()
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeCursor reverses EncodeCursor.
+func DecodeCursor(value string) (time.Time, uuid.UUID, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("failed to decode cursor: %w", err)
+	}
+
+	creationTimeStr, idStr, found := strings.Cut(string(raw), "|")
+	if !found {
+		return time.Time{}, uuid.Nil, errors.New("malformed cursor")
+	}
+
+	creationTime, err := time.Parse(time.RFC3339Nano, creationTimeStr)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("failed to parse cursor creation time: %w", err)
+	}
+
+	id, err := uuid.Parse(idStr)
+	if err != nil {
+		return time.Time{}, uuid.Nil, fmt.Errorf("failed to parse cursor ID: %w", err)
+	}
+
+	return creationTime, id, nil
+}
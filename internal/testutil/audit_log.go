@@ -0,0 +1,44 @@
+package testutil
+
+import (
+	"context"
+
+	"github.com/JosephJoshua/remana-backend/internal/modules/audit"
+	"github.com/google/uuid"
+)
+
+type AuditLogStub struct {
+	Changes []audit.Change
+	Entries []audit.Entry
+	err     error
+}
+
+func NewAuditLogStub() *AuditLogStub {
+	return &AuditLogStub{}
+}
+
+func (a *AuditLogStub) SetError(err error) {
+	a.err = err
+}
+
+func (a *AuditLogStub) Record(_ context.Context, change audit.Change) error {
+	if a.err != nil {
+		return a.err
+	}
+
+	a.Changes = append(a.Changes, change)
+	return nil
+}
+
+func (a *AuditLogStub) History(
+	_ context.Context,
+	_ uuid.UUID,
+	_ audit.EntityType,
+	_ uuid.UUID,
+) ([]audit.Entry, error) {
+	if a.err != nil {
+		return nil, a.err
+	}
+
+	return a.Entries, nil
+}
@@ -0,0 +1,36 @@
+package testutil
+
+import (
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/domain"
+)
+
+type LabelRendererStub struct {
+	Orders []domain.Order
+	err    error
+}
+
+func NewLabelRendererStub() *LabelRendererStub {
+	return &LabelRendererStub{}
+}
+
+func (l *LabelRendererStub) SetError(err error) {
+	l.err = err
+}
+
+func (l *LabelRendererStub) RenderPNG(orders []domain.Order) ([]byte, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	l.Orders = orders
+	return []byte("\x89PNG"), nil
+}
+
+func (l *LabelRendererStub) RenderSVG(orders []domain.Order) ([]byte, error) {
+	if l.err != nil {
+		return nil, l.err
+	}
+
+	l.Orders = orders
+	return []byte("<svg></svg>"), nil
+}
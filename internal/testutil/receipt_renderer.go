@@ -0,0 +1,45 @@
+package testutil
+
+import (
+	"github.com/JosephJoshua/remana-backend/internal/modules/repairorder/readmodel"
+)
+
+type ReceiptRendererStub struct {
+	Receipt readmodel.RepairOrderReceipt
+	err     error
+}
+
+func NewReceiptRendererStub() *ReceiptRendererStub {
+	return &ReceiptRendererStub{}
+}
+
+func (r *ReceiptRendererStub) SetError(err error) {
+	r.err = err
+}
+
+func (r *ReceiptRendererStub) RenderHTML(receipt readmodel.RepairOrderReceipt) ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	r.Receipt = receipt
+	return []byte("<html>" + receipt.Order.Slug() + "</html>"), nil
+}
+
+func (r *ReceiptRendererStub) RenderPDF(receipt readmodel.RepairOrderReceipt) ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	r.Receipt = receipt
+	return []byte("%PDF-" + receipt.Order.Slug()), nil
+}
+
+func (r *ReceiptRendererStub) RenderESCPOS(receipt readmodel.RepairOrderReceipt) ([]byte, error) {
+	if r.err != nil {
+		return nil, r.err
+	}
+
+	r.Receipt = receipt
+	return []byte("\x1b@" + receipt.Order.Slug()), nil
+}
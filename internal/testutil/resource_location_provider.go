@@ -16,6 +16,8 @@ type ResourceLocationProviderStub struct {
 	phoneEquipmentLocation url.URL
 	paymentMethodLocation  url.URL
 	roleLocation           url.URL
+	webhookLocation        url.URL
+	photoLocation          url.URL
 
 	RepairOrderID    optional.Optional[uuid.UUID]
 	TechnicianID     optional.Optional[uuid.UUID]
@@ -25,6 +27,8 @@ type ResourceLocationProviderStub struct {
 	PhoneEquipmentID optional.Optional[uuid.UUID]
 	PaymentMethodID  optional.Optional[uuid.UUID]
 	RoleID           optional.Optional[uuid.UUID]
+	WebhookID        optional.Optional[uuid.UUID]
+	PhotoID          optional.Optional[uuid.UUID]
 }
 
 func NewResourceLocationProviderStubForRepairOrder(location url.URL) *ResourceLocationProviderStub {
@@ -83,6 +87,20 @@ func NewResourceLocationProviderStubForRole(location url.URL) *ResourceLocationP
 	}
 }
 
+func NewResourceLocationProviderStubForWebhook(location url.URL) *ResourceLocationProviderStub {
+	return &ResourceLocationProviderStub{
+		webhookLocation: location,
+		WebhookID:       optional.None[uuid.UUID](),
+	}
+}
+
+func NewResourceLocationProviderStubForPhoto(location url.URL) *ResourceLocationProviderStub {
+	return &ResourceLocationProviderStub{
+		photoLocation: location,
+		PhotoID:       optional.None[uuid.UUID](),
+	}
+}
+
 func (r *ResourceLocationProviderStub) RepairOrder(id uuid.UUID) url.URL {
 	r.RepairOrderID = optional.Some(id)
 	return r.repairOrderLocation
@@ -122,3 +140,13 @@ func (r *ResourceLocationProviderStub) Role(id uuid.UUID) url.URL {
 	r.RoleID = optional.Some(id)
 	return r.roleLocation
 }
+
+func (r *ResourceLocationProviderStub) Webhook(id uuid.UUID) url.URL {
+	r.WebhookID = optional.Some(id)
+	return r.webhookLocation
+}
+
+func (r *ResourceLocationProviderStub) Photo(id uuid.UUID) url.URL {
+	r.PhotoID = optional.Some(id)
+	return r.photoLocation
+}
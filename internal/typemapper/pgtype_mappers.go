@@ -63,6 +63,30 @@ func TimeToPgtypeTimestamptz(t time.Time) pgtype.Timestamptz {
 	return pgtype.Timestamptz{Time: t, InfinityModifier: pgtype.Finite, Valid: true}
 }
 
+func PgtypeTextToOptionalString(t pgtype.Text) optional.Optional[string] {
+	if !t.Valid {
+		return optional.None[string]()
+	}
+
+	return optional.Some(t.String)
+}
+
+func PgtypeTimestamptzToOptionalTime(t pgtype.Timestamptz) optional.Optional[time.Time] {
+	if !t.Valid {
+		return optional.None[time.Time]()
+	}
+
+	return optional.Some(t.Time)
+}
+
+func OptionalTimeToPgtypeTimestamptz(t optional.Optional[time.Time]) pgtype.Timestamptz {
+	return pgtype.Timestamptz{
+		Time:             t.GetOrElse(time.Time{}),
+		InfinityModifier: pgtype.Finite,
+		Valid:            t.IsSet(),
+	}
+}
+
 func MustPgtypeUUIDToUUID(id pgtype.UUID) uuid.UUID {
 	uuid, err := PgtypeUUIDToUUID(id)
 	if err != nil {